@@ -2,10 +2,12 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/rilldata/rill/admin/database"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // LookupAlert fetches a alert's spec from a runtime deployment.
@@ -29,3 +31,39 @@ func (s *Service) LookupAlert(ctx context.Context, depl *database.Deployment, al
 
 	return res.Resource.Resource.(*runtimev1.Resource_Alert).Alert.Spec, nil
 }
+
+// AcknowledgeAlert acknowledges an alert's current failure on a runtime deployment.
+func (s *Service) AcknowledgeAlert(ctx context.Context, depl *database.Deployment, alertName, actor string) error {
+	rt, err := s.OpenRuntimeClient(depl)
+	if err != nil {
+		return err
+	}
+	defer rt.Close()
+
+	_, err = rt.AcknowledgeAlert(ctx, &runtimev1.AcknowledgeAlertRequest{
+		InstanceId: depl.RuntimeInstanceID,
+		Name:       alertName,
+		Actor:      actor,
+	})
+	return err
+}
+
+// SnoozeAlert snoozes an alert on a runtime deployment. If until is nil, an existing snooze is cleared.
+func (s *Service) SnoozeAlert(ctx context.Context, depl *database.Deployment, alertName string, until *time.Time, actor string) error {
+	rt, err := s.OpenRuntimeClient(depl)
+	if err != nil {
+		return err
+	}
+	defer rt.Close()
+
+	req := &runtimev1.SnoozeAlertRequest{
+		InstanceId: depl.RuntimeInstanceID,
+		Name:       alertName,
+		Actor:      actor,
+	}
+	if until != nil {
+		req.Until = timestamppb.New(*until)
+	}
+	_, err = rt.SnoozeAlert(ctx, req)
+	return err
+}
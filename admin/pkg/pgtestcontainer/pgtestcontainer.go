@@ -25,7 +25,9 @@ func New(t *testing.T) Container {
 		ContainerRequest: testcontainers.ContainerRequest{
 			Image:        "postgres:14",
 			ExposedPorts: []string{"5432/tcp"},
-			WaitingFor:   wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(15 * time.Second),
+			// Logical replication is used to test change data capture
+			Cmd:        []string{"postgres", "-c", "wal_level=logical"},
+			WaitingFor: wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(15 * time.Second),
 			Env: map[string]string{
 				"POSTGRES_USER":     "postgres",
 				"POSTGRES_PASSWORD": "postgres",
@@ -18,6 +18,7 @@ import (
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -329,6 +330,111 @@ func (s *Server) UnsubscribeAlert(ctx context.Context, req *adminv1.UnsubscribeA
 	return &adminv1.UnsubscribeAlertResponse{}, nil
 }
 
+func (s *Server) AcknowledgeAlert(ctx context.Context, req *adminv1.AcknowledgeAlertRequest) (*adminv1.AcknowledgeAlertResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.name", req.Name),
+	)
+
+	depl, actor, err := s.authorizeAlertStatusChange(ctx, req.Organization, req.Project, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.admin.AcknowledgeAlert(ctx, depl, req.Name, actor)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to acknowledge alert: %s", err.Error())
+	}
+
+	return &adminv1.AcknowledgeAlertResponse{}, nil
+}
+
+func (s *Server) SnoozeAlert(ctx context.Context, req *adminv1.SnoozeAlertRequest) (*adminv1.SnoozeAlertResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.organization", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.name", req.Name),
+	)
+
+	depl, actor, err := s.authorizeAlertStatusChange(ctx, req.Organization, req.Project, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var until *time.Time
+	if req.Until != nil {
+		t := req.Until.AsTime()
+		until = &t
+	}
+
+	err = s.admin.SnoozeAlert(ctx, depl, req.Name, until, actor)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to snooze alert: %s", err.Error())
+	}
+
+	return &adminv1.SnoozeAlertResponse{}, nil
+}
+
+// authorizeAlertStatusChange checks that the caller can acknowledge or snooze an alert.
+// Unlike edits, this is allowed for all alerts (not only UI-managed alerts) and for users who receive the alert's emails.
+// It returns the project's production deployment and an identifier of the caller to record in the alert's state.
+func (s *Server) authorizeAlertStatusChange(ctx context.Context, org, project, name string) (*database.Deployment, string, error) {
+	proj, err := s.admin.DB.FindProjectByName(ctx, org, project)
+	if err != nil {
+		return nil, "", err
+	}
+
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if !permissions.ReadProd {
+		return nil, "", status.Error(codes.PermissionDenied, "does not have permission to read project repo")
+	}
+
+	if proj.ProdDeploymentID == nil {
+		return nil, "", status.Error(codes.FailedPrecondition, "project does not have a production deployment")
+	}
+
+	depl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	spec, err := s.admin.LookupAlert(ctx, depl, name)
+	if err != nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "could not get alert: %s", err.Error())
+	}
+	annotations := parseAlertAnnotations(spec.Annotations)
+
+	var actor string
+	var isRecipient bool
+	if claims.OwnerType() == auth.OwnerTypeUser {
+		user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
+		if err != nil {
+			return nil, "", err
+		}
+		actor = user.Email
+
+		notifiers := slices.Clone(spec.Notifiers)
+		for _, e := range spec.Escalations {
+			notifiers = append(notifiers, e.Notifiers...)
+		}
+		for _, n := range notifiers {
+			if n.Connector == "email" && slices.Contains(pbutil.ToSliceString(n.Properties.AsMap()["recipients"]), user.Email) {
+				isRecipient = true
+				break
+			}
+		}
+	}
+
+	isOwner := claims.OwnerType() == auth.OwnerTypeUser && annotations.AdminOwnerUserID == claims.OwnerID()
+	if !permissions.ManageAlerts && !isOwner && !isRecipient {
+		return nil, "", status.Error(codes.PermissionDenied, "does not have permission to acknowledge or snooze alert")
+	}
+
+	return depl, actor, nil
+}
+
 func (s *Server) DeleteAlert(ctx context.Context, req *adminv1.DeleteAlertRequest) (*adminv1.DeleteAlertResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.organization", req.Organization),
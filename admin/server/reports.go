@@ -24,6 +24,7 @@ import (
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"gopkg.in/yaml.v3"
 )
 
@@ -32,6 +33,7 @@ func (s *Server) GetReportMeta(ctx context.Context, req *adminv1.GetReportMetaRe
 		attribute.String("args.project_id", req.ProjectId),
 		attribute.String("args.branch", req.Branch),
 		attribute.String("args.report", req.Report),
+		attribute.Bool("args.query_for", req.QueryForUserEmail != ""),
 	)
 
 	proj, err := s.admin.DB.FindProject(ctx, req.ProjectId)
@@ -53,10 +55,30 @@ func (s *Server) GetReportMeta(ctx context.Context, req *adminv1.GetReportMetaRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	// Return the attributes of the user to query for. If no user is specified, we fall back to the report's owner (if any).
+	var attrPB *structpb.Struct
+	ownerID := parseReportAnnotations(req.Annotations).AdminOwnerUserID
+	if req.QueryForUserEmail != "" || ownerID != "" {
+		var attr map[string]any
+		if req.QueryForUserEmail != "" {
+			attr, err = s.getAttributesForUser(ctx, proj.OrganizationID, proj.ID, "", req.QueryForUserEmail)
+		} else {
+			attr, err = s.getAttributesForUser(ctx, proj.OrganizationID, proj.ID, ownerID, "")
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		attrPB, err = structpb.NewStruct(attr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return &adminv1.GetReportMetaResponse{
-		OpenUrl:   s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportOpen(org.Name, proj.Name, req.Report, req.ExecutionTime.AsTime()),
-		ExportUrl: s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportExport(org.Name, proj.Name, req.Report),
-		EditUrl:   s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportEdit(org.Name, proj.Name, req.Report),
+		OpenUrl:            s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportOpen(org.Name, proj.Name, req.Report, req.ExecutionTime.AsTime()),
+		ExportUrl:          s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportExport(org.Name, proj.Name, req.Report),
+		EditUrl:            s.admin.URLs.WithCustomDomain(org.CustomDomain).ReportEdit(org.Name, proj.Name, req.Report),
+		QueryForAttributes: attrPB,
 	}, nil
 }
 
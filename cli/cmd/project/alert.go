@@ -0,0 +1,150 @@
+package project
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AlertCmd(ch *cmdutil.Helper) *cobra.Command {
+	alertCmd := &cobra.Command{
+		Use:   "alert",
+		Short: "Acknowledge or snooze alerts",
+	}
+
+	alertCmd.AddCommand(AlertAcknowledgeCmd(ch))
+	alertCmd.AddCommand(AlertSnoozeCmd(ch))
+
+	return alertCmd
+}
+
+func AlertAcknowledgeCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path, alert string
+	var local bool
+
+	ackCmd := &cobra.Command{
+		Use:     "acknowledge [<project>] <alert>",
+		Aliases: []string{"ack"},
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "Acknowledge a failing alert to stop notifications until it recovers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			alert = args[len(args)-1]
+			if len(args) == 2 {
+				project = args[0]
+			}
+			if !local && !cmd.Flags().Changed("project") && len(args) <= 1 && ch.Interactive {
+				var err error
+				project, err = ch.InferProjectName(cmd.Context(), ch.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			rt, instanceID, err := ch.OpenRuntimeClient(cmd.Context(), ch.Org, project, local)
+			if err != nil {
+				return err
+			}
+
+			_, err = rt.AcknowledgeAlert(cmd.Context(), &runtimev1.AcknowledgeAlertRequest{
+				InstanceId: instanceID,
+				Name:       alert,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to acknowledge alert: %w", err)
+			}
+
+			ch.Printf("Acknowledged alert %q. Notifications will resume when it recovers.\n", alert)
+			return nil
+		},
+	}
+
+	ackCmd.Flags().SortFlags = false
+	ackCmd.Flags().StringVar(&project, "project", "", "Project name")
+	ackCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	ackCmd.Flags().BoolVar(&local, "local", false, "Target locally running Rill")
+
+	return ackCmd
+}
+
+func AlertSnoozeCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path, alert, until string
+	var local, unsnooze bool
+	var dur time.Duration
+
+	snoozeCmd := &cobra.Command{
+		Use:   "snooze [<project>] <alert>",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Suppress an alert's notifications for a period of time",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			alert = args[len(args)-1]
+			if len(args) == 2 {
+				project = args[0]
+			}
+			if !local && !cmd.Flags().Changed("project") && len(args) <= 1 && ch.Interactive {
+				var err error
+				project, err = ch.InferProjectName(cmd.Context(), ch.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			// Determine the snooze time
+			var numSet int
+			for _, f := range []string{"for", "until", "clear"} {
+				if cmd.Flags().Changed(f) {
+					numSet++
+				}
+			}
+			if numSet != 1 {
+				return fmt.Errorf("must set exactly one of --for, --until or --clear")
+			}
+			var t *timestamppb.Timestamp
+			if dur > 0 {
+				t = timestamppb.New(time.Now().Add(dur))
+			} else if until != "" {
+				var err error
+				t, err = parseBackfillTime(until)
+				if err != nil {
+					return fmt.Errorf("invalid value for --until: %w", err)
+				}
+			} else if !unsnooze {
+				return fmt.Errorf("the snooze duration must be positive")
+			}
+
+			rt, instanceID, err := ch.OpenRuntimeClient(cmd.Context(), ch.Org, project, local)
+			if err != nil {
+				return err
+			}
+
+			_, err = rt.SnoozeAlert(cmd.Context(), &runtimev1.SnoozeAlertRequest{
+				InstanceId: instanceID,
+				Name:       alert,
+				Until:      t,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to snooze alert: %w", err)
+			}
+
+			if t == nil {
+				ch.Printf("Cleared snooze for alert %q.\n", alert)
+			} else {
+				ch.Printf("Snoozed alert %q until %s.\n", alert, t.AsTime().Local().Format(time.RFC1123))
+			}
+			return nil
+		},
+	}
+
+	snoozeCmd.Flags().SortFlags = false
+	snoozeCmd.Flags().StringVar(&project, "project", "", "Project name")
+	snoozeCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	snoozeCmd.Flags().BoolVar(&local, "local", false, "Target locally running Rill")
+	snoozeCmd.Flags().DurationVar(&dur, "for", 0, "Snooze for a duration (e.g. 2h)")
+	snoozeCmd.Flags().StringVar(&until, "until", "", "Snooze until a time (e.g. 2024-01-02T15:04:05Z or 2024-01-02)")
+	snoozeCmd.Flags().BoolVar(&unsnooze, "clear", false, "Clear an existing snooze")
+
+	return snoozeCmd
+}
@@ -0,0 +1,118 @@
+package project
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func BackfillCmd(ch *cmdutil.Helper) *cobra.Command {
+	var project, path, model, from, to string
+	var local bool
+
+	backfillCmd := &cobra.Command{
+		Use:   "backfill [<project>] <model>",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Re-execute the splits of a model with a watermark in a time range",
+		Long: `Re-execute the splits of a model with a watermark in a time range.
+
+Only the splits with a watermark at or after --from and before --to are executed again.
+The splits are executed with the model's splits_concurrency, and the progress of each split can be inspected with "rill project splits".`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				model = args[0]
+			} else if len(args) == 2 {
+				project = args[0]
+				model = args[1]
+			}
+
+			if from == "" && to == "" {
+				return fmt.Errorf("must specify --from and/or --to")
+			}
+			start, err := parseBackfillTime(from)
+			if err != nil {
+				return fmt.Errorf("invalid --from: %w", err)
+			}
+			end, err := parseBackfillTime(to)
+			if err != nil {
+				return fmt.Errorf("invalid --to: %w", err)
+			}
+			if start != nil && end != nil && !start.AsTime().Before(end.AsTime()) {
+				return fmt.Errorf("--from must be before --to")
+			}
+
+			if !local && !cmd.Flags().Changed("project") && len(args) <= 1 && ch.Interactive {
+				project, err = ch.InferProjectName(cmd.Context(), ch.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			rt, instanceID, err := ch.OpenRuntimeClient(cmd.Context(), ch.Org, project, local)
+			if err != nil {
+				return err
+			}
+
+			// Since it's a common error, do an early check to ensure the model has splits.
+			// (This error will also be logged by the reconciler, but surfacing it here is more user-friendly.)
+			resp, err := rt.GetResource(cmd.Context(), &runtimev1.GetResourceRequest{
+				InstanceId: instanceID,
+				Name:       &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: model},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get model %q: %w", model, err)
+			}
+			m := resp.Resource.GetModel()
+			if !m.Spec.Incremental || m.Spec.SplitsResolver == "" {
+				return fmt.Errorf("can't backfill model %q because it is not an incremental model with splits", model)
+			}
+
+			_, err = rt.CreateTrigger(cmd.Context(), &runtimev1.CreateTriggerRequest{
+				InstanceId: instanceID,
+				Models: []*runtimev1.RefreshModelTrigger{
+					{
+						Model:                model,
+						SplitsWatermarkStart: start,
+						SplitsWatermarkEnd:   end,
+					},
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create trigger: %w", err)
+			}
+
+			ch.Printf("Backfill initiated. To check the progress, run `rill project splits %s --pending`.\n", model)
+
+			return nil
+		},
+	}
+
+	backfillCmd.Flags().SortFlags = false
+	backfillCmd.Flags().StringVar(&project, "project", "", "Project Name")
+	backfillCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	backfillCmd.Flags().StringVar(&from, "from", "", "Backfill splits with a watermark at or after this time (RFC3339 or YYYY-MM-DD)")
+	backfillCmd.Flags().StringVar(&to, "to", "", "Backfill splits with a watermark before this time (RFC3339 or YYYY-MM-DD)")
+	backfillCmd.Flags().BoolVar(&local, "local", false, "Target locally running Rill")
+
+	return backfillCmd
+}
+
+// parseBackfillTime parses a RFC3339 timestamp or a date. It returns nil for an empty string.
+func parseBackfillTime(s string) (*timestamppb.Timestamp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t, err = time.Parse(time.DateOnly, s)
+		if err != nil {
+			return nil, fmt.Errorf("expected a RFC3339 timestamp or a date in the format YYYY-MM-DD, got %q", s)
+		}
+	}
+	return timestamppb.New(t), nil
+}
@@ -25,9 +25,11 @@ func ProjectCmd(ch *cmdutil.Helper) *cobra.Command {
 	projectCmd.AddCommand(DeleteCmd(ch))
 	projectCmd.AddCommand(StatusCmd(ch))
 	projectCmd.AddCommand(SplitsCmd(ch))
+	projectCmd.AddCommand(BackfillCmd(ch))
 	projectCmd.AddCommand(LogsCmd(ch))
 	projectCmd.AddCommand(DescribeCmd(ch))
 	projectCmd.AddCommand(RefreshCmd(ch))
+	projectCmd.AddCommand(AlertCmd(ch))
 	projectCmd.AddCommand(JwtCmd(ch))
 	projectCmd.AddCommand(GitPushCmd(ch))
 	projectCmd.AddCommand(DeployCmd(ch))
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -36,7 +37,10 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/file"
 	_ "github.com/rilldata/rill/runtime/drivers/gcs"
 	_ "github.com/rilldata/rill/runtime/drivers/https"
+	_ "github.com/rilldata/rill/runtime/drivers/kafka"
+	_ "github.com/rilldata/rill/runtime/drivers/mongodb"
 	_ "github.com/rilldata/rill/runtime/drivers/mysql"
+	_ "github.com/rilldata/rill/runtime/drivers/pagerduty"
 	_ "github.com/rilldata/rill/runtime/drivers/pinot"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/redshift"
@@ -45,6 +49,9 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/slack"
 	_ "github.com/rilldata/rill/runtime/drivers/snowflake"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
+	_ "github.com/rilldata/rill/runtime/drivers/starrocks"
+	_ "github.com/rilldata/rill/runtime/drivers/teams"
+	_ "github.com/rilldata/rill/runtime/drivers/webhook"
 	_ "github.com/rilldata/rill/runtime/reconcilers"
 	_ "github.com/rilldata/rill/runtime/resolvers"
 )
@@ -75,7 +82,10 @@ type Config struct {
 	EmailSenderName         string                 `split_words:"true"`
 	EmailBCC                string                 `split_words:"true"`
 	ConnectionCacheSize     int                    `default:"100" split_words:"true"`
-	QueryCacheSizeBytes     int64                  `default:"104857600" split_words:"true"` // 100MB by default
+	QueryCacheSizeBytes     int64                  `default:"104857600" split_words:"true"`  // 100MB by default
+	QueryCacheBackend       string                 `default:"memory" split_words:"true"`     // "memory" or "disk"
+	QueryCacheDiskSizeBytes int64                  `default:"1073741824" split_words:"true"` // 1GB by default
+	QueryCacheTTLSeconds    int                    `default:"300" split_words:"true"`        // 5 minutes by default
 	SecurityEngineCacheSize int                    `default:"1000" split_words:"true"`
 	LogBufferCapacity       int                    `default:"10000" split_words:"true"`    // 10k log lines
 	LogBufferSizeBytes      int64                  `default:"16777216" split_words:"true"` // 16MB by default
@@ -195,6 +205,23 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 				activityClient = activityClient.WithIsDev()
 			}
 
+			// Init query cache backend
+			var queryCacheBackend runtime.QueryCacheBackend
+			switch conf.QueryCacheBackend {
+			case "", "memory":
+				// Uses the default in-memory backend
+			case "disk":
+				if conf.DataDir == "" {
+					logger.Fatal("the disk query cache backend requires a data dir")
+				}
+				queryCacheBackend, err = runtime.NewDiskQueryCacheBackend(filepath.Join(conf.DataDir, "query_cache"), conf.QueryCacheDiskSizeBytes)
+				if err != nil {
+					logger.Fatal("error creating disk query cache backend", zap.Error(err))
+				}
+			default:
+				logger.Fatal("unknown query cache backend", zap.String("backend", conf.QueryCacheBackend))
+			}
+
 			// Create ctx that cancels on termination signals
 			ctx := graceful.WithCancelOnTerminate(context.Background())
 
@@ -203,6 +230,8 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 				ConnectionCacheSize:          conf.ConnectionCacheSize,
 				MetastoreConnector:           "metastore",
 				QueryCacheSizeBytes:          conf.QueryCacheSizeBytes,
+				QueryCacheBackend:            queryCacheBackend,
+				QueryCacheTTL:                time.Duration(conf.QueryCacheTTLSeconds) * time.Second,
 				SecurityEngineCacheSize:      conf.SecurityEngineCacheSize,
 				ControllerLogBufferCapacity:  conf.LogBufferCapacity,
 				ControllerLogBufferSizeBytes: conf.LogBufferSizeBytes,
@@ -128,6 +128,8 @@ const (
 	AdminService_CreateAlert_FullMethodName                           = "/rill.admin.v1.AdminService/CreateAlert"
 	AdminService_EditAlert_FullMethodName                             = "/rill.admin.v1.AdminService/EditAlert"
 	AdminService_UnsubscribeAlert_FullMethodName                      = "/rill.admin.v1.AdminService/UnsubscribeAlert"
+	AdminService_AcknowledgeAlert_FullMethodName                      = "/rill.admin.v1.AdminService/AcknowledgeAlert"
+	AdminService_SnoozeAlert_FullMethodName                           = "/rill.admin.v1.AdminService/SnoozeAlert"
 	AdminService_DeleteAlert_FullMethodName                           = "/rill.admin.v1.AdminService/DeleteAlert"
 	AdminService_GenerateAlertYAML_FullMethodName                     = "/rill.admin.v1.AdminService/GenerateAlertYAML"
 	AdminService_GetAlertYAML_FullMethodName                          = "/rill.admin.v1.AdminService/GetAlertYAML"
@@ -378,6 +380,10 @@ type AdminServiceClient interface {
 	EditAlert(ctx context.Context, in *EditAlertRequest, opts ...grpc.CallOption) (*EditAlertResponse, error)
 	// UnsubscribeAlert removes the calling user from a alert's recipients list
 	UnsubscribeAlert(ctx context.Context, in *UnsubscribeAlertRequest, opts ...grpc.CallOption) (*UnsubscribeAlertResponse, error)
+	// AcknowledgeAlert acknowledges an alert's current failure, which suppresses further notifications until the alert recovers
+	AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error)
+	// SnoozeAlert suppresses an alert's notifications until a specific time
+	SnoozeAlert(ctx context.Context, in *SnoozeAlertRequest, opts ...grpc.CallOption) (*SnoozeAlertResponse, error)
 	// DeleteAlert deletes the virtual file for a UI-managed alert, triggers a reconcile, and waits for the alert to be deleted in the runtime
 	DeleteAlert(ctx context.Context, in *DeleteAlertRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error)
 	// GenerateAlertYAML generates YAML for an alert to be copied into a project's Git repository
@@ -1502,6 +1508,26 @@ func (c *adminServiceClient) UnsubscribeAlert(ctx context.Context, in *Unsubscri
 	return out, nil
 }
 
+func (c *adminServiceClient) AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeAlertResponse)
+	err := c.cc.Invoke(ctx, AdminService_AcknowledgeAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SnoozeAlert(ctx context.Context, in *SnoozeAlertRequest, opts ...grpc.CallOption) (*SnoozeAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnoozeAlertResponse)
+	err := c.cc.Invoke(ctx, AdminService_SnoozeAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DeleteAlert(ctx context.Context, in *DeleteAlertRequest, opts ...grpc.CallOption) (*DeleteAlertResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteAlertResponse)
@@ -1876,6 +1902,10 @@ type AdminServiceServer interface {
 	EditAlert(context.Context, *EditAlertRequest) (*EditAlertResponse, error)
 	// UnsubscribeAlert removes the calling user from a alert's recipients list
 	UnsubscribeAlert(context.Context, *UnsubscribeAlertRequest) (*UnsubscribeAlertResponse, error)
+	// AcknowledgeAlert acknowledges an alert's current failure, which suppresses further notifications until the alert recovers
+	AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error)
+	// SnoozeAlert suppresses an alert's notifications until a specific time
+	SnoozeAlert(context.Context, *SnoozeAlertRequest) (*SnoozeAlertResponse, error)
 	// DeleteAlert deletes the virtual file for a UI-managed alert, triggers a reconcile, and waits for the alert to be deleted in the runtime
 	DeleteAlert(context.Context, *DeleteAlertRequest) (*DeleteAlertResponse, error)
 	// GenerateAlertYAML generates YAML for an alert to be copied into a project's Git repository
@@ -2237,6 +2267,12 @@ func (UnimplementedAdminServiceServer) EditAlert(context.Context, *EditAlertRequ
 func (UnimplementedAdminServiceServer) UnsubscribeAlert(context.Context, *UnsubscribeAlertRequest) (*UnsubscribeAlertResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsubscribeAlert not implemented")
 }
+func (UnimplementedAdminServiceServer) AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeAlert not implemented")
+}
+func (UnimplementedAdminServiceServer) SnoozeAlert(context.Context, *SnoozeAlertRequest) (*SnoozeAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeAlert not implemented")
+}
 func (UnimplementedAdminServiceServer) DeleteAlert(context.Context, *DeleteAlertRequest) (*DeleteAlertResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlert not implemented")
 }
@@ -4262,6 +4298,42 @@ func _AdminService_UnsubscribeAlert_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_AcknowledgeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AcknowledgeAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AcknowledgeAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AcknowledgeAlert(ctx, req.(*AcknowledgeAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SnoozeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SnoozeAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SnoozeAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SnoozeAlert(ctx, req.(*SnoozeAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DeleteAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteAlertRequest)
 	if err := dec(in); err != nil {
@@ -4957,6 +5029,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnsubscribeAlert",
 			Handler:    _AdminService_UnsubscribeAlert_Handler,
 		},
+		{
+			MethodName: "AcknowledgeAlert",
+			Handler:    _AdminService_AcknowledgeAlert_Handler,
+		},
+		{
+			MethodName: "SnoozeAlert",
+			Handler:    _AdminService_SnoozeAlert_Handler,
+		},
 		{
 			MethodName: "DeleteAlert",
 			Handler:    _AdminService_DeleteAlert_Handler,
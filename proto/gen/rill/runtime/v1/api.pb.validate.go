@@ -8307,6 +8307,455 @@ var _ interface {
 	ErrorName() string
 } = CreateTriggerResponseValidationError{}
 
+// Validate checks the field values on AcknowledgeAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcknowledgeAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcknowledgeAlertRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcknowledgeAlertRequestMultiError, or nil if none found.
+func (m *AcknowledgeAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcknowledgeAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Name
+
+	// no validation rules for Actor
+
+	if len(errors) > 0 {
+		return AcknowledgeAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcknowledgeAlertRequestMultiError is an error wrapping multiple validation
+// errors returned by AcknowledgeAlertRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcknowledgeAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcknowledgeAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcknowledgeAlertRequestMultiError) AllErrors() []error { return m }
+
+// AcknowledgeAlertRequestValidationError is the validation error returned by
+// AcknowledgeAlertRequest.Validate if the designated constraints aren't met.
+type AcknowledgeAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcknowledgeAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcknowledgeAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcknowledgeAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcknowledgeAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcknowledgeAlertRequestValidationError) ErrorName() string {
+	return "AcknowledgeAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcknowledgeAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcknowledgeAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcknowledgeAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcknowledgeAlertRequestValidationError{}
+
+// Validate checks the field values on AcknowledgeAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcknowledgeAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcknowledgeAlertResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcknowledgeAlertResponseMultiError, or nil if none found.
+func (m *AcknowledgeAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcknowledgeAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AcknowledgeAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcknowledgeAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by AcknowledgeAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AcknowledgeAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcknowledgeAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcknowledgeAlertResponseMultiError) AllErrors() []error { return m }
+
+// AcknowledgeAlertResponseValidationError is the validation error returned by
+// AcknowledgeAlertResponse.Validate if the designated constraints aren't met.
+type AcknowledgeAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcknowledgeAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcknowledgeAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcknowledgeAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcknowledgeAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcknowledgeAlertResponseValidationError) ErrorName() string {
+	return "AcknowledgeAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcknowledgeAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcknowledgeAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcknowledgeAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcknowledgeAlertResponseValidationError{}
+
+// Validate checks the field values on SnoozeAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SnoozeAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SnoozeAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SnoozeAlertRequestMultiError, or nil if none found.
+func (m *SnoozeAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SnoozeAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SnoozeAlertRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SnoozeAlertRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SnoozeAlertRequestValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Actor
+
+	if len(errors) > 0 {
+		return SnoozeAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SnoozeAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by SnoozeAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SnoozeAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SnoozeAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SnoozeAlertRequestMultiError) AllErrors() []error { return m }
+
+// SnoozeAlertRequestValidationError is the validation error returned by
+// SnoozeAlertRequest.Validate if the designated constraints aren't met.
+type SnoozeAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SnoozeAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SnoozeAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SnoozeAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SnoozeAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SnoozeAlertRequestValidationError) ErrorName() string {
+	return "SnoozeAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SnoozeAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSnoozeAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SnoozeAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SnoozeAlertRequestValidationError{}
+
+// Validate checks the field values on SnoozeAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SnoozeAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SnoozeAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SnoozeAlertResponseMultiError, or nil if none found.
+func (m *SnoozeAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SnoozeAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SnoozeAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SnoozeAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by SnoozeAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SnoozeAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SnoozeAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SnoozeAlertResponseMultiError) AllErrors() []error { return m }
+
+// SnoozeAlertResponseValidationError is the validation error returned by
+// SnoozeAlertResponse.Validate if the designated constraints aren't met.
+type SnoozeAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SnoozeAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SnoozeAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SnoozeAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SnoozeAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SnoozeAlertResponseValidationError) ErrorName() string {
+	return "SnoozeAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SnoozeAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSnoozeAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SnoozeAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SnoozeAlertResponseValidationError{}
+
 // Validate checks the field values on ConnectorDriver with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -48,6 +48,8 @@ const (
 	RuntimeService_GetExplore_FullMethodName              = "/rill.runtime.v1.RuntimeService/GetExplore"
 	RuntimeService_GetModelSplits_FullMethodName          = "/rill.runtime.v1.RuntimeService/GetModelSplits"
 	RuntimeService_CreateTrigger_FullMethodName           = "/rill.runtime.v1.RuntimeService/CreateTrigger"
+	RuntimeService_AcknowledgeAlert_FullMethodName        = "/rill.runtime.v1.RuntimeService/AcknowledgeAlert"
+	RuntimeService_SnoozeAlert_FullMethodName             = "/rill.runtime.v1.RuntimeService/SnoozeAlert"
 	RuntimeService_ListConnectorDrivers_FullMethodName    = "/rill.runtime.v1.RuntimeService/ListConnectorDrivers"
 	RuntimeService_AnalyzeConnectors_FullMethodName       = "/rill.runtime.v1.RuntimeService/AnalyzeConnectors"
 	RuntimeService_ListNotifierConnectors_FullMethodName  = "/rill.runtime.v1.RuntimeService/ListNotifierConnectors"
@@ -121,6 +123,10 @@ type RuntimeServiceClient interface {
 	// CreateTrigger submits a refresh trigger, which will asynchronously refresh the specified resources.
 	// Triggers are ephemeral resources that will be cleaned up by the controller.
 	CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error)
+	// AcknowledgeAlert acknowledges an alert's current failure, which suppresses further notifications until the alert recovers.
+	AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error)
+	// SnoozeAlert suppresses an alert's notifications until a specific time.
+	SnoozeAlert(ctx context.Context, in *SnoozeAlertRequest, opts ...grpc.CallOption) (*SnoozeAlertResponse, error)
 	// ListConnectorDrivers returns a description of all the connector drivers registed in the runtime,
 	// including their configuration specs and the capabilities they support.
 	ListConnectorDrivers(ctx context.Context, in *ListConnectorDriversRequest, opts ...grpc.CallOption) (*ListConnectorDriversResponse, error)
@@ -458,6 +464,26 @@ func (c *runtimeServiceClient) CreateTrigger(ctx context.Context, in *CreateTrig
 	return out, nil
 }
 
+func (c *runtimeServiceClient) AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeAlertResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_AcknowledgeAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) SnoozeAlert(ctx context.Context, in *SnoozeAlertRequest, opts ...grpc.CallOption) (*SnoozeAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnoozeAlertResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_SnoozeAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListConnectorDrivers(ctx context.Context, in *ListConnectorDriversRequest, opts ...grpc.CallOption) (*ListConnectorDriversResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListConnectorDriversResponse)
@@ -565,6 +591,10 @@ type RuntimeServiceServer interface {
 	// CreateTrigger submits a refresh trigger, which will asynchronously refresh the specified resources.
 	// Triggers are ephemeral resources that will be cleaned up by the controller.
 	CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error)
+	// AcknowledgeAlert acknowledges an alert's current failure, which suppresses further notifications until the alert recovers.
+	AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error)
+	// SnoozeAlert suppresses an alert's notifications until a specific time.
+	SnoozeAlert(context.Context, *SnoozeAlertRequest) (*SnoozeAlertResponse, error)
 	// ListConnectorDrivers returns a description of all the connector drivers registed in the runtime,
 	// including their configuration specs and the capabilities they support.
 	ListConnectorDrivers(context.Context, *ListConnectorDriversRequest) (*ListConnectorDriversResponse, error)
@@ -672,6 +702,12 @@ func (UnimplementedRuntimeServiceServer) GetModelSplits(context.Context, *GetMod
 func (UnimplementedRuntimeServiceServer) CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTrigger not implemented")
 }
+func (UnimplementedRuntimeServiceServer) AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeAlert not implemented")
+}
+func (UnimplementedRuntimeServiceServer) SnoozeAlert(context.Context, *SnoozeAlertRequest) (*SnoozeAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeAlert not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListConnectorDrivers(context.Context, *ListConnectorDriversRequest) (*ListConnectorDriversResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListConnectorDrivers not implemented")
 }
@@ -1206,6 +1242,42 @@ func _RuntimeService_CreateTrigger_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_AcknowledgeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).AcknowledgeAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_AcknowledgeAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).AcknowledgeAlert(ctx, req.(*AcknowledgeAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_SnoozeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).SnoozeAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_SnoozeAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).SnoozeAlert(ctx, req.(*SnoozeAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListConnectorDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListConnectorDriversRequest)
 	if err := dec(in); err != nil {
@@ -1389,6 +1461,14 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTrigger",
 			Handler:    _RuntimeService_CreateTrigger_Handler,
 		},
+		{
+			MethodName: "AcknowledgeAlert",
+			Handler:    _RuntimeService_AcknowledgeAlert_Handler,
+		},
+		{
+			MethodName: "SnoozeAlert",
+			Handler:    _RuntimeService_SnoozeAlert_Handler,
+		},
 		{
 			MethodName: "ListConnectorDrivers",
 			Handler:    _RuntimeService_ListConnectorDrivers_Handler,
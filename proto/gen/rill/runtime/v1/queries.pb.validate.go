@@ -3321,6 +3321,93 @@ func (m *MetricsViewAggregationDimension) validate(all bool) error {
 
 	// no validation rules for Alias
 
+	switch v := m.Compute.(type) {
+	case *MetricsViewAggregationDimension_Cohort:
+		if v == nil {
+			err := MetricsViewAggregationDimensionValidationError{
+				field:  "Compute",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCohort()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationDimensionValidationError{
+						field:  "Cohort",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationDimensionValidationError{
+						field:  "Cohort",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCohort()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationDimensionValidationError{
+					field:  "Cohort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetricsViewAggregationDimension_CohortOffset:
+		if v == nil {
+			err := MetricsViewAggregationDimensionValidationError{
+				field:  "Compute",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCohortOffset()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationDimensionValidationError{
+						field:  "CohortOffset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationDimensionValidationError{
+						field:  "CohortOffset",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCohortOffset()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationDimensionValidationError{
+					field:  "CohortOffset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewAggregationDimensionMultiError(errors)
 	}
@@ -3402,6 +3489,235 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewAggregationDimensionValidationError{}
 
+// Validate checks the field values on
+// MetricsViewAggregationDimensionComputeCohort with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewAggregationDimensionComputeCohort) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// MetricsViewAggregationDimensionComputeCohort with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// MetricsViewAggregationDimensionComputeCohortMultiError, or nil if none found.
+func (m *MetricsViewAggregationDimensionComputeCohort) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewAggregationDimensionComputeCohort) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Dimension
+
+	// no validation rules for TimeGrain
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationDimensionComputeCohortMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewAggregationDimensionComputeCohortMultiError is an error wrapping
+// multiple validation errors returned by
+// MetricsViewAggregationDimensionComputeCohort.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewAggregationDimensionComputeCohortMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewAggregationDimensionComputeCohortMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewAggregationDimensionComputeCohortMultiError) AllErrors() []error { return m }
+
+// MetricsViewAggregationDimensionComputeCohortValidationError is the
+// validation error returned by
+// MetricsViewAggregationDimensionComputeCohort.Validate if the designated
+// constraints aren't met.
+type MetricsViewAggregationDimensionComputeCohortValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewAggregationDimensionComputeCohortValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewAggregationDimensionComputeCohortValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewAggregationDimensionComputeCohortValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewAggregationDimensionComputeCohortValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewAggregationDimensionComputeCohortValidationError) ErrorName() string {
+	return "MetricsViewAggregationDimensionComputeCohortValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewAggregationDimensionComputeCohortValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewAggregationDimensionComputeCohort.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewAggregationDimensionComputeCohortValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewAggregationDimensionComputeCohortValidationError{}
+
+// Validate checks the field values on
+// MetricsViewAggregationDimensionComputeCohortOffset with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewAggregationDimensionComputeCohortOffset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// MetricsViewAggregationDimensionComputeCohortOffset with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// MetricsViewAggregationDimensionComputeCohortOffsetMultiError, or nil if
+// none found.
+func (m *MetricsViewAggregationDimensionComputeCohortOffset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewAggregationDimensionComputeCohortOffset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Dimension
+
+	// no validation rules for TimeGrain
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationDimensionComputeCohortOffsetMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewAggregationDimensionComputeCohortOffsetMultiError is an error
+// wrapping multiple validation errors returned by
+// MetricsViewAggregationDimensionComputeCohortOffset.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewAggregationDimensionComputeCohortOffsetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewAggregationDimensionComputeCohortOffsetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewAggregationDimensionComputeCohortOffsetMultiError) AllErrors() []error { return m }
+
+// MetricsViewAggregationDimensionComputeCohortOffsetValidationError is the
+// validation error returned by
+// MetricsViewAggregationDimensionComputeCohortOffset.Validate if the
+// designated constraints aren't met.
+type MetricsViewAggregationDimensionComputeCohortOffsetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewAggregationDimensionComputeCohortOffsetValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e MetricsViewAggregationDimensionComputeCohortOffsetValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e MetricsViewAggregationDimensionComputeCohortOffsetValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e MetricsViewAggregationDimensionComputeCohortOffsetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewAggregationDimensionComputeCohortOffsetValidationError) ErrorName() string {
+	return "MetricsViewAggregationDimensionComputeCohortOffsetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewAggregationDimensionComputeCohortOffsetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewAggregationDimensionComputeCohortOffset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewAggregationDimensionComputeCohortOffsetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewAggregationDimensionComputeCohortOffsetValidationError{}
+
 // Validate checks the field values on MetricsViewAggregationMeasure with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -3779,16 +4095,180 @@ func (m *MetricsViewAggregationMeasure) validate(all bool) error {
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return MetricsViewAggregationMeasureMultiError(errors)
-	}
-
-	return nil
-}
+	case *MetricsViewAggregationMeasure_Retention:
+		if v == nil {
+			err := MetricsViewAggregationMeasureValidationError{
+				field:  "Compute",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRetention()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  "Retention",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  "Retention",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationMeasureValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetricsViewAggregationMeasure_RunningTotal:
+		if v == nil {
+			err := MetricsViewAggregationMeasureValidationError{
+				field:  "Compute",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRunningTotal()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  "RunningTotal",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  "RunningTotal",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRunningTotal()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationMeasureValidationError{
+					field:  "RunningTotal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetricsViewAggregationMeasure_MovingAverage:
+		if v == nil {
+			err := MetricsViewAggregationMeasureValidationError{
+				field:  "Compute",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMovingAverage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  "MovingAverage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  "MovingAverage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMovingAverage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationMeasureValidationError{
+					field:  "MovingAverage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MetricsViewAggregationMeasure_PeriodToDate:
+		if v == nil {
+			err := MetricsViewAggregationMeasureValidationError{
+				field:  "Compute",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPeriodToDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  "PeriodToDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewAggregationMeasureValidationError{
+						field:  "PeriodToDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPeriodToDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewAggregationMeasureValidationError{
+					field:  "PeriodToDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationMeasureMultiError(errors)
+	}
+
+	return nil
+}
 
 // MetricsViewAggregationMeasureMultiError is an error wrapping multiple
 // validation errors returned by MetricsViewAggregationMeasure.ValidateAll()
@@ -4657,6 +5137,466 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewAggregationMeasureComputeURIValidationError{}
 
+// Validate checks the field values on
+// MetricsViewAggregationMeasureComputeRetention with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewAggregationMeasureComputeRetention) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// MetricsViewAggregationMeasureComputeRetention with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// MetricsViewAggregationMeasureComputeRetentionMultiError, or nil if none found.
+func (m *MetricsViewAggregationMeasureComputeRetention) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewAggregationMeasureComputeRetention) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Measure
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationMeasureComputeRetentionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewAggregationMeasureComputeRetentionMultiError is an error wrapping
+// multiple validation errors returned by
+// MetricsViewAggregationMeasureComputeRetention.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewAggregationMeasureComputeRetentionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewAggregationMeasureComputeRetentionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewAggregationMeasureComputeRetentionMultiError) AllErrors() []error { return m }
+
+// MetricsViewAggregationMeasureComputeRetentionValidationError is the
+// validation error returned by
+// MetricsViewAggregationMeasureComputeRetention.Validate if the designated
+// constraints aren't met.
+type MetricsViewAggregationMeasureComputeRetentionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewAggregationMeasureComputeRetentionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewAggregationMeasureComputeRetentionValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e MetricsViewAggregationMeasureComputeRetentionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewAggregationMeasureComputeRetentionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewAggregationMeasureComputeRetentionValidationError) ErrorName() string {
+	return "MetricsViewAggregationMeasureComputeRetentionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewAggregationMeasureComputeRetentionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewAggregationMeasureComputeRetention.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewAggregationMeasureComputeRetentionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewAggregationMeasureComputeRetentionValidationError{}
+
+// Validate checks the field values on
+// MetricsViewAggregationMeasureComputeRunningTotal with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewAggregationMeasureComputeRunningTotal) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// MetricsViewAggregationMeasureComputeRunningTotal with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// MetricsViewAggregationMeasureComputeRunningTotalMultiError, or nil if none found.
+func (m *MetricsViewAggregationMeasureComputeRunningTotal) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewAggregationMeasureComputeRunningTotal) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Measure
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationMeasureComputeRunningTotalMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewAggregationMeasureComputeRunningTotalMultiError is an error
+// wrapping multiple validation errors returned by
+// MetricsViewAggregationMeasureComputeRunningTotal.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewAggregationMeasureComputeRunningTotalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewAggregationMeasureComputeRunningTotalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewAggregationMeasureComputeRunningTotalMultiError) AllErrors() []error { return m }
+
+// MetricsViewAggregationMeasureComputeRunningTotalValidationError is the
+// validation error returned by
+// MetricsViewAggregationMeasureComputeRunningTotal.Validate if the designated
+// constraints aren't met.
+type MetricsViewAggregationMeasureComputeRunningTotalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewAggregationMeasureComputeRunningTotalValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e MetricsViewAggregationMeasureComputeRunningTotalValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e MetricsViewAggregationMeasureComputeRunningTotalValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e MetricsViewAggregationMeasureComputeRunningTotalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewAggregationMeasureComputeRunningTotalValidationError) ErrorName() string {
+	return "MetricsViewAggregationMeasureComputeRunningTotalValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewAggregationMeasureComputeRunningTotalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewAggregationMeasureComputeRunningTotal.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewAggregationMeasureComputeRunningTotalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewAggregationMeasureComputeRunningTotalValidationError{}
+
+// Validate checks the field values on
+// MetricsViewAggregationMeasureComputeMovingAverage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewAggregationMeasureComputeMovingAverage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// MetricsViewAggregationMeasureComputeMovingAverage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// MetricsViewAggregationMeasureComputeMovingAverageMultiError, or nil if none found.
+func (m *MetricsViewAggregationMeasureComputeMovingAverage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewAggregationMeasureComputeMovingAverage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Measure
+
+	// no validation rules for Periods
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationMeasureComputeMovingAverageMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewAggregationMeasureComputeMovingAverageMultiError is an error
+// wrapping multiple validation errors returned by
+// MetricsViewAggregationMeasureComputeMovingAverage.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewAggregationMeasureComputeMovingAverageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewAggregationMeasureComputeMovingAverageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewAggregationMeasureComputeMovingAverageMultiError) AllErrors() []error { return m }
+
+// MetricsViewAggregationMeasureComputeMovingAverageValidationError is the
+// validation error returned by
+// MetricsViewAggregationMeasureComputeMovingAverage.Validate if the
+// designated constraints aren't met.
+type MetricsViewAggregationMeasureComputeMovingAverageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewAggregationMeasureComputeMovingAverageValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e MetricsViewAggregationMeasureComputeMovingAverageValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e MetricsViewAggregationMeasureComputeMovingAverageValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e MetricsViewAggregationMeasureComputeMovingAverageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewAggregationMeasureComputeMovingAverageValidationError) ErrorName() string {
+	return "MetricsViewAggregationMeasureComputeMovingAverageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewAggregationMeasureComputeMovingAverageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewAggregationMeasureComputeMovingAverage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewAggregationMeasureComputeMovingAverageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewAggregationMeasureComputeMovingAverageValidationError{}
+
+// Validate checks the field values on
+// MetricsViewAggregationMeasureComputePeriodToDate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewAggregationMeasureComputePeriodToDate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// MetricsViewAggregationMeasureComputePeriodToDate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// MetricsViewAggregationMeasureComputePeriodToDateMultiError, or nil if none found.
+func (m *MetricsViewAggregationMeasureComputePeriodToDate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewAggregationMeasureComputePeriodToDate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Measure
+
+	// no validation rules for TimeGrain
+
+	if len(errors) > 0 {
+		return MetricsViewAggregationMeasureComputePeriodToDateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewAggregationMeasureComputePeriodToDateMultiError is an error
+// wrapping multiple validation errors returned by
+// MetricsViewAggregationMeasureComputePeriodToDate.ValidateAll() if the
+// designated constraints aren't met.
+type MetricsViewAggregationMeasureComputePeriodToDateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewAggregationMeasureComputePeriodToDateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewAggregationMeasureComputePeriodToDateMultiError) AllErrors() []error { return m }
+
+// MetricsViewAggregationMeasureComputePeriodToDateValidationError is the
+// validation error returned by
+// MetricsViewAggregationMeasureComputePeriodToDate.Validate if the designated
+// constraints aren't met.
+type MetricsViewAggregationMeasureComputePeriodToDateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewAggregationMeasureComputePeriodToDateValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e MetricsViewAggregationMeasureComputePeriodToDateValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e MetricsViewAggregationMeasureComputePeriodToDateValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e MetricsViewAggregationMeasureComputePeriodToDateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewAggregationMeasureComputePeriodToDateValidationError) ErrorName() string {
+	return "MetricsViewAggregationMeasureComputePeriodToDateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewAggregationMeasureComputePeriodToDateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewAggregationMeasureComputePeriodToDate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewAggregationMeasureComputePeriodToDateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewAggregationMeasureComputePeriodToDateValidationError{}
+
 // Validate checks the field values on MetricsViewAggregationSort with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2550,6 +2550,69 @@ func (m *ModelSpec) validate(all bool) error {
 
 	// no validation rules for TriggerFull
 
+	for idx, item := range m.GetTests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelSpecValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelSpecValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelSpecValidationError{
+					field:  fmt.Sprintf("Tests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelSpecValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ModelSpecMultiError(errors)
 	}
@@ -2627,171 +2690,48 @@ var _ interface {
 	ErrorName() string
 } = ModelSpecValidationError{}
 
-// Validate checks the field values on ModelState with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ModelSnapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ModelState) Validate() error {
+func (m *ModelSnapshot) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ModelState with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ModelStateMultiError, or
+// ValidateAll checks the field values on ModelSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelSnapshotMultiError, or
 // nil if none found.
-func (m *ModelState) ValidateAll() error {
+func (m *ModelSnapshot) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ModelState) validate(all bool) error {
+func (m *ModelSnapshot) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ExecutorConnector
-
-	// no validation rules for ResultConnector
-
-	if all {
-		switch v := interface{}(m.GetResultProperties()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ModelStateValidationError{
-					field:  "ResultProperties",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ModelStateValidationError{
-					field:  "ResultProperties",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResultProperties()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ModelStateValidationError{
-				field:  "ResultProperties",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for ResultTable
-
-	// no validation rules for SpecHash
-
-	// no validation rules for RefsHash
-
-	if all {
-		switch v := interface{}(m.GetRefreshedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ModelStateValidationError{
-					field:  "RefreshedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ModelStateValidationError{
-					field:  "RefreshedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRefreshedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ModelStateValidationError{
-				field:  "RefreshedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetIncrementalState()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ModelStateValidationError{
-					field:  "IncrementalState",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ModelStateValidationError{
-					field:  "IncrementalState",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetIncrementalState()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ModelStateValidationError{
-				field:  "IncrementalState",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetIncrementalStateSchema()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ModelStateValidationError{
-					field:  "IncrementalStateSchema",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ModelStateValidationError{
-					field:  "IncrementalStateSchema",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetIncrementalStateSchema()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ModelStateValidationError{
-				field:  "IncrementalStateSchema",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Strategy
 
-	// no validation rules for SplitsModelId
+	// no validation rules for UpdatedAt
 
-	// no validation rules for SplitsHaveErrors
+	// no validation rules for InvalidateHardDeletes
 
 	if len(errors) > 0 {
-		return ModelStateMultiError(errors)
+		return ModelSnapshotMultiError(errors)
 	}
 
 	return nil
 }
 
-// ModelStateMultiError is an error wrapping multiple validation errors
-// returned by ModelState.ValidateAll() if the designated constraints aren't met.
-type ModelStateMultiError []error
+// ModelSnapshotMultiError is an error wrapping multiple validation errors
+// returned by ModelSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type ModelSnapshotMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ModelStateMultiError) Error() string {
+func (m ModelSnapshotMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2800,11 +2740,11 @@ func (m ModelStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ModelStateMultiError) AllErrors() []error { return m }
+func (m ModelSnapshotMultiError) AllErrors() []error { return m }
 
-// ModelStateValidationError is the validation error returned by
-// ModelState.Validate if the designated constraints aren't met.
-type ModelStateValidationError struct {
+// ModelSnapshotValidationError is the validation error returned by
+// ModelSnapshot.Validate if the designated constraints aren't met.
+type ModelSnapshotValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2812,22 +2752,22 @@ type ModelStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e ModelStateValidationError) Field() string { return e.field }
+func (e ModelSnapshotValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ModelStateValidationError) Reason() string { return e.reason }
+func (e ModelSnapshotValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ModelStateValidationError) Cause() error { return e.cause }
+func (e ModelSnapshotValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ModelStateValidationError) Key() bool { return e.key }
+func (e ModelSnapshotValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ModelStateValidationError) ErrorName() string { return "ModelStateValidationError" }
+func (e ModelSnapshotValidationError) ErrorName() string { return "ModelSnapshotValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ModelStateValidationError) Error() string {
+func (e ModelSnapshotValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2839,14 +2779,14 @@ func (e ModelStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sModelState.%s: %s%s",
+		"invalid %sModelSnapshot.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ModelStateValidationError{}
+var _ error = ModelSnapshotValidationError{}
 
 var _ interface {
 	Field() string
@@ -2854,53 +2794,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ModelStateValidationError{}
+} = ModelSnapshotValidationError{}
 
-// Validate checks the field values on MetricsViewV2 with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ModelTest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewV2) Validate() error {
+func (m *ModelTest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewV2 with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MetricsViewV2MultiError, or
-// nil if none found.
-func (m *MetricsViewV2) ValidateAll() error {
+// ValidateAll checks the field values on ModelTest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelTestMultiError, or nil
+// if none found.
+func (m *ModelTest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewV2) validate(all bool) error {
+func (m *ModelTest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
 	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
+		switch v := interface{}(m.GetAcceptedValues()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "Spec",
+				errors = append(errors, ModelTestValidationError{
+					field:  "AcceptedValues",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "Spec",
+				errors = append(errors, ModelTestValidationError{
+					field:  "AcceptedValues",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAcceptedValues()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewV2ValidationError{
-				field:  "Spec",
+			return ModelTestValidationError{
+				field:  "AcceptedValues",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2908,48 +2850,51 @@ func (m *MetricsViewV2) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetState()).(type) {
+		switch v := interface{}(m.GetRowCount()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "State",
+				errors = append(errors, ModelTestValidationError{
+					field:  "RowCount",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "State",
+				errors = append(errors, ModelTestValidationError{
+					field:  "RowCount",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRowCount()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewV2ValidationError{
-				field:  "State",
+			return ModelTestValidationError{
+				field:  "RowCount",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Sql
+
+	// no validation rules for Block
+
 	if len(errors) > 0 {
-		return MetricsViewV2MultiError(errors)
+		return ModelTestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewV2MultiError is an error wrapping multiple validation errors
-// returned by MetricsViewV2.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewV2MultiError []error
+// ModelTestMultiError is an error wrapping multiple validation errors returned
+// by ModelTest.ValidateAll() if the designated constraints aren't met.
+type ModelTestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewV2MultiError) Error() string {
+func (m ModelTestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2958,11 +2903,11 @@ func (m MetricsViewV2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewV2MultiError) AllErrors() []error { return m }
+func (m ModelTestMultiError) AllErrors() []error { return m }
 
-// MetricsViewV2ValidationError is the validation error returned by
-// MetricsViewV2.Validate if the designated constraints aren't met.
-type MetricsViewV2ValidationError struct {
+// ModelTestValidationError is the validation error returned by
+// ModelTest.Validate if the designated constraints aren't met.
+type ModelTestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2970,22 +2915,22 @@ type MetricsViewV2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewV2ValidationError) Field() string { return e.field }
+func (e ModelTestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewV2ValidationError) Reason() string { return e.reason }
+func (e ModelTestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewV2ValidationError) Cause() error { return e.cause }
+func (e ModelTestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewV2ValidationError) Key() bool { return e.key }
+func (e ModelTestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewV2ValidationError) ErrorName() string { return "MetricsViewV2ValidationError" }
+func (e ModelTestValidationError) ErrorName() string { return "ModelTestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewV2ValidationError) Error() string {
+func (e ModelTestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2997,14 +2942,14 @@ func (e MetricsViewV2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewV2.%s: %s%s",
+		"invalid %sModelTest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewV2ValidationError{}
+var _ error = ModelTestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3012,181 +2957,177 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewV2ValidationError{}
+} = ModelTestValidationError{}
 
-// Validate checks the field values on MetricsViewSpec with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSpec) Validate() error {
+// Validate checks the field values on ModelState with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModelState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSpec with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewSpecMultiError, or nil if none found.
-func (m *MetricsViewSpec) ValidateAll() error {
+// ValidateAll checks the field values on ModelState with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelStateMultiError, or
+// nil if none found.
+func (m *ModelState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSpec) validate(all bool) error {
+func (m *ModelState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
-
-	// no validation rules for Table
-
-	// no validation rules for Model
-
-	// no validation rules for Title
+	// no validation rules for ExecutorConnector
 
-	// no validation rules for Description
+	// no validation rules for ResultConnector
 
-	// no validation rules for TimeDimension
+	if all {
+		switch v := interface{}(m.GetResultProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "ResultProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "ResultProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResultProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelStateValidationError{
+				field:  "ResultProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for SmallestTimeGrain
+	// no validation rules for ResultTable
 
-	// no validation rules for WatermarkExpression
+	// no validation rules for SpecHash
 
-	for idx, item := range m.GetDimensions() {
-		_, _ = idx, item
+	// no validation rules for RefsHash
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Dimensions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Dimensions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetRefreshedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "RefreshedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("Dimensions[%v]", idx),
+				errors = append(errors, ModelStateValidationError{
+					field:  "RefreshedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelStateValidationError{
+				field:  "RefreshedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	for idx, item := range m.GetMeasures() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Measures[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Measures[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetIncrementalState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "IncrementalState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("Measures[%v]", idx),
+				errors = append(errors, ModelStateValidationError{
+					field:  "IncrementalState",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIncrementalState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelStateValidationError{
+				field:  "IncrementalState",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	for idx, item := range m.GetSecurityRules() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("SecurityRules[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("SecurityRules[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetIncrementalStateSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelStateValidationError{
+					field:  "IncrementalStateSchema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("SecurityRules[%v]", idx),
+				errors = append(errors, ModelStateValidationError{
+					field:  "IncrementalStateSchema",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIncrementalStateSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelStateValidationError{
+				field:  "IncrementalStateSchema",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for FirstDayOfWeek
-
-	// no validation rules for FirstMonthOfYear
-
-	// no validation rules for DefaultTimeRange
-
-	// no validation rules for DefaultComparisonMode
-
-	// no validation rules for DefaultComparisonDimension
+	// no validation rules for SplitsModelId
 
-	// no validation rules for DefaultTheme
+	// no validation rules for SplitsHaveErrors
 
-	for idx, item := range m.GetAvailableTimeRanges() {
+	for idx, item := range m.GetTestResults() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("TestResults[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("TestResults[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3194,8 +3135,8 @@ func (m *MetricsViewSpec) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+				return ModelStateValidationError{
+					field:  fmt.Sprintf("TestResults[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3205,19 +3146,18 @@ func (m *MetricsViewSpec) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewSpecMultiError(errors)
+		return ModelStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewSpecMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewSpec.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewSpecMultiError []error
+// ModelStateMultiError is an error wrapping multiple validation errors
+// returned by ModelState.ValidateAll() if the designated constraints aren't met.
+type ModelStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSpecMultiError) Error() string {
+func (m ModelStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3226,11 +3166,11 @@ func (m MetricsViewSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewSpecMultiError) AllErrors() []error { return m }
+func (m ModelStateMultiError) AllErrors() []error { return m }
 
-// MetricsViewSpecValidationError is the validation error returned by
-// MetricsViewSpec.Validate if the designated constraints aren't met.
-type MetricsViewSpecValidationError struct {
+// ModelStateValidationError is the validation error returned by
+// ModelState.Validate if the designated constraints aren't met.
+type ModelStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3238,22 +3178,22 @@ type MetricsViewSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSpecValidationError) Field() string { return e.field }
+func (e ModelStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSpecValidationError) Reason() string { return e.reason }
+func (e ModelStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSpecValidationError) Cause() error { return e.cause }
+func (e ModelStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSpecValidationError) Key() bool { return e.key }
+func (e ModelStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSpecValidationError) ErrorName() string { return "MetricsViewSpecValidationError" }
+func (e ModelStateValidationError) ErrorName() string { return "ModelStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSpecValidationError) Error() string {
+func (e ModelStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3265,14 +3205,14 @@ func (e MetricsViewSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSpec.%s: %s%s",
+		"invalid %sModelState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSpecValidationError{}
+var _ error = ModelStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -3280,171 +3220,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSpecValidationError{}
+} = ModelStateValidationError{}
 
-// Validate checks the field values on SecurityRule with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SecurityRule) Validate() error {
+// Validate checks the field values on ModelTestResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ModelTestResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecurityRule with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SecurityRuleMultiError, or
-// nil if none found.
-func (m *SecurityRule) ValidateAll() error {
+// ValidateAll checks the field values on ModelTestResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModelTestResultMultiError, or nil if none found.
+func (m *ModelTestResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecurityRule) validate(all bool) error {
+func (m *ModelTestResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Rule.(type) {
-	case *SecurityRule_Access:
-		if v == nil {
-			err := SecurityRuleValidationError{
-				field:  "Rule",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetAccess()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "Access",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "Access",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetAccess()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SecurityRuleValidationError{
-					field:  "Access",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *SecurityRule_FieldAccess:
-		if v == nil {
-			err := SecurityRuleValidationError{
-				field:  "Rule",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetFieldAccess()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "FieldAccess",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "FieldAccess",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetFieldAccess()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SecurityRuleValidationError{
-					field:  "FieldAccess",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *SecurityRule_RowFilter:
-		if v == nil {
-			err := SecurityRuleValidationError{
-				field:  "Rule",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Name
 
-		if all {
-			switch v := interface{}(m.GetRowFilter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "RowFilter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SecurityRuleValidationError{
-						field:  "RowFilter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetRowFilter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SecurityRuleValidationError{
-					field:  "RowFilter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for FailedRows
 
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Error
 
 	if len(errors) > 0 {
-		return SecurityRuleMultiError(errors)
+		return ModelTestResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecurityRuleMultiError is an error wrapping multiple validation errors
-// returned by SecurityRule.ValidateAll() if the designated constraints aren't met.
-type SecurityRuleMultiError []error
+// ModelTestResultMultiError is an error wrapping multiple validation errors
+// returned by ModelTestResult.ValidateAll() if the designated constraints
+// aren't met.
+type ModelTestResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecurityRuleMultiError) Error() string {
+func (m ModelTestResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3453,11 +3272,11 @@ func (m SecurityRuleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecurityRuleMultiError) AllErrors() []error { return m }
+func (m ModelTestResultMultiError) AllErrors() []error { return m }
 
-// SecurityRuleValidationError is the validation error returned by
-// SecurityRule.Validate if the designated constraints aren't met.
-type SecurityRuleValidationError struct {
+// ModelTestResultValidationError is the validation error returned by
+// ModelTestResult.Validate if the designated constraints aren't met.
+type ModelTestResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3465,22 +3284,22 @@ type SecurityRuleValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecurityRuleValidationError) Field() string { return e.field }
+func (e ModelTestResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecurityRuleValidationError) Reason() string { return e.reason }
+func (e ModelTestResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecurityRuleValidationError) Cause() error { return e.cause }
+func (e ModelTestResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecurityRuleValidationError) Key() bool { return e.key }
+func (e ModelTestResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecurityRuleValidationError) ErrorName() string { return "SecurityRuleValidationError" }
+func (e ModelTestResultValidationError) ErrorName() string { return "ModelTestResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SecurityRuleValidationError) Error() string {
+func (e ModelTestResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3492,14 +3311,14 @@ func (e SecurityRuleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecurityRule.%s: %s%s",
+		"invalid %sModelTestResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecurityRuleValidationError{}
+var _ error = ModelTestResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -3507,45 +3326,732 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecurityRuleValidationError{}
+} = ModelTestResultValidationError{}
 
-// Validate checks the field values on SecurityRuleAccess with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SecurityRuleAccess) Validate() error {
+// Validate checks the field values on MetricsViewV2 with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewV2) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecurityRuleAccess with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SecurityRuleAccessMultiError, or nil if none found.
-func (m *SecurityRuleAccess) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewV2 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricsViewV2MultiError, or
+// nil if none found.
+func (m *MetricsViewV2) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecurityRuleAccess) validate(all bool) error {
+func (m *MetricsViewV2) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Condition
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewV2ValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Allow
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewV2ValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return SecurityRuleAccessMultiError(errors)
+		return MetricsViewV2MultiError(errors)
 	}
 
 	return nil
 }
 
-// SecurityRuleAccessMultiError is an error wrapping multiple validation errors
-// returned by SecurityRuleAccess.ValidateAll() if the designated constraints
+// MetricsViewV2MultiError is an error wrapping multiple validation errors
+// returned by MetricsViewV2.ValidateAll() if the designated constraints
 // aren't met.
-type SecurityRuleAccessMultiError []error
+type MetricsViewV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewV2MultiError) AllErrors() []error { return m }
+
+// MetricsViewV2ValidationError is the validation error returned by
+// MetricsViewV2.Validate if the designated constraints aren't met.
+type MetricsViewV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewV2ValidationError) ErrorName() string { return "MetricsViewV2ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsViewV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewV2ValidationError{}
+
+// Validate checks the field values on MetricsViewSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpecMultiError, or nil if none found.
+func (m *MetricsViewSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	// no validation rules for Table
+
+	// no validation rules for Model
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for TimeDimension
+
+	// no validation rules for SmallestTimeGrain
+
+	// no validation rules for WatermarkExpression
+
+	for idx, item := range m.GetDimensions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Dimensions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Dimensions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Dimensions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMeasures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Measures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSecurityRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("SecurityRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("SecurityRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("SecurityRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FirstDayOfWeek
+
+	// no validation rules for FirstMonthOfYear
+
+	for idx, item := range m.GetRollups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Rollups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Rollups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Rollups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DefaultTimeRange
+
+	// no validation rules for DefaultComparisonMode
+
+	// no validation rules for DefaultComparisonDimension
+
+	// no validation rules for DefaultTheme
+
+	for idx, item := range m.GetAvailableTimeRanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsViewSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpecMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewSpec.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpecMultiError) AllErrors() []error { return m }
+
+// MetricsViewSpecValidationError is the validation error returned by
+// MetricsViewSpec.Validate if the designated constraints aren't met.
+type MetricsViewSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpecValidationError) ErrorName() string { return "MetricsViewSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpecValidationError{}
+
+// Validate checks the field values on SecurityRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecurityRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecurityRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecurityRuleMultiError, or
+// nil if none found.
+func (m *SecurityRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecurityRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Rule.(type) {
+	case *SecurityRule_Access:
+		if v == nil {
+			err := SecurityRuleValidationError{
+				field:  "Rule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAccess()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "Access",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "Access",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccess()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecurityRuleValidationError{
+					field:  "Access",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SecurityRule_FieldAccess:
+		if v == nil {
+			err := SecurityRuleValidationError{
+				field:  "Rule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFieldAccess()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "FieldAccess",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "FieldAccess",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFieldAccess()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecurityRuleValidationError{
+					field:  "FieldAccess",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SecurityRule_RowFilter:
+		if v == nil {
+			err := SecurityRuleValidationError{
+				field:  "Rule",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRowFilter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "RowFilter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecurityRuleValidationError{
+						field:  "RowFilter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRowFilter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecurityRuleValidationError{
+					field:  "RowFilter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SecurityRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecurityRuleMultiError is an error wrapping multiple validation errors
+// returned by SecurityRule.ValidateAll() if the designated constraints aren't met.
+type SecurityRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecurityRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecurityRuleMultiError) AllErrors() []error { return m }
+
+// SecurityRuleValidationError is the validation error returned by
+// SecurityRule.Validate if the designated constraints aren't met.
+type SecurityRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecurityRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecurityRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityRuleValidationError) ErrorName() string { return "SecurityRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecurityRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecurityRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityRuleValidationError{}
+
+// Validate checks the field values on SecurityRuleAccess with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SecurityRuleAccess) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecurityRuleAccess with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecurityRuleAccessMultiError, or nil if none found.
+func (m *SecurityRuleAccess) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecurityRuleAccess) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Condition
+
+	// no validation rules for Allow
+
+	if len(errors) > 0 {
+		return SecurityRuleAccessMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecurityRuleAccessMultiError is an error wrapping multiple validation errors
+// returned by SecurityRuleAccess.ValidateAll() if the designated constraints
+// aren't met.
+type SecurityRuleAccessMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m SecurityRuleAccessMultiError) Error() string {
@@ -5855,6 +6361,45 @@ func (m *ReportSpec) validate(all bool) error {
 
 	// no validation rules for IntervalsCheckUnclosed
 
+	// no validation rules for ExportAttach
+
+	// no validation rules for ExportAttachMaxBytes
+
+	// no validation rules for ExportPreviewRows
+
+	// no validation rules for BurstResolver
+
+	if all {
+		switch v := interface{}(m.GetBurstResolverProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "BurstResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "BurstResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBurstResolverProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "BurstResolverProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BurstRecipientField
+
 	if len(errors) > 0 {
 		return ReportSpecMultiError(errors)
 	}
@@ -6580,9 +7125,43 @@ func (m *AlertSpec) validate(all bool) error {
 
 	// no validation rules for Renotify
 
-	// no validation rules for RenotifyAfterSeconds
+	// no validation rules for RenotifyAfterSeconds
+
+	for idx, item := range m.GetNotifiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AlertSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AlertSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertSpecValidationError{
+					field:  fmt.Sprintf("Notifiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
-	for idx, item := range m.GetNotifiers() {
+	for idx, item := range m.GetEscalations() {
 		_, _ = idx, item
 
 		if all {
@@ -6590,7 +7169,7 @@ func (m *AlertSpec) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, AlertSpecValidationError{
-						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						field:  fmt.Sprintf("Escalations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -6598,7 +7177,7 @@ func (m *AlertSpec) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, AlertSpecValidationError{
-						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						field:  fmt.Sprintf("Escalations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -6607,7 +7186,7 @@ func (m *AlertSpec) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return AlertSpecValidationError{
-					field:  fmt.Sprintf("Notifiers[%v]", idx),
+					field:  fmt.Sprintf("Escalations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -6616,6 +7195,35 @@ func (m *AlertSpec) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetStatusChange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "StatusChange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "StatusChange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatusChange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertSpecValidationError{
+				field:  "StatusChange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	// no validation rules for Annotations
 
 	switch v := m.QueryFor.(type) {
@@ -6689,18 +7297,289 @@ func (m *AlertSpec) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AlertSpecMultiError(errors)
+		return AlertSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertSpecMultiError is an error wrapping multiple validation errors returned
+// by AlertSpec.ValidateAll() if the designated constraints aren't met.
+type AlertSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertSpecMultiError) AllErrors() []error { return m }
+
+// AlertSpecValidationError is the validation error returned by
+// AlertSpec.Validate if the designated constraints aren't met.
+type AlertSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertSpecValidationError) ErrorName() string { return "AlertSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertSpecValidationError{}
+
+// Validate checks the field values on AlertEscalation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AlertEscalation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertEscalation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AlertEscalationMultiError, or nil if none found.
+func (m *AlertEscalation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertEscalation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AfterExecutions
+
+	for idx, item := range m.GetNotifiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AlertEscalationValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AlertEscalationValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertEscalationValidationError{
+					field:  fmt.Sprintf("Notifiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AlertEscalationMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlertEscalationMultiError is an error wrapping multiple validation errors
+// returned by AlertEscalation.ValidateAll() if the designated constraints
+// aren't met.
+type AlertEscalationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlertEscalationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlertEscalationMultiError) AllErrors() []error { return m }
+
+// AlertEscalationValidationError is the validation error returned by
+// AlertEscalation.Validate if the designated constraints aren't met.
+type AlertEscalationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertEscalationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertEscalationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertEscalationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertEscalationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertEscalationValidationError) ErrorName() string { return "AlertEscalationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertEscalationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlertEscalation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertEscalationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertEscalationValidationError{}
+
+// Validate checks the field values on AlertStatusChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AlertStatusChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlertStatusChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AlertStatusChangeMultiError, or nil if none found.
+func (m *AlertStatusChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlertStatusChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Acknowledge
+
+	if all {
+		switch v := interface{}(m.GetSnoozeUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertStatusChangeValidationError{
+					field:  "SnoozeUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertStatusChangeValidationError{
+					field:  "SnoozeUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnoozeUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertStatusChangeValidationError{
+				field:  "SnoozeUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Unsnooze
+
+	// no validation rules for Actor
+
+	if len(errors) > 0 {
+		return AlertStatusChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// AlertSpecMultiError is an error wrapping multiple validation errors returned
-// by AlertSpec.ValidateAll() if the designated constraints aren't met.
-type AlertSpecMultiError []error
+// AlertStatusChangeMultiError is an error wrapping multiple validation errors
+// returned by AlertStatusChange.ValidateAll() if the designated constraints
+// aren't met.
+type AlertStatusChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AlertSpecMultiError) Error() string {
+func (m AlertStatusChangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6709,11 +7588,11 @@ func (m AlertSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AlertSpecMultiError) AllErrors() []error { return m }
+func (m AlertStatusChangeMultiError) AllErrors() []error { return m }
 
-// AlertSpecValidationError is the validation error returned by
-// AlertSpec.Validate if the designated constraints aren't met.
-type AlertSpecValidationError struct {
+// AlertStatusChangeValidationError is the validation error returned by
+// AlertStatusChange.Validate if the designated constraints aren't met.
+type AlertStatusChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6721,22 +7600,24 @@ type AlertSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e AlertSpecValidationError) Field() string { return e.field }
+func (e AlertStatusChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AlertSpecValidationError) Reason() string { return e.reason }
+func (e AlertStatusChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AlertSpecValidationError) Cause() error { return e.cause }
+func (e AlertStatusChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AlertSpecValidationError) Key() bool { return e.key }
+func (e AlertStatusChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AlertSpecValidationError) ErrorName() string { return "AlertSpecValidationError" }
+func (e AlertStatusChangeValidationError) ErrorName() string {
+	return "AlertStatusChangeValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AlertSpecValidationError) Error() string {
+func (e AlertStatusChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6748,14 +7629,14 @@ func (e AlertSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAlertSpec.%s: %s%s",
+		"invalid %sAlertStatusChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AlertSpecValidationError{}
+var _ error = AlertStatusChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -6763,7 +7644,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AlertSpecValidationError{}
+} = AlertStatusChangeValidationError{}
 
 // Validate checks the field values on Notifier with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -7015,6 +7896,72 @@ func (m *AlertState) validate(all bool) error {
 
 	// no validation rules for ExecutionCount
 
+	// no validation rules for ConsecutiveFailures
+
+	// no validation rules for EscalationLevel
+
+	if all {
+		switch v := interface{}(m.GetAcknowledgedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "AcknowledgedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "AcknowledgedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAcknowledgedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertStateValidationError{
+				field:  "AcknowledgedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AcknowledgedBy
+
+	if all {
+		switch v := interface{}(m.GetSnoozedUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "SnoozedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "SnoozedUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnoozedUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertStateValidationError{
+				field:  "SnoozedUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SnoozedBy
+
 	if len(errors) > 0 {
 		return AlertStateMultiError(errors)
 	}
@@ -7263,6 +8210,8 @@ func (m *AlertExecution) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Silenced
+
 	if len(errors) > 0 {
 		return AlertExecutionMultiError(errors)
 	}
@@ -8079,6 +9028,40 @@ func (m *RefreshTriggerSpec) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetAlerts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefreshTriggerSpecValidationError{
+						field:  fmt.Sprintf("Alerts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefreshTriggerSpecValidationError{
+						field:  fmt.Sprintf("Alerts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RefreshTriggerSpecValidationError{
+					field:  fmt.Sprintf("Alerts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return RefreshTriggerSpecMultiError(errors)
 	}
@@ -8261,48 +9244,239 @@ var _ interface {
 	ErrorName() string
 } = RefreshTriggerStateValidationError{}
 
-// Validate checks the field values on RefreshModelTrigger with the rules
+// Validate checks the field values on RefreshModelTrigger with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshModelTrigger) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshModelTrigger with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshModelTriggerMultiError, or nil if none found.
+func (m *RefreshModelTrigger) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshModelTrigger) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Model
+
+	// no validation rules for Full
+
+	// no validation rules for AllErroredSplits
+
+	if all {
+		switch v := interface{}(m.GetSplitsWatermarkStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefreshModelTriggerValidationError{
+					field:  "SplitsWatermarkStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefreshModelTriggerValidationError{
+					field:  "SplitsWatermarkStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSplitsWatermarkStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefreshModelTriggerValidationError{
+				field:  "SplitsWatermarkStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSplitsWatermarkEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefreshModelTriggerValidationError{
+					field:  "SplitsWatermarkEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefreshModelTriggerValidationError{
+					field:  "SplitsWatermarkEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSplitsWatermarkEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefreshModelTriggerValidationError{
+				field:  "SplitsWatermarkEnd",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RefreshModelTriggerMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshModelTriggerMultiError is an error wrapping multiple validation
+// errors returned by RefreshModelTrigger.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshModelTriggerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshModelTriggerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshModelTriggerMultiError) AllErrors() []error { return m }
+
+// RefreshModelTriggerValidationError is the validation error returned by
+// RefreshModelTrigger.Validate if the designated constraints aren't met.
+type RefreshModelTriggerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshModelTriggerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshModelTriggerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshModelTriggerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshModelTriggerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshModelTriggerValidationError) ErrorName() string {
+	return "RefreshModelTriggerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshModelTriggerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshModelTrigger.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshModelTriggerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshModelTriggerValidationError{}
+
+// Validate checks the field values on RefreshAlertTrigger with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RefreshModelTrigger) Validate() error {
+func (m *RefreshAlertTrigger) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefreshModelTrigger with the rules
+// ValidateAll checks the field values on RefreshAlertTrigger with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RefreshModelTriggerMultiError, or nil if none found.
-func (m *RefreshModelTrigger) ValidateAll() error {
+// RefreshAlertTriggerMultiError, or nil if none found.
+func (m *RefreshAlertTrigger) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefreshModelTrigger) validate(all bool) error {
+func (m *RefreshAlertTrigger) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Model
-
-	// no validation rules for Full
+	// no validation rules for Alert
 
-	// no validation rules for AllErroredSplits
+	if all {
+		switch v := interface{}(m.GetStatusChange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefreshAlertTriggerValidationError{
+					field:  "StatusChange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefreshAlertTriggerValidationError{
+					field:  "StatusChange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatusChange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefreshAlertTriggerValidationError{
+				field:  "StatusChange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RefreshModelTriggerMultiError(errors)
+		return RefreshAlertTriggerMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefreshModelTriggerMultiError is an error wrapping multiple validation
-// errors returned by RefreshModelTrigger.ValidateAll() if the designated
+// RefreshAlertTriggerMultiError is an error wrapping multiple validation
+// errors returned by RefreshAlertTrigger.ValidateAll() if the designated
 // constraints aren't met.
-type RefreshModelTriggerMultiError []error
+type RefreshAlertTriggerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefreshModelTriggerMultiError) Error() string {
+func (m RefreshAlertTriggerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8311,11 +9485,11 @@ func (m RefreshModelTriggerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefreshModelTriggerMultiError) AllErrors() []error { return m }
+func (m RefreshAlertTriggerMultiError) AllErrors() []error { return m }
 
-// RefreshModelTriggerValidationError is the validation error returned by
-// RefreshModelTrigger.Validate if the designated constraints aren't met.
-type RefreshModelTriggerValidationError struct {
+// RefreshAlertTriggerValidationError is the validation error returned by
+// RefreshAlertTrigger.Validate if the designated constraints aren't met.
+type RefreshAlertTriggerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8323,24 +9497,24 @@ type RefreshModelTriggerValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefreshModelTriggerValidationError) Field() string { return e.field }
+func (e RefreshAlertTriggerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefreshModelTriggerValidationError) Reason() string { return e.reason }
+func (e RefreshAlertTriggerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefreshModelTriggerValidationError) Cause() error { return e.cause }
+func (e RefreshAlertTriggerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefreshModelTriggerValidationError) Key() bool { return e.key }
+func (e RefreshAlertTriggerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefreshModelTriggerValidationError) ErrorName() string {
-	return "RefreshModelTriggerValidationError"
+func (e RefreshAlertTriggerValidationError) ErrorName() string {
+	return "RefreshAlertTriggerValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RefreshModelTriggerValidationError) Error() string {
+func (e RefreshAlertTriggerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8352,14 +9526,14 @@ func (e RefreshModelTriggerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefreshModelTrigger.%s: %s%s",
+		"invalid %sRefreshAlertTrigger.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefreshModelTriggerValidationError{}
+var _ error = RefreshAlertTriggerValidationError{}
 
 var _ interface {
 	Field() string
@@ -8367,7 +9541,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefreshModelTriggerValidationError{}
+} = RefreshAlertTriggerValidationError{}
 
 // Validate checks the field values on BucketPlanner with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -11357,39 +12531,242 @@ func (m *DependencyError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DependencyError with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DependencyErrorMultiError, or nil if none found.
-func (m *DependencyError) ValidateAll() error {
+// ValidateAll checks the field values on DependencyError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DependencyErrorMultiError, or nil if none found.
+func (m *DependencyError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DependencyError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for Dependency
+
+	if len(errors) > 0 {
+		return DependencyErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// DependencyErrorMultiError is an error wrapping multiple validation errors
+// returned by DependencyError.ValidateAll() if the designated constraints
+// aren't met.
+type DependencyErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DependencyErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DependencyErrorMultiError) AllErrors() []error { return m }
+
+// DependencyErrorValidationError is the validation error returned by
+// DependencyError.Validate if the designated constraints aren't met.
+type DependencyErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DependencyErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DependencyErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DependencyErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DependencyErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DependencyErrorValidationError) ErrorName() string { return "DependencyErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DependencyErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDependencyError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DependencyErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DependencyErrorValidationError{}
+
+// Validate checks the field values on ExecutionError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExecutionError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecutionError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExecutionErrorMultiError,
+// or nil if none found.
+func (m *ExecutionError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecutionError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ExecutionErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecutionErrorMultiError is an error wrapping multiple validation errors
+// returned by ExecutionError.ValidateAll() if the designated constraints
+// aren't met.
+type ExecutionErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecutionErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecutionErrorMultiError) AllErrors() []error { return m }
+
+// ExecutionErrorValidationError is the validation error returned by
+// ExecutionError.Validate if the designated constraints aren't met.
+type ExecutionErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecutionErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecutionErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecutionErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecutionErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecutionErrorValidationError) ErrorName() string { return "ExecutionErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecutionErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecutionError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecutionErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecutionErrorValidationError{}
+
+// Validate checks the field values on CharLocation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CharLocation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CharLocation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CharLocationMultiError, or
+// nil if none found.
+func (m *CharLocation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DependencyError) validate(all bool) error {
+func (m *CharLocation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
-
-	// no validation rules for Dependency
+	// no validation rules for Line
 
 	if len(errors) > 0 {
-		return DependencyErrorMultiError(errors)
+		return CharLocationMultiError(errors)
 	}
 
 	return nil
 }
 
-// DependencyErrorMultiError is an error wrapping multiple validation errors
-// returned by DependencyError.ValidateAll() if the designated constraints
-// aren't met.
-type DependencyErrorMultiError []error
+// CharLocationMultiError is an error wrapping multiple validation errors
+// returned by CharLocation.ValidateAll() if the designated constraints aren't met.
+type CharLocationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DependencyErrorMultiError) Error() string {
+func (m CharLocationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11398,11 +12775,11 @@ func (m DependencyErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DependencyErrorMultiError) AllErrors() []error { return m }
+func (m CharLocationMultiError) AllErrors() []error { return m }
 
-// DependencyErrorValidationError is the validation error returned by
-// DependencyError.Validate if the designated constraints aren't met.
-type DependencyErrorValidationError struct {
+// CharLocationValidationError is the validation error returned by
+// CharLocation.Validate if the designated constraints aren't met.
+type CharLocationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11410,22 +12787,22 @@ type DependencyErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e DependencyErrorValidationError) Field() string { return e.field }
+func (e CharLocationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DependencyErrorValidationError) Reason() string { return e.reason }
+func (e CharLocationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DependencyErrorValidationError) Cause() error { return e.cause }
+func (e CharLocationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DependencyErrorValidationError) Key() bool { return e.key }
+func (e CharLocationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DependencyErrorValidationError) ErrorName() string { return "DependencyErrorValidationError" }
+func (e CharLocationValidationError) ErrorName() string { return "CharLocationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DependencyErrorValidationError) Error() string {
+func (e CharLocationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11437,14 +12814,14 @@ func (e DependencyErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDependencyError.%s: %s%s",
+		"invalid %sCharLocation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DependencyErrorValidationError{}
+var _ error = CharLocationValidationError{}
 
 var _ interface {
 	Field() string
@@ -11452,46 +12829,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DependencyErrorValidationError{}
+} = CharLocationValidationError{}
 
-// Validate checks the field values on ExecutionError with the rules defined in
+// Validate checks the field values on ConnectorSpec with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ExecutionError) Validate() error {
+func (m *ConnectorSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExecutionError with the rules defined
+// ValidateAll checks the field values on ConnectorSpec with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExecutionErrorMultiError,
-// or nil if none found.
-func (m *ExecutionError) ValidateAll() error {
+// result is a list of violation errors wrapped in ConnectorSpecMultiError, or
+// nil if none found.
+func (m *ConnectorSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExecutionError) validate(all bool) error {
+func (m *ConnectorSpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for Driver
+
+	// no validation rules for Properties
+
+	// no validation rules for PropertiesFromVariables
 
 	if len(errors) > 0 {
-		return ExecutionErrorMultiError(errors)
+		return ConnectorSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExecutionErrorMultiError is an error wrapping multiple validation errors
-// returned by ExecutionError.ValidateAll() if the designated constraints
+// ConnectorSpecMultiError is an error wrapping multiple validation errors
+// returned by ConnectorSpec.ValidateAll() if the designated constraints
 // aren't met.
-type ExecutionErrorMultiError []error
+type ConnectorSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExecutionErrorMultiError) Error() string {
+func (m ConnectorSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11500,11 +12881,11 @@ func (m ExecutionErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExecutionErrorMultiError) AllErrors() []error { return m }
+func (m ConnectorSpecMultiError) AllErrors() []error { return m }
 
-// ExecutionErrorValidationError is the validation error returned by
-// ExecutionError.Validate if the designated constraints aren't met.
-type ExecutionErrorValidationError struct {
+// ConnectorSpecValidationError is the validation error returned by
+// ConnectorSpec.Validate if the designated constraints aren't met.
+type ConnectorSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11512,22 +12893,22 @@ type ExecutionErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExecutionErrorValidationError) Field() string { return e.field }
+func (e ConnectorSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExecutionErrorValidationError) Reason() string { return e.reason }
+func (e ConnectorSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExecutionErrorValidationError) Cause() error { return e.cause }
+func (e ConnectorSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExecutionErrorValidationError) Key() bool { return e.key }
+func (e ConnectorSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExecutionErrorValidationError) ErrorName() string { return "ExecutionErrorValidationError" }
+func (e ConnectorSpecValidationError) ErrorName() string { return "ConnectorSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExecutionErrorValidationError) Error() string {
+func (e ConnectorSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11539,14 +12920,14 @@ func (e ExecutionErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExecutionError.%s: %s%s",
+		"invalid %sConnectorSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExecutionErrorValidationError{}
+var _ error = ConnectorSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -11554,45 +12935,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExecutionErrorValidationError{}
+} = ConnectorSpecValidationError{}
 
-// Validate checks the field values on CharLocation with the rules defined in
+// Validate checks the field values on ConnectorState with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CharLocation) Validate() error {
+func (m *ConnectorState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CharLocation with the rules defined
+// ValidateAll checks the field values on ConnectorState with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CharLocationMultiError, or
-// nil if none found.
-func (m *CharLocation) ValidateAll() error {
+// result is a list of violation errors wrapped in ConnectorStateMultiError,
+// or nil if none found.
+func (m *ConnectorState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CharLocation) validate(all bool) error {
+func (m *ConnectorState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Line
+	// no validation rules for SpecHash
 
 	if len(errors) > 0 {
-		return CharLocationMultiError(errors)
+		return ConnectorStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// CharLocationMultiError is an error wrapping multiple validation errors
-// returned by CharLocation.ValidateAll() if the designated constraints aren't met.
-type CharLocationMultiError []error
+// ConnectorStateMultiError is an error wrapping multiple validation errors
+// returned by ConnectorState.ValidateAll() if the designated constraints
+// aren't met.
+type ConnectorStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CharLocationMultiError) Error() string {
+func (m ConnectorStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11601,11 +12983,11 @@ func (m CharLocationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CharLocationMultiError) AllErrors() []error { return m }
+func (m ConnectorStateMultiError) AllErrors() []error { return m }
 
-// CharLocationValidationError is the validation error returned by
-// CharLocation.Validate if the designated constraints aren't met.
-type CharLocationValidationError struct {
+// ConnectorStateValidationError is the validation error returned by
+// ConnectorState.Validate if the designated constraints aren't met.
+type ConnectorStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11613,22 +12995,22 @@ type CharLocationValidationError struct {
 }
 
 // Field function returns field value.
-func (e CharLocationValidationError) Field() string { return e.field }
+func (e ConnectorStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CharLocationValidationError) Reason() string { return e.reason }
+func (e ConnectorStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CharLocationValidationError) Cause() error { return e.cause }
+func (e ConnectorStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CharLocationValidationError) Key() bool { return e.key }
+func (e ConnectorStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CharLocationValidationError) ErrorName() string { return "CharLocationValidationError" }
+func (e ConnectorStateValidationError) ErrorName() string { return "ConnectorStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CharLocationValidationError) Error() string {
+func (e ConnectorStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11640,14 +13022,14 @@ func (e CharLocationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCharLocation.%s: %s%s",
+		"invalid %sConnectorState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CharLocationValidationError{}
+var _ error = ConnectorStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -11655,50 +13037,101 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CharLocationValidationError{}
+} = ConnectorStateValidationError{}
 
-// Validate checks the field values on ConnectorSpec with the rules defined in
+// Validate checks the field values on ConnectorV2 with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ConnectorSpec) Validate() error {
+func (m *ConnectorV2) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConnectorSpec with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ConnectorSpecMultiError, or
+// ValidateAll checks the field values on ConnectorV2 with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConnectorV2MultiError, or
 // nil if none found.
-func (m *ConnectorSpec) ValidateAll() error {
+func (m *ConnectorV2) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConnectorSpec) validate(all bool) error {
+func (m *ConnectorV2) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Driver
-
-	// no validation rules for Properties
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectorV2ValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectorV2ValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectorV2ValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for PropertiesFromVariables
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConnectorV2ValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConnectorV2ValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectorV2ValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ConnectorSpecMultiError(errors)
+		return ConnectorV2MultiError(errors)
 	}
 
 	return nil
 }
 
-// ConnectorSpecMultiError is an error wrapping multiple validation errors
-// returned by ConnectorSpec.ValidateAll() if the designated constraints
-// aren't met.
-type ConnectorSpecMultiError []error
+// ConnectorV2MultiError is an error wrapping multiple validation errors
+// returned by ConnectorV2.ValidateAll() if the designated constraints aren't met.
+type ConnectorV2MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConnectorSpecMultiError) Error() string {
+func (m ConnectorV2MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11707,11 +13140,11 @@ func (m ConnectorSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConnectorSpecMultiError) AllErrors() []error { return m }
+func (m ConnectorV2MultiError) AllErrors() []error { return m }
 
-// ConnectorSpecValidationError is the validation error returned by
-// ConnectorSpec.Validate if the designated constraints aren't met.
-type ConnectorSpecValidationError struct {
+// ConnectorV2ValidationError is the validation error returned by
+// ConnectorV2.Validate if the designated constraints aren't met.
+type ConnectorV2ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11719,22 +13152,22 @@ type ConnectorSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConnectorSpecValidationError) Field() string { return e.field }
+func (e ConnectorV2ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConnectorSpecValidationError) Reason() string { return e.reason }
+func (e ConnectorV2ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConnectorSpecValidationError) Cause() error { return e.cause }
+func (e ConnectorV2ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConnectorSpecValidationError) Key() bool { return e.key }
+func (e ConnectorV2ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConnectorSpecValidationError) ErrorName() string { return "ConnectorSpecValidationError" }
+func (e ConnectorV2ValidationError) ErrorName() string { return "ConnectorV2ValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ConnectorSpecValidationError) Error() string {
+func (e ConnectorV2ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11746,14 +13179,14 @@ func (e ConnectorSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConnectorSpec.%s: %s%s",
+		"invalid %sConnectorV2.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConnectorSpecValidationError{}
+var _ error = ConnectorV2ValidationError{}
 
 var _ interface {
 	Field() string
@@ -11761,46 +13194,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConnectorSpecValidationError{}
+} = ConnectorV2ValidationError{}
 
-// Validate checks the field values on ConnectorState with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ConnectorState) Validate() error {
+// Validate checks the field values on ModelTest_AcceptedValues with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModelTest_AcceptedValues) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConnectorState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ConnectorStateMultiError,
-// or nil if none found.
-func (m *ConnectorState) ValidateAll() error {
+// ValidateAll checks the field values on ModelTest_AcceptedValues with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModelTest_AcceptedValuesMultiError, or nil if none found.
+func (m *ModelTest_AcceptedValues) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConnectorState) validate(all bool) error {
+func (m *ModelTest_AcceptedValues) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SpecHash
+	// no validation rules for Column
+
+	for idx, item := range m.GetValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelTest_AcceptedValuesValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelTest_AcceptedValuesValidationError{
+						field:  fmt.Sprintf("Values[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelTest_AcceptedValuesValidationError{
+					field:  fmt.Sprintf("Values[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return ConnectorStateMultiError(errors)
+		return ModelTest_AcceptedValuesMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConnectorStateMultiError is an error wrapping multiple validation errors
-// returned by ConnectorState.ValidateAll() if the designated constraints
-// aren't met.
-type ConnectorStateMultiError []error
+// ModelTest_AcceptedValuesMultiError is an error wrapping multiple validation
+// errors returned by ModelTest_AcceptedValues.ValidateAll() if the designated
+// constraints aren't met.
+type ModelTest_AcceptedValuesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConnectorStateMultiError) Error() string {
+func (m ModelTest_AcceptedValuesMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11809,11 +13276,11 @@ func (m ConnectorStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConnectorStateMultiError) AllErrors() []error { return m }
+func (m ModelTest_AcceptedValuesMultiError) AllErrors() []error { return m }
 
-// ConnectorStateValidationError is the validation error returned by
-// ConnectorState.Validate if the designated constraints aren't met.
-type ConnectorStateValidationError struct {
+// ModelTest_AcceptedValuesValidationError is the validation error returned by
+// ModelTest_AcceptedValues.Validate if the designated constraints aren't met.
+type ModelTest_AcceptedValuesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11821,22 +13288,24 @@ type ConnectorStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConnectorStateValidationError) Field() string { return e.field }
+func (e ModelTest_AcceptedValuesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConnectorStateValidationError) Reason() string { return e.reason }
+func (e ModelTest_AcceptedValuesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConnectorStateValidationError) Cause() error { return e.cause }
+func (e ModelTest_AcceptedValuesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConnectorStateValidationError) Key() bool { return e.key }
+func (e ModelTest_AcceptedValuesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConnectorStateValidationError) ErrorName() string { return "ConnectorStateValidationError" }
+func (e ModelTest_AcceptedValuesValidationError) ErrorName() string {
+	return "ModelTest_AcceptedValuesValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ConnectorStateValidationError) Error() string {
+func (e ModelTest_AcceptedValuesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11848,14 +13317,14 @@ func (e ConnectorStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConnectorState.%s: %s%s",
+		"invalid %sModelTest_AcceptedValues.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConnectorStateValidationError{}
+var _ error = ModelTest_AcceptedValuesValidationError{}
 
 var _ interface {
 	Field() string
@@ -11863,101 +13332,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConnectorStateValidationError{}
+} = ModelTest_AcceptedValuesValidationError{}
 
-// Validate checks the field values on ConnectorV2 with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ConnectorV2) Validate() error {
+// Validate checks the field values on ModelTest_RowCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModelTest_RowCount) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConnectorV2 with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ConnectorV2MultiError, or
-// nil if none found.
-func (m *ConnectorV2) ValidateAll() error {
+// ValidateAll checks the field values on ModelTest_RowCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModelTest_RowCountMultiError, or nil if none found.
+func (m *ModelTest_RowCount) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConnectorV2) validate(all bool) error {
+func (m *ModelTest_RowCount) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ConnectorV2ValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ConnectorV2ValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ConnectorV2ValidationError{
-				field:  "Spec",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if m.Min != nil {
+		// no validation rules for Min
 	}
 
-	if all {
-		switch v := interface{}(m.GetState()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ConnectorV2ValidationError{
-					field:  "State",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ConnectorV2ValidationError{
-					field:  "State",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ConnectorV2ValidationError{
-				field:  "State",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if m.Max != nil {
+		// no validation rules for Max
 	}
 
 	if len(errors) > 0 {
-		return ConnectorV2MultiError(errors)
+		return ModelTest_RowCountMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConnectorV2MultiError is an error wrapping multiple validation errors
-// returned by ConnectorV2.ValidateAll() if the designated constraints aren't met.
-type ConnectorV2MultiError []error
+// ModelTest_RowCountMultiError is an error wrapping multiple validation errors
+// returned by ModelTest_RowCount.ValidateAll() if the designated constraints
+// aren't met.
+type ModelTest_RowCountMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConnectorV2MultiError) Error() string {
+func (m ModelTest_RowCountMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11966,11 +13386,11 @@ func (m ConnectorV2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConnectorV2MultiError) AllErrors() []error { return m }
+func (m ModelTest_RowCountMultiError) AllErrors() []error { return m }
 
-// ConnectorV2ValidationError is the validation error returned by
-// ConnectorV2.Validate if the designated constraints aren't met.
-type ConnectorV2ValidationError struct {
+// ModelTest_RowCountValidationError is the validation error returned by
+// ModelTest_RowCount.Validate if the designated constraints aren't met.
+type ModelTest_RowCountValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11978,22 +13398,24 @@ type ConnectorV2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConnectorV2ValidationError) Field() string { return e.field }
+func (e ModelTest_RowCountValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConnectorV2ValidationError) Reason() string { return e.reason }
+func (e ModelTest_RowCountValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConnectorV2ValidationError) Cause() error { return e.cause }
+func (e ModelTest_RowCountValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConnectorV2ValidationError) Key() bool { return e.key }
+func (e ModelTest_RowCountValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConnectorV2ValidationError) ErrorName() string { return "ConnectorV2ValidationError" }
+func (e ModelTest_RowCountValidationError) ErrorName() string {
+	return "ModelTest_RowCountValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ConnectorV2ValidationError) Error() string {
+func (e ModelTest_RowCountValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12005,14 +13427,14 @@ func (e ConnectorV2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConnectorV2.%s: %s%s",
+		"invalid %sModelTest_RowCount.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConnectorV2ValidationError{}
+var _ error = ModelTest_RowCountValidationError{}
 
 var _ interface {
 	Field() string
@@ -12020,7 +13442,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConnectorV2ValidationError{}
+} = ModelTest_RowCountValidationError{}
 
 // Validate checks the field values on MetricsViewSpec_DimensionV2 with the
 // rules defined in the proto definition for this message. If any rules are
@@ -12606,6 +14028,118 @@ var _ interface {
 	ErrorName() string
 } = MetricsViewSpec_MeasureV2ValidationError{}
 
+// Validate checks the field values on MetricsViewSpec_Rollup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec_Rollup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewSpec_Rollup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpec_RollupMultiError, or nil if none found.
+func (m *MetricsViewSpec_Rollup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec_Rollup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Model
+
+	// no validation rules for TimeGrain
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	// no validation rules for Table
+
+	if len(errors) > 0 {
+		return MetricsViewSpec_RollupMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpec_RollupMultiError is an error wrapping multiple validation
+// errors returned by MetricsViewSpec_Rollup.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsViewSpec_RollupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpec_RollupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpec_RollupMultiError) AllErrors() []error { return m }
+
+// MetricsViewSpec_RollupValidationError is the validation error returned by
+// MetricsViewSpec_Rollup.Validate if the designated constraints aren't met.
+type MetricsViewSpec_RollupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpec_RollupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpec_RollupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpec_RollupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpec_RollupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpec_RollupValidationError) ErrorName() string {
+	return "MetricsViewSpec_RollupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpec_RollupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec_Rollup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpec_RollupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpec_RollupValidationError{}
+
 // Validate checks the field values on
 // MetricsViewSpec_AvailableComparisonOffset with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
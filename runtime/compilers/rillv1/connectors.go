@@ -7,6 +7,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/pagerduty"
 	"github.com/rilldata/rill/runtime/drivers/slack"
 	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
@@ -197,15 +198,25 @@ func (a *connectorAnalyzer) analyzeResourceNotifiers(r *Resource, notifiers []*r
 			continue
 		}
 
-		// Slack notifier can be used anonymously if no users and no channels are specified (only webhooks)
 		anonAccess := false
-		if n.Connector == "slack" {
+		switch n.Connector {
+		case "slack":
+			// Slack notifier can be used anonymously if no users and no channels are specified (only webhooks)
 			props, err := slack.DecodeProps(n.Properties.AsMap())
 			if err == nil {
 				if len(props.Users) == 0 && len(props.Channels) == 0 {
 					anonAccess = true
 				}
 			}
+		case "pagerduty":
+			// PagerDuty notifier can be used anonymously if the routing keys are specified in the notifier
+			props, err := pagerduty.DecodeProps(n.Properties.AsMap())
+			if err == nil && len(props.RoutingKeys) > 0 {
+				anonAccess = true
+			}
+		case "webhook", "teams":
+			// Webhook and Microsoft Teams notifiers only post to the URLs specified in the notifier
+			anonAccess = true
 		}
 
 		a.trackConnector(n.Connector, r, anonAccess)
@@ -200,3 +200,67 @@ notify:
 	require.Equal(t, true, c.AnonymousAccess)
 	require.Equal(t, drivers.Connectors["slack"].Spec(), *c.Spec)
 }
+
+func TestNotifierConnectors(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		"/alerts/a1.yaml": `
+type: alert
+refresh:
+  cron: '0 * * * *'
+data:
+  sql: SELECT 1
+notify:
+  webhook:
+    urls:
+    - https://example.com/hook
+  teams:
+    webhooks:
+    - https://example.webhook.office.com/webhookb2/123
+  pagerduty:
+    severity: warning
+`,
+		"/alerts/a2.yaml": `
+type: alert
+refresh:
+  cron: '0 * * * *'
+data:
+  sql: SELECT 1
+notify:
+  pagerduty:
+    routing_keys:
+    - abc
+`,
+	})
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+
+	cs := p.AnalyzeConnectors(ctx)
+	for _, c := range cs {
+		if c.Err != nil {
+			require.NoError(t, c.Err)
+		}
+	}
+
+	require.Len(t, cs, 3)
+
+	// Connectors are sorted by name
+	c := cs[0]
+	require.Equal(t, "pagerduty", c.Name)
+	require.Len(t, c.Resources, 2)
+	// One of the alerts relies on the connector's routing key
+	require.Equal(t, false, c.AnonymousAccess)
+	require.Equal(t, drivers.Connectors["pagerduty"].Spec(), *c.Spec)
+
+	c = cs[1]
+	require.Equal(t, "teams", c.Name)
+	require.Len(t, c.Resources, 1)
+	require.Equal(t, true, c.AnonymousAccess)
+
+	c = cs[2]
+	require.Equal(t, "webhook", c.Name)
+	require.Len(t, c.Resources, 1)
+	require.Equal(t, true, c.AnonymousAccess)
+}
@@ -9,7 +9,10 @@ import (
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers/pagerduty"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/teams"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -42,21 +45,16 @@ type AlertYAML struct {
 			Attributes map[string]any `yaml:"attributes"`
 		} `yaml:"for"`
 	} `yaml:"query"`
-	OnRecover     *bool  `yaml:"on_recover"`
-	OnFail        *bool  `yaml:"on_fail"`
-	OnError       *bool  `yaml:"on_error"`
-	Renotify      *bool  `yaml:"renotify"`
-	RenotifyAfter string `yaml:"renotify_after"`
-	Notify        struct {
-		Email struct {
-			Recipients []string `yaml:"recipients"`
-		} `yaml:"email"`
-		Slack struct {
-			Users    []string `yaml:"users"`
-			Channels []string `yaml:"channels"`
-			Webhooks []string `yaml:"webhooks"`
-		} `yaml:"slack"`
-	} `yaml:"notify"`
+	OnRecover     *bool           `yaml:"on_recover"`
+	OnFail        *bool           `yaml:"on_fail"`
+	OnError       *bool           `yaml:"on_error"`
+	Renotify      *bool           `yaml:"renotify"`
+	RenotifyAfter string          `yaml:"renotify_after"`
+	Notify        AlertNotifyYAML `yaml:"notify"`
+	Escalations   []struct {
+		AfterExecutions uint            `yaml:"after_executions"`
+		Notify          AlertNotifyYAML `yaml:"notify"`
+	} `yaml:"escalations"`
 	Annotations map[string]string `yaml:"annotations"`
 	// Backwards compatibility
 	Email struct {
@@ -69,6 +67,28 @@ type AlertYAML struct {
 	} `yaml:"email"`
 }
 
+// AlertNotifyYAML is the raw structure of the notifiers of an alert (or of one of its escalation tiers)
+type AlertNotifyYAML struct {
+	Email struct {
+		Recipients []string `yaml:"recipients"`
+	} `yaml:"email"`
+	Slack struct {
+		Users    []string `yaml:"users"`
+		Channels []string `yaml:"channels"`
+		Webhooks []string `yaml:"webhooks"`
+	} `yaml:"slack"`
+	Webhook struct {
+		URLs []string `yaml:"urls"`
+	} `yaml:"webhook"`
+	Teams struct {
+		Webhooks []string `yaml:"webhooks"`
+	} `yaml:"teams"`
+	PagerDuty *struct { // Setting it without routing keys uses the routing key of the connector
+		RoutingKeys []string `yaml:"routing_keys"`
+		Severity    string   `yaml:"severity"`
+	} `yaml:"pagerduty"`
+}
+
 // parseAlert parses an alert definition and adds the resulting resource to p.Resources.
 func (p *Parser) parseAlert(node *Node) error {
 	// Parse YAML
@@ -229,6 +249,7 @@ func (p *Parser) parseAlert(node *Node) error {
 
 	// Validate the input
 	var renotifyAfter time.Duration
+	var notifiers []*runtimev1.Notifier
 	if isLegacyNotify {
 		// Backwards compatibility
 		// Validate email recipients
@@ -246,12 +267,14 @@ func (p *Parser) parseAlert(node *Node) error {
 			}
 		}
 	} else {
-		// Validate email recipients
-		for _, email := range tmp.Notify.Email.Recipients {
-			_, err := mail.ParseAddress(email)
-			if err != nil {
-				return fmt.Errorf("invalid recipient email address %q", email)
-			}
+		// Validate and build notifiers
+		err = validateAlertNotify(&tmp.Notify, "notify")
+		if err != nil {
+			return err
+		}
+		notifiers, err = alertNotifiers(&tmp.Notify)
+		if err != nil {
+			return err
 		}
 		// Validate renotify_after
 		if tmp.RenotifyAfter != "" {
@@ -262,6 +285,35 @@ func (p *Parser) parseAlert(node *Node) error {
 		}
 	}
 
+	// Validate and build escalations
+	var escalations []*runtimev1.AlertEscalation
+	if len(tmp.Escalations) > 0 && isLegacyNotify {
+		return errors.New(`cannot set "escalations" together with "email.recipients" (use "notify.email.recipients" instead)`)
+	}
+	for i, e := range tmp.Escalations {
+		if e.AfterExecutions == 0 {
+			return fmt.Errorf(`property "escalations[%d].after_executions" must be greater than 0`, i)
+		}
+		if i > 0 && e.AfterExecutions <= tmp.Escalations[i-1].AfterExecutions {
+			return fmt.Errorf(`property "escalations[%d].after_executions" must be greater than the previous escalation's`, i)
+		}
+		err = validateAlertNotify(&e.Notify, fmt.Sprintf("escalations[%d].notify", i))
+		if err != nil {
+			return err
+		}
+		notifiers, err := alertNotifiers(&e.Notify)
+		if err != nil {
+			return err
+		}
+		if len(notifiers) == 0 {
+			return fmt.Errorf(`property "escalations[%d].notify" must specify at least one notifier`, i)
+		}
+		escalations = append(escalations, &runtimev1.AlertEscalation{
+			AfterExecutions: uint32(e.AfterExecutions),
+			Notifiers:       notifiers,
+		})
+	}
+
 	// Track alert
 	r, err := p.insertResource(ResourceKindAlert, node.Name, node.Paths, node.Refs...)
 	if err != nil {
@@ -343,33 +395,90 @@ func (p *Parser) parseAlert(node *Node) error {
 			r.AlertSpec.Renotify = *tmp.Renotify
 			r.AlertSpec.RenotifyAfterSeconds = uint32(renotifyAfter.Seconds())
 		}
-		// Email settings
-		if len(tmp.Notify.Email.Recipients) > 0 {
-			props, err := structpb.NewStruct(map[string]any{
-				"recipients": pbutil.ToSliceAny(tmp.Notify.Email.Recipients),
-			})
-			if err != nil {
-				return fmt.Errorf("encountered invalid property type: %w", err)
-			}
-			r.AlertSpec.Notifiers = append(r.AlertSpec.Notifiers, &runtimev1.Notifier{
-				Connector:  "email",
-				Properties: props,
-			})
-		}
-		// Slack settings
-		if len(tmp.Notify.Slack.Channels) > 0 || len(tmp.Notify.Slack.Users) > 0 || len(tmp.Notify.Slack.Webhooks) > 0 {
-			props, err := structpb.NewStruct(slack.EncodeProps(tmp.Notify.Slack.Users, tmp.Notify.Slack.Channels, tmp.Notify.Slack.Webhooks))
-			if err != nil {
-				return err
-			}
-			r.AlertSpec.Notifiers = append(r.AlertSpec.Notifiers, &runtimev1.Notifier{
-				Connector:  "slack",
-				Properties: props,
-			})
-		}
+		r.AlertSpec.Notifiers = notifiers
+		r.AlertSpec.Escalations = escalations
 	}
 
 	r.AlertSpec.Annotations = tmp.Annotations
 
 	return nil
 }
+
+// validateAlertNotify validates the notifier properties of an alert. The prefix is used to reference the properties in error messages.
+func validateAlertNotify(n *AlertNotifyYAML, prefix string) error {
+	// Validate email recipients
+	for _, email := range n.Email.Recipients {
+		_, err := mail.ParseAddress(email)
+		if err != nil {
+			return fmt.Errorf("invalid recipient email address %q", email)
+		}
+	}
+	// Validate PagerDuty severity
+	if n.PagerDuty != nil && n.PagerDuty.Severity != "" && !pagerduty.IsValidSeverity(n.PagerDuty.Severity) {
+		return fmt.Errorf(`invalid value %q for property "%s.pagerduty.severity"`, n.PagerDuty.Severity, prefix)
+	}
+	return nil
+}
+
+// alertNotifiers builds the notifiers for the notifier properties of an alert.
+func alertNotifiers(n *AlertNotifyYAML) ([]*runtimev1.Notifier, error) {
+	var notifiers []*runtimev1.Notifier
+	// Email settings
+	if len(n.Email.Recipients) > 0 {
+		props, err := structpb.NewStruct(map[string]any{
+			"recipients": pbutil.ToSliceAny(n.Email.Recipients),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("encountered invalid property type: %w", err)
+		}
+		notifiers = append(notifiers, &runtimev1.Notifier{
+			Connector:  "email",
+			Properties: props,
+		})
+	}
+	// Slack settings
+	if len(n.Slack.Channels) > 0 || len(n.Slack.Users) > 0 || len(n.Slack.Webhooks) > 0 {
+		props, err := structpb.NewStruct(slack.EncodeProps(n.Slack.Users, n.Slack.Channels, n.Slack.Webhooks))
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, &runtimev1.Notifier{
+			Connector:  "slack",
+			Properties: props,
+		})
+	}
+	// Webhook settings
+	if len(n.Webhook.URLs) > 0 {
+		props, err := structpb.NewStruct(webhook.EncodeProps(n.Webhook.URLs))
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, &runtimev1.Notifier{
+			Connector:  "webhook",
+			Properties: props,
+		})
+	}
+	// Microsoft Teams settings
+	if len(n.Teams.Webhooks) > 0 {
+		props, err := structpb.NewStruct(teams.EncodeProps(n.Teams.Webhooks))
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, &runtimev1.Notifier{
+			Connector:  "teams",
+			Properties: props,
+		})
+	}
+	// PagerDuty settings
+	if n.PagerDuty != nil {
+		props, err := structpb.NewStruct(pagerduty.EncodeProps(n.PagerDuty.RoutingKeys, n.PagerDuty.Severity))
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, &runtimev1.Notifier{
+			Connector:  "pagerduty",
+			Properties: props,
+		})
+	}
+	return notifiers, nil
+}
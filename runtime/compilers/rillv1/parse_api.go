@@ -102,6 +102,8 @@ type DataYAML struct {
 	Args           map[string]any `yaml:"args"`
 	Glob           yaml.Node      `yaml:"glob"` // Path (string) or properties (map[string]any)
 	ResourceStatus map[string]any `yaml:"resource_status"`
+	Funnel         map[string]any `yaml:"funnel"`
+	Anomaly        map[string]any `yaml:"anomaly"`
 }
 
 // parseDataYAML parses a data resolver and its properties from a DataYAML.
@@ -165,6 +167,26 @@ func (p *Parser) parseDataYAML(raw *DataYAML) (string, *structpb.Struct, []Resou
 		resolverProps = raw.ResourceStatus
 	}
 
+	// Handle metrics funnel resolver
+	if raw.Funnel != nil {
+		count++
+		resolver = "metrics_funnel"
+		resolverProps = raw.Funnel
+		if mv, ok := raw.Funnel["metrics_view"].(string); ok && mv != "" {
+			refs = append(refs, ResourceName{Kind: ResourceKindMetricsView, Name: mv})
+		}
+	}
+
+	// Handle metrics anomaly resolver
+	if raw.Anomaly != nil {
+		count++
+		resolver = "metrics_anomaly"
+		resolverProps = raw.Anomaly
+		if mv, ok := raw.Anomaly["metrics_view"].(string); ok && mv != "" {
+			refs = append(refs, ResourceName{Kind: ResourceKindMetricsView, Name: mv})
+		}
+	}
+
 	// Validate there was exactly one resolver
 	if count == 0 {
 		return "", nil, nil, fmt.Errorf(`the API definition does not specify a resolver (for example, "sql:", "metrics_sql:", ...)`)
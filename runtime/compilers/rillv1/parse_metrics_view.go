@@ -2,11 +2,14 @@ package rillv1
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/duration"
+	"google.golang.org/protobuf/types/known/structpb"
 	"gopkg.in/yaml.v3"
 
 	// Load IANA time zone data
@@ -54,6 +57,7 @@ type MetricsViewYAML struct {
 		ValidPercentOfTotal bool   `yaml:"valid_percent_of_total"`
 	}
 	Security *SecurityPolicyYAML
+	Rollups  []*MetricsViewRollupYAML
 
 	// DEPRECATED FIELDS
 	DefaultTimeRange   string   `yaml:"default_time_range"`
@@ -68,6 +72,16 @@ type MetricsViewYAML struct {
 	AvailableTimeRanges []ExploreTimeRangeYAML `yaml:"available_time_ranges"`
 }
 
+// MetricsViewRollupYAML is the raw structure of a rollup declared in a metrics view.
+// Each rollup is materialized as a model that pre-aggregates the metrics view's measures at the given time grain and dimensions.
+type MetricsViewRollupYAML struct {
+	Name       string        `yaml:"name"`
+	TimeGrain  string        `yaml:"time_grain"`
+	Dimensions []string      `yaml:"dimensions"`
+	Measures   []string      `yaml:"measures"`
+	Refresh    *ScheduleYAML `yaml:"refresh"`
+}
+
 type MetricsViewFieldSelectorYAML struct {
 	Name       string
 	TimeGrain  runtimev1.TimeGrain // Only for time dimensions
@@ -728,6 +742,11 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		return err
 	}
 
+	rollups, err := p.parseMetricsViewRollups(node, tmp, measures)
+	if err != nil {
+		return err
+	}
+
 	if tmp.Model != "" {
 		// Not setting Kind because for backwards compatibility, it may actually be a source or an external table.
 		node.Refs = append(node.Refs, ResourceName{Name: tmp.Model})
@@ -743,6 +762,34 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindTheme, Name: tmp.DefaultTheme})
 	}
 
+	// Check for name collisions before inserting any resources (since we can't return an error after inserting the first resource)
+	if len(rollups) > 0 {
+		names := []ResourceName{{Kind: ResourceKindMetricsView, Name: node.Name}}
+		for _, rollup := range rollups {
+			names = append(names, ResourceName{Kind: ResourceKindModel, Name: rollup.name})
+		}
+		for _, rn := range names {
+			if _, ok := p.Resources[rn.Normalized()]; ok {
+				return externalError{err: fmt.Errorf("name collision: another resource of type %q is also named %q", rn.Kind, rn.Name)}
+			}
+		}
+	}
+
+	// Insert the rollup models. The metrics view refs them, so it is reconciled when they are refreshed.
+	for _, rollup := range rollups {
+		m, err := p.insertResource(ResourceKindModel, rollup.name, node.Paths, rollup.refs...)
+		if err != nil {
+			return err
+		}
+		m.ModelSpec.RefreshSchedule = rollup.refresh
+		m.ModelSpec.InputConnector = node.Connector
+		m.ModelSpec.InputProperties = must(structpb.NewStruct(map[string]any{"sql": rollup.sql}))
+		m.ModelSpec.OutputConnector = node.Connector
+		m.ModelSpec.OutputProperties = must(structpb.NewStruct(map[string]any{"materialize": true}))
+
+		node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindModel, Name: rollup.name})
+	}
+
 	r, err := p.insertResource(ResourceKindMetricsView, node.Name, node.Paths, node.Refs...)
 	if err != nil {
 		return err
@@ -783,6 +830,10 @@ func (p *Parser) parseMetricsView(node *Node) error {
 
 	spec.SecurityRules = securityRules
 
+	for _, rollup := range rollups {
+		spec.Rollups = append(spec.Rollups, rollup.spec)
+	}
+
 	// Backwards compatibility: When the version is 0, populate the deprecated fields and also emit an Explore resource for the metrics view.
 	if node.Version > 0 {
 		return nil
@@ -885,6 +936,181 @@ func (p *Parser) parseMetricsView(node *Node) error {
 	return nil
 }
 
+// metricsViewRollup is a parsed rollup declared in a metrics view.
+type metricsViewRollup struct {
+	name    string
+	sql     string
+	refs    []ResourceName
+	refresh *runtimev1.Schedule
+	spec    *runtimev1.MetricsViewSpec_Rollup
+}
+
+// parseMetricsViewRollups parses and validates the rollups declared in a metrics view.
+// For each rollup, it generates the SQL for a model that pre-aggregates the metrics view's underlying table.
+func (p *Parser) parseMetricsViewRollups(node *Node, tmp *MetricsViewYAML, measures []*runtimev1.MetricsViewSpec_MeasureV2) ([]*metricsViewRollup, error) {
+	if len(tmp.Rollups) == 0 {
+		return nil, nil
+	}
+
+	driver, _, _ := p.driverForConnector(node.Connector)
+	if driver != "duckdb" && driver != "clickhouse" {
+		return nil, fmt.Errorf("rollups are only supported for metrics views on DuckDB or ClickHouse")
+	}
+	if tmp.TimeDimension == "" {
+		return nil, fmt.Errorf("rollups require the metrics view to have a time dimension")
+	}
+
+	dialect := drivers.DialectDuckDB
+	if driver == "clickhouse" {
+		dialect = drivers.DialectClickHouse
+	}
+
+	// Index the dimension expressions and the measures that can be rolled up
+	dimExprs := make(map[string]string)
+	var dimUnnest []string
+	for _, dim := range tmp.Dimensions {
+		if dim == nil || dim.Ignore {
+			continue
+		}
+		if dim.Unnest {
+			dimUnnest = append(dimUnnest, dim.Name)
+		}
+		if dim.Expression != "" {
+			dimExprs[dim.Name] = dim.Expression
+		} else {
+			dimExprs[dim.Name] = dialect.EscapeIdentifier(dim.Column)
+		}
+	}
+	simpleMeasures := make(map[string]*runtimev1.MetricsViewSpec_MeasureV2)
+	var defaultMeasures []string
+	for _, m := range measures {
+		if m.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE && m.Window == nil {
+			simpleMeasures[m.Name] = m
+			defaultMeasures = append(defaultMeasures, m.Name)
+		}
+	}
+
+	// The time dimension may be a column or reference a dimension
+	timeExpr := dialect.EscapeIdentifier(tmp.TimeDimension)
+	if expr, ok := dimExprs[tmp.TimeDimension]; ok {
+		timeExpr = expr
+	}
+
+	// Build the table to select from
+	var from string
+	var refs []ResourceName
+	if tmp.Model != "" {
+		from = dialect.EscapeIdentifier(tmp.Model)
+		refs = append(refs, ResourceName{Name: tmp.Model})
+	} else {
+		from = dialect.EscapeTable(tmp.Database, tmp.DatabaseSchema, tmp.Table)
+		refs = append(refs, ResourceName{Name: tmp.Table})
+	}
+
+	var res []*metricsViewRollup
+	seen := make(map[string]bool)
+	for i, r := range tmp.Rollups {
+		if r == nil {
+			continue
+		}
+
+		name := r.Name
+		if name == "" {
+			name = fmt.Sprintf("%s_rollup_%d", node.Name, i)
+		}
+		if seen[strings.ToLower(name)] {
+			return nil, fmt.Errorf("found duplicate rollup name %q", name)
+		}
+		seen[strings.ToLower(name)] = true
+
+		grain, err := parseTimeGrain(r.TimeGrain)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid "time_grain" for rollup %q: %w`, name, err)
+		}
+		if grain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED || grain == runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND {
+			return nil, fmt.Errorf(`rollup %q must have a "time_grain" of second or coarser`, name)
+		}
+
+		// Build the rollup's SELECT list, starting with the time dimension truncated to the rollup's grain
+		exprs := []string{fmt.Sprintf("date_trunc('%s', %s) AS %s", rollupTimeGrainUnit(grain), timeExpr, dialect.EscapeIdentifier(tmp.TimeDimension))}
+
+		for _, d := range r.Dimensions {
+			expr, ok := dimExprs[d]
+			if !ok || strings.EqualFold(d, tmp.TimeDimension) {
+				return nil, fmt.Errorf("dimension %q in rollup %q not found", d, name)
+			}
+			for _, u := range dimUnnest {
+				if u == d {
+					return nil, fmt.Errorf("dimension %q in rollup %q is unnested, which is not supported for rollups", d, name)
+				}
+			}
+			exprs = append(exprs, fmt.Sprintf("%s AS %s", expr, dialect.EscapeIdentifier(d)))
+		}
+		groupBy := len(exprs)
+
+		rollupMeasures := r.Measures
+		if len(rollupMeasures) == 0 {
+			rollupMeasures = defaultMeasures
+		}
+		for _, mn := range rollupMeasures {
+			m, ok := simpleMeasures[mn]
+			if !ok {
+				return nil, fmt.Errorf("measure %q in rollup %q not found (only simple measures can be rolled up)", mn, name)
+			}
+			exprs = append(exprs, fmt.Sprintf("(%s) AS %s", m.Expression, dialect.EscapeIdentifier(m.Name)))
+		}
+
+		groupByPositions := make([]string, groupBy)
+		for j := range groupByPositions {
+			groupByPositions[j] = strconv.Itoa(j + 1)
+		}
+
+		schedule, err := p.parseScheduleYAML(r.Refresh)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid "refresh" for rollup %q: %w`, name, err)
+		}
+
+		res = append(res, &metricsViewRollup{
+			name:    name,
+			sql:     fmt.Sprintf("SELECT %s FROM %s GROUP BY %s", strings.Join(exprs, ", "), from, strings.Join(groupByPositions, ", ")),
+			refs:    refs,
+			refresh: schedule,
+			spec: &runtimev1.MetricsViewSpec_Rollup{
+				Model:      name,
+				TimeGrain:  grain,
+				Dimensions: r.Dimensions,
+				Measures:   rollupMeasures,
+			},
+		})
+	}
+
+	return res, nil
+}
+
+// rollupTimeGrainUnit returns the date_trunc unit for a time grain.
+func rollupTimeGrainUnit(grain runtimev1.TimeGrain) string {
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		return "second"
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		return "minute"
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		return "hour"
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return "day"
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		return "week"
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return "month"
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		return "quarter"
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return "year"
+	default:
+		panic(fmt.Errorf("unexpected time grain %q", grain))
+	}
+}
+
 // parseTimeGrain parses a YAML time grain string
 func parseTimeGrain(s string) (runtimev1.TimeGrain, error) {
 	switch strings.ToLower(s) {
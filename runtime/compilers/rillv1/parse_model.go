@@ -33,7 +33,35 @@ type ModelYAML struct {
 		Connector  string         `yaml:"connector"`
 		Properties map[string]any `yaml:",inline" mapstructure:",remain"`
 	} `yaml:"output"`
-	Materialize *bool `yaml:"materialize"`
+	Materialize *bool              `yaml:"materialize"`
+	Tests       []*ModelTestYAML   `yaml:"tests"`
+	Snapshot    *ModelSnapshotYAML `yaml:"snapshot"`
+}
+
+// ModelSnapshotYAML is the raw structure of the snapshot config for a model.
+type ModelSnapshotYAML struct {
+	UniqueKey             MetricsViewFieldSelectorsYAML `yaml:"unique_key"`
+	Strategy              string                        `yaml:"strategy"`
+	UpdatedAt             string                        `yaml:"updated_at"`
+	CheckColumns          MetricsViewFieldSelectorsYAML `yaml:"check_columns"`
+	InvalidateHardDeletes bool                          `yaml:"invalidate_hard_deletes"`
+}
+
+// ModelTestYAML is the raw structure of a data quality test for a model.
+type ModelTestYAML struct {
+	Name           string                        `yaml:"name"`
+	NotNull        MetricsViewFieldSelectorsYAML `yaml:"not_null"`
+	Unique         MetricsViewFieldSelectorsYAML `yaml:"unique"`
+	AcceptedValues *struct {
+		Column string `yaml:"column"`
+		Values []any  `yaml:"values"`
+	} `yaml:"accepted_values"`
+	RowCount *struct {
+		Min *int64 `yaml:"min"`
+		Max *int64 `yaml:"max"`
+	} `yaml:"row_count"`
+	SQL   string `yaml:"sql"`
+	Block bool   `yaml:"block"`
 }
 
 // parseModel parses a model definition and adds the resulting resource to p.Resources.
@@ -155,6 +183,22 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 		}
 	}
 
+	// Parse tests
+	tests, testRefs, err := p.parseModelTests(node.Name, tmp.Tests)
+	if err != nil {
+		return err
+	}
+	node.Refs = append(node.Refs, testRefs...)
+
+	// Parse snapshot
+	var snapshot *runtimev1.ModelSnapshot
+	if tmp.Snapshot != nil {
+		snapshot, err = p.parseModelSnapshot(tmp, inputConnector, outputConnector)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Insert the model
 	r, err := p.insertResource(ResourceKindModel, node.Name, node.Paths, node.Refs...)
 	if err != nil {
@@ -170,7 +214,7 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 		r.ModelSpec.TimeoutSeconds = uint32(timeout.Seconds())
 	}
 
-	r.ModelSpec.Incremental = tmp.Incremental
+	r.ModelSpec.Incremental = tmp.Incremental || snapshot != nil
 
 	r.ModelSpec.IncrementalStateResolver = incrementalStateResolver
 	r.ModelSpec.IncrementalStateResolverProperties = incrementalStateResolverProps
@@ -189,9 +233,196 @@ func (p *Parser) parseModel(ctx context.Context, node *Node) error {
 	r.ModelSpec.OutputConnector = outputConnector
 	r.ModelSpec.OutputProperties = outputPropsPB
 
+	r.ModelSpec.Tests = tests
+
+	r.ModelSpec.Snapshot = snapshot
+
 	return nil
 }
 
+// parseModelSnapshot parses and validates the snapshot config of a model.
+func (p *Parser) parseModelSnapshot(tmp *ModelYAML, inputConnector, outputConnector string) (*runtimev1.ModelSnapshot, error) {
+	s := tmp.Snapshot
+	if len(s.UniqueKey) == 0 {
+		return nil, errors.New(`"snapshot" must specify a "unique_key"`)
+	}
+
+	// Infer the strategy if not set
+	strategy := s.Strategy
+	if strategy == "" {
+		if s.UpdatedAt != "" {
+			strategy = "timestamp"
+		} else {
+			strategy = "check"
+		}
+	}
+	switch strategy {
+	case "timestamp":
+		if s.UpdatedAt == "" {
+			return nil, errors.New(`"snapshot" must specify "updated_at" when "strategy" is "timestamp"`)
+		}
+		if len(s.CheckColumns) > 0 {
+			return nil, errors.New(`"snapshot" can't specify "check_columns" when "strategy" is "timestamp"`)
+		}
+	case "check":
+		if len(s.CheckColumns) == 0 {
+			return nil, errors.New(`"snapshot" must specify "check_columns" when "strategy" is "check"`)
+		}
+		if s.UpdatedAt != "" {
+			return nil, errors.New(`"snapshot" can't specify "updated_at" when "strategy" is "check"`)
+		}
+	default:
+		return nil, fmt.Errorf(`invalid snapshot strategy %q (must be "timestamp" or "check")`, strategy)
+	}
+
+	// Snapshots are maintained with SQL in the output connector, so the model must run fully inside a supported OLAP
+	if tmp.Splits != nil {
+		return nil, errors.New(`"snapshot" can't be used together with "splits"`)
+	}
+	if tmp.Stage.Connector != "" || inputConnector != outputConnector {
+		return nil, errors.New(`"snapshot" requires the model's input and output connector to be the same`)
+	}
+	driver, _, err := p.driverForConnector(outputConnector)
+	if err == nil && driver != "duckdb" && driver != "clickhouse" {
+		return nil, fmt.Errorf(`"snapshot" is not supported for connector %q (only DuckDB and ClickHouse are supported)`, outputConnector)
+	}
+
+	uniqueKey, err := fieldSelectorNames(s.UniqueKey)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid "unique_key" in "snapshot": %w`, err)
+	}
+	checkColumns, err := fieldSelectorNames(s.CheckColumns)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid "check_columns" in "snapshot": %w`, err)
+	}
+
+	return &runtimev1.ModelSnapshot{
+		UniqueKey:             uniqueKey,
+		Strategy:              strategy,
+		UpdatedAt:             s.UpdatedAt,
+		CheckColumns:          checkColumns,
+		InvalidateHardDeletes: s.InvalidateHardDeletes,
+	}, nil
+}
+
+// parseModelTests parses and validates the data quality tests of a model.
+// It also returns the resources referenced in the tests' SQL, excluding the model itself.
+func (p *Parser) parseModelTests(modelName string, tests []*ModelTestYAML) ([]*runtimev1.ModelTest, []ResourceName, error) {
+	var res []*runtimev1.ModelTest
+	var refs []ResourceName
+	names := make(map[string]bool)
+	for i, t := range tests {
+		if t == nil {
+			return nil, nil, fmt.Errorf("test at index %d is empty", i)
+		}
+
+		notNull, err := fieldSelectorNames(t.NotNull)
+		if err != nil {
+			return nil, nil, fmt.Errorf(`test at index %d: invalid "not_null": %w`, i, err)
+		}
+		unique, err := fieldSelectorNames(t.Unique)
+		if err != nil {
+			return nil, nil, fmt.Errorf(`test at index %d: invalid "unique": %w`, i, err)
+		}
+
+		pb := &runtimev1.ModelTest{
+			Name:  t.Name,
+			Block: t.Block,
+		}
+
+		// Exactly one assertion must be set. We also derive a default name from it.
+		var n int
+		var defaultName string
+		if len(notNull) > 0 {
+			n++
+			pb.NotNull = notNull
+			defaultName = "not_null_" + strings.Join(notNull, "_")
+		}
+		if len(unique) > 0 {
+			n++
+			pb.Unique = unique
+			defaultName = "unique_" + strings.Join(unique, "_")
+		}
+		if t.AcceptedValues != nil {
+			n++
+			if t.AcceptedValues.Column == "" {
+				return nil, nil, fmt.Errorf(`test at index %d: "accepted_values" must specify a "column"`, i)
+			}
+			if len(t.AcceptedValues.Values) == 0 {
+				return nil, nil, fmt.Errorf(`test at index %d: "accepted_values" must specify at least one value in "values"`, i)
+			}
+			vals, err := structpb.NewList(t.AcceptedValues.Values)
+			if err != nil {
+				return nil, nil, fmt.Errorf(`test at index %d: invalid "accepted_values": %w`, i, err)
+			}
+			pb.AcceptedValues = &runtimev1.ModelTest_AcceptedValues{
+				Column: t.AcceptedValues.Column,
+				Values: vals.Values,
+			}
+			defaultName = "accepted_values_" + t.AcceptedValues.Column
+		}
+		if t.RowCount != nil {
+			n++
+			if t.RowCount.Min == nil && t.RowCount.Max == nil {
+				return nil, nil, fmt.Errorf(`test at index %d: "row_count" must specify "min" and/or "max"`, i)
+			}
+			if t.RowCount.Min != nil && t.RowCount.Max != nil && *t.RowCount.Min > *t.RowCount.Max {
+				return nil, nil, fmt.Errorf(`test at index %d: "row_count" has "min" greater than "max"`, i)
+			}
+			pb.RowCount = &runtimev1.ModelTest_RowCount{
+				Min: t.RowCount.Min,
+				Max: t.RowCount.Max,
+			}
+			defaultName = "row_count"
+		}
+		if t.SQL != "" {
+			n++
+			pb.Sql = strings.TrimSpace(t.SQL)
+			defaultName = fmt.Sprintf("sql_%d", i)
+
+			meta, err := AnalyzeTemplate(pb.Sql)
+			if err != nil {
+				return nil, nil, fmt.Errorf(`test at index %d: invalid "sql": %w`, i, err)
+			}
+			for _, ref := range meta.Refs {
+				if !strings.EqualFold(ref.Name, modelName) {
+					refs = append(refs, ref) // If needed, deduplication happens in insertResource
+				}
+			}
+		}
+		if n != 1 {
+			return nil, nil, fmt.Errorf(`test at index %d must specify exactly one of "not_null", "unique", "accepted_values", "row_count" or "sql"`, i)
+		}
+
+		if pb.Name == "" {
+			pb.Name = defaultName
+		}
+		if names[strings.ToLower(pb.Name)] {
+			return nil, nil, fmt.Errorf(`found duplicate test name %q (set a unique "name" for the test)`, pb.Name)
+		}
+		names[strings.ToLower(pb.Name)] = true
+
+		res = append(res, pb)
+	}
+	return res, refs, nil
+}
+
+// fieldSelectorNames returns the names in a list of field selectors.
+// It is used for properties that take a column name or a list of column names.
+func fieldSelectorNames(fs MetricsViewFieldSelectorsYAML) ([]string, error) {
+	var names []string
+	for _, f := range fs {
+		if f.Name == "" {
+			return nil, errors.New("column name can't be empty")
+		}
+		if f.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+			return nil, fmt.Errorf("column %q can't specify a time grain", f.Name)
+		}
+		names = append(names, f.Name)
+	}
+	return names, nil
+}
+
 // inferSQLRefs attempts to infer table references from the node's SQL.
 // The provided node must have a non-empty SQL field.
 func (p *Parser) inferSQLRefs(node *Node) ([]ResourceName, error) {
@@ -8,12 +8,22 @@ import (
 	"strings"
 	"time"
 
+	"github.com/c2h5oh/datasize"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers/pagerduty"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/teams"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// reportMaxPreviewRows is the maximum number of rows that can be inlined in report notifications.
+const reportMaxPreviewRows = 100
+
+// reportBurstDefaultRecipientField is the field that contains recipient emails in the rows returned by a report's burst resolver.
+const reportBurstDefaultRecipientField = "email"
+
 // ReportYAML is the raw structure of a Report resource defined in YAML (does not include common fields)
 type ReportYAML struct {
 	commonYAML `yaml:",inline"` // Not accessed here, only setting it so we can use KnownFields for YAML parsing
@@ -32,9 +42,16 @@ type ReportYAML struct {
 		ArgsJSON string         `yaml:"args_json"`
 	} `yaml:"query"`
 	Export struct {
-		Format string `yaml:"format"`
-		Limit  uint   `yaml:"limit"`
+		Format        string `yaml:"format"`
+		Limit         uint   `yaml:"limit"`
+		Attach        bool   `yaml:"attach"`
+		AttachMaxSize string `yaml:"attach_max_size"`
+		PreviewRows   uint   `yaml:"preview_rows"`
 	} `yaml:"export"`
+	Burst *struct {
+		Data      *DataYAML `yaml:"data"`
+		Recipient string    `yaml:"recipient"`
+	} `yaml:"burst"`
 	Email struct {
 		Recipients []string `yaml:"recipients"`
 	} `yaml:"email"`
@@ -47,6 +64,16 @@ type ReportYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
+		Webhook struct {
+			URLs []string `yaml:"urls"`
+		} `yaml:"webhook"`
+		Teams struct {
+			Webhooks []string `yaml:"webhooks"`
+		} `yaml:"teams"`
+		PagerDuty *struct { // Setting it without routing keys uses the routing key of the connector
+			RoutingKeys []string `yaml:"routing_keys"`
+			Severity    string   `yaml:"severity"`
+		} `yaml:"pagerduty"`
 	} `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
 }
@@ -136,6 +163,66 @@ func (p *Parser) parseReport(node *Node) error {
 		return fmt.Errorf(`missing required property "export.format"`)
 	}
 
+	// Parse attachment size limit
+	var attachMaxSize datasize.ByteSize
+	if tmp.Export.AttachMaxSize != "" {
+		attachMaxSize, err = datasize.ParseString(tmp.Export.AttachMaxSize)
+		if err != nil {
+			return fmt.Errorf(`invalid value %q for property "export.attach_max_size": %w`, tmp.Export.AttachMaxSize, err)
+		}
+	}
+
+	// Validate preview rows
+	if tmp.Export.PreviewRows > reportMaxPreviewRows {
+		return fmt.Errorf(`property "export.preview_rows" must be at most %d`, reportMaxPreviewRows)
+	}
+
+	// Attachments and previews are only supported for metrics view aggregation and comparison queries
+	if tmp.Export.Attach || tmp.Export.PreviewRows > 0 {
+		if tmp.Query.Name != "MetricsViewAggregation" && tmp.Query.Name != "MetricsViewComparison" {
+			return fmt.Errorf(`"export.attach" and "export.preview_rows" are not supported for query %q`, tmp.Query.Name)
+		}
+	}
+
+	// Parse the burst resolver.
+	// When bursting, the recipients are determined by the resolver, so static recipients are not allowed.
+	var burstResolver, burstRecipientField string
+	var burstResolverProps *structpb.Struct
+	isBurst := tmp.Burst != nil
+	if isBurst {
+		if tmp.Burst.Data == nil {
+			return errors.New(`missing required property "burst.data"`)
+		}
+		var refs []ResourceName
+		burstResolver, burstResolverProps, refs, err = p.parseDataYAML(tmp.Burst.Data)
+		if err != nil {
+			return fmt.Errorf(`failed to parse "burst.data": %w`, err)
+		}
+		node.Refs = append(node.Refs, refs...)
+
+		burstRecipientField = tmp.Burst.Recipient
+		if burstRecipientField == "" {
+			burstRecipientField = reportBurstDefaultRecipientField
+		}
+
+		// Burst reports are rendered separately for each recipient, so they can only be sent by email
+		if len(tmp.Notify.Slack.Channels) > 0 || len(tmp.Notify.Slack.Users) > 0 || len(tmp.Notify.Slack.Webhooks) > 0 {
+			return errors.New(`reports with "burst" can only be sent by email (remove "notify.slack")`)
+		}
+		if len(tmp.Notify.Webhook.URLs) > 0 {
+			return errors.New(`reports with "burst" can only be sent by email (remove "notify.webhook")`)
+		}
+		if len(tmp.Notify.Teams.Webhooks) > 0 {
+			return errors.New(`reports with "burst" can only be sent by email (remove "notify.teams")`)
+		}
+		if tmp.Notify.PagerDuty != nil {
+			return errors.New(`reports with "burst" can only be sent by email (remove "notify.pagerduty")`)
+		}
+		if len(tmp.Email.Recipients) > 0 || len(tmp.Notify.Email.Recipients) > 0 {
+			return errors.New(`cannot set notification recipients for a report with "burst"`)
+		}
+	}
+
 	if len(tmp.Email.Recipients) > 0 && len(tmp.Notify.Email.Recipients) > 0 {
 		return errors.New(`cannot set both "email.recipients" and "notify.email.recipients"`)
 	}
@@ -151,11 +238,16 @@ func (p *Parser) parseReport(node *Node) error {
 				return fmt.Errorf("invalid recipient email address %q", email)
 			}
 		}
-	} else {
+	} else if !isBurst {
 		if len(tmp.Notify.Email.Recipients) == 0 && len(tmp.Notify.Slack.Channels) == 0 &&
-			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 {
+			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 &&
+			len(tmp.Notify.Webhook.URLs) == 0 && len(tmp.Notify.Teams.Webhooks) == 0 && tmp.Notify.PagerDuty == nil {
 			return fmt.Errorf(`missing notification recipients`)
 		}
+		// Validate PagerDuty severity
+		if tmp.Notify.PagerDuty != nil && tmp.Notify.PagerDuty.Severity != "" && !pagerduty.IsValidSeverity(tmp.Notify.PagerDuty.Severity) {
+			return fmt.Errorf(`invalid value %q for property "notify.pagerduty.severity"`, tmp.Notify.PagerDuty.Severity)
+		}
 		for _, email := range tmp.Notify.Email.Recipients {
 			_, err := mail.ParseAddress(email)
 			if err != nil {
@@ -192,6 +284,12 @@ func (p *Parser) parseReport(node *Node) error {
 	r.ReportSpec.QueryArgsJson = tmp.Query.ArgsJSON
 	r.ReportSpec.ExportLimit = uint64(tmp.Export.Limit)
 	r.ReportSpec.ExportFormat = exportFormat
+	r.ReportSpec.ExportAttach = tmp.Export.Attach
+	r.ReportSpec.ExportAttachMaxBytes = attachMaxSize.Bytes()
+	r.ReportSpec.ExportPreviewRows = uint32(tmp.Export.PreviewRows)
+	r.ReportSpec.BurstResolver = burstResolver
+	r.ReportSpec.BurstResolverProperties = burstResolverProps
+	r.ReportSpec.BurstRecipientField = burstRecipientField
 
 	if isLegacySyntax {
 		// Backwards compatibility
@@ -233,6 +331,39 @@ func (p *Parser) parseReport(node *Node) error {
 				Properties: props,
 			})
 		}
+		// Webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 {
+			props, err := structpb.NewStruct(webhook.EncodeProps(tmp.Notify.Webhook.URLs))
+			if err != nil {
+				return err
+			}
+			r.ReportSpec.Notifiers = append(r.ReportSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "webhook",
+				Properties: props,
+			})
+		}
+		// Microsoft Teams settings
+		if len(tmp.Notify.Teams.Webhooks) > 0 {
+			props, err := structpb.NewStruct(teams.EncodeProps(tmp.Notify.Teams.Webhooks))
+			if err != nil {
+				return err
+			}
+			r.ReportSpec.Notifiers = append(r.ReportSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "teams",
+				Properties: props,
+			})
+		}
+		// PagerDuty settings
+		if tmp.Notify.PagerDuty != nil {
+			props, err := structpb.NewStruct(pagerduty.EncodeProps(tmp.Notify.PagerDuty.RoutingKeys, tmp.Notify.PagerDuty.Severity))
+			if err != nil {
+				return err
+			}
+			r.ReportSpec.Notifiers = append(r.ReportSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  "pagerduty",
+				Properties: props,
+			})
+		}
 	}
 
 	r.ReportSpec.Annotations = tmp.Annotations
@@ -1459,6 +1459,480 @@ annotations:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestAlertNotifiers(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`alerts/a1.yaml`: `
+type: alert
+refresh:
+  cron: '0 * * * *'
+data:
+  sql: SELECT 1
+on_recover: true
+notify:
+  webhook:
+    urls:
+      - https://example.com/hook
+  teams:
+    webhooks:
+      - https://example.webhook.office.com/webhookb2/123
+  pagerduty:
+    severity: critical
+`,
+		`alerts/a2.yaml`: `
+type: alert
+refresh:
+  cron: '0 * * * *'
+data:
+  sql: SELECT 1
+notify:
+  pagerduty:
+    routing_keys:
+      - abc
+    severity: urgent
+`,
+		`reports/r1.yaml`: `
+type: report
+refresh:
+  cron: '0 * * * *'
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+notify:
+  pagerduty: {}
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			AlertSpec: &runtimev1.AlertSpec{
+				RefreshSchedule:    &runtimev1.Schedule{Cron: "0 * * * *", RefUpdate: true},
+				Resolver:           "sql",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{"connector": "", "sql": "SELECT 1"})),
+				NotifyOnRecover:    true,
+				NotifyOnFail:       true,
+				Notifiers: []*runtimev1.Notifier{
+					{Connector: "webhook", Properties: must(structpb.NewStruct(map[string]any{"urls": []any{"https://example.com/hook"}}))},
+					{Connector: "teams", Properties: must(structpb.NewStruct(map[string]any{"webhooks": []any{"https://example.webhook.office.com/webhookb2/123"}}))},
+					{Connector: "pagerduty", Properties: must(structpb.NewStruct(map[string]any{"routing_keys": []any{}, "severity": "critical"}))},
+				},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindReport, Name: "r1"},
+			Paths: []string{"/reports/r1.yaml"},
+			ReportSpec: &runtimev1.ReportSpec{
+				RefreshSchedule: &runtimev1.Schedule{Cron: "0 * * * *", RefUpdate: true},
+				QueryName:       "MetricsViewToplist",
+				QueryArgsJson:   `{"metrics_view":"mv1"}`,
+				ExportFormat:    runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
+				Notifiers: []*runtimev1.Notifier{
+					{Connector: "pagerduty", Properties: must(structpb.NewStruct(map[string]any{"routing_keys": []any{}}))},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `invalid value "urgent" for property "notify.pagerduty.severity"`,
+			FilePath: "/alerts/a2.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestReportAttachments(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`reports/r1.yaml`: `
+type: report
+refresh:
+  cron: '0 * * * *'
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: xlsx
+  attach: true
+  attach_max_size: 5MB
+  preview_rows: 10
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+		`reports/r2.yaml`: `
+type: report
+refresh:
+  cron: '0 * * * *'
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+  attach: true
+  attach_max_size: lots
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+		`reports/r3.yaml`: `
+type: report
+refresh:
+  cron: '0 * * * *'
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+  preview_rows: 1000
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+		`reports/r4.yaml`: `
+type: report
+refresh:
+  cron: '0 * * * *'
+query:
+  name: MetricsViewRows
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+  attach: true
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindReport, Name: "r1"},
+			Paths: []string{"/reports/r1.yaml"},
+			ReportSpec: &runtimev1.ReportSpec{
+				RefreshSchedule:      &runtimev1.Schedule{Cron: "0 * * * *", RefUpdate: true},
+				QueryName:            "MetricsViewAggregation",
+				QueryArgsJson:        `{"metrics_view":"mv1"}`,
+				ExportFormat:         runtimev1.ExportFormat_EXPORT_FORMAT_XLSX,
+				ExportAttach:         true,
+				ExportAttachMaxBytes: 5 * 1024 * 1024,
+				ExportPreviewRows:    10,
+				Notifiers: []*runtimev1.Notifier{
+					{Connector: "email", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"benjamin@example.com"}}))},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `invalid value "lots" for property "export.attach_max_size"`,
+			FilePath: "/reports/r2.yaml",
+		},
+		{
+			Message:  `property "export.preview_rows" must be at most 100`,
+			FilePath: "/reports/r3.yaml",
+		},
+		{
+			Message:  `"export.attach" and "export.preview_rows" are not supported for query "MetricsViewRows"`,
+			FilePath: "/reports/r4.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestReportBursting(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`reports/r1.yaml`: `
+type: report
+refresh:
+  cron: '0 * * * *'
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+  attach: true
+burst:
+  data:
+    sql: SELECT manager_email, region FROM managers
+  recipient: manager_email
+`,
+		`reports/r2.yaml`: `
+type: report
+refresh:
+  cron: '0 * * * *'
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+burst:
+  data:
+    sql: SELECT email, region FROM managers
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+		`reports/r3.yaml`: `
+type: report
+refresh:
+  cron: '0 * * * *'
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+burst:
+  recipient: email
+`,
+		`reports/r4.yaml`: `
+type: report
+refresh:
+  cron: '0 * * * *'
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+export:
+  format: csv
+burst:
+  data:
+    sql: SELECT email, region FROM managers
+notify:
+  slack:
+    channels:
+      - reports
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindReport, Name: "r1"},
+			Paths: []string{"/reports/r1.yaml"},
+			ReportSpec: &runtimev1.ReportSpec{
+				RefreshSchedule:         &runtimev1.Schedule{Cron: "0 * * * *", RefUpdate: true},
+				QueryName:               "MetricsViewAggregation",
+				QueryArgsJson:           `{"metrics_view":"mv1"}`,
+				ExportFormat:            runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
+				ExportAttach:            true,
+				BurstResolver:           "sql",
+				BurstResolverProperties: must(structpb.NewStruct(map[string]any{"connector": "", "sql": "SELECT manager_email, region FROM managers"})),
+				BurstRecipientField:     "manager_email",
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `cannot set notification recipients for a report with "burst"`,
+			FilePath: "/reports/r2.yaml",
+		},
+		{
+			Message:  `missing required property "burst.data"`,
+			FilePath: "/reports/r3.yaml",
+		},
+		{
+			Message:  `reports with "burst" can only be sent by email (remove "notify.slack")`,
+			FilePath: "/reports/r4.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestAlertAnomaly(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`alerts/a1.yaml`: `
+type: alert
+refs:
+  - mv1
+intervals:
+  duration: P1D
+data:
+  anomaly:
+    metrics_view: mv1
+    measure: revenue
+    dimensions:
+      - name: country
+    time_grain: day
+    method: seasonal
+    threshold: 2.5
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+			AlertSpec: &runtimev1.AlertSpec{
+				RefreshSchedule:      &runtimev1.Schedule{RefUpdate: true},
+				IntervalsIsoDuration: "P1D",
+				Resolver:             "metrics_anomaly",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{
+					"metrics_view": "mv1",
+					"measure":      "revenue",
+					"dimensions":   []any{map[string]any{"name": "country"}},
+					"time_grain":   "day",
+					"method":       "seasonal",
+					"threshold":    2.5,
+				})),
+				NotifyOnFail: true,
+				Notifiers: []*runtimev1.Notifier{
+					{Connector: "email", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"benjamin@example.com"}}))},
+				},
+			},
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, nil)
+}
+
+func TestAlertEscalations(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`alerts/a1.yaml`: `
+type: alert
+data:
+  sql: select 1
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+escalations:
+  - after_executions: 3
+    notify:
+      slack:
+        channels:
+          - oncall
+  - after_executions: 6
+    notify:
+      email:
+        recipients:
+          - manager@example.com
+      pagerduty:
+        severity: critical
+`,
+		`alerts/a2.yaml`: `
+type: alert
+data:
+  sql: select 1
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+escalations:
+  - after_executions: 3
+    notify:
+      slack:
+        channels:
+          - oncall
+  - after_executions: 2
+    notify:
+      slack:
+        channels:
+          - managers
+`,
+		`alerts/a3.yaml`: `
+type: alert
+data:
+  sql: select 1
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+escalations:
+  - after_executions: 3
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			AlertSpec: &runtimev1.AlertSpec{
+				RefreshSchedule:    &runtimev1.Schedule{RefUpdate: true},
+				Resolver:           "sql",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{"connector": "", "sql": "select 1"})),
+				NotifyOnFail:       true,
+				Notifiers: []*runtimev1.Notifier{
+					{Connector: "email", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"benjamin@example.com"}}))},
+				},
+				Escalations: []*runtimev1.AlertEscalation{
+					{
+						AfterExecutions: 3,
+						Notifiers: []*runtimev1.Notifier{
+							{Connector: "slack", Properties: must(structpb.NewStruct(map[string]any{"users": []any{}, "channels": []any{"oncall"}, "webhooks": []any{}}))},
+						},
+					},
+					{
+						AfterExecutions: 6,
+						Notifiers: []*runtimev1.Notifier{
+							{Connector: "email", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"manager@example.com"}}))},
+							{Connector: "pagerduty", Properties: must(structpb.NewStruct(map[string]any{"routing_keys": []any{}, "severity": "critical"}))},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `property "escalations[1].after_executions" must be greater than the previous escalation's`,
+			FilePath: "/alerts/a2.yaml",
+		},
+		{
+			Message:  `property "escalations[0].notify" must specify at least one notifier`,
+			FilePath: "/alerts/a3.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestMetricsViewAvoidSelfCyclicRef(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1677,6 +2151,20 @@ sql: select * from m1
 		`apis/a2.yaml`: `
 type: api
 metrics_sql: select * from m1
+`,
+		// api a3
+		`apis/a3.yaml`: `
+type: api
+funnel:
+  metrics_view: mv1
+  entity: user_id
+  conversion_window: P7D
+  steps:
+    - name: Signup
+      where:
+        cond:
+          op: eq
+          exprs: [{name: event}, {val: signup}]
 `,
 	})
 
@@ -1707,6 +2195,30 @@ metrics_sql: select * from m1
 				ResolverProperties: must(structpb.NewStruct(map[string]any{"sql": "select * from m1"})),
 			},
 		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAPI, Name: "a3"},
+			Paths: []string{"/apis/a3.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+			APISpec: &runtimev1.APISpec{
+				Resolver: "metrics_funnel",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{
+					"metrics_view":      "mv1",
+					"entity":            "user_id",
+					"conversion_window": "P7D",
+					"steps": []any{
+						map[string]any{
+							"name": "Signup",
+							"where": map[string]any{
+								"cond": map[string]any{
+									"op":    "eq",
+									"exprs": []any{map[string]any{"name": "event"}, map[string]any{"val": "signup"}},
+								},
+							},
+						},
+					},
+				})),
+			},
+		},
 	}
 
 	p, err := Parse(ctx, repo, "", "", "duckdb")
@@ -1885,6 +2397,347 @@ measures:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestMetricsViewRollups(t *testing.T) {
+	files := map[string]string{
+		// rill.yaml
+		`rill.yaml`: ``,
+		// dashboard d1
+		`metrics/d1.yaml`: `
+version: 1
+type: metrics_view
+table: t1
+timeseries: t
+dimensions:
+  - column: foo
+  - name: bar
+    expression: upper(bar)
+measures:
+  - name: a
+    expression: count(*)
+  - name: b
+    expression: sum(x)
+  - name: c
+    type: derived
+    expression: a+b
+    requires: [a, b]
+rollups:
+  - name: d1_daily
+    time_grain: day
+    dimensions: [foo, bar]
+  - time_grain: month
+    dimensions: [foo]
+    measures: [b]
+    refresh:
+      cron: 0 * * * *
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "d1_daily"},
+			Paths: []string{"/metrics/d1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule:  &runtimev1.Schedule{RefUpdate: true},
+				InputConnector:   "duckdb",
+				InputProperties:  must(structpb.NewStruct(map[string]any{"sql": `SELECT date_trunc('day', "t") AS "t", "foo" AS "foo", upper(bar) AS "bar", (count(*)) AS "a", (sum(x)) AS "b" FROM "t1" GROUP BY 1, 2, 3`})),
+				OutputConnector:  "duckdb",
+				OutputProperties: must(structpb.NewStruct(map[string]any{"materialize": true})),
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "d1_rollup_1"},
+			Paths: []string{"/metrics/d1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule:  &runtimev1.Schedule{RefUpdate: true, Cron: "0 * * * *"},
+				InputConnector:   "duckdb",
+				InputProperties:  must(structpb.NewStruct(map[string]any{"sql": `SELECT date_trunc('month', "t") AS "t", "foo" AS "foo", (sum(x)) AS "b" FROM "t1" GROUP BY 1, 2`})),
+				OutputConnector:  "duckdb",
+				OutputProperties: must(structpb.NewStruct(map[string]any{"materialize": true})),
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/metrics/d1.yaml"},
+			Refs: []ResourceName{
+				{Kind: ResourceKindModel, Name: "d1_daily"},
+				{Kind: ResourceKindModel, Name: "d1_rollup_1"},
+			},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Connector:     "duckdb",
+				Table:         "t1",
+				TimeDimension: "t",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "foo", Column: "foo"},
+					{Name: "bar", Expression: "upper(bar)"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "a", Expression: "count(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+					{Name: "b", Expression: "sum(x)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+					{Name: "c", Expression: "a+b", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, ReferencedMeasures: []string{"a", "b"}},
+				},
+				Rollups: []*runtimev1.MetricsViewSpec_Rollup{
+					{Model: "d1_daily", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY, Dimensions: []string{"foo", "bar"}, Measures: []string{"a", "b"}},
+					{Model: "d1_rollup_1", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH, Dimensions: []string{"foo"}, Measures: []string{"b"}},
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, nil)
+
+	// Rollups can only include simple measures
+	putRepo(t, repo, map[string]string{`metrics/d1.yaml`: strings.Replace(files[`metrics/d1.yaml`], "measures: [b]", "measures: [c]", 1)})
+	p, err = Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, nil, []*runtimev1.ParseError{
+		{
+			Message:  `measure "c" in rollup "d1_rollup_1" not found`,
+			FilePath: "/metrics/d1.yaml",
+		},
+	})
+}
+
+func TestModelTests(t *testing.T) {
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`m1.yaml`: `
+type: model
+connector: bigquery
+sql: SELECT 1
+output:
+  connector: duckdb
+tests:
+  - not_null: id
+  - name: unique_keys
+    unique: [id, day]
+    block: true
+  - accepted_values:
+      column: status
+      values: [active, 1]
+  - row_count:
+      min: 1
+  - name: no_negative
+    sql: SELECT * FROM {{ ref "m1" }} WHERE x < 0
+`,
+	}
+
+	one := int64(1)
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/m1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				InputConnector:  "bigquery",
+				InputProperties: must(structpb.NewStruct(map[string]any{"sql": `SELECT 1`})),
+				OutputConnector: "duckdb",
+				Tests: []*runtimev1.ModelTest{
+					{Name: "not_null_id", NotNull: []string{"id"}},
+					{Name: "unique_keys", Unique: []string{"id", "day"}, Block: true},
+					{Name: "accepted_values_status", AcceptedValues: &runtimev1.ModelTest_AcceptedValues{Column: "status", Values: []*structpb.Value{structpb.NewStringValue("active"), structpb.NewNumberValue(1)}}},
+					{Name: "row_count", RowCount: &runtimev1.ModelTest_RowCount{Min: &one}},
+					{Name: "no_negative", Sql: `SELECT * FROM {{ ref "m1" }} WHERE x < 0`},
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, nil)
+
+	// Each test must have exactly one assertion
+	putRepo(t, repo, map[string]string{`m1.yaml`: `
+type: model
+connector: bigquery
+sql: SELECT 1
+tests:
+  - not_null: id
+    unique: id
+`})
+	p, err = Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, nil, []*runtimev1.ParseError{
+		{
+			Message:  `test at index 0 must specify exactly one of "not_null", "unique", "accepted_values", "row_count" or "sql"`,
+			FilePath: "/m1.yaml",
+		},
+	})
+
+	// Test names must be unique
+	putRepo(t, repo, map[string]string{`m1.yaml`: `
+type: model
+connector: bigquery
+sql: SELECT 1
+tests:
+  - not_null: id
+  - not_null: [id]
+`})
+	p, err = Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, nil, []*runtimev1.ParseError{
+		{
+			Message:  `found duplicate test name "not_null_id" (set a unique "name" for the test)`,
+			FilePath: "/m1.yaml",
+		},
+	})
+
+	// Refs in test SQL are tracked as refs of the model, except refs to the model itself
+	putRepo(t, repo, map[string]string{
+		`m0.yaml`: `
+type: model
+connector: bigquery
+sql: SELECT 1
+`,
+		`m1.yaml`: `
+type: model
+connector: bigquery
+sql: SELECT 1
+tests:
+  - name: known_ids
+    sql: SELECT * FROM {{ ref "m1" }} WHERE id NOT IN (SELECT id FROM {{ ref "m0" }})
+`,
+	})
+	p, err = Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	resources = []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m0"},
+			Paths: []string{"/m0.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				InputConnector:  "bigquery",
+				InputProperties: must(structpb.NewStruct(map[string]any{"sql": `SELECT 1`})),
+				OutputConnector: "bigquery",
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/m1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindModel, Name: "m0"}},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				InputConnector:  "bigquery",
+				InputProperties: must(structpb.NewStruct(map[string]any{"sql": `SELECT 1`})),
+				OutputConnector: "bigquery",
+				Tests: []*runtimev1.ModelTest{
+					{Name: "known_ids", Sql: `SELECT * FROM {{ ref "m1" }} WHERE id NOT IN (SELECT id FROM {{ ref "m0" }})`},
+				},
+			},
+		},
+	}
+	requireResourcesAndErrors(t, p, resources, nil)
+}
+
+func TestModelSnapshot(t *testing.T) {
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`m1.yaml`: `
+type: model
+connector: clickhouse
+sql: SELECT * FROM customers
+snapshot:
+  unique_key: id
+  updated_at: updated_at
+`,
+		`m2.yaml`: `
+type: model
+connector: clickhouse
+sql: SELECT * FROM customers
+snapshot:
+  unique_key: [id, region]
+  check_columns: [name, email]
+  invalidate_hard_deletes: true
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/m1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				Incremental:     true,
+				InputConnector:  "clickhouse",
+				InputProperties: must(structpb.NewStruct(map[string]any{"sql": `SELECT * FROM customers`})),
+				OutputConnector: "clickhouse",
+				Snapshot: &runtimev1.ModelSnapshot{
+					UniqueKey: []string{"id"},
+					Strategy:  "timestamp",
+					UpdatedAt: "updated_at",
+				},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m2"},
+			Paths: []string{"/m2.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				Incremental:     true,
+				InputConnector:  "clickhouse",
+				InputProperties: must(structpb.NewStruct(map[string]any{"sql": `SELECT * FROM customers`})),
+				OutputConnector: "clickhouse",
+				Snapshot: &runtimev1.ModelSnapshot{
+					UniqueKey:             []string{"id", "region"},
+					Strategy:              "check",
+					CheckColumns:          []string{"name", "email"},
+					InvalidateHardDeletes: true,
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, nil)
+
+	// The strategy's columns must be set
+	putRepo(t, repo, map[string]string{`m2.yaml`: `
+type: model
+connector: clickhouse
+sql: SELECT * FROM customers
+snapshot:
+  unique_key: id
+  strategy: check
+`})
+	p, err = Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources[0:1], []*runtimev1.ParseError{
+		{
+			Message:  `"snapshot" must specify "check_columns" when "strategy" is "check"`,
+			FilePath: "/m2.yaml",
+		},
+	})
+
+	// The output connector must be the same as the input connector
+	putRepo(t, repo, map[string]string{`m2.yaml`: `
+type: model
+connector: bigquery
+sql: SELECT * FROM customers
+output:
+  connector: duckdb
+snapshot:
+  unique_key: id
+  updated_at: updated_at
+`})
+	p, err = Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources[0:1], []*runtimev1.ParseError{
+		{
+			Message:  `"snapshot" requires the model's input and output connector to be the same`,
+			FilePath: "/m2.yaml",
+		},
+	})
+}
+
 func TestRefreshInDev(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1079,6 +1079,220 @@ path: data/bar.csv
 	testruntime.RequireResource(t, rt, id, metricsRes)
 }
 
+func TestModelTests(t *testing.T) {
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files:        map[string]string{"rill.yaml": ""},
+		StageChanges: true,
+	})
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/foo.yaml": `
+type: model
+materialize: true
+sql: SELECT * FROM (VALUES (1, 'a'), (2, 'b'), (NULL, 'c')) t(id, status)
+tests:
+- not_null: id
+- accepted_values:
+    column: status
+    values: [a, b, c]
+- name: max_rows
+  row_count:
+    max: 3
+  block: true
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 3)
+
+	// Non-blocking test failures are recorded in the state
+	mdl := testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "foo").GetModel()
+	require.Len(t, mdl.State.TestResults, 3)
+	require.Equal(t, "not_null_id", mdl.State.TestResults[0].Name)
+	require.Equal(t, int64(1), mdl.State.TestResults[0].FailedRows)
+	require.Equal(t, "found 1 failing rows", mdl.State.TestResults[0].Error)
+	require.Equal(t, "accepted_values_status", mdl.State.TestResults[1].Name)
+	require.Empty(t, mdl.State.TestResults[1].Error)
+	require.Equal(t, "max_rows", mdl.State.TestResults[2].Name)
+	require.Empty(t, mdl.State.TestResults[2].Error)
+
+	// Blocking test failures retain the previous result and its test results, and report the new result's failures in the error
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/foo.yaml": `
+type: model
+materialize: true
+sql: SELECT * FROM (VALUES (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd')) t(id, status)
+tests:
+- not_null: id
+- accepted_values:
+    column: status
+    values: [a, b, c]
+- name: max_rows
+  row_count:
+    max: 3
+  block: true
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 3)
+
+	res := testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "foo")
+	require.Contains(t, res.Meta.ReconcileError, "the new result was not promoted: model tests failed: max_rows (row count is outside the expected bounds)")
+	require.Equal(t, mdl.State.TestResults, res.GetModel().State.TestResults)
+}
+
+func TestModelTestsIncremental(t *testing.T) {
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files:        map[string]string{"rill.yaml": ""},
+		StageChanges: true,
+	})
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/foo.yaml": `
+type: model
+incremental: true
+sql: SELECT {{ if incremental }} 2 {{ else }} 1 {{ end }} AS id
+tests:
+- name: max_rows
+  row_count:
+    max: 1
+  block: true
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 1)
+
+	// Blocking tests don't stop incremental runs, since they are run after the new rows have been inserted
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "foo"})
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 2)
+
+	res := testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "foo")
+	require.Contains(t, res.Meta.ReconcileError, "model tests failed: max_rows")
+	require.NotContains(t, res.Meta.ReconcileError, "not promoted")
+	require.Len(t, res.GetModel().State.TestResults, 1)
+	require.Equal(t, "row count is outside the expected bounds", res.GetModel().State.TestResults[0].Error)
+}
+
+func TestModelTestsRefs(t *testing.T) {
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{"rill.yaml": ""},
+	})
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/statuses.yaml": `
+type: model
+sql: SELECT * FROM (VALUES ('a'), ('b')) t(status)
+output:
+  table: valid_statuses
+`,
+		"/models/foo.yaml": `
+type: model
+sql: SELECT * FROM (VALUES (1, 'a'), (2, 'b'), (3, 'c')) t(id, status)
+tests:
+- name: known_statuses
+  sql: SELECT * FROM {{ ref "foo" }} WHERE status NOT IN (SELECT status FROM {{ ref "statuses" }})
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+
+	// The ref to "statuses" resolves to its output table, and is tracked as a ref of the model
+	res := testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "foo")
+	require.Equal(t, []*runtimev1.ResourceName{{Kind: runtime.ResourceKindModel, Name: "statuses"}}, res.Meta.Refs)
+	require.Len(t, res.GetModel().State.TestResults, 1)
+	require.Equal(t, int64(1), res.GetModel().State.TestResults[0].FailedRows)
+	require.Equal(t, "found 1 failing rows", res.GetModel().State.TestResults[0].Error)
+}
+
+func TestModelSnapshot(t *testing.T) {
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{"rill.yaml": ""},
+	})
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/customers.sql": `
+-- @materialize: true
+SELECT * FROM (VALUES (1, 'a'), (2, 'b')) t(id, status)
+`,
+		"/models/customers_history.yaml": `
+type: model
+sql: SELECT * FROM customers
+snapshot:
+  unique_key: id
+  check_columns: status
+  invalidate_hard_deletes: true
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "customers_history", 2)
+
+	// Update a row, delete a row and add a row
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/customers.sql": `
+-- @materialize: true
+SELECT * FROM (VALUES (1, 'c'), (3, 'd')) t(id, status)
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 3, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "customers_history", 4)
+
+	olap, release, err := rt.OLAP(context.Background(), id, "")
+	require.NoError(t, err)
+	defer release()
+	rows, err := olap.Execute(context.Background(), &drivers.Statement{
+		Query: "SELECT id, status FROM customers_history WHERE valid_to IS NULL ORDER BY id",
+	})
+	require.NoError(t, err)
+	defer rows.Close()
+	var current []string
+	for rows.Next() {
+		var id int
+		var status string
+		require.NoError(t, rows.Scan(&id, &status))
+		current = append(current, fmt.Sprintf("%d:%s", id, status))
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []string{"1:c", "3:d"}, current)
+}
+
+func TestModelSnapshotInvalidSource(t *testing.T) {
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{"rill.yaml": ""},
+	})
+
+	// The model's SQL returns a validity column
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/history.yaml": `
+type: model
+sql: SELECT * FROM (VALUES (1, 'a', DATE '2024-01-01')) t(id, status, valid_from)
+snapshot:
+  unique_key: id
+  check_columns: status
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+	mdl := testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "history")
+	require.Contains(t, mdl.Meta.ReconcileError, `must not return a column named "valid_from"`)
+
+	// The model's SQL returns duplicate keys
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/history.yaml": `
+type: model
+sql: SELECT * FROM (VALUES (1, 'a'), (1, 'b')) t(id, status)
+snapshot:
+  unique_key: id
+  check_columns: status
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+	mdl = testruntime.GetResource(t, rt, id, runtime.ResourceKindModel, "history")
+	require.Contains(t, mdl.Meta.ReconcileError, "multiple rows for the same unique key")
+	testruntime.RequireNoOLAPTable(t, rt, id, "history")
+}
+
 func TestWatch(t *testing.T) {
 	// Create instance with watch
 	// Create source, wait and verify
@@ -6,14 +6,15 @@ import (
 )
 
 type AdminService interface {
-	GetReportMetadata(ctx context.Context, reportName string, annotations map[string]string, executionTime time.Time) (*ReportMetadata, error)
+	GetReportMetadata(ctx context.Context, reportName string, annotations map[string]string, executionTime time.Time, queryForUserEmail string) (*ReportMetadata, error)
 	GetAlertMetadata(ctx context.Context, alertName string, annotations map[string]string, queryForUserID, queryForUserEmail string) (*AlertMetadata, error)
 }
 
 type ReportMetadata struct {
-	OpenURL   string
-	ExportURL string
-	EditURL   string
+	OpenURL            string
+	ExportURL          string
+	EditURL            string
+	QueryForAttributes map[string]any
 }
 
 type AlertMetadata struct {
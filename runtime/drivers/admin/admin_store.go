@@ -9,23 +9,30 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (h *Handle) GetReportMetadata(ctx context.Context, reportName string, annotations map[string]string, executionTime time.Time) (*drivers.ReportMetadata, error) {
+func (h *Handle) GetReportMetadata(ctx context.Context, reportName string, annotations map[string]string, executionTime time.Time, queryForUserEmail string) (*drivers.ReportMetadata, error) {
 	res, err := h.admin.GetReportMeta(ctx, &adminv1.GetReportMetaRequest{
-		ProjectId:     h.config.ProjectID,
-		Branch:        h.config.Branch,
-		Report:        reportName,
-		Annotations:   annotations,
-		ExecutionTime: timestamppb.New(executionTime),
+		ProjectId:         h.config.ProjectID,
+		Branch:            h.config.Branch,
+		Report:            reportName,
+		Annotations:       annotations,
+		ExecutionTime:     timestamppb.New(executionTime),
+		QueryForUserEmail: queryForUserEmail,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &drivers.ReportMetadata{
+	meta := &drivers.ReportMetadata{
 		OpenURL:   res.OpenUrl,
 		ExportURL: res.ExportUrl,
 		EditURL:   res.EditUrl,
-	}, nil
+	}
+
+	if res.QueryForAttributes != nil {
+		meta.QueryForAttributes = res.QueryForAttributes.AsMap()
+	}
+
+	return meta, nil
 }
 
 func (h *Handle) GetAlertMetadata(ctx context.Context, alertName string, annotations map[string]string, queryForUserID, queryForUserEmail string) (*drivers.AlertMetadata, error) {
@@ -0,0 +1,38 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/azureblob"
+)
+
+var _ drivers.ModelManager = &Connection{}
+
+func (c *Connection) Rename(ctx context.Context, res *drivers.ModelResult, newName string, env *drivers.ModelEnv) (*drivers.ModelResult, error) {
+	return nil, nil
+}
+
+func (c *Connection) Exists(ctx context.Context, res *drivers.ModelResult) (bool, error) {
+	return true, nil
+}
+
+func (c *Connection) Delete(ctx context.Context, res *drivers.ModelResult) error {
+	return rillblob.DeleteModelResult(ctx, res, c.openBucket)
+}
+
+func (c *Connection) MergeSplitResults(a, b *drivers.ModelResult) (*drivers.ModelResult, error) {
+	return rillblob.MergeModelResults(a, b)
+}
+
+// openBucket opens an Azure Blob Storage container using the connector's account and credentials.
+func (c *Connection) openBucket(ctx context.Context, container string) (*blob.Bucket, error) {
+	client, err := c.getClient(&sourceProperties{url: &globutil.URL{Scheme: "azure", Host: container}})
+	if err != nil {
+		return nil, err
+	}
+	return azureblob.OpenBucket(ctx, client, nil)
+}
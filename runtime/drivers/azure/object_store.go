@@ -35,8 +35,13 @@ type sourceProperties struct {
 	GlobMaxObjectsListed  int64          `mapstructure:"glob.max_objects_listed"`
 	GlobPageSize          int            `mapstructure:"glob.page_size"`
 	BatchSize             string         `mapstructure:"batch_size"`
+	TableFormat           string         `mapstructure:"table_format"`
+	TableVersion          string         `mapstructure:"table_version"`
+	PartitionFilters      map[string]any `mapstructure:"partition_filters"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	tableOptions          *rillblob.TableOptions
+	partitionFilters      rillblob.PartitionFilters
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -57,6 +62,20 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 	}
 
 	conf.url = bucketURL
+
+	conf.tableOptions, err = rillblob.ParseTableOptions(conf.TableFormat, conf.TableVersion, conf.PartitionFilters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse table config: %w", err)
+	}
+
+	// Without a table format, the partition filters apply to Hive partitions in the paths
+	if conf.tableOptions == nil {
+		conf.partitionFilters, err = rillblob.ParsePartitionFilters(conf.PartitionFilters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse partition filters: %w", err)
+		}
+	}
+
 	return conf, nil
 }
 
@@ -83,7 +102,7 @@ func (c *Connection) ListObjects(ctx context.Context, propsMap map[string]any) (
 	}
 	defer bucket.Close()
 
-	return bucket.ListObjects(ctx, props.url.Path)
+	return bucket.ListObjects(ctx, props.url.Path, props.partitionFilters)
 }
 
 // DownloadFiles returns a file iterator over objects stored in azure blob storage.
@@ -123,6 +142,8 @@ func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (d
 		GlobPageSize:          conf.GlobPageSize,
 		GlobPattern:           conf.url.Path,
 		ExtractPolicy:         conf.extractPolicy,
+		Table:                 conf.tableOptions,
+		PartitionFilters:      conf.partitionFilters,
 		BatchSizeBytes:        int64(batchSize.Bytes()),
 		KeepFilesUntilClose:   conf.BatchSize == "-1",
 		TempDir:               c.config.TempDir,
@@ -44,6 +44,11 @@ type Options struct {
 	GlobPageSize          int
 	ExtractPolicy         *ExtractPolicy
 	GlobPattern           string
+	// Table resolves the data files of a lakehouse table at GlobPattern instead of listing files matching it
+	Table *TableOptions
+	// PartitionFilters prunes the files matching GlobPattern based on their Hive partitions before they are downloaded.
+	// It is not used for lakehouse tables, which take partition filters in TableOptions.
+	PartitionFilters PartitionFilters
 	// Retain files and only delete during close
 	KeepFilesUntilClose bool
 	// Retainfiles retains files for debugging purposes
@@ -235,6 +240,10 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		return nil, err
 	}
 
+	if it.opts.Table != nil {
+		return it.planTable(planner)
+	}
+
 	listOpts, ok := listOptions(it.opts.GlobPattern)
 	if !ok {
 		it.logger.Debug("glob pattern corresponds to single object", zap.String("glob", it.opts.GlobPattern))
@@ -254,6 +263,10 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		return planner.items(), nil
 	}
 	it.logger.Debug("planner started", zap.String("glob", it.opts.GlobPattern), zap.String("prefix", listOpts.Prefix), observability.ZapCtx(it.ctx))
+	// If the extract policy selects partitions, the objects are added to the planner after the listing completes
+	selector := newPartitionSelector(it.opts.ExtractPolicy)
+	pruned := 0
+	seenColumns := make(map[string]bool)
 	token := blob.FirstPageToken
 	for token != nil && !planner.done() {
 		objs, nextToken, err := it.bucket.ListPage(it.ctx, token, it.opts.GlobPageSize, listOpts)
@@ -264,12 +277,45 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		token = nextToken
 		fetched += int64(len(objs))
 		for _, obj := range objs {
-			if matched, _ := doublestar.Match(it.opts.GlobPattern, obj.Key); matched {
-				size += obj.Size
-				matchCount++
-				if !planner.add(obj) {
+			if matched, _ := doublestar.Match(it.opts.GlobPattern, obj.Key); !matched {
+				continue
+			}
+			if len(it.opts.PartitionFilters) != 0 {
+				partition := HivePartitions(obj.Key)
+				for col := range partition {
+					seenColumns[col] = true
+				}
+				if !it.opts.PartitionFilters.Match(partition) {
+					pruned++
+					continue
+				}
+			}
+
+			if selector != nil {
+				if !selector.add(obj) {
+					token = nil
 					break
 				}
+				continue
+			}
+
+			size += obj.Size
+			matchCount++
+			if !planner.add(obj) {
+				break
+			}
+		}
+		if err := it.opts.validateLimits(size, matchCount, fetched); err != nil {
+			return nil, err
+		}
+	}
+
+	if selector != nil {
+		for _, obj := range selector.objects() {
+			size += obj.Size
+			matchCount++
+			if !planner.add(obj) {
+				break
 			}
 		}
 		if err := it.opts.validateLimits(size, matchCount, fetched); err != nil {
@@ -277,13 +323,23 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		}
 	}
 
+	// Check that the partition filters reference actual partition columns to catch typos
+	for col := range it.opts.PartitionFilters {
+		if !seenColumns[col] && (matchCount > 0 || pruned > 0) {
+			return nil, fmt.Errorf("partition filter %q does not match a Hive partition in the paths matching %q", col, it.opts.GlobPattern)
+		}
+	}
+
 	items := planner.items()
 	if len(items) == 0 {
+		if pruned > 0 {
+			return nil, fmt.Errorf("no files found for glob pattern %q matching the partition filters", it.opts.GlobPattern)
+		}
 		return nil, fmt.Errorf("no files found for glob pattern %q", it.opts.GlobPattern)
 	}
 
 	it.logger.Debug("planner completed", zap.String("glob", it.opts.GlobPattern), zap.Int64("listed_objects", fetched),
-		zap.Int("matched", matchCount), zap.Int64("bytes_matched", size), zap.Int64("batch_size", it.opts.BatchSizeBytes),
+		zap.Int("matched", matchCount), zap.Int("pruned", pruned), zap.Int64("bytes_matched", size), zap.Int64("batch_size", it.opts.BatchSizeBytes),
 		observability.ZapCtx(it.ctx))
 	return items, nil
 }
@@ -0,0 +1,284 @@
+package blob
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	"gocloud.dev/blob"
+)
+
+var (
+	// deltaCommitRegex matches commit files in the Delta log, such as 00000000000000000010.json
+	deltaCommitRegex = regexp.MustCompile(`^(\d{20})\.json$`)
+	// deltaCheckpointRegex matches single-part (00000000000000000010.checkpoint.parquet) and multi-part (00000000000000000010.checkpoint.0000000001.0000000002.parquet) checkpoint files
+	deltaCheckpointRegex = regexp.MustCompile(`^(\d{20})\.checkpoint(?:\.(\d{10})\.(\d{10}))?\.parquet$`)
+)
+
+// deltaAction is an action in a Delta commit file.
+// See: https://github.com/delta-io/delta/blob/master/PROTOCOL.md#actions
+type deltaAction struct {
+	Add    *deltaAddAction `json:"add"`
+	Remove *struct {
+		Path string `json:"path"`
+	} `json:"remove"`
+}
+
+type deltaAddAction struct {
+	Path            string          `json:"path"`
+	Size            int64           `json:"size"`
+	PartitionValues map[string]any  `json:"partitionValues"`
+	DeletionVector  json.RawMessage `json:"deletionVector"`
+}
+
+// deltaCheckpoint is a (possibly multi-part) checkpoint in the Delta log.
+type deltaCheckpoint struct {
+	version int64
+	parts   []string
+	total   int
+}
+
+// deltaFiles returns the live data files of the Delta Lake table at root as of the given version or timestamp (or the latest version if empty).
+// As in the Delta protocol, a timestamp resolves to the latest commit whose file was last modified at or before it.
+// It replays the Delta log from the last checkpoint before the version.
+func deltaFiles(ctx context.Context, bucket *blob.Bucket, root, version string) ([]tableFile, error) {
+	logDir := root + "/_delta_log/"
+
+	// List the commit and checkpoint files
+	commits := make(map[int64]string)
+	commitTimes := make(map[int64]time.Time)
+	checkpoints := make(map[int64]*deltaCheckpoint)
+	iter := bucket.List(&blob.ListOptions{Prefix: logDir})
+	for {
+		obj, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		name := path.Base(obj.Key)
+		if m := deltaCommitRegex.FindStringSubmatch(name); m != nil {
+			v, _ := strconv.ParseInt(m[1], 10, 64)
+			commits[v] = obj.Key
+			commitTimes[v] = obj.ModTime
+		} else if m := deltaCheckpointRegex.FindStringSubmatch(name); m != nil {
+			v, _ := strconv.ParseInt(m[1], 10, 64)
+			cp, ok := checkpoints[v]
+			if !ok {
+				cp = &deltaCheckpoint{version: v, total: 1}
+				checkpoints[v] = cp
+			}
+			if m[3] != "" {
+				cp.total, _ = strconv.Atoi(m[3])
+			}
+			cp.parts = append(cp.parts, obj.Key)
+		}
+	}
+	if len(commits) == 0 && len(checkpoints) == 0 {
+		return nil, fmt.Errorf("no Delta log found at %q", logDir)
+	}
+
+	// Resolve the version to read
+	var target int64 = -1
+	if ts, ok := parseTableTimestamp(version); ok {
+		for v, t := range commitTimes {
+			if !t.After(ts) {
+				target = max(target, v)
+			}
+		}
+		if target == -1 {
+			return nil, fmt.Errorf("no Delta table version found at or before %s", ts.Format(time.RFC3339))
+		}
+	} else if version != "" {
+		v, err := strconv.ParseInt(version, 10, 64)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("invalid Delta table version %q", version)
+		}
+		target = v
+	} else {
+		for v := range commits {
+			target = max(target, v)
+		}
+		for v := range checkpoints {
+			target = max(target, v)
+		}
+	}
+
+	// Find the latest complete checkpoint at or before the target version
+	var cp *deltaCheckpoint
+	for v, c := range checkpoints {
+		if v <= target && len(c.parts) == c.total && (cp == nil || v > cp.version) {
+			cp = c
+		}
+	}
+
+	// Load the files from the checkpoint
+	files := make(map[string]*deltaAddAction)
+	start := int64(0)
+	if cp != nil {
+		sort.Strings(cp.parts)
+		for _, key := range cp.parts {
+			err := readDeltaCheckpoint(ctx, bucket, key, files)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read checkpoint %q: %w", key, err)
+			}
+		}
+		start = cp.version + 1
+	}
+
+	// Replay the commits after the checkpoint
+	for v := start; v <= target; v++ {
+		key, ok := commits[v]
+		if !ok {
+			return nil, fmt.Errorf("version %d not found in the Delta log (it may have been removed by log cleanup)", v)
+		}
+
+		data, err := bucket.ReadAll(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(nil, len(data)+1)
+		for scanner.Scan() {
+			line := bytes.TrimSpace(scanner.Bytes())
+			if len(line) == 0 {
+				continue
+			}
+
+			var action deltaAction
+			if err := json.Unmarshal(line, &action); err != nil {
+				return nil, fmt.Errorf("failed to parse commit %q: %w", key, err)
+			}
+			if action.Add != nil {
+				files[action.Add.Path] = action.Add
+			}
+			if action.Remove != nil {
+				delete(files, action.Remove.Path)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	res := make([]tableFile, 0, len(files))
+	for p, f := range files {
+		if len(f.DeletionVector) != 0 && !bytes.Equal(f.DeletionVector, []byte("null")) {
+			return nil, fmt.Errorf("file %q has a deletion vector, which is not supported", p)
+		}
+
+		// Paths in the Delta log are URL-encoded
+		unescaped, err := url.PathUnescape(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid file path %q: %w", p, err)
+		}
+		key, err := tableFileKey(root, unescaped)
+		if err != nil {
+			return nil, err
+		}
+
+		partition := f.PartitionValues
+		if partition == nil {
+			partition = map[string]any{}
+		}
+		res = append(res, tableFile{key: key, size: f.Size, partition: partition})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].key < res[j].key })
+	return res, nil
+}
+
+// readDeltaCheckpoint reads the add actions from a Delta checkpoint file into files.
+// Checkpoints only contain add actions for live files, so remove actions (tombstones) are ignored.
+func readDeltaCheckpoint(ctx context.Context, bucket *blob.Bucket, key string, files map[string]*deltaAddAction) error {
+	data, err := bucket.ReadAll(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	tbl, err := pqarrow.ReadTable(ctx, bytes.NewReader(data), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return err
+	}
+	defer tbl.Release()
+
+	idx := tbl.Schema().FieldIndices("add")
+	if len(idx) == 0 {
+		return errors.New("checkpoint has no add column")
+	}
+
+	for _, chunk := range tbl.Column(idx[0]).Data().Chunks() {
+		adds, ok := chunk.(*array.Struct)
+		if !ok {
+			return fmt.Errorf("unexpected type %s for add column", chunk.DataType())
+		}
+		typ := adds.DataType().(*arrow.StructType)
+
+		paths, ok := structField[*array.String](adds, typ, "path")
+		if !ok {
+			return errors.New("checkpoint has no add.path column")
+		}
+		sizes, _ := structField[*array.Int64](adds, typ, "size")
+		partitionValues, _ := structField[*array.Map](adds, typ, "partitionValues")
+		deletionVectors, _ := structField[*array.Struct](adds, typ, "deletionVector")
+
+		for i := 0; i < adds.Len(); i++ {
+			if adds.IsNull(i) || paths.IsNull(i) {
+				continue
+			}
+
+			add := &deltaAddAction{Path: paths.Value(i), PartitionValues: map[string]any{}}
+			if sizes != nil {
+				add.Size = sizes.Value(i)
+			}
+			if deletionVectors != nil && deletionVectors.IsValid(i) {
+				add.DeletionVector = json.RawMessage("{}")
+			}
+			if partitionValues != nil && partitionValues.IsValid(i) {
+				keys, ok1 := partitionValues.Keys().(*array.String)
+				items, ok2 := partitionValues.Items().(*array.String)
+				if !ok1 || !ok2 {
+					return errors.New("unexpected type for add.partitionValues column")
+				}
+				start, end := partitionValues.ValueOffsets(i)
+				for j := int(start); j < int(end); j++ {
+					if items.IsNull(j) {
+						add.PartitionValues[keys.Value(j)] = nil
+					} else {
+						add.PartitionValues[keys.Value(j)] = items.Value(j)
+					}
+				}
+			}
+			files[add.Path] = add
+		}
+	}
+
+	return nil
+}
+
+// structField returns the child array for the named field of a struct array.
+func structField[T arrow.Array](arr *array.Struct, typ *arrow.StructType, name string) (T, bool) {
+	var zero T
+	idx, ok := typ.FieldIdx(name)
+	if !ok {
+		return zero, false
+	}
+	res, ok := arr.Field(idx).(T)
+	return res, ok
+}
@@ -14,6 +14,10 @@ type ExtractPolicy struct {
 	RowsLimitBytes uint64
 	FilesStrategy  ExtractPolicyStrategy
 	FilesLimit     uint64
+	// PartitionsStrategy and PartitionsLimit select the files in the first or last N Hive partitions (see HivePartitions).
+	// They are applied before the files and rows strategies.
+	PartitionsStrategy ExtractPolicyStrategy
+	PartitionsLimit    uint64
 }
 
 type ExtractPolicyStrategy int
@@ -44,6 +48,10 @@ type rawExtractPolicy struct {
 		Strategy string `mapstructure:"strategy"`
 		Size     string `mapstructure:"size"`
 	} `mapstructure:"files"`
+	Partitions *struct {
+		Strategy string `mapstructure:"strategy"`
+		Size     string `mapstructure:"size"`
+	} `mapstructure:"partitions"`
 }
 
 func ParseExtractPolicy(cfg map[string]any) (*ExtractPolicy, error) {
@@ -76,6 +84,24 @@ func ParseExtractPolicy(cfg map[string]any) (*ExtractPolicy, error) {
 		res.FilesLimit = size
 	}
 
+	// Parse partitions
+	if raw.Partitions != nil {
+		strategy, err := parseStrategy(raw.Partitions.Strategy)
+		if err != nil {
+			return nil, err
+		}
+		res.PartitionsStrategy = strategy
+
+		size, err := strconv.ParseUint(raw.Partitions.Size, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid size, parse failed with error %w", err)
+		}
+		if size == 0 {
+			return nil, fmt.Errorf("invalid size %q", raw.Partitions.Size)
+		}
+		res.PartitionsLimit = size
+	}
+
 	// Parse rows
 	if raw.Rows != nil {
 		strategy, err := parseStrategy(raw.Rows.Strategy)
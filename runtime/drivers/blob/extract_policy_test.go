@@ -58,6 +58,17 @@ func Test_fromExtractArtifact(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:  "parse partitions",
+			input: map[string]any{"partitions": map[string]any{"strategy": "tail", "size": "7"}, "files": map[string]any{"strategy": "head", "size": "10"}},
+			want: &ExtractPolicy{
+				PartitionsStrategy: ExtractPolicyStrategyTail,
+				PartitionsLimit:    7,
+				FilesStrategy:      ExtractPolicyStrategyHead,
+				FilesLimit:         10,
+			},
+			wantErr: false,
+		},
 		{
 			name:    "invalid",
 			input:   map[string]any{"files": map[string]any{"strategy": "tail", "size": "23"}, "rows": map[string]any{"strategy": "tail", "size": "23%"}},
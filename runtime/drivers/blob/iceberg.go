@@ -0,0 +1,286 @@
+package blob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/avro"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// icebergMetadataRegex matches table metadata files, such as v3.metadata.json (Hadoop tables) or 00003-<uuid>.metadata.json (catalog tables)
+var icebergMetadataRegex = regexp.MustCompile(`^v?(\d+)[-.].*metadata\.json$`)
+
+// icebergMetadata is the subset of Iceberg table metadata needed to resolve data files.
+// See: https://iceberg.apache.org/spec/#table-metadata-fields
+type icebergMetadata struct {
+	CurrentSnapshotID *int64                `json:"current-snapshot-id"`
+	Snapshots         []icebergSnapshot     `json:"snapshots"`
+	Refs              map[string]icebergRef `json:"refs"`
+	// SnapshotLog is the history of the table's current snapshot
+	SnapshotLog []icebergSnapshotLogEntry `json:"snapshot-log"`
+}
+
+type icebergSnapshot struct {
+	SnapshotID   int64  `json:"snapshot-id"`
+	TimestampMs  int64  `json:"timestamp-ms"`
+	ManifestList string `json:"manifest-list"`
+	// Manifests is only used by format version 1 tables without a manifest list
+	Manifests []string `json:"manifests"`
+}
+
+type icebergSnapshotLogEntry struct {
+	SnapshotID  int64 `json:"snapshot-id"`
+	TimestampMs int64 `json:"timestamp-ms"`
+}
+
+type icebergRef struct {
+	SnapshotID int64 `json:"snapshot-id"`
+}
+
+// icebergFiles returns the live data files of the Iceberg table at root as of the given snapshot ID, ref name or timestamp (or the current snapshot if empty).
+// The root may also be the path of a specific metadata file.
+func icebergFiles(ctx context.Context, bucket *blob.Bucket, root, version string) ([]tableFile, error) {
+	metadataKey := root
+	if !strings.HasSuffix(root, ".metadata.json") {
+		var err error
+		metadataKey, err = icebergMetadataKey(ctx, bucket, root)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		root = path.Dir(path.Dir(root))
+	}
+
+	data, err := bucket.ReadAll(ctx, metadataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata file %q: %w", metadataKey, err)
+	}
+	meta := &icebergMetadata{}
+	if err := json.Unmarshal(data, meta); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata file %q: %w", metadataKey, err)
+	}
+
+	// Resolve the snapshot to read
+	var snapshotID int64
+	if ts, ok := parseTableTimestamp(version); ok {
+		snapshotID, ok = meta.snapshotAsOf(ts)
+		if !ok {
+			return nil, fmt.Errorf("no snapshot found at or before %s", ts.Format(time.RFC3339))
+		}
+	} else if version != "" {
+		if ref, ok := meta.Refs[version]; ok {
+			snapshotID = ref.SnapshotID
+		} else {
+			snapshotID, err = strconv.ParseInt(version, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("table version %q is neither a snapshot ID nor a branch or tag", version)
+			}
+		}
+	} else {
+		if meta.CurrentSnapshotID == nil || *meta.CurrentSnapshotID == -1 {
+			// The table has no snapshots
+			return nil, nil
+		}
+		snapshotID = *meta.CurrentSnapshotID
+	}
+
+	var snapshot *icebergSnapshot
+	for i := range meta.Snapshots {
+		if meta.Snapshots[i].SnapshotID == snapshotID {
+			snapshot = &meta.Snapshots[i]
+			break
+		}
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot %d not found (it may have been expired)", snapshotID)
+	}
+
+	// Resolve the manifests of the snapshot
+	manifests := snapshot.Manifests
+	if snapshot.ManifestList != "" {
+		manifests = nil
+		entries, err := readIcebergAvro(ctx, bucket, root, snapshot.ManifestList)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest list: %w", err)
+		}
+		for _, e := range entries {
+			p, _ := e["manifest_path"].(string)
+			if p == "" {
+				return nil, errors.New("invalid manifest list: missing manifest_path")
+			}
+			// Manifests with content 1 track delete files
+			if content, _ := e["content"].(int64); content != 0 {
+				if err := checkIcebergDeleteManifest(ctx, bucket, root, p); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			manifests = append(manifests, p)
+		}
+	}
+
+	// Read the data files from the manifests
+	files := make(map[string]tableFile)
+	for _, m := range manifests {
+		entries, err := readIcebergAvro(ctx, bucket, root, m)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest %q: %w", m, err)
+		}
+		for _, e := range entries {
+			// Status 2 means the file was deleted in the snapshot
+			if status, _ := e["status"].(int64); status == 2 {
+				continue
+			}
+
+			df, _ := e["data_file"].(map[string]any)
+			if df == nil {
+				return nil, fmt.Errorf("invalid manifest %q: missing data_file", m)
+			}
+			if content, _ := df["content"].(int64); content != 0 {
+				return nil, errors.New("tables with row-level deletes are not supported")
+			}
+			if format, _ := df["file_format"].(string); !strings.EqualFold(format, "parquet") {
+				return nil, fmt.Errorf("data file format %q is not supported, only Parquet is supported", format)
+			}
+
+			p, _ := df["file_path"].(string)
+			key, err := tableFileKey(root, p)
+			if err != nil {
+				return nil, err
+			}
+			size, _ := df["file_size_in_bytes"].(int64)
+			partition, _ := df["partition"].(map[string]any)
+			if partition == nil {
+				partition = map[string]any{}
+			}
+			files[key] = tableFile{key: key, size: size, partition: partition}
+		}
+	}
+
+	res := make([]tableFile, 0, len(files))
+	for _, f := range files {
+		res = append(res, f)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].key < res[j].key })
+	return res, nil
+}
+
+// snapshotAsOf returns the ID of the snapshot that was current at the given time.
+// It uses the snapshot log (the history of the main branch) if present and otherwise the snapshot timestamps.
+func (m *icebergMetadata) snapshotAsOf(t time.Time) (int64, bool) {
+	ms := t.UnixMilli()
+	var id, latest int64 = 0, -1
+	if len(m.SnapshotLog) > 0 {
+		for _, e := range m.SnapshotLog {
+			if e.TimestampMs <= ms && e.TimestampMs >= latest {
+				id, latest = e.SnapshotID, e.TimestampMs
+			}
+		}
+	} else {
+		for _, s := range m.Snapshots {
+			if s.TimestampMs <= ms && s.TimestampMs >= latest {
+				id, latest = s.SnapshotID, s.TimestampMs
+			}
+		}
+	}
+	return id, latest != -1
+}
+
+// icebergMetadataKey returns the key of the latest metadata file of the Iceberg table at root.
+// It uses the version hint file if present and otherwise picks the metadata file with the highest version.
+func icebergMetadataKey(ctx context.Context, bucket *blob.Bucket, root string) (string, error) {
+	metadataDir := root + "/metadata/"
+
+	hint, err := bucket.ReadAll(ctx, metadataDir+"version-hint.text")
+	if err == nil {
+		v := strings.TrimSpace(string(hint))
+		if strings.HasSuffix(v, ".metadata.json") {
+			return metadataDir + v, nil
+		}
+		if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return fmt.Sprintf("%sv%s.metadata.json", metadataDir, v), nil
+		}
+	} else if gcerrors.Code(err) != gcerrors.NotFound {
+		return "", fmt.Errorf("failed to read version hint: %w", err)
+	}
+
+	var res string
+	latest := int64(-1)
+	iter := bucket.List(&blob.ListOptions{Prefix: metadataDir})
+	for {
+		obj, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return "", err
+		}
+
+		m := icebergMetadataRegex.FindStringSubmatch(path.Base(obj.Key))
+		if m == nil {
+			continue
+		}
+		v, err := strconv.ParseInt(m[1], 10, 64)
+		if err == nil && v > latest {
+			latest = v
+			res = obj.Key
+		}
+	}
+	if res == "" {
+		return "", fmt.Errorf("no Iceberg metadata found at %q", metadataDir)
+	}
+	return res, nil
+}
+
+// checkIcebergDeleteManifest returns an error if a delete manifest tracks any live delete files.
+func checkIcebergDeleteManifest(ctx context.Context, bucket *blob.Bucket, root, p string) error {
+	entries, err := readIcebergAvro(ctx, bucket, root, p)
+	if err != nil {
+		return fmt.Errorf("failed to read manifest %q: %w", p, err)
+	}
+	for _, e := range entries {
+		if status, _ := e["status"].(int64); status != 2 {
+			return errors.New("tables with row-level deletes are not supported")
+		}
+	}
+	return nil
+}
+
+// readIcebergAvro reads the records of an Avro file referenced by Iceberg metadata.
+func readIcebergAvro(ctx context.Context, bucket *blob.Bucket, root, p string) ([]map[string]any, error) {
+	key, err := tableFileKey(root, p)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := bucket.ReadAll(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := avro.DecodeOCF(data)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]map[string]any, len(vals))
+	for i, v := range vals {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected value of type %T", v)
+		}
+		res[i] = m
+	}
+	return res, nil
+}
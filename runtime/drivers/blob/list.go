@@ -38,7 +38,8 @@ func (b *Bucket) Close() error {
 // ListObjects lists objects in the bucket that match the given glob pattern.
 // The glob pattern should be a valid path *without* scheme or bucket name.
 // E.g. to list gs://my-bucket/path/to/files/*, the glob pattern should be "path/to/files/*".
-func (b *Bucket) ListObjects(ctx context.Context, glob string) ([]drivers.ObjectStoreEntry, error) {
+// If filters are provided, files in Hive partitions that don't match the filters are skipped.
+func (b *Bucket) ListObjects(ctx context.Context, glob string, filters PartitionFilters) ([]drivers.ObjectStoreEntry, error) {
 	// If it's not a glob, we're pulling a single file.
 	// TODO: Should we add support for listing out directories without ** at the end?
 	if !fileutil.IsGlob(glob) {
@@ -96,6 +97,9 @@ func (b *Bucket) ListObjects(ctx context.Context, glob string) ([]drivers.Object
 		if !ok {
 			continue
 		}
+		if !obj.IsDir && len(filters) != 0 && !filters.Match(HivePartitions(obj.Key)) {
+			continue
+		}
 
 		entries = append(entries, drivers.ObjectStoreEntry{
 			Path:      obj.Key,
@@ -0,0 +1,445 @@
+package blob
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/compress"
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+	"github.com/rilldata/rill/runtime/pkg/pathutil"
+	"gocloud.dev/blob"
+)
+
+// BucketOpener opens the bucket with the given name.
+type BucketOpener func(ctx context.Context, bucket string) (*blob.Bucket, error)
+
+// NewModelExecutor returns a model executor that writes the result of a SQL query in olap to files in the buckets opened by open.
+// It returns false if the OLAP's dialect is not supported. Currently, DuckDB and ClickHouse are supported.
+//
+// Each execution writes to a new "rill-tmp-<uuid>" directory under the output path,
+// so the previous result stays readable until the model manager deletes it.
+func NewModelExecutor(olap drivers.OLAPStore, open BucketOpener) (drivers.ModelExecutor, bool) {
+	switch olap.Dialect() {
+	case drivers.DialectDuckDB, drivers.DialectClickHouse:
+	default:
+		return nil, false
+	}
+	return &modelExecutor{olap: olap, open: open}, true
+}
+
+type modelExecutor struct {
+	olap drivers.OLAPStore
+	open BucketOpener
+}
+
+var _ drivers.ModelExecutor = &modelExecutor{}
+
+func (e *modelExecutor) Concurrency(desired int) (int, bool) {
+	if desired > 0 {
+		return desired, true
+	}
+	return 1, true
+}
+
+func (e *modelExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
+	// Parse SQL from input properties
+	inputProps := &struct {
+		SQL  string `mapstructure:"sql"`
+		Args []any  `mapstructure:"args"`
+	}{}
+	if err := mapstructure.WeakDecode(opts.InputProperties, inputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if inputProps.SQL == "" {
+		return nil, errors.New("missing SQL in input properties")
+	}
+
+	// Parse output properties
+	outputProps := &drivers.ObjectStoreModelOutputProperties{}
+	if err := mapstructure.WeakDecode(opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if outputProps.Path == "" {
+		return nil, errors.New("missing path in output properties")
+	}
+	if fileutil.IsGlob(outputProps.Path) {
+		return nil, fmt.Errorf("output path %q must be a directory, not a glob", outputProps.Path)
+	}
+	if outputProps.Format == drivers.FileFormatUnspecified {
+		outputProps.Format = drivers.FileFormatParquet
+	}
+	w, err := newFileWriter(outputProps.Format, outputProps.Compression)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := globutil.ParseBucketURL(outputProps.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse output path %q: %w", outputProps.Path, err)
+	}
+	prefix := path.Join(strings.Trim(u.Path, "/"), "rill-tmp-"+uuid.New().String()) + "/"
+
+	bucket, err := e.open(ctx, u.Host)
+	if err != nil {
+		return nil, err
+	}
+	defer bucket.Close()
+
+	if len(outputProps.PartitionBy) == 0 {
+		err = e.writeResult(ctx, bucket, prefix, w, &drivers.Statement{
+			Query:    inputProps.SQL,
+			Args:     inputProps.Args,
+			Priority: opts.Priority,
+		})
+	} else {
+		err = e.writePartitions(ctx, bucket, prefix, w, inputProps.SQL, inputProps.Args, outputProps.PartitionBy, opts.Priority)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resultProps := &drivers.ObjectStoreModelResultProperties{
+		Path:   fmt.Sprintf("%s://%s/%s", u.Scheme, u.Host, prefix),
+		Format: string(outputProps.Format),
+	}
+	resultPropsMap := map[string]any{}
+	if err := mapstructure.Decode(resultProps, &resultPropsMap); err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+	return &drivers.ModelResult{
+		Connector:  opts.OutputConnector,
+		Properties: resultPropsMap,
+	}, nil
+}
+
+// writeResult writes the result of a query to a file in the given directory of the bucket.
+func (e *modelExecutor) writeResult(ctx context.Context, bucket *blob.Bucket, dir string, w *fileWriter, stmt *drivers.Statement) error {
+	res, err := e.olap.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+	return writeFile(ctx, bucket, dir, w, res.Schema, res)
+}
+
+// writePartitions writes one file for each distinct combination of values of the partition columns to a Hive-style directory, such as "country=DK/".
+// Like Hive and Spark, it omits the partition columns from the files and writes null values as __HIVE_DEFAULT_PARTITION__.
+// It runs the query once, ordered by the partition columns, and starts a new file whenever the partition changes.
+func (e *modelExecutor) writePartitions(ctx context.Context, bucket *blob.Bucket, prefix string, w *fileWriter, sql string, args []any, partitionBy []string, priority int) error {
+	dialect := e.olap.Dialect()
+	cols := make([]string, len(partitionBy))
+	for i, c := range partitionBy {
+		cols[i] = dialect.EscapeIdentifier(c)
+	}
+	colList := strings.Join(cols, ", ")
+
+	exclude := "EXCLUDE"
+	if dialect == drivers.DialectClickHouse {
+		exclude = "EXCEPT"
+	}
+	res, err := e.olap.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT %s, * %s (%s) FROM (%s) ORDER BY %s", colList, exclude, colList, sql, colList),
+		Args:     args,
+		Priority: priority,
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+
+	schema := &runtimev1.StructType{Fields: res.Schema.Fields[len(cols):]}
+	rows := newPartitionedRows(res, partitionBy)
+	for rows.nextPartition() {
+		if err := writeFile(ctx, bucket, prefix+rows.dir, w, schema, rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// partitionedRows splits a result ordered by its leading partition columns into the rows of each partition.
+// Call nextPartition to advance to the next partition, and then Next and Scan to iterate over its rows (without the partition columns).
+type partitionedRows struct {
+	res         *drivers.Result
+	partitionBy []string
+	vals        []any
+	// dir is the Hive-style directory of the partition being iterated over.
+	dir string
+	// rowDir is the Hive-style directory of the last row read from res.
+	rowDir string
+	// pending is true if the last row read from res has not been returned by Next yet.
+	pending bool
+	err     error
+}
+
+func newPartitionedRows(res *drivers.Result, partitionBy []string) *partitionedRows {
+	return &partitionedRows{
+		res:         res,
+		partitionBy: partitionBy,
+		vals:        make([]any, len(res.Schema.Fields)),
+	}
+}
+
+// nextPartition advances to the partition of the next row. It returns false if there are no more rows.
+func (p *partitionedRows) nextPartition() bool {
+	// Skip the rows of the current partition that were not read
+	for (p.pending || p.read()) && p.rowDir == p.dir {
+		p.pending = false
+	}
+	if !p.pending {
+		return false
+	}
+	p.dir = p.rowDir
+	return true
+}
+
+// Next advances to the next row of the current partition.
+func (p *partitionedRows) Next() bool {
+	if !p.pending && !p.read() {
+		return false
+	}
+	if p.rowDir != p.dir {
+		return false
+	}
+	p.pending = false
+	return true
+}
+
+// Scan copies the values of the current row, excluding the partition columns, into dest, which must be *any values.
+func (p *partitionedRows) Scan(dest ...any) error {
+	vals := p.vals[len(p.partitionBy):]
+	if len(dest) != len(vals) {
+		return fmt.Errorf("expected %d destination arguments in Scan, not %d", len(vals), len(dest))
+	}
+	for i, v := range vals {
+		d, ok := dest[i].(*any)
+		if !ok {
+			return fmt.Errorf("unsupported Scan destination %T", dest[i])
+		}
+		*d = v
+	}
+	return nil
+}
+
+func (p *partitionedRows) Err() error {
+	return p.err
+}
+
+// read reads the next row from the result and computes its partition directory.
+func (p *partitionedRows) read() bool {
+	if p.err != nil || !p.res.Next() {
+		if p.err == nil {
+			p.err = p.res.Err()
+		}
+		return false
+	}
+	ptrs := make([]any, len(p.vals))
+	for i := range p.vals {
+		ptrs[i] = &p.vals[i]
+	}
+	if err := p.res.Scan(ptrs...); err != nil {
+		p.err = err
+		return false
+	}
+
+	var dir strings.Builder
+	for i, name := range p.partitionBy {
+		s, notNull := partitionValueString(p.vals[i])
+		if !notNull {
+			s = hiveDefaultPartition
+		}
+		dir.WriteString(url.PathEscape(name) + "=" + url.PathEscape(s) + "/")
+	}
+	p.rowDir = dir.String()
+	p.pending = true
+	return true
+}
+
+// writeFile writes rows to a file in the given directory of the bucket.
+func writeFile(ctx context.Context, bucket *blob.Bucket, dir string, w *fileWriter, schema *runtimev1.StructType, rows rowIterator) error {
+	// Cancelling the context passed to NewWriter aborts the upload.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	key := dir + w.filename("data")
+	bw, err := bucket.NewWriter(ctx, key, nil)
+	if err != nil {
+		return fmt.Errorf("failed to write %q: %w", key, err)
+	}
+	if err := w.write(schema, rows, bw); err != nil {
+		cancel()
+		_ = bw.Close()
+		return fmt.Errorf("failed to write %q: %w", key, err)
+	}
+	if err := bw.Close(); err != nil {
+		return fmt.Errorf("failed to write %q: %w", key, err)
+	}
+	return nil
+}
+
+// fileWriter writes query results in a file format with a compression codec.
+type fileWriter struct {
+	format      drivers.FileFormat
+	parquetOpts *parquet.WriterProperties
+	gzip        bool
+}
+
+// newFileWriter validates the output format and compression.
+// Parquet files support the snappy (default), zstd, gzip, brotli and uncompressed codecs. CSV files support gzip and uncompressed (default).
+func newFileWriter(format drivers.FileFormat, compression string) (*fileWriter, error) {
+	compression = strings.ToLower(compression)
+	switch format {
+	case drivers.FileFormatParquet:
+		codec := compress.Codecs.Snappy
+		switch compression {
+		case "", "snappy":
+		case "zstd":
+			codec = compress.Codecs.Zstd
+		case "gzip":
+			codec = compress.Codecs.Gzip
+		case "brotli":
+			codec = compress.Codecs.Brotli
+		case "none", "uncompressed":
+			codec = compress.Codecs.Uncompressed
+		default:
+			return nil, fmt.Errorf("unsupported compression %q for format %q", compression, format)
+		}
+		return &fileWriter{format: format, parquetOpts: parquet.NewWriterProperties(parquet.WithCompression(codec))}, nil
+	case drivers.FileFormatCSV:
+		switch compression {
+		case "", "none", "uncompressed":
+			return &fileWriter{format: format}, nil
+		case "gzip":
+			return &fileWriter{format: format, gzip: true}, nil
+		default:
+			return nil, fmt.Errorf("unsupported compression %q for format %q", compression, format)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported output format %q: must be %q or %q", format, drivers.FileFormatParquet, drivers.FileFormatCSV)
+	}
+}
+
+func (w *fileWriter) filename(stem string) string {
+	name := w.format.Filename(stem)
+	if w.gzip {
+		name += ".gz"
+	}
+	return name
+}
+
+func (w *fileWriter) write(schema *runtimev1.StructType, rows rowIterator, dst io.Writer) error {
+	if w.format == drivers.FileFormatParquet {
+		// Hide dst's Close method since the Parquet writer closes its sink, which would commit the blob before we can check for errors.
+		return writeParquet(schema, rows, struct{ io.Writer }{dst}, w.parquetOpts)
+	}
+	if !w.gzip {
+		return writeCSV(schema, rows, dst)
+	}
+	gw := gzip.NewWriter(dst)
+	if err := writeCSV(schema, rows, gw); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// DeleteModelResult deletes the files of a model result produced by a model executor that writes to an object store.
+// The result path must be a directory (or a glob, in which case the directory before the first wildcard is used). All the objects in it are deleted.
+func DeleteModelResult(ctx context.Context, res *drivers.ModelResult, open BucketOpener) error {
+	p := &drivers.ObjectStoreModelResultProperties{}
+	if err := mapstructure.Decode(res.Properties, p); err != nil {
+		return err
+	}
+	u, err := globutil.ParseBucketURL(p.Path)
+	if err != nil {
+		return err
+	}
+	prefix := strings.TrimPrefix(u.Path, "/")
+	if fileutil.IsGlob(prefix) {
+		prefix, _ = doublestar.SplitPattern(prefix)
+	}
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" || prefix == "." {
+		return fmt.Errorf("refusing to delete the root of bucket %q", u.Host)
+	}
+	// Only delete objects in the directory, not objects in sibling directories that share the prefix
+	prefix += "/"
+
+	bucket, err := open(ctx, u.Host)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+
+	it := bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if obj.IsDir {
+			continue
+		}
+		if err := bucket.Delete(ctx, obj.Key); err != nil {
+			return fmt.Errorf("failed to delete %q: %w", obj.Key, err)
+		}
+	}
+}
+
+// MergeModelResults merges the results of two splits that were written to an object store.
+// The merged result points to the common path of the two results.
+func MergeModelResults(a, b *drivers.ModelResult) (*drivers.ModelResult, error) {
+	propsA := &drivers.ObjectStoreModelResultProperties{}
+	if err := mapstructure.Decode(a.Properties, propsA); err != nil {
+		return nil, err
+	}
+
+	propsB := &drivers.ObjectStoreModelResultProperties{}
+	if err := mapstructure.Decode(b.Properties, propsB); err != nil {
+		return nil, err
+	}
+
+	if propsA.Format != propsB.Format {
+		return nil, fmt.Errorf("cannot merge split results that output to different file formats (format %q is not %q)", propsA.Format, propsB.Format)
+	}
+
+	// NOTE: This makes an assumption that the common path of the individual split results only contains data for the model.
+	// This is a convenient assumption, but may cause data loss if the common path contains other data.
+	// To protect against the most obvious error case, we check that the common path is not the bucket root.
+
+	commonPath := pathutil.CommonPrefix(propsA.Path, propsB.Path)
+	if commonPath == "" {
+		return nil, fmt.Errorf("cannot merge split results that do not share a common subpath (%q vs. %q)", propsA.Path, propsB.Path)
+	}
+
+	p := &drivers.ObjectStoreModelResultProperties{
+		Path:   commonPath,
+		Format: propsA.Format,
+	}
+
+	pm := map[string]any{}
+	if err := mapstructure.Decode(p, &pm); err != nil {
+		return nil, err
+	}
+
+	return &drivers.ModelResult{
+		Connector:  a.Connector,
+		Properties: pm,
+		Table:      "",
+	}, nil
+}
@@ -0,0 +1,144 @@
+package blob_test
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
+
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+)
+
+func TestModelExecutor(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	open := func(ctx context.Context, bucket string) (*blob.Bucket, error) {
+		require.Equal(t, "bucket", bucket)
+		return fileblob.OpenBucket(dir, nil)
+	}
+
+	handle, err := drivers.Open("duckdb", "default", map[string]any{"dsn": ":memory:"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer handle.Close()
+	olap, ok := handle.AsOLAP("default")
+	require.True(t, ok)
+
+	executor, ok := rillblob.NewModelExecutor(olap, open)
+	require.True(t, ok)
+
+	execute := func(outputProps map[string]any) (string, error) {
+		res, err := executor.Execute(ctx, &drivers.ModelExecuteOptions{
+			ModelExecutorOptions: &drivers.ModelExecutorOptions{OutputConnector: "gcs"},
+			InputProperties:      map[string]any{"sql": "SELECT * FROM (VALUES ('DK', 1), ('US', 2), ('US', 3), (NULL, 4)) t(country, n) WHERE n < ?", "args": []any{10}},
+			OutputProperties:     outputProps,
+		})
+		if err != nil {
+			return "", err
+		}
+		require.Equal(t, "gcs", res.Connector)
+		return res.Properties["path"].(string), nil
+	}
+
+	// Partitioned Parquet
+	path, err := execute(map[string]any{"path": "gs://bucket/exports", "compression": "zstd", "partition_by": []any{"country"}})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(path, "gs://bucket/exports/rill-tmp-"), path)
+	require.True(t, strings.HasSuffix(path, "/"), path)
+	prefix := strings.TrimPrefix(path, "gs://bucket/")
+	require.Equal(t, []string{
+		prefix + "country=DK/data.parquet",
+		prefix + "country=US/data.parquet",
+		prefix + "country=__HIVE_DEFAULT_PARTITION__/data.parquet",
+	}, listFiles(t, dir))
+
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query: "SELECT country, count(*) AS n FROM read_parquet(?, hive_partitioning=true) GROUP BY country ORDER BY country",
+		Args:  []any{filepath.Join(dir, prefix, "*", "*.parquet")},
+	})
+	require.NoError(t, err)
+	var counts []string
+	for res.Next() {
+		var country string
+		var n int
+		require.NoError(t, res.Scan(&country, &n))
+		counts = append(counts, fmt.Sprintf("%s:%d", country, n))
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, []string{"DK:1", "US:2", "__HIVE_DEFAULT_PARTITION__:1"}, counts)
+
+	// Delete the result
+	err = rillblob.DeleteModelResult(ctx, &drivers.ModelResult{Properties: map[string]any{"path": path}}, open)
+	require.NoError(t, err)
+	require.Empty(t, listFiles(t, dir))
+
+	// Gzipped CSV
+	path, err = execute(map[string]any{"path": "gs://bucket/exports/", "format": "csv", "compression": "gzip"})
+	require.NoError(t, err)
+	prefix = strings.TrimPrefix(path, "gs://bucket/")
+	require.Equal(t, []string{prefix + "data.csv.gz"}, listFiles(t, dir))
+
+	f, err := os.Open(filepath.Join(dir, prefix, "data.csv.gz"))
+	require.NoError(t, err)
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	data, err := io.ReadAll(gr)
+	require.NoError(t, err)
+	require.Equal(t, "country,n\n\"\"\"DK\"\"\",1\n\"\"\"US\"\"\",2\n\"\"\"US\"\"\",3\n,4\n", string(data))
+
+	// Invalid properties
+	_, err = execute(map[string]any{"path": "gs://bucket/exports/", "format": "csv", "compression": "zstd"})
+	require.ErrorContains(t, err, `unsupported compression "zstd"`)
+	_, err = execute(map[string]any{"path": "gs://bucket/exports/", "format": "xlsx"})
+	require.ErrorContains(t, err, "unsupported output format")
+	_, err = execute(map[string]any{"path": "gs://bucket/exports/*.parquet"})
+	require.ErrorContains(t, err, "must be a directory")
+	err = rillblob.DeleteModelResult(ctx, &drivers.ModelResult{Properties: map[string]any{"path": "gs://bucket/"}}, open)
+	require.ErrorContains(t, err, "refusing to delete")
+}
+
+func TestMergeModelResults(t *testing.T) {
+	a := &drivers.ModelResult{Connector: "gcs", Properties: map[string]any{"path": "gs://bucket/exports/rill-tmp-a/", "format": "parquet"}}
+	b := &drivers.ModelResult{Connector: "gcs", Properties: map[string]any{"path": "gs://bucket/exports/rill-tmp-b/", "format": "parquet"}}
+	res, err := rillblob.MergeModelResults(a, b)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"path": "gs://bucket/exports", "format": "parquet"}, res.Properties)
+
+	b.Properties["format"] = "csv"
+	_, err = rillblob.MergeModelResults(a, b)
+	require.ErrorContains(t, err, "different file formats")
+}
+
+// listFiles returns the sorted paths of the files in dir relative to dir, skipping the attribute files written by fileblob.
+func listFiles(t *testing.T, dir string) []string {
+	var res []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || strings.HasSuffix(p, ".attrs") {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		res = append(res, filepath.ToSlash(rel))
+		return nil
+	})
+	require.NoError(t, err)
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,177 @@
+package blob
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"gocloud.dev/blob"
+)
+
+// hiveDefaultPartition is the directory name Hive and Spark use for null partition values.
+const hiveDefaultPartition = "__HIVE_DEFAULT_PARTITION__"
+
+// PartitionFilters restricts files to partitions where each column has one of the listed values.
+// Values are compared on their string representation. A nil value matches null partition values.
+type PartitionFilters map[string][]any
+
+// ParsePartitionFilters parses the partition_filters source property.
+// Each key is a partition column and each value is a scalar or a list of scalars to match.
+func ParsePartitionFilters(filters map[string]any) (PartitionFilters, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	res := make(PartitionFilters, len(filters))
+	for col, v := range filters {
+		var vals []any
+		switch v := v.(type) {
+		case []any:
+			vals = v
+		case []string:
+			for _, s := range v {
+				vals = append(vals, s)
+			}
+		default:
+			vals = []any{v}
+		}
+		for _, val := range vals {
+			switch val.(type) {
+			case map[string]any, []any:
+				return nil, fmt.Errorf("invalid value for partition filter %q: must be a scalar or a list of scalars", col)
+			}
+		}
+		if len(vals) == 0 {
+			return nil, fmt.Errorf("partition filter %q has no values", col)
+		}
+		res[col] = vals
+	}
+	return res, nil
+}
+
+// Match returns true if the partition values satisfy all the partition filters.
+// Files that are not partitioned by a filtered column can't be pruned and always match.
+func (f PartitionFilters) Match(partition map[string]any) bool {
+	for col, vals := range f {
+		v, ok := partition[col]
+		if !ok {
+			continue
+		}
+		s, notNull := partitionValueString(v)
+
+		var match bool
+		for _, val := range vals {
+			fs, fNotNull := partitionValueString(val)
+			if fNotNull == notNull && fs == s {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+// HivePartitions parses Hive-style partition values from the directories of an object key.
+// For example, for "events/dt=2024-01-01/country=DK/part-0.parquet", it returns {"dt": "2024-01-01", "country": "DK"}.
+// Values are URL-unescaped, and the __HIVE_DEFAULT_PARTITION__ placeholder is returned as nil.
+// It returns nil if the key doesn't contain any partitions.
+func HivePartitions(key string) map[string]any {
+	var res map[string]any
+	segments := strings.Split(key, "/")
+	for _, seg := range segments[:len(segments)-1] {
+		k, v, ok := strings.Cut(seg, "=")
+		if !ok || k == "" {
+			continue
+		}
+		if uk, err := url.PathUnescape(k); err == nil {
+			k = uk
+		}
+		if uv, err := url.PathUnescape(v); err == nil {
+			v = uv
+		}
+
+		if res == nil {
+			res = make(map[string]any)
+		}
+		if v == hiveDefaultPartition {
+			res[k] = nil
+		} else {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// hivePartitionPrefix returns the prefix of an object key up to and including its last Hive partition directory.
+// It returns an empty string if the key doesn't contain any partitions.
+func hivePartitionPrefix(key string) string {
+	end := 0
+	start := 0
+	for i := 0; i < len(key); i++ {
+		if key[i] != '/' {
+			continue
+		}
+		if strings.Contains(key[start:i], "=") {
+			end = i + 1
+		}
+		start = i + 1
+	}
+	return key[:end]
+}
+
+// partitionSelector implements the partitions extract policy, which selects the objects in the first or last N Hive partitions of a listing.
+// It relies on object stores listing keys in lexicographic order, which means the objects of each partition are listed contiguously.
+type partitionSelector struct {
+	strategy ExtractPolicyStrategy
+	limit    int
+	groups   map[string][]*blob.ListObject
+}
+
+func newPartitionSelector(policy *ExtractPolicy) *partitionSelector {
+	if policy == nil || policy.PartitionsStrategy == ExtractPolicyStrategyUnspecified {
+		return nil
+	}
+	return &partitionSelector{
+		strategy: policy.PartitionsStrategy,
+		limit:    int(policy.PartitionsLimit),
+		groups:   make(map[string][]*blob.ListObject),
+	}
+}
+
+// add adds an object. It returns false if the object and all the objects listed after it are not needed.
+// Objects that are not in a Hive partition are treated as belonging to one partition.
+func (s *partitionSelector) add(obj *blob.ListObject) bool {
+	prefix := hivePartitionPrefix(obj.Key)
+	if _, ok := s.groups[prefix]; !ok && s.strategy == ExtractPolicyStrategyHead && len(s.groups) >= s.limit {
+		return false
+	}
+	s.groups[prefix] = append(s.groups[prefix], obj)
+	return true
+}
+
+// objects returns the objects of the selected partitions in listing order.
+func (s *partitionSelector) objects() []*blob.ListObject {
+	prefixes := make([]string, 0, len(s.groups))
+	for p := range s.groups {
+		prefixes = append(prefixes, p)
+	}
+	sort.Strings(prefixes)
+
+	if len(prefixes) > s.limit {
+		if s.strategy == ExtractPolicyStrategyTail {
+			prefixes = prefixes[len(prefixes)-s.limit:]
+		} else {
+			prefixes = prefixes[:s.limit]
+		}
+	}
+
+	var res []*blob.ListObject
+	for _, p := range prefixes {
+		res = append(res, s.groups[p]...)
+	}
+	return res
+}
@@ -0,0 +1,114 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestHivePartitions(t *testing.T) {
+	require.Nil(t, HivePartitions("events/part-0.parquet"))
+	require.Nil(t, HivePartitions("dt=2024-01-01.parquet"))
+	require.Equal(t, map[string]any{"dt": "2024-01-01"}, HivePartitions("dt=2024-01-01/part-0.parquet"))
+	require.Equal(t, map[string]any{"dt": "2024-01-01", "country": "New York", "region": nil}, HivePartitions("events/dt=2024-01-01/country=New%20York/region=__HIVE_DEFAULT_PARTITION__/part-0.parquet"))
+
+	require.Equal(t, "", hivePartitionPrefix("events/part-0.parquet"))
+	require.Equal(t, "events/dt=2024-01-01/hour=01/", hivePartitionPrefix("events/dt=2024-01-01/hour=01/part-0.parquet"))
+	require.Equal(t, "events/dt=2024-01-01/", hivePartitionPrefix("events/dt=2024-01-01/_tmp/part-0.parquet"))
+}
+
+func TestPartitionFilters(t *testing.T) {
+	f, err := ParsePartitionFilters(nil)
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	f, err = ParsePartitionFilters(map[string]any{"dt": []any{"2024-01-01", "2024-01-02"}, "hour": 1, "region": nil})
+	require.NoError(t, err)
+	require.Equal(t, PartitionFilters{"dt": {"2024-01-01", "2024-01-02"}, "hour": {1}, "region": {nil}}, f)
+
+	require.True(t, f.Match(map[string]any{"dt": "2024-01-02", "hour": "1", "region": nil}))
+	require.True(t, f.Match(map[string]any{"dt": "2024-01-02"}))
+	require.False(t, f.Match(map[string]any{"dt": "2024-01-03", "hour": "1"}))
+	require.False(t, f.Match(map[string]any{"dt": "2024-01-01", "region": "EU"}))
+
+	_, err = ParsePartitionFilters(map[string]any{"dt": map[string]any{"gt": "2024-01-01"}})
+	require.Error(t, err)
+
+	_, err = ParsePartitionFilters(map[string]any{"dt": []any{}})
+	require.Error(t, err)
+}
+
+func TestHivePartitionPruning(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	bucket := openDirBucket(t, dir)
+	for _, key := range []string{
+		"events/dt=2024-01-01/a.csv",
+		"events/dt=2024-01-02/b.csv",
+		"events/dt=2024-01-02/c.csv",
+		"events/dt=2024-01-03/d.csv",
+		"events/dt=2024-01-04/e.csv",
+	} {
+		require.NoError(t, bucket.WriteAll(ctx, key, []byte(key), nil))
+	}
+
+	files, err := readGlobFiles(t, dir, Options{PartitionFilters: PartitionFilters{"dt": {"2024-01-02", "2024-01-04"}}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"events/dt=2024-01-02/b.csv", "events/dt=2024-01-02/c.csv", "events/dt=2024-01-04/e.csv"}, files)
+
+	_, err = readGlobFiles(t, dir, Options{PartitionFilters: PartitionFilters{"date": {"2024-01-02"}}})
+	require.ErrorContains(t, err, `partition filter "date" does not match`)
+
+	_, err = readGlobFiles(t, dir, Options{PartitionFilters: PartitionFilters{"dt": {"2023-12-31"}}})
+	require.ErrorContains(t, err, "matching the partition filters")
+
+	files, err = readGlobFiles(t, dir, Options{ExtractPolicy: &ExtractPolicy{PartitionsStrategy: ExtractPolicyStrategyTail, PartitionsLimit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"events/dt=2024-01-03/d.csv", "events/dt=2024-01-04/e.csv"}, files)
+
+	files, err = readGlobFiles(t, dir, Options{ExtractPolicy: &ExtractPolicy{PartitionsStrategy: ExtractPolicyStrategyHead, PartitionsLimit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"events/dt=2024-01-01/a.csv", "events/dt=2024-01-02/b.csv", "events/dt=2024-01-02/c.csv"}, files)
+
+	// The partitions policy is applied before the files policy
+	files, err = readGlobFiles(t, dir, Options{
+		PartitionFilters: PartitionFilters{"dt": {"2024-01-01", "2024-01-02", "2024-01-03"}},
+		ExtractPolicy:    &ExtractPolicy{PartitionsStrategy: ExtractPolicyStrategyTail, PartitionsLimit: 2, FilesStrategy: ExtractPolicyStrategyHead, FilesLimit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"events/dt=2024-01-02/b.csv", "events/dt=2024-01-02/c.csv"}, files)
+}
+
+// readGlobFiles returns the contents of the files downloaded for the glob "events/**/*.csv" in dir.
+// The test files store their key as their contents.
+func readGlobFiles(t *testing.T, dir string, opts Options) ([]string, error) {
+	opts.GlobPattern = "events/**/*.csv"
+	opts.KeepFilesUntilClose = true
+	it, err := NewIterator(context.Background(), openDirBucket(t, dir), opts, zap.NewNop())
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var res []string
+	for {
+		paths, err := it.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		for _, p := range paths {
+			data, err := os.ReadFile(p)
+			require.NoError(t, err)
+			res = append(res, string(data))
+		}
+	}
+	sort.Strings(res)
+	return res, nil
+}
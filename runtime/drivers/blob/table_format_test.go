@@ -0,0 +1,329 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
+)
+
+func TestParseTableOptions(t *testing.T) {
+	opts, err := ParseTableOptions("", "", nil)
+	require.NoError(t, err)
+	require.Nil(t, opts)
+
+	opts, err = ParseTableOptions("Delta", "3", map[string]any{"date": "2024-01-01", "country": []any{"US", "DK", nil}})
+	require.NoError(t, err)
+	require.Equal(t, &TableOptions{
+		Format:           TableFormatDelta,
+		Version:          "3",
+		PartitionFilters: map[string][]any{"date": {"2024-01-01"}, "country": {"US", "DK", nil}},
+	}, opts)
+
+	_, err = ParseTableOptions("hudi", "", nil)
+	require.Error(t, err)
+
+	_, err = ParseTableOptions("", "3", nil)
+	require.Error(t, err)
+
+	_, err = ParseTableOptions("iceberg", "", map[string]any{"date": map[string]any{"gt": 1}})
+	require.Error(t, err)
+
+	_, err = ParseTableOptions("iceberg", "", map[string]any{"date": []any{}})
+	require.Error(t, err)
+}
+
+func TestDeltaTable(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	bucket := openDirBucket(t, dir)
+
+	write := func(key, data string) {
+		require.NoError(t, bucket.WriteAll(ctx, key, []byte(data), nil))
+	}
+	for _, f := range []string{"date=2024-01-01/a.parquet", "date=2024-01-01/b.parquet", "date=2024-01-02/c%20d.parquet", "date=2024-01-03/e.parquet"} {
+		write("tbl/"+f, f)
+	}
+	write("tbl/_delta_log/00000000000000000000.json", `{"protocol":{"minReaderVersion":1,"minWriterVersion":2}}
+{"metaData":{"id":"1","partitionColumns":["date"]}}
+{"add":{"path":"date=2024-01-01/a.parquet","size":10,"partitionValues":{"date":"2024-01-01"},"dataChange":true}}
+{"add":{"path":"date=2024-01-01/b.parquet","size":10,"partitionValues":{"date":"2024-01-01"},"dataChange":true}}
+`)
+	write("tbl/_delta_log/00000000000000000001.json", `{"remove":{"path":"date=2024-01-01/a.parquet","dataChange":true}}
+{"add":{"path":"date=2024-01-02/c%2520d.parquet","size":10,"partitionValues":{"date":"2024-01-02"},"dataChange":true}}
+`)
+	write("tbl/_delta_log/00000000000000000002.json", `{"add":{"path":"s3://bucket/tbl/date=2024-01-03/e.parquet","size":10,"partitionValues":{"date":"2024-01-03"},"dataChange":true}}
+`)
+
+	// Latest version
+	files := readTableFiles(t, dir, &TableOptions{Format: TableFormatDelta})
+	require.Equal(t, []string{"date=2024-01-01/b.parquet", "date=2024-01-02/c%20d.parquet", "date=2024-01-03/e.parquet"}, files)
+
+	// Time travel
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatDelta, Version: "0"})
+	require.Equal(t, []string{"date=2024-01-01/a.parquet", "date=2024-01-01/b.parquet"}, files)
+
+	// Time travel by timestamp uses the modification times of the commit files
+	for i, day := range []int{1, 2, 3} {
+		ts := time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, fmt.Sprintf("tbl/_delta_log/%020d.json", i)), ts, ts))
+	}
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatDelta, Version: "2024-01-02T12:00:00Z"})
+	require.Equal(t, []string{"date=2024-01-01/b.parquet", "date=2024-01-02/c%20d.parquet"}, files)
+	_, err := deltaFiles(ctx, bucket, "tbl", "2023-12-31")
+	require.ErrorContains(t, err, "no Delta table version found")
+
+	// Partition filters
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatDelta, PartitionFilters: map[string][]any{"date": {"2024-01-02", "2024-01-03"}}})
+	require.Equal(t, []string{"date=2024-01-02/c%20d.parquet", "date=2024-01-03/e.parquet"}, files)
+
+	_, err = NewIterator(ctx, openDirBucket(t, dir), Options{GlobPattern: "tbl", Table: &TableOptions{Format: TableFormatDelta, PartitionFilters: map[string][]any{"day": {"2024-01-02"}}}}, zap.NewNop())
+	require.ErrorContains(t, err, "does not match a partition column")
+
+	// Replace the first commits with a checkpoint
+	writeDeltaCheckpoint(t, bucket, "tbl/_delta_log/00000000000000000001.checkpoint.parquet", []deltaAddAction{
+		{Path: "date=2024-01-01/b.parquet", Size: 10, PartitionValues: map[string]any{"date": "2024-01-01"}},
+		{Path: "date=2024-01-02/c%2520d.parquet", Size: 10, PartitionValues: map[string]any{"date": "2024-01-02"}},
+	})
+	require.NoError(t, bucket.Delete(ctx, "tbl/_delta_log/00000000000000000000.json"))
+	require.NoError(t, bucket.Delete(ctx, "tbl/_delta_log/00000000000000000001.json"))
+
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatDelta})
+	require.Equal(t, []string{"date=2024-01-01/b.parquet", "date=2024-01-02/c%20d.parquet", "date=2024-01-03/e.parquet"}, files)
+
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatDelta, Version: "1", PartitionFilters: map[string][]any{"date": {"2024-01-01"}}})
+	require.Equal(t, []string{"date=2024-01-01/b.parquet"}, files)
+
+	_, err = deltaFiles(ctx, bucket, "tbl", "0")
+	require.ErrorContains(t, err, "version 0 not found")
+}
+
+func TestIcebergTable(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	bucket := openDirBucket(t, dir)
+
+	write := func(key string, data []byte) {
+		require.NoError(t, bucket.WriteAll(ctx, key, data, nil))
+	}
+	for _, f := range []string{"data/date=2024-01-01/a.parquet", "data/date=2024-01-01/b.parquet", "data/date=2024-01-02/c.parquet"} {
+		write("tbl/"+f, []byte(f))
+	}
+
+	// Snapshot 1 adds a and b, snapshot 2 deletes a and adds c
+	write("tbl/metadata/m1.avro", icebergManifest(
+		icebergEntry{1, "a.parquet", "2024-01-01"},
+		icebergEntry{1, "b.parquet", "2024-01-01"},
+	))
+	write("tbl/metadata/m2.avro", icebergManifest(
+		icebergEntry{2, "a.parquet", "2024-01-01"},
+		icebergEntry{0, "b.parquet", "2024-01-01"},
+		icebergEntry{1, "c.parquet", "2024-01-02"},
+	))
+	write("tbl/metadata/snap-1.avro", icebergManifestList("s3://bucket/tbl/metadata/m1.avro"))
+	write("tbl/metadata/snap-2.avro", icebergManifestList("s3://bucket/tbl/metadata/m2.avro"))
+	write("tbl/metadata/00001-abc.metadata.json", []byte(`{"format-version":2,"current-snapshot-id":1,"snapshots":[{"snapshot-id":1,"manifest-list":"s3://bucket/tbl/metadata/snap-1.avro"}]}`))
+	write("tbl/metadata/00002-def.metadata.json", []byte(`{
+		"format-version": 2,
+		"current-snapshot-id": 2,
+		"snapshots": [
+			{"snapshot-id": 1, "timestamp-ms": 1704067200000, "manifest-list": "s3://bucket/tbl/metadata/snap-1.avro"},
+			{"snapshot-id": 2, "timestamp-ms": 1704153600000, "manifest-list": "s3://bucket/tbl/metadata/snap-2.avro"}
+		],
+		"snapshot-log": [
+			{"snapshot-id": 1, "timestamp-ms": 1704067200000},
+			{"snapshot-id": 2, "timestamp-ms": 1704153600000}
+		],
+		"refs": {"main": {"snapshot-id": 2, "type": "branch"}, "v1": {"snapshot-id": 1, "type": "tag"}}
+	}`))
+
+	// Current snapshot
+	files := readTableFiles(t, dir, &TableOptions{Format: TableFormatIceberg})
+	require.Equal(t, []string{"data/date=2024-01-01/b.parquet", "data/date=2024-01-02/c.parquet"}, files)
+
+	// Time travel by snapshot ID and tag
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatIceberg, Version: "1"})
+	require.Equal(t, []string{"data/date=2024-01-01/a.parquet", "data/date=2024-01-01/b.parquet"}, files)
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatIceberg, Version: "v1"})
+	require.Equal(t, []string{"data/date=2024-01-01/a.parquet", "data/date=2024-01-01/b.parquet"}, files)
+
+	// Time travel by timestamp
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatIceberg, Version: "2024-01-01T12:00:00Z"})
+	require.Equal(t, []string{"data/date=2024-01-01/a.parquet", "data/date=2024-01-01/b.parquet"}, files)
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatIceberg, Version: "2024-01-02"})
+	require.Equal(t, []string{"data/date=2024-01-01/b.parquet", "data/date=2024-01-02/c.parquet"}, files)
+	_, err := icebergFiles(ctx, bucket, "tbl", "2023-12-31")
+	require.ErrorContains(t, err, "no snapshot found")
+
+	// Partition filters
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatIceberg, PartitionFilters: map[string][]any{"date": {"2024-01-02"}}})
+	require.Equal(t, []string{"data/date=2024-01-02/c.parquet"}, files)
+
+	// Version hint takes precedence over listing
+	write("tbl/metadata/version-hint.text", []byte("00001-abc.metadata.json"))
+	files = readTableFiles(t, dir, &TableOptions{Format: TableFormatIceberg})
+	require.Equal(t, []string{"data/date=2024-01-01/a.parquet", "data/date=2024-01-01/b.parquet"}, files)
+
+	_, err = icebergFiles(ctx, bucket, "tbl", "3")
+	require.ErrorContains(t, err, "snapshot 3 not found")
+}
+
+// readTableFiles returns the contents of the files downloaded for the table at "tbl" in dir.
+// The test tables store the relative path of each data file as its contents.
+func readTableFiles(t *testing.T, dir string, table *TableOptions) []string {
+	it, err := NewIterator(context.Background(), openDirBucket(t, dir), Options{GlobPattern: "tbl", Table: table, KeepFilesUntilClose: true}, zap.NewNop())
+	require.NoError(t, err)
+	defer it.Close()
+
+	var res []string
+	for {
+		paths, err := it.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		for _, p := range paths {
+			data, err := os.ReadFile(p)
+			require.NoError(t, err)
+			res = append(res, string(data))
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+func writeDeltaCheckpoint(t *testing.T, bucket *blob.Bucket, key string, adds []deltaAddAction) {
+	addType := arrow.StructOf(
+		arrow.Field{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true},
+		arrow.Field{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		arrow.Field{Name: "partitionValues", Type: arrow.MapOf(arrow.BinaryTypes.String, arrow.BinaryTypes.String), Nullable: true},
+	)
+	schema := arrow.NewSchema([]arrow.Field{{Name: "add", Type: addType, Nullable: true}}, nil)
+
+	b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer b.Release()
+	sb := b.Field(0).(*array.StructBuilder)
+	for _, add := range adds {
+		sb.Append(true)
+		sb.FieldBuilder(0).(*array.StringBuilder).Append(add.Path)
+		sb.FieldBuilder(1).(*array.Int64Builder).Append(add.Size)
+		mb := sb.FieldBuilder(2).(*array.MapBuilder)
+		mb.Append(true)
+		for k, v := range add.PartitionValues {
+			mb.KeyBuilder().(*array.StringBuilder).Append(k)
+			mb.ItemBuilder().(*array.StringBuilder).Append(v.(string))
+		}
+	}
+	// Checkpoints contain rows for other actions where add is null
+	sb.AppendNull()
+
+	rec := b.NewRecord()
+	defer rec.Release()
+	tbl := array.NewTableFromRecords(schema, []arrow.Record{rec})
+	defer tbl.Release()
+
+	var buf bytes.Buffer
+	require.NoError(t, pqarrow.WriteTable(tbl, &buf, tbl.NumRows(), nil, pqarrow.DefaultWriterProps()))
+	require.NoError(t, bucket.WriteAll(context.Background(), key, buf.Bytes(), nil))
+}
+
+type icebergEntry struct {
+	status int64
+	file   string
+	date   string
+}
+
+// icebergManifest returns a manifest file (an Avro object container file) with the given entries.
+func icebergManifest(entries ...icebergEntry) []byte {
+	schema := `{"type": "record", "name": "manifest_entry", "fields": [
+		{"name": "status", "type": "int"},
+		{"name": "data_file", "type": {"type": "record", "name": "r2", "fields": [
+			{"name": "content", "type": "int"},
+			{"name": "file_path", "type": "string"},
+			{"name": "file_format", "type": "string"},
+			{"name": "partition", "type": {"type": "record", "name": "r102", "fields": [{"name": "date", "type": ["null", "string"]}]}},
+			{"name": "file_size_in_bytes", "type": "long"}
+		]}}
+	]}`
+
+	var data []byte
+	for _, e := range entries {
+		path := fmt.Sprintf("s3://bucket/tbl/data/date=%s/%s", e.date, e.file)
+		data = avroLong(data, e.status)
+		data = avroLong(data, 0)
+		data = avroString(data, path)
+		data = avroString(data, "PARQUET")
+		data = avroLong(data, 1)
+		data = avroString(data, e.date)
+		data = avroLong(data, 10)
+	}
+	return avroOCF(schema, int64(len(entries)), data)
+}
+
+// icebergManifestList returns a manifest list with the given data manifests.
+func icebergManifestList(manifests ...string) []byte {
+	schema := `{"type": "record", "name": "manifest_file", "fields": [
+		{"name": "manifest_path", "type": "string"},
+		{"name": "content", "type": "int"}
+	]}`
+
+	var data []byte
+	for _, m := range manifests {
+		data = avroString(data, m)
+		data = avroLong(data, 0)
+	}
+	return avroOCF(schema, int64(len(manifests)), data)
+}
+
+// avroOCF returns an uncompressed Avro object container file with a single block.
+func avroOCF(schema string, count int64, data []byte) []byte {
+	sync := []byte("0123456789abcdef")
+	b := []byte("Obj\x01")
+	b = avroLong(b, 1)
+	b = avroString(b, "avro.schema")
+	b = avroString(b, schema)
+	b = avroLong(b, 0)
+	b = append(b, sync...)
+	b = avroLong(b, count)
+	b = avroLong(b, int64(len(data)))
+	b = append(b, data...)
+	return append(b, sync...)
+}
+
+func avroLong(b []byte, n int64) []byte {
+	u := uint64((n << 1) ^ (n >> 63))
+	for u >= 0x80 {
+		b = append(b, byte(u)|0x80)
+		u >>= 7
+	}
+	return append(b, byte(u))
+}
+
+func avroString(b []byte, s string) []byte {
+	b = avroLong(b, int64(len(s)))
+	return append(b, s...)
+}
+
+// openDirBucket opens a bucket backed by a local directory.
+// Unlike memblob, the contents persist across buckets, which is needed since NewIterator closes the bucket.
+func openDirBucket(t *testing.T, dir string) *blob.Bucket {
+	bucket, err := fileblob.OpenBucket(dir, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = bucket.Close() })
+	return bucket
+}
@@ -0,0 +1,224 @@
+package blob
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	"github.com/c2h5oh/datasize"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/jsonval"
+)
+
+const maxParquetRowGroupSize = 512 * int64(datasize.MB)
+
+// rowIterator is the subset of *drivers.Result used by the file writers.
+// Scan is always called with *any destinations.
+type rowIterator interface {
+	Next() bool
+	Scan(dest ...any) error
+	Err() error
+}
+
+// WriteCSV writes the rows of res to fw as CSV with a header row.
+// Non-string values are encoded as JSON.
+func WriteCSV(res *drivers.Result, fw io.Writer) error {
+	return writeCSV(res.Schema, res, fw)
+}
+
+func writeCSV(schema *runtimev1.StructType, res rowIterator, fw io.Writer) error {
+	w := csv.NewWriter(fw)
+
+	strs := make([]string, len(schema.Fields))
+	for i, f := range schema.Fields {
+		strs[i] = f.Name
+	}
+	err := w.Write(strs)
+	if err != nil {
+		return err
+	}
+
+	vals := make([]any, len(schema.Fields))
+	for i := range vals {
+		vals[i] = new(any)
+	}
+
+	for res.Next() {
+		err := res.Scan(vals...)
+		if err != nil {
+			return err
+		}
+
+		for i, v := range vals {
+			v := *(v.(*any))
+
+			v, err := jsonval.ToValue(v, schema.Fields[i].Type)
+			if err != nil {
+				return fmt.Errorf("failed to convert to JSON value: %w", err)
+			}
+
+			var s string
+			if v != nil {
+				tmp, err := json.Marshal(v)
+				if err != nil {
+					return fmt.Errorf("failed to marshal JSON value: %w", err)
+				}
+				s = string(tmp)
+			}
+
+			strs[i] = s
+		}
+
+		err = w.Write(strs)
+		if err != nil {
+			return err
+		}
+	}
+	if res.Err() != nil {
+		return res.Err()
+	}
+
+	w.Flush()
+	return nil
+}
+
+// WriteParquet writes the rows of res to fw as a Parquet file.
+// If props is nil, the default writer properties are used, which do not compress the data.
+func WriteParquet(res *drivers.Result, fw io.Writer, props *parquet.WriterProperties) error {
+	return writeParquet(res.Schema, res, fw, props)
+}
+
+func writeParquet(schema *runtimev1.StructType, res rowIterator, fw io.Writer, props *parquet.WriterProperties) error {
+	fields := make([]arrow.Field, 0, len(schema.Fields))
+	for _, f := range schema.Fields {
+		arrowField := arrow.Field{}
+		arrowField.Name = f.Name
+		switch f.Type.Code {
+		case runtimev1.Type_CODE_BOOL:
+			arrowField.Type = arrow.FixedWidthTypes.Boolean
+		case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
+			arrowField.Type = arrow.PrimitiveTypes.Int64
+		case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
+			arrowField.Type = arrow.PrimitiveTypes.Uint64
+		case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_DECIMAL:
+			arrowField.Type = arrow.PrimitiveTypes.Float64
+		case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
+			arrowField.Type = arrow.FixedWidthTypes.Timestamp_us
+		case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
+			arrowField.Type = arrow.BinaryTypes.String
+		case runtimev1.Type_CODE_BYTES:
+			arrowField.Type = arrow.BinaryTypes.Binary
+		}
+		fields = append(fields, arrowField)
+	}
+	arrowSchema := arrow.NewSchema(fields, nil)
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	recordBuilder := array.NewRecordBuilder(mem, arrowSchema)
+	defer recordBuilder.Release()
+
+	vals := make([]any, len(schema.Fields))
+	for i := range vals {
+		vals[i] = new(any)
+	}
+
+	parquetwriter, err := pqarrow.NewFileWriter(arrowSchema, fw, props, pqarrow.ArrowWriterProperties{})
+	if err != nil {
+		return err
+	}
+	defer parquetwriter.Close()
+	var rows int64
+	for res.Next() {
+		err := res.Scan(vals...)
+		if err != nil {
+			return err
+		}
+
+		for i, v := range vals {
+			t := schema.Fields[i].Type
+			v := *(v.(*any))
+			v, err := jsonval.ToValue(v, schema.Fields[i].Type)
+			if err != nil {
+				return fmt.Errorf("failed to convert to JSON value: %w", err)
+			}
+
+			switch t.Code {
+			case runtimev1.Type_CODE_BOOL:
+				v, _ := v.(bool)
+				recordBuilder.Field(i).(*array.BooleanBuilder).Append(v)
+			case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
+				v, _ := v.(int64)
+				recordBuilder.Field(i).(*array.Int64Builder).Append(v)
+			case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
+				v, _ := v.(float64)
+				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
+			case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
+				v, _ := v.(uint64)
+				recordBuilder.Field(i).(*array.Uint64Builder).Append(v)
+			case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
+				v, _ := v.(float64)
+				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
+			case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
+				v, _ := v.(float64)
+				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
+			case runtimev1.Type_CODE_DECIMAL:
+				v, _ := v.(float64)
+				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
+			case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
+				v, _ := v.(time.Time)
+				tmp, err := arrow.TimestampFromTime(v, arrow.Microsecond)
+				if err != nil {
+					return err
+				}
+				recordBuilder.Field(i).(*array.TimestampBuilder).Append(tmp)
+			case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
+				res, err := json.Marshal(v)
+				if err != nil {
+					return fmt.Errorf("failed to convert to JSON value: %w", err)
+				}
+				recordBuilder.Field(i).(*array.StringBuilder).Append(string(res))
+			case runtimev1.Type_CODE_BYTES:
+				v, _ := v.([]byte)
+				recordBuilder.Field(i).(*array.BinaryBuilder).Append(v)
+			}
+		}
+		rows++
+		if rows == 1000 {
+			rec := recordBuilder.NewRecord()
+			if err := parquetwriter.WriteBuffered(rec); err != nil {
+				rec.Release()
+				return err
+			}
+			rec.Release()
+			if parquetwriter.RowGroupTotalBytesWritten() >= maxParquetRowGroupSize {
+				// Also flushes the data to the disk freeing memory
+				parquetwriter.NewBufferedRowGroup()
+			}
+			rows = 0
+		}
+	}
+	if res.Err() != nil {
+		return res.Err()
+	}
+	if rows == 0 {
+		return nil
+	}
+	rec := recordBuilder.NewRecord()
+	err = parquetwriter.Write(rec)
+	// release the record before returning the error
+	rec.Release()
+	return err
+}
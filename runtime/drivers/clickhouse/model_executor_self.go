@@ -52,14 +52,33 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 
 	asView := outputProps.Typ == "VIEW"
 	tableName := outputProps.Table
+	incrementalRun := opts.IncrementalRun
+	stageChanges := opts.Env.StageChanges
+
+	// Snapshot models maintain the history of the model's rows.
+	// On incremental runs, we replace the history of every changed key.
+	// ClickHouse can only atomically replace whole partitions, so we rebuild the table in a staging table and swap it with the current table.
+	if opts.Snapshot != nil {
+		sql, err := opts.Snapshot.PrepareSQL(ctx, e.c, inputProps.SQL, tableName, opts.IncrementalRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare snapshot: %w", err)
+		}
+		inputProps.SQL = sql
+		if opts.IncrementalRun {
+			inputProps.SQL = opts.Snapshot.RebuildSQL(e.c.Dialect(), sql, tableName)
+			incrementalRun = false
+			stageChanges = true
+		}
+	}
+
 	if outputProps.QuerySettings != "" {
 		// Note: This will lead to failures if user sets settings both in query and output properties
 		inputProps.SQL = inputProps.SQL + " SETTINGS " + outputProps.QuerySettings
 	}
 
-	if !opts.IncrementalRun {
+	if !incrementalRun {
 		stagingTableName := tableName
-		if opts.Env.StageChanges {
+		if stageChanges {
 			stagingTableName = stagingTableNameFor(tableName)
 		}
 
@@ -76,6 +95,15 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 			return nil, fmt.Errorf("failed to create model: %w", err)
 		}
 
+		// Give the caller a chance to reject the staged result before it replaces the previous result
+		if stagingTableName != tableName && opts.PrePromote != nil {
+			err = opts.PrePromote(ctx, &drivers.ModelResult{Connector: opts.OutputConnector, Table: stagingTableName})
+			if err != nil {
+				_ = e.c.DropTable(ctx, stagingTableName, asView)
+				return nil, err
+			}
+		}
+
 		// Rename the staging table to the final table name
 		if stagingTableName != tableName {
 			err = olapForceRenameTable(ctx, e.c, stagingTableName, asView, tableName)
@@ -85,7 +113,7 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 		}
 	} else {
 		// Insert into the table
-		err := e.c.InsertTableAsSelect(ctx, tableName, inputProps.SQL, false, true, outputProps.IncrementalStrategy, outputProps.UniqueKey)
+		err := e.c.InsertTableAsSelect(ctx, tableName, inputProps.SQL, false, true, outputProps.IncrementalStrategy, outputProps.UniqueKey, outputProps.PartitionBy)
 		if err != nil {
 			return nil, fmt.Errorf("failed to incrementally insert into table: %w", err)
 		}
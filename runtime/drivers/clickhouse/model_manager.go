@@ -93,6 +93,15 @@ func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecuteOptions) erro
 
 	switch p.IncrementalStrategy {
 	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend:
+	case drivers.IncrementalStrategyDeleteInsert:
+		if p.PartitionBy == "" {
+			return fmt.Errorf(`must specify a "partition_by" when "incremental_strategy" is %q`, p.IncrementalStrategy)
+		}
+	case drivers.IncrementalStrategyReplacePartition:
+		// The partitions are determined by the table's partition key, which may also be set in "engine_full"
+		if p.PartitionBy == "" && p.EngineFull == "" {
+			return fmt.Errorf(`must specify a "partition_by" when "incremental_strategy" is %q`, p.IncrementalStrategy)
+		}
 	default:
 		return fmt.Errorf("invalid incremental strategy %q", p.IncrementalStrategy)
 	}
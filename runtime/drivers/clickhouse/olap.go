@@ -294,22 +294,110 @@ func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
-func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string, partitionBy string) error {
 	if !inPlace {
 		return fmt.Errorf("clickhouse: inserts does not support inPlace=false")
 	}
-	if strategy == drivers.IncrementalStrategyAppend {
+	switch strategy {
+	case drivers.IncrementalStrategyAppend:
 		return c.Exec(ctx, &drivers.Statement{
 			Query:       fmt.Sprintf("INSERT INTO %s %s", safeSQLName(name), sql),
 			Priority:    1,
 			LongRunning: true,
 		})
+	case drivers.IncrementalStrategyDeleteInsert, drivers.IncrementalStrategyReplacePartition:
+		// The table is partitioned by the "partition_by" expression (see ModelOutputProperties),
+		// so deleting the rows in the partitions present in the new data and inserting it is the same as replacing those partitions.
+		return c.replacePartitions(ctx, name, sql)
 	}
 
 	// merge strategy is also not supported for clickhouse
 	return fmt.Errorf("incremental insert strategy %q not supported", strategy)
 }
 
+// replacePartitions atomically replaces the partitions of the table present in the new data.
+// It relies on the table's partition key, so the new data is staged in a table with the same structure and engine.
+// Unlike deleting and inserting rows, each partition is swapped in a single operation, so queries never observe a partition with missing or duplicate rows.
+func (c *connection) replacePartitions(ctx context.Context, name, sql string) error {
+	if c.config.Cluster != "" {
+		return fmt.Errorf("clickhouse: incremental insert strategy %q is not supported on clusters", drivers.IncrementalStrategyReplacePartition)
+	}
+
+	// Stage the new data in a temporary table
+	tmp, err := c.createIncrementalStagingTable(ctx, name, sql)
+	if err != nil {
+		return err
+	}
+	defer c.dropIncrementalStagingTable(tmp)
+
+	// Find the partitions present in the new data
+	res, err := c.Execute(ctx, &drivers.Statement{
+		Query:    "SELECT DISTINCT partition_id FROM system.parts WHERE database = currentDatabase() AND table = ? AND active",
+		Args:     []any{tmp},
+		Priority: 1,
+	})
+	if err != nil {
+		return err
+	}
+	var partitions []string
+	for res.Next() {
+		var id string
+		if err := res.Scan(&id); err != nil {
+			res.Close()
+			return err
+		}
+		partitions = append(partitions, id)
+	}
+	err = res.Err()
+	res.Close()
+	if err != nil {
+		return err
+	}
+
+	// Replace each partition
+	for _, id := range partitions {
+		err := c.Exec(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("ALTER TABLE %s REPLACE PARTITION ID ? FROM %s", safeSQLName(name), safeSQLName(tmp)),
+			Args:        []any{id},
+			Priority:    1,
+			LongRunning: true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to replace partition %q: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// createIncrementalStagingTable creates a table with the same structure and engine as the given table and inserts the result of the SQL query into it.
+func (c *connection) createIncrementalStagingTable(ctx context.Context, name, sql string) (string, error) {
+	tmp := tempName("__rill_incremental_")
+	err := c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("CREATE TABLE %s AS %s", safeSQLName(tmp), safeSQLName(name)),
+		Priority: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	err = c.Exec(ctx, &drivers.Statement{
+		Query:       fmt.Sprintf("INSERT INTO %s %s", safeSQLName(tmp), sql),
+		Priority:    1,
+		LongRunning: true,
+	})
+	if err != nil {
+		c.dropIncrementalStagingTable(tmp)
+		return "", err
+	}
+	return tmp, nil
+}
+
+func (c *connection) dropIncrementalStagingTable(tmp string) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	_ = c.Exec(ctx, &drivers.Statement{Query: fmt.Sprintf("DROP TABLE IF EXISTS %s", safeSQLName(tmp)), Priority: 1})
+}
+
 // DropTable implements drivers.OLAPStore.
 func (c *connection) DropTable(ctx context.Context, name string, _ bool) error {
 	typ, onCluster, err := informationSchema{c: c}.entityType(ctx, "", name)
@@ -36,7 +36,7 @@ func testClickhouseSingleHost(t *testing.T, dsn string) {
 	})
 	t.Run("RenameTable", func(t *testing.T) { testRenameTable(t, olap) })
 	t.Run("CreateTableAsSelect", func(t *testing.T) { testCreateTableAsSelect(t, olap) })
-
+	t.Run("IncrementalStrategies", func(t *testing.T) { testIncrementalStrategies(t, olap) })
 }
 
 func testClickhouseCluster(t *testing.T, dsn, cluster string) {
@@ -116,6 +116,49 @@ func testCreateTableAsSelect(t *testing.T, olap drivers.OLAPStore) {
 	require.NoError(t, err)
 }
 
+func testIncrementalStrategies(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+	for _, strategy := range []drivers.IncrementalStrategy{drivers.IncrementalStrategyDeleteInsert, drivers.IncrementalStrategyReplacePartition} {
+		t.Run(string(strategy), func(t *testing.T) {
+			err := olap.CreateTableAsSelect(ctx, "incremental", false, "SELECT * FROM (SELECT 'a' AS country, 1 AS val UNION ALL SELECT 'a', 2 UNION ALL SELECT 'b', 3)", map[string]any{
+				"type":         "TABLE",
+				"engine":       "MergeTree",
+				"order_by":     "tuple()",
+				"partition_by": "country",
+			})
+			require.NoError(t, err)
+
+			// Replaces partition "a", adds partition "c" and keeps partition "b"
+			err = olap.InsertTableAsSelect(ctx, "incremental", "SELECT * FROM (SELECT 'a' AS country, 10 AS val UNION ALL SELECT 'c', 20)", false, true, strategy, nil, "country")
+			require.NoError(t, err)
+
+			res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT country, val FROM incremental ORDER BY country, val"})
+			require.NoError(t, err)
+			var rows []string
+			for res.Next() {
+				var country string
+				var val int32
+				require.NoError(t, res.Scan(&country, &val))
+				rows = append(rows, fmt.Sprintf("%s=%d", country, val))
+			}
+			require.NoError(t, res.Err())
+			require.NoError(t, res.Close())
+			require.Equal(t, []string{"a=10", "b=3", "c=20"}, rows)
+
+			// The staging tables are dropped
+			res, err = olap.Execute(ctx, &drivers.Statement{Query: "SELECT count() FROM system.tables WHERE database = currentDatabase() AND name LIKE '__rill_incremental_%'"})
+			require.NoError(t, err)
+			require.True(t, res.Next())
+			var n uint64
+			require.NoError(t, res.Scan(&n))
+			require.NoError(t, res.Close())
+			require.Equal(t, uint64(0), n)
+
+			require.NoError(t, olap.DropTable(ctx, "incremental", false))
+		})
+	}
+}
+
 func prepareClusterConn(t *testing.T, olap drivers.OLAPStore, cluster string) {
 	err := olap.Exec(context.Background(), &drivers.Statement{
 		Query: fmt.Sprintf("CREATE OR REPLACE TABLE foo_local ON CLUSTER %s (bar VARCHAR, baz INTEGER) engine=MergeTree ORDER BY tuple()", cluster),
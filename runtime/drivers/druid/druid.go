@@ -78,6 +78,15 @@ var spec = drivers.Spec{
 			DisplayName: "SSL",
 			Description: "Use SSL to connect to the Druid server",
 		},
+		{
+			Key:         "coordinator_url",
+			Type:        drivers.StringPropertyType,
+			Required:    false,
+			DisplayName: "Coordinator URL",
+			Description: "URL of the Druid Router or Overlord used to ingest model outputs. Defaults to the host of the connection string.",
+			Placeholder: "https://example.com:8888",
+			NoPrompt:    true,
+		},
 	},
 	ImplementsOLAP: true,
 }
@@ -97,6 +106,8 @@ type configProperties struct {
 	SSL bool `mapstructure:"ssl"`
 	// LogQueries controls whether to log the raw SQL passed to OLAP.Execute.
 	LogQueries bool `mapstructure:"log_queries"`
+	// CoordinatorURL is the URL of the Router or Overlord used to submit ingestion tasks. Defaults to the scheme and host of the DSN.
+	CoordinatorURL string `mapstructure:"coordinator_url"`
 }
 
 // Opens a connection to Apache Druid using HTTP API.
@@ -230,12 +241,19 @@ func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
 
 // AsModelExecutor implements drivers.Handle.
 func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	if opts.OutputHandle != c {
+		return nil, false
+	}
+	switch opts.InputHandle.Driver() {
+	case "s3", "gcs", "azure":
+		return &objectStoreToSelfExecutor{store: opts.InputHandle, c: c}, true
+	}
 	return nil, false
 }
 
 // AsModelManager implements drivers.Handle.
 func (c *connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
-	return nil, false
+	return c, true
 }
 
 // AsTransporter implements drivers.Connection.
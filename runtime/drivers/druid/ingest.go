@@ -1,7 +1,10 @@
 package druid
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,10 +13,23 @@ import (
 	"time"
 )
 
+const (
+	_taskPollInterval    = 2 * time.Second
+	_taskShutdownTimeout = 10 * time.Second
+)
+
 type taskResult struct {
 	Task string
 }
 
+type taskStatus struct {
+	Status struct {
+		Status     string `json:"status"`
+		StatusCode string `json:"statusCode"`
+		ErrorMsg   string `json:"errorMsg"`
+	} `json:"status"`
+}
+
 type taskReport struct {
 	IngestionStatsAndErrors struct {
 		Payload struct {
@@ -34,28 +50,32 @@ type datasourceDetails struct {
 }
 
 // Ingest uses the Druid REST API to submit an ingestion spec. It returns once Druid has finished ingesting data.
-// This function is for test and development usage and has not been tested for production use.
+// This function is for test and development usage. Models ingest data into Druid with the model executor in model_executor.go.
 func Ingest(coordinatorURL, specJSON, datasourceName string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	c := &indexerClient{baseURL: coordinatorURL, client: http.DefaultClient, pollInterval: _taskPollInterval}
+
 	var status taskResult
-	err := sendRequest(coordinatorURL, http.MethodPost, "/druid/indexer/v1/task", specJSON, &status)
+	err := c.do(ctx, http.MethodPost, "/druid/indexer/v1/task", json.RawMessage(specJSON), &status)
 	if err != nil {
 		return err
 	}
 
-	deadline := time.Now().Add(timeout)
-	pollInterval := 2 * time.Second
-
 	for {
-		time.Sleep(pollInterval)
-
-		if time.Now().After(deadline) {
+		select {
+		case <-ctx.Done():
 			return fmt.Errorf("ingestion timeout")
+		case <-time.After(c.pollInterval):
 		}
 
-		tr, err := getTaskReport(coordinatorURL, status.Task)
+		var tr taskReport
+		err := c.do(ctx, http.MethodGet, fmt.Sprintf("/druid/indexer/v1/task/%s/reports", url.PathEscape(status.Task)), nil, &tr)
 		if err != nil {
 			// The coordinator may return 404 or 500 on the first few polls
-			if strings.Contains(err.Error(), "failed with status:") {
+			var se *statusError
+			if errors.As(err, &se) {
 				continue
 			}
 			return err
@@ -66,7 +86,8 @@ func Ingest(coordinatorURL, specJSON, datasourceName string, timeout time.Durati
 			continue
 		}
 
-		ds, err := getDatasourceDetails(coordinatorURL, datasourceName)
+		var ds datasourceDetails
+		err = c.do(ctx, http.MethodGet, "/druid/coordinator/v1/datasources/"+url.PathEscape(datasourceName), nil, &ds)
 		if err != nil {
 			return err
 		}
@@ -81,61 +102,196 @@ func Ingest(coordinatorURL, specJSON, datasourceName string, timeout time.Durati
 	}
 }
 
-func getTaskReport(coordinatorURL, taskID string) (*taskReport, error) {
-	var res taskReport
-	path := fmt.Sprintf("/druid/indexer/v1/task/%s/reports", taskID)
-	err := sendRequest(coordinatorURL, http.MethodGet, path, "", &res)
+// indexerClient calls the Overlord and Coordinator APIs that are used for batch ingestion.
+// The Druid Router proxies both APIs, so a single base URL is sufficient for most deployments.
+type indexerClient struct {
+	baseURL      string
+	username     string
+	password     string
+	client       *http.Client
+	pollInterval time.Duration
+}
+
+// newIndexerClient creates an indexerClient for the connector's config.
+// It uses the "coordinator_url" if set, and otherwise the scheme and host of the SQL connection string.
+func newIndexerClient(conf *configProperties) (*indexerClient, error) {
+	rawURL := conf.CoordinatorURL
+	if rawURL == "" {
+		dsn, err := dsnFromConfig(conf)
+		if err != nil {
+			return nil, err
+		}
+		rawURL = dsn
+	}
+
+	u, err := url.Parse(rawURL)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid coordinator URL: %w", maskURLError(err, rawURL))
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid coordinator URL: must be an absolute http(s) URL")
 	}
-	return &res, err
+
+	username, password := conf.Username, conf.Password
+	if u.User != nil {
+		username = u.User.Username()
+		password, _ = u.User.Password()
+	}
+
+	base := &url.URL{Scheme: u.Scheme, Host: u.Host}
+	if conf.CoordinatorURL != "" {
+		base.Path = strings.TrimSuffix(u.Path, "/")
+	}
+
+	return &indexerClient{
+		baseURL:      base.String(),
+		username:     username,
+		password:     password,
+		client:       &http.Client{},
+		pollInterval: _taskPollInterval,
+	}, nil
 }
 
-func getDatasourceDetails(coordinatorURL, datasourceName string) (*datasourceDetails, error) {
-	var res datasourceDetails
-	path := fmt.Sprintf("/druid/coordinator/v1/datasources/%s", datasourceName)
-	err := sendRequest(coordinatorURL, http.MethodGet, path, "", &res)
+// submitTask submits an ingestion task and returns its ID.
+func (c *indexerClient) submitTask(ctx context.Context, spec any) (string, error) {
+	var res taskResult
+	err := c.do(ctx, http.MethodPost, "/druid/indexer/v1/task", spec, &res)
 	if err != nil {
-		return nil, err
+		return "", fmt.Errorf("failed to submit ingestion task: %w", err)
+	}
+	if res.Task == "" {
+		return "", errors.New("failed to submit ingestion task: no task ID in response")
+	}
+	return res.Task, nil
+}
+
+// waitForTask polls the status of a task until it succeeds or fails.
+// If ctx is cancelled, it asks the Overlord to shut down the task before returning.
+func (c *indexerClient) waitForTask(ctx context.Context, taskID string) error {
+	path := fmt.Sprintf("/druid/indexer/v1/task/%s/status", url.PathEscape(taskID))
+	for first := true; ; first = false {
+		if !first {
+			select {
+			case <-ctx.Done():
+				c.shutdownTask(taskID)
+				return ctx.Err()
+			case <-time.After(c.pollInterval):
+			}
+		}
+
+		var res taskStatus
+		err := c.do(ctx, http.MethodGet, path, nil, &res)
+		if err != nil {
+			// The Overlord may not know about the task for a brief moment after it was submitted
+			if isNotFound(err) {
+				continue
+			}
+			if ctx.Err() != nil {
+				c.shutdownTask(taskID)
+				return ctx.Err()
+			}
+			return fmt.Errorf("failed to get status of ingestion task %q: %w", taskID, err)
+		}
+
+		code := res.Status.StatusCode
+		if code == "" {
+			code = res.Status.Status
+		}
+		switch code {
+		case "SUCCESS":
+			return nil
+		case "FAILED":
+			if res.Status.ErrorMsg != "" {
+				return fmt.Errorf("ingestion task %q failed: %s", taskID, res.Status.ErrorMsg)
+			}
+			return fmt.Errorf("ingestion task %q failed, see the task log in Druid for details", taskID)
+		}
 	}
-	return &res, err
 }
 
-func sendRequest(coordinatorURL, method, path, jsonBody string, out any) error {
-	reqURL, err := url.JoinPath(coordinatorURL, path)
+// shutdownTask asks the Overlord to stop a running task. It is best-effort.
+func (c *indexerClient) shutdownTask(taskID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), _taskShutdownTimeout)
+	defer cancel()
+	_ = c.do(ctx, http.MethodPost, fmt.Sprintf("/druid/indexer/v1/task/%s/shutdown", url.PathEscape(taskID)), nil, nil)
+}
+
+// usedSegments returns the IDs of the used segments of a datasource.
+// It returns an empty list if the datasource doesn't exist.
+func (c *indexerClient) usedSegments(ctx context.Context, datasource string) ([]string, error) {
+	var ids []string
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/druid/coordinator/v1/metadata/datasources/%s/segments", url.PathEscape(datasource)), nil, &ids)
 	if err != nil {
-		return err
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list segments of datasource %q: %w", datasource, err)
+	}
+	return ids, nil
+}
+
+// markSegmentsUnused marks the given segments of a datasource as unused, which removes them from queries.
+func (c *indexerClient) markSegmentsUnused(ctx context.Context, datasource string, ids []string) error {
+	body := map[string]any{"segmentIds": ids}
+	err := c.do(ctx, http.MethodPost, fmt.Sprintf("/druid/coordinator/v1/datasources/%s/markUnused", url.PathEscape(datasource)), body, nil)
+	if err != nil {
+		return fmt.Errorf("failed to mark segments of datasource %q as unused: %w", datasource, err)
 	}
+	return nil
+}
 
-	var reqBody io.Reader
-	if jsonBody != "" {
-		reqBody = strings.NewReader(jsonBody)
+// markDatasourceUnused marks all segments of a datasource as unused, which removes the datasource from queries.
+// The segments are deleted from deep storage by the Coordinator's kill tasks if they are enabled.
+func (c *indexerClient) markDatasourceUnused(ctx context.Context, datasource string) error {
+	err := c.do(ctx, http.MethodDelete, "/druid/coordinator/v1/datasources/"+url.PathEscape(datasource), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed to drop datasource %q: %w", datasource, err)
 	}
+	return nil
+}
 
-	req, err := http.NewRequest(method, reqURL, reqBody)
+// do sends a request with an optional JSON body and decodes the JSON response into out (if not nil).
+func (c *indexerClient) do(ctx context.Context, method, path string, body, out any) error {
+	reqURL, err := url.JoinPath(c.baseURL, path)
 	if err != nil {
 		return err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	reqBody := io.Reader(http.NoBody)
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
 
-	res, err := http.DefaultClient.Do(req)
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
 	if err != nil {
 		return err
 	}
-	defer res.Body.Close()
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	req.Header.Set("Content-Type", "application/json")
 
-	if res.StatusCode >= http.StatusBadRequest {
-		return fmt.Errorf("coordinator request failed with status: %d", res.StatusCode)
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
 	}
+	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
+	resBody, err := io.ReadAll(res.Body)
 	if err != nil {
 		return err
 	}
 
-	if len(body) > 0 {
-		err = json.Unmarshal(body, out)
+	if res.StatusCode >= http.StatusBadRequest {
+		return newStatusError(res.StatusCode, resBody)
+	}
+
+	if out != nil && len(resBody) > 0 {
+		err = json.Unmarshal(resBody, out)
 		if err != nil {
 			return err
 		}
@@ -143,3 +299,49 @@ func sendRequest(coordinatorURL, method, path, jsonBody string, out any) error {
 
 	return nil
 }
+
+// statusError is returned for responses with an error status code.
+type statusError struct {
+	code int
+	msg  string
+}
+
+func newStatusError(code int, body []byte) *statusError {
+	// Druid usually returns errors as {"error": "..."}, but some endpoints return plain text
+	var res struct {
+		Error        string `json:"error"`
+		ErrorMessage string `json:"errorMessage"`
+	}
+	msg := strings.TrimSpace(string(body))
+	if json.Unmarshal(body, &res) == nil {
+		if res.ErrorMessage != "" {
+			msg = res.ErrorMessage
+		} else if res.Error != "" {
+			msg = res.Error
+		}
+	}
+	if len(msg) > 512 {
+		msg = msg[:512] + "..."
+	}
+	return &statusError{code: code, msg: msg}
+}
+
+func (e *statusError) Error() string {
+	if e.msg == "" {
+		return fmt.Sprintf("coordinator request failed with status: %d", e.code)
+	}
+	return fmt.Sprintf("coordinator request failed with status: %d: %s", e.code, e.msg)
+}
+
+func isNotFound(err error) bool {
+	var se *statusError
+	return errors.As(err, &se) && se.code == http.StatusNotFound
+}
+
+// maskURLError removes a URL that may contain a password from a parse error.
+func maskURLError(err error, rawURL string) error {
+	if strings.Contains(err.Error(), rawURL) {
+		return errors.New(strings.ReplaceAll(err.Error(), rawURL, "<masked>"))
+	}
+	return err
+}
@@ -0,0 +1,336 @@
+package druid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/s3"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// _segmentAvailabilityTimeout is how long an ingestion task waits for its segments to be loaded by historicals before it completes.
+const _segmentAvailabilityTimeout = 10 * time.Minute
+
+// ModelInputProperties are the input properties of a model that ingests files from object storage into Druid.
+// They match the result properties of object store model outputs, so they are usually set by a stage connector.
+type ModelInputProperties struct {
+	// Path is a file, directory or glob in object storage, e.g. "s3://bucket/path/".
+	Path string `mapstructure:"path"`
+	// Format is the format of the files. It is inferred from the path's extension if not set.
+	Format drivers.FileFormat `mapstructure:"format"`
+}
+
+func (p *ModelInputProperties) Validate() error {
+	if p.Path == "" {
+		return errors.New(`missing property "path"`)
+	}
+	if p.Format == drivers.FileFormatUnspecified {
+		ext := fileutil.FullExt(p.Path)
+		switch {
+		case strings.Contains(ext, ".parquet"):
+			p.Format = drivers.FileFormatParquet
+		case strings.Contains(ext, ".csv"):
+			p.Format = drivers.FileFormatCSV
+		case strings.Contains(ext, ".json"):
+			p.Format = drivers.FileFormatJSON
+		}
+	}
+	switch p.Format {
+	case drivers.FileFormatParquet, drivers.FileFormatCSV, drivers.FileFormatJSON:
+	case drivers.FileFormatUnspecified:
+		return fmt.Errorf("could not infer the format of %q, set the format explicitly", p.Path)
+	default:
+		return fmt.Errorf("unsupported format %q: must be parquet, csv or json", p.Format)
+	}
+	return nil
+}
+
+// ModelOutputProperties are the output properties of a model that ingests data into Druid.
+type ModelOutputProperties struct {
+	// Datasource is the datasource to ingest into. It defaults to the model name.
+	Datasource string `mapstructure:"datasource"`
+	// TimestampColumn is the column to use as the primary timestamp (__time). Default: __time.
+	TimestampColumn string `mapstructure:"timestamp_column"`
+	// TimestampFormat is the format of the timestamp column, e.g. "iso", "millis" or "auto" (default).
+	TimestampFormat string `mapstructure:"timestamp_format"`
+	// Dimensions are the columns to ingest as dimensions. If empty, Druid discovers the columns and their types from the input.
+	Dimensions []string `mapstructure:"dimensions"`
+	// SegmentGranularity is the time granularity of segments. Default: DAY.
+	SegmentGranularity string `mapstructure:"segment_granularity"`
+	// QueryGranularity is the granularity that timestamps are truncated to. Default: NONE.
+	QueryGranularity string `mapstructure:"query_granularity"`
+	// Rollup enables rollup of rows with the same truncated timestamp and dimension values.
+	Rollup bool `mapstructure:"rollup"`
+	// MaxNumConcurrentSubTasks is the maximum number of worker tasks that ingest in parallel. Default: 1.
+	MaxNumConcurrentSubTasks int `mapstructure:"max_num_concurrent_subtasks"`
+	// IncrementalStrategy must be "append" (the default) for incremental models.
+	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+}
+
+func (p *ModelOutputProperties) Validate() error {
+	switch p.IncrementalStrategy {
+	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend:
+		p.IncrementalStrategy = drivers.IncrementalStrategyAppend
+	default:
+		return fmt.Errorf("invalid incremental strategy %q: druid only supports %q", p.IncrementalStrategy, drivers.IncrementalStrategyAppend)
+	}
+	if p.MaxNumConcurrentSubTasks < 0 {
+		return errors.New(`"max_num_concurrent_subtasks" must be positive`)
+	}
+	if p.TimestampColumn == "" {
+		p.TimestampColumn = "__time"
+	}
+	if p.TimestampFormat == "" {
+		p.TimestampFormat = "auto"
+	}
+	if p.SegmentGranularity == "" {
+		p.SegmentGranularity = "DAY"
+	}
+	if p.QueryGranularity == "" {
+		p.QueryGranularity = "NONE"
+	}
+	if p.MaxNumConcurrentSubTasks == 0 {
+		p.MaxNumConcurrentSubTasks = 1
+	}
+	return nil
+}
+
+type ModelResultProperties struct {
+	Datasource    string `mapstructure:"datasource"`
+	UsedModelName bool   `mapstructure:"used_model_name"`
+}
+
+// objectStoreToSelfExecutor ingests files from S3, GCS or Azure into a Druid datasource with a native batch ingestion task.
+// It is usually used with a stage connector, which writes the output of a model in another OLAP to object storage first.
+//
+// On full runs, it replaces the datasource's data: after the task succeeds, the segments that existed before the task are marked as unused.
+// On incremental runs, it appends to the datasource.
+type objectStoreToSelfExecutor struct {
+	store drivers.Handle
+	c     *connection
+}
+
+var _ drivers.ModelExecutor = &objectStoreToSelfExecutor{}
+
+func (e *objectStoreToSelfExecutor) Concurrency(desired int) (int, bool) {
+	if desired > 1 {
+		return 0, false
+	}
+	return 1, true
+}
+
+func (e *objectStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
+	if opts.SplitRun {
+		return nil, errors.New("models that output to druid do not support splits")
+	}
+
+	inputProps := &ModelInputProperties{}
+	if err := mapstructure.WeakDecode(opts.InputProperties, inputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if err := inputProps.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+
+	outputProps := &ModelOutputProperties{}
+	if err := mapstructure.WeakDecode(opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if err := outputProps.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid output properties: %w", err)
+	}
+
+	usedModelName := false
+	if outputProps.Datasource == "" {
+		outputProps.Datasource = opts.ModelName
+		usedModelName = true
+	}
+	datasource := outputProps.Datasource
+
+	inputSource, err := e.inputSource(inputProps.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newIndexerClient(e.c.config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Capture the current segments so we can drop them after a full run has replaced them
+	var previousSegments []string
+	if !opts.IncrementalRun {
+		previousSegments, err = client.usedSegments(ctx, datasource)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	spec := ingestionSpec(datasource, inputSource, inputProps.Format, outputProps, opts.IncrementalRun)
+	taskID, err := client.submitTask(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	e.c.logger.Info("druid: submitted ingestion task", zap.String("model", opts.ModelName), zap.String("datasource", datasource), zap.String("task_id", taskID), observability.ZapCtx(ctx))
+
+	err = client.waitForTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !opts.IncrementalRun {
+		// The new segments overshadow the previous segments for the time intervals they cover.
+		// We mark the previous segments as unused to also remove data for intervals that are not in the new data.
+		if len(previousSegments) > 0 {
+			err = client.markSegmentsUnused(ctx, datasource, previousSegments)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Drop the previous datasource if the model now outputs to a different datasource
+		prev := opts.PreviousResult
+		if prev != nil && prev.Connector == opts.OutputConnector && prev.Table != "" && prev.Table != datasource {
+			err = client.markDatasourceUnused(ctx, prev.Table)
+			if err != nil {
+				e.c.logger.Warn("druid: failed to drop previous datasource", zap.String("model", opts.ModelName), zap.String("datasource", prev.Table), zap.Error(err), observability.ZapCtx(ctx))
+			}
+		}
+	}
+
+	resultProps := &ModelResultProperties{
+		Datasource:    datasource,
+		UsedModelName: usedModelName,
+	}
+	resultPropsMap := map[string]any{}
+	err = mapstructure.WeakDecode(resultProps, &resultPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	return &drivers.ModelResult{
+		Connector:  opts.OutputConnector,
+		Properties: resultPropsMap,
+		Table:      datasource,
+	}, nil
+}
+
+// inputSource returns a Druid input source that reads the files at the given path.
+// Druid reads the files with its own object storage extensions. Only S3 credentials can be passed in the input source;
+// for GCS and Azure, Druid uses the credentials configured in its deep storage extensions.
+func (e *objectStoreToSelfExecutor) inputSource(p string) (map[string]any, error) {
+	u, err := globutil.ParseBucketURL(p)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", p, err)
+	}
+
+	var typ string
+	switch u.Scheme {
+	case "s3", "s3a":
+		typ = "s3"
+	case "gs":
+		typ = "google"
+	case "azure":
+		typ = "azure"
+	default:
+		return nil, fmt.Errorf("unsupported path %q: druid can only ingest from s3://, gs:// and azure:// paths", p)
+	}
+
+	src := map[string]any{"type": typ}
+	base, pattern := doublestar.SplitPattern(u.Path)
+	switch {
+	case fileutil.IsGlob(pattern):
+		// The object glob is matched against the object key
+		src["prefixes"] = []string{dirURI(u, base)}
+		src["objectGlob"] = u.Path
+	case path.Ext(u.Path) != "":
+		src["uris"] = []string{p}
+	default:
+		src["prefixes"] = []string{p}
+	}
+
+	if typ == "s3" {
+		conf := &s3.ConfigProperties{}
+		if err := mapstructure.WeakDecode(e.store.Config(), conf); err != nil {
+			return nil, err
+		}
+		if conf.AccessKeyID != "" {
+			src["properties"] = map[string]any{
+				"accessKeyId":     map[string]any{"type": "default", "password": conf.AccessKeyID},
+				"secretAccessKey": map[string]any{"type": "default", "password": conf.SecretAccessKey},
+			}
+		}
+	}
+
+	return src, nil
+}
+
+// ingestionSpec builds the spec of a native parallel batch ingestion task (see https://druid.apache.org/docs/latest/ingestion/native-batch).
+func ingestionSpec(datasource string, inputSource map[string]any, format drivers.FileFormat, props *ModelOutputProperties, appendToExisting bool) map[string]any {
+	var inputFormat map[string]any
+	switch format {
+	case drivers.FileFormatParquet:
+		inputFormat = map[string]any{"type": "parquet"}
+	case drivers.FileFormatCSV:
+		inputFormat = map[string]any{"type": "csv", "findColumnsFromHeader": true}
+	default:
+		inputFormat = map[string]any{"type": "json"}
+	}
+
+	dimensionsSpec := map[string]any{}
+	if len(props.Dimensions) == 0 {
+		dimensionsSpec["useSchemaDiscovery"] = true
+	} else {
+		dimensionsSpec["dimensions"] = props.Dimensions
+	}
+
+	return map[string]any{
+		"type": "index_parallel",
+		"spec": map[string]any{
+			"dataSchema": map[string]any{
+				"dataSource": datasource,
+				"timestampSpec": map[string]any{
+					"column": props.TimestampColumn,
+					"format": props.TimestampFormat,
+				},
+				"dimensionsSpec": dimensionsSpec,
+				"granularitySpec": map[string]any{
+					"segmentGranularity": props.SegmentGranularity,
+					"queryGranularity":   props.QueryGranularity,
+					"rollup":             props.Rollup,
+				},
+			},
+			"ioConfig": map[string]any{
+				"type":             "index_parallel",
+				"inputSource":      inputSource,
+				"inputFormat":      inputFormat,
+				"appendToExisting": appendToExisting,
+			},
+			"tuningConfig": map[string]any{
+				"type":                                  "index_parallel",
+				"maxNumConcurrentSubTasks":              props.MaxNumConcurrentSubTasks,
+				"awaitSegmentAvailabilityTimeoutMillis": _segmentAvailabilityTimeout.Milliseconds(),
+			},
+		},
+	}
+}
+
+// dirURI returns the URI of a directory in the bucket of u. It ends with a slash.
+func dirURI(u *globutil.URL, dir string) string {
+	res := fmt.Sprintf("%s://%s/", u.Scheme, u.Host)
+	dir = strings.Trim(dir, "/")
+	if dir != "" && dir != "." {
+		res += dir + "/"
+	}
+	return res
+}
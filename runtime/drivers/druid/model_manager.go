@@ -0,0 +1,49 @@
+package druid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+var _ drivers.ModelManager = &connection{}
+
+// Rename implements drivers.ModelManager.
+// Druid doesn't support renaming datasources, so the model keeps its current datasource.
+// If the datasource was named after the model, the next full run ingests into a datasource with the new name and drops the old datasource.
+func (c *connection) Rename(ctx context.Context, res *drivers.ModelResult, newName string, env *drivers.ModelEnv) (*drivers.ModelResult, error) {
+	return res, nil
+}
+
+// Exists implements drivers.ModelManager.
+func (c *connection) Exists(ctx context.Context, res *drivers.ModelResult) (bool, error) {
+	client, err := newIndexerClient(c.config)
+	if err != nil {
+		return false, err
+	}
+
+	segments, err := client.usedSegments(ctx, res.Table)
+	if err != nil {
+		return false, err
+	}
+	return len(segments) > 0, nil
+}
+
+// Delete implements drivers.ModelManager.
+// It marks all the datasource's segments as unused. Druid deletes unused segments from deep storage if kill tasks are enabled on the Coordinator.
+func (c *connection) Delete(ctx context.Context, res *drivers.ModelResult) error {
+	client, err := newIndexerClient(c.config)
+	if err != nil {
+		return err
+	}
+	return client.markDatasourceUnused(ctx, res.Table)
+}
+
+// MergeSplitResults implements drivers.ModelManager.
+func (c *connection) MergeSplitResults(a, b *drivers.ModelResult) (*drivers.ModelResult, error) {
+	if a.Table != b.Table {
+		return nil, fmt.Errorf("cannot merge split results that output to different datasources (%q != %q)", a.Table, b.Table)
+	}
+	return a, nil
+}
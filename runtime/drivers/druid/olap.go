@@ -36,7 +36,7 @@ func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
-func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string, partitionBy string) error {
 	return fmt.Errorf("druid: data transformation not yet supported")
 }
 
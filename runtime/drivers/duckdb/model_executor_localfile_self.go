@@ -99,6 +99,15 @@ func (e *localFileToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 		return nil, fmt.Errorf("failed to create model: %w", err)
 	}
 
+	// Give the caller a chance to reject the staged result before it replaces the previous result
+	if stagingTableName != tableName && opts.PrePromote != nil {
+		err = opts.PrePromote(ctx, &drivers.ModelResult{Connector: opts.OutputConnector, Table: stagingTableName})
+		if err != nil {
+			_ = e.c.DropTable(ctx, stagingTableName, asView)
+			return nil, err
+		}
+	}
+
 	// Rename the staging table to the final table name
 	if stagingTableName != tableName {
 		err = olapForceRenameTable(ctx, e.c, stagingTableName, asView, tableName)
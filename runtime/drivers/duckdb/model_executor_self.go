@@ -56,6 +56,22 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 
 	asView := !materialize
 	tableName := outputProps.Table
+	incrementalStrategy := outputProps.IncrementalStrategy
+	partitionBy := outputProps.PartitionBy
+
+	// Snapshot models maintain the history of the model's rows.
+	// On incremental runs, we replace the history of every changed key.
+	if opts.Snapshot != nil {
+		sql, err := opts.Snapshot.PrepareSQL(ctx, olap, inputProps.SQL, tableName, opts.IncrementalRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare snapshot: %w", err)
+		}
+		inputProps.SQL = sql
+		if opts.IncrementalRun {
+			incrementalStrategy = drivers.IncrementalStrategyDeleteInsert
+			partitionBy = opts.Snapshot.KeyExpr(olap.Dialect())
+		}
+	}
 
 	if !opts.IncrementalRun {
 		// Prepare for ingesting into the staging view/table.
@@ -75,6 +91,15 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 			return nil, fmt.Errorf("failed to create model: %w", err)
 		}
 
+		// Give the caller a chance to reject the staged result before it replaces the previous result
+		if stagingTableName != tableName && opts.PrePromote != nil {
+			err = opts.PrePromote(ctx, &drivers.ModelResult{Connector: opts.OutputConnector, Table: stagingTableName})
+			if err != nil {
+				_ = olap.DropTable(ctx, stagingTableName, asView)
+				return nil, err
+			}
+		}
+
 		// Rename the staging table to the final table name
 		if stagingTableName != tableName {
 			err = olapForceRenameTable(ctx, olap, stagingTableName, asView, tableName)
@@ -84,7 +109,7 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 		}
 	} else {
 		// Insert into the table
-		err := olap.InsertTableAsSelect(ctx, tableName, inputProps.SQL, false, true, outputProps.IncrementalStrategy, outputProps.UniqueKey)
+		err := olap.InsertTableAsSelect(ctx, tableName, inputProps.SQL, false, true, incrementalStrategy, outputProps.UniqueKey, partitionBy)
 		if err != nil {
 			return nil, fmt.Errorf("failed to incrementally insert into table: %w", err)
 		}
@@ -70,6 +70,15 @@ func (e *warehouseToSelfExecutor) Execute(ctx context.Context, opts *drivers.Mod
 	}
 
 	if !opts.IncrementalRun {
+		// Give the caller a chance to reject the staged result before it replaces the previous result
+		if stagingTableName != tableName && opts.PrePromote != nil {
+			err = opts.PrePromote(ctx, &drivers.ModelResult{Connector: opts.OutputConnector, Table: stagingTableName})
+			if err != nil {
+				_ = olap.DropTable(ctx, stagingTableName, false)
+				return nil, err
+			}
+		}
+
 		if stagingTableName != tableName {
 			err = olapForceRenameTable(ctx, olap, stagingTableName, false, tableName)
 			if err != nil {
@@ -109,7 +118,18 @@ func (e *warehouseToSelfExecutor) queryAndInsert(ctx context.Context, opts *driv
 	}
 	defer iter.Close()
 
-	create := !opts.IncrementalRun
+	// Strategies that replace partitions must see all the new data at once, so in incremental runs we stage the batches in a separate table before inserting them.
+	stageIncremental := opts.IncrementalRun && replacesPartitions(outputProps.IncrementalStrategy)
+	insertTable := outputTable
+	if stageIncremental {
+		insertTable = stagingTableNameFor(outputTable)
+		if t, err := olap.InformationSchema().Lookup(ctx, "", "", insertTable); err == nil {
+			_ = olap.DropTable(ctx, insertTable, t.View)
+		}
+		defer func() { _ = olap.DropTable(context.Background(), insertTable, false) }()
+	}
+
+	create := !opts.IncrementalRun || stageIncremental
 	for {
 		files, err := iter.Next()
 		if err != nil {
@@ -131,8 +151,8 @@ func (e *warehouseToSelfExecutor) queryAndInsert(ctx context.Context, opts *driv
 		}
 		qry := fmt.Sprintf("SELECT * FROM %s", from)
 
-		if !create && opts.IncrementalRun {
-			err := olap.InsertTableAsSelect(ctx, outputTable, qry, false, true, outputProps.IncrementalStrategy, outputProps.UniqueKey)
+		if !create && opts.IncrementalRun && !stageIncremental {
+			err := olap.InsertTableAsSelect(ctx, outputTable, qry, false, true, outputProps.IncrementalStrategy, outputProps.UniqueKey, outputProps.PartitionBy)
 			if err != nil {
 				return fmt.Errorf("failed to incrementally insert into table: %w", err)
 			}
@@ -140,14 +160,14 @@ func (e *warehouseToSelfExecutor) queryAndInsert(ctx context.Context, opts *driv
 		}
 
 		if !create {
-			err := olap.InsertTableAsSelect(ctx, outputTable, qry, false, true, drivers.IncrementalStrategyAppend, nil)
+			err := olap.InsertTableAsSelect(ctx, insertTable, qry, false, true, drivers.IncrementalStrategyAppend, nil, "")
 			if err != nil {
 				return fmt.Errorf("failed to insert into table: %w", err)
 			}
 			continue
 		}
 
-		err = olap.CreateTableAsSelect(ctx, outputTable, false, qry, nil)
+		err = olap.CreateTableAsSelect(ctx, insertTable, false, qry, nil)
 		if err != nil {
 			return fmt.Errorf("failed to create table: %w", err)
 		}
@@ -155,6 +175,19 @@ func (e *warehouseToSelfExecutor) queryAndInsert(ctx context.Context, opts *driv
 		create = false
 	}
 
+	if stageIncremental {
+		// No new data, so there are no partitions to replace
+		if create {
+			return nil
+		}
+
+		err := olap.InsertTableAsSelect(ctx, outputTable, fmt.Sprintf("SELECT * FROM %s", safeSQLName(insertTable)), false, true, outputProps.IncrementalStrategy, outputProps.UniqueKey, outputProps.PartitionBy)
+		if err != nil {
+			return fmt.Errorf("failed to incrementally insert into table: %w", err)
+		}
+		return nil
+	}
+
 	// We were supposed to create the table, but didn't get any data
 	if create {
 		return drivers.ErrNoRows
@@ -26,6 +26,9 @@ type ModelOutputProperties struct {
 	Materialize         *bool                       `mapstructure:"materialize"`
 	UniqueKey           []string                    `mapstructure:"unique_key"`
 	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+	// PartitionBy is a SQL expression that identifies the partition of a row.
+	// It is used by the "delete+insert" and "replace_partition" incremental strategies to determine which rows to replace.
+	PartitionBy string `mapstructure:"partition_by"`
 }
 
 func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecuteOptions) error {
@@ -44,7 +47,7 @@ func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecuteOptions) erro
 	}
 
 	switch p.IncrementalStrategy {
-	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend, drivers.IncrementalStrategyMerge:
+	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend, drivers.IncrementalStrategyMerge, drivers.IncrementalStrategyDeleteInsert, drivers.IncrementalStrategyReplacePartition:
 	default:
 		return fmt.Errorf("invalid incremental strategy %q", p.IncrementalStrategy)
 	}
@@ -53,6 +56,10 @@ func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecuteOptions) erro
 		return fmt.Errorf(`must specify a "unique_key" when "incremental_strategy" is %q`, p.IncrementalStrategy)
 	}
 
+	if replacesPartitions(p.IncrementalStrategy) && p.PartitionBy == "" {
+		return fmt.Errorf(`must specify a "partition_by" when "incremental_strategy" is %q`, p.IncrementalStrategy)
+	}
+
 	if p.IncrementalStrategy == drivers.IncrementalStrategyUnspecified {
 		if len(p.UniqueKey) == 0 {
 			p.IncrementalStrategy = drivers.IncrementalStrategyAppend
@@ -140,6 +147,11 @@ func (c *connection) MergeSplitResults(a, b *drivers.ModelResult) (*drivers.Mode
 	return a, nil
 }
 
+// replacesPartitions returns true if the incremental strategy replaces the partitions present in the new data.
+func replacesPartitions(s drivers.IncrementalStrategy) bool {
+	return s == drivers.IncrementalStrategyDeleteInsert || s == drivers.IncrementalStrategyReplacePartition
+}
+
 // stagingTableName returns a stable temporary table name for a destination table.
 // By using a stable temporary table name, we can ensure proper garbage collection without managing additional state.
 func stagingTableNameFor(table string) string {
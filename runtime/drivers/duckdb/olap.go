@@ -364,12 +364,12 @@ func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
-func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
-	c.logger.Debug("insert table", zap.String("name", name), zap.Bool("byName", byName), zap.String("strategy", string(strategy)), zap.Strings("uniqueKey", uniqueKey))
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string, partitionBy string) error {
+	c.logger.Debug("insert table", zap.String("name", name), zap.Bool("byName", byName), zap.String("strategy", string(strategy)), zap.Strings("uniqueKey", uniqueKey), zap.String("partitionBy", partitionBy))
 
 	if !c.config.ExtTableStorage {
 		return c.WithConnection(ctx, 1, true, false, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
-			return c.execIncrementalInsert(ctx, safeSQLName(name), sql, byName, strategy, uniqueKey)
+			return c.execIncrementalInsert(ctx, safeSQLName(name), sql, byName, strategy, uniqueKey, partitionBy)
 		})
 	}
 
@@ -386,7 +386,7 @@ func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string,
 		safeName := fmt.Sprintf("%s.default", safeSQLName(db))
 
 		return c.WithConnection(ctx, 1, true, false, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
-			return c.execIncrementalInsert(ctx, safeName, sql, byName, strategy, uniqueKey)
+			return c.execIncrementalInsert(ctx, safeName, sql, byName, strategy, uniqueKey, partitionBy)
 		})
 	}
 
@@ -422,7 +422,7 @@ func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string,
 
 		// Execute the insert
 		safeName := fmt.Sprintf("%s.default", safeSQLName(newDB))
-		err = c.execIncrementalInsert(ctx, safeName, sql, byName, strategy, uniqueKey)
+		err = c.execIncrementalInsert(ctx, safeName, sql, byName, strategy, uniqueKey, partitionBy)
 		if err != nil {
 			cleanupFunc = func() { c.detachAndRemoveFile(newDB, newDBFile) }
 			return fmt.Errorf("insert: create %q.default table failed: %w", newDB, err)
@@ -625,7 +625,7 @@ func (c *connection) MayBeScaledToZero(ctx context.Context) bool {
 	return false
 }
 
-func (c *connection) execIncrementalInsert(ctx context.Context, safeName, sql string, byName bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
+func (c *connection) execIncrementalInsert(ctx context.Context, safeName, sql string, byName bool, strategy drivers.IncrementalStrategy, uniqueKey []string, partitionBy string) error {
 	var byNameClause string
 	if byName {
 		byNameClause = "BY NAME"
@@ -639,7 +639,7 @@ func (c *connection) execIncrementalInsert(ctx context.Context, safeName, sql st
 		})
 	}
 
-	if strategy == drivers.IncrementalStrategyMerge {
+	if strategy == drivers.IncrementalStrategyMerge || replacesPartitions(strategy) {
 		// Create a temporary table with the new data
 		tmp := uuid.New().String()
 		err := c.Exec(ctx, &drivers.Statement{
@@ -673,17 +673,32 @@ func (c *connection) execIncrementalInsert(ctx context.Context, safeName, sql st
 			return nil
 		}
 
-		// Drop the rows from the target table where the unique key is present in the temporary table
-		where := ""
-		for i, key := range uniqueKey {
-			key = safeSQLName(key)
-			if i != 0 {
-				where += " AND "
+		var del string
+		if strategy == drivers.IncrementalStrategyMerge {
+			// Drop the rows from the target table where the unique key is present in the temporary table
+			where := ""
+			for i, key := range uniqueKey {
+				key = safeSQLName(key)
+				if i != 0 {
+					where += " AND "
+				}
+				where += fmt.Sprintf("base.%s IS NOT DISTINCT FROM tmp.%s", key, key)
+			}
+			del = fmt.Sprintf("DELETE FROM %s base WHERE EXISTS (SELECT 1 FROM %s tmp WHERE %s)", safeName, safeSQLName(tmp), where)
+		} else {
+			// Drop the rows from the target table in the partitions present in the temporary table.
+			// DuckDB doesn't have native partitions, so "replace_partition" is also implemented as a delete followed by an insert.
+			// Note that unqualified column references in the partition expression resolve to the temporary table inside the subqueries.
+			if partitionBy == "" {
+				return fmt.Errorf("incremental insert strategy %q requires a partition expression", strategy)
 			}
-			where += fmt.Sprintf("base.%s IS NOT DISTINCT FROM tmp.%s", key, key)
+			del = fmt.Sprintf(
+				"DELETE FROM %s WHERE (%s) IN (SELECT DISTINCT (%s) FROM %s) OR ((%s) IS NULL AND EXISTS (SELECT 1 FROM %s WHERE (%s) IS NULL))",
+				safeName, partitionBy, partitionBy, safeSQLName(tmp), partitionBy, safeSQLName(tmp), partitionBy,
+			)
 		}
 		err = c.Exec(ctx, &drivers.Statement{
-			Query:       fmt.Sprintf("DELETE FROM %s base WHERE EXISTS (SELECT 1 FROM %s tmp WHERE %s)", safeName, safeSQLName(tmp), where),
+			Query:       del,
 			Priority:    1,
 			LongRunning: true,
 		})
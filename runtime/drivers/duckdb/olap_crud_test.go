@@ -183,10 +183,10 @@ func Test_connection_InsertTableAsSelect(t *testing.T) {
 	err = c.CreateTableAsSelect(context.Background(), "test-insert", false, "select 1", nil)
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test-insert", "select 2", false, true, drivers.IncrementalStrategyAppend, nil)
+	err = c.InsertTableAsSelect(context.Background(), "test-insert", "select 2", false, true, drivers.IncrementalStrategyAppend, nil, "")
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test-insert", "select 3", true, true, drivers.IncrementalStrategyAppend, nil)
+	err = c.InsertTableAsSelect(context.Background(), "test-insert", "select 3", true, true, drivers.IncrementalStrategyAppend, nil, "")
 	require.Error(t, err)
 
 	res, err := c.Execute(context.Background(), &drivers.Statement{Query: "SELECT count(*) FROM 'test-insert'"})
@@ -198,6 +198,39 @@ func Test_connection_InsertTableAsSelect(t *testing.T) {
 	require.NoError(t, res.Close())
 }
 
+func Test_connection_InsertTableAsSelectDeleteInsert(t *testing.T) {
+	temp := t.TempDir()
+
+	dbPath := filepath.Join(temp, "view.db")
+	handle, err := Driver{}.Open("default", map[string]any{"path": dbPath, "external_table_storage": true}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	c := handle.(*connection)
+	require.NoError(t, c.Migrate(context.Background()))
+	c.AsOLAP("default")
+
+	err = c.CreateTableAsSelect(context.Background(), "test", false, "SELECT * FROM (VALUES (DATE '2024-01-01', 1), (DATE '2024-01-01', 2), (DATE '2024-01-02', 3), (NULL, 4)) t(day, val)", nil)
+	require.NoError(t, err)
+
+	// Restate the first day
+	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT DATE '2024-01-01' AS day, 10 AS val", false, true, drivers.IncrementalStrategyDeleteInsert, nil, "day")
+	require.NoError(t, err)
+
+	// Restate the NULL partition and add a new day
+	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT * FROM (VALUES (NULL, 40), (DATE '2024-01-03', 50)) t(day, val)", false, true, drivers.IncrementalStrategyReplacePartition, nil, "day")
+	require.NoError(t, err)
+
+	res, err := c.Execute(context.Background(), &drivers.Statement{Query: "SELECT val FROM test ORDER BY val"})
+	require.NoError(t, err)
+	var vals []int
+	for res.Next() {
+		var val int
+		require.NoError(t, res.Scan(&val))
+		vals = append(vals, val)
+	}
+	require.NoError(t, res.Close())
+	require.Equal(t, []int{3, 10, 40, 50}, vals)
+}
+
 func Test_connection_RenameTable(t *testing.T) {
 	temp := t.TempDir()
 	os.Mkdir(temp, fs.ModePerm)
@@ -325,16 +358,16 @@ func Test_connection_CastEnum(t *testing.T) {
 	err = c.CreateTableAsSelect(context.Background(), "test", false, "SELECT 1 AS id, 'bglr' AS city, 'IND' AS country", nil)
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 2, 'mUm', 'IND'", false, true, drivers.IncrementalStrategyAppend, nil)
+	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 2, 'mUm', 'IND'", false, true, drivers.IncrementalStrategyAppend, nil, "")
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, 'Perth', 'Aus'", false, true, drivers.IncrementalStrategyAppend, nil)
+	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, 'Perth', 'Aus'", false, true, drivers.IncrementalStrategyAppend, nil, "")
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, null, 'Aus'", false, true, drivers.IncrementalStrategyAppend, nil)
+	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, null, 'Aus'", false, true, drivers.IncrementalStrategyAppend, nil, "")
 	require.NoError(t, err)
 
-	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, 'bglr', null", false, true, drivers.IncrementalStrategyAppend, nil)
+	err = c.InsertTableAsSelect(context.Background(), "test", "SELECT 3, 'bglr', null", false, true, drivers.IncrementalStrategyAppend, nil, "")
 	require.NoError(t, err)
 
 	err = c.convertToEnum(context.Background(), "test", []string{"city", "country"})
@@ -0,0 +1,92 @@
+package duckdb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	_ "github.com/rilldata/rill/runtime/drivers/mongodb"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+func TestMongoDBToDuckDBTransfer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("mongodb: skipping test in short mode")
+	}
+
+	ctx := context.Background()
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			WaitingFor:   wait.ForLog("Waiting for connections").WithStartupTimeout(30 * time.Second),
+			Image:        "mongo:7.0",
+			ExposedPorts: []string{"27017/tcp"},
+		},
+	})
+	require.NoError(t, err)
+	defer container.Terminate(ctx)
+
+	host, err := container.Host(ctx)
+	require.NoError(t, err)
+	port, err := container.MappedPort(ctx, "27017/tcp")
+	require.NoError(t, err)
+
+	uri := fmt.Sprintf("mongodb://%s:%d/mydb", host, port.Int())
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	require.NoError(t, err)
+	defer client.Disconnect(ctx)
+
+	_, err = client.Database("mydb").Collection("events").InsertMany(ctx, []any{
+		bson.D{{Key: "name", Value: "a"}, {Key: "amount", Value: int32(1)}, {Key: "user", Value: bson.D{{Key: "country", Value: "DK"}}}, {Key: "tags", Value: bson.A{"x", "y"}}},
+		bson.D{{Key: "name", Value: "b"}, {Key: "amount", Value: 2.5}, {Key: "user", Value: bson.D{{Key: "country", Value: "IN"}}}},
+		bson.D{{Key: "name", Value: "c"}, {Key: "amount", Value: nil}},
+	})
+	require.NoError(t, err)
+
+	handle, err := drivers.Open("mongodb", "default", map[string]any{"uri": uri}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, handle.Ping(ctx))
+
+	sqlStore, ok := handle.AsSQLStore()
+	require.True(t, ok)
+	to, err := drivers.Open("duckdb", "default", map[string]any{"dsn": ":memory:"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	olap, _ := to.AsOLAP("")
+
+	tr := NewSQLStoreToDuckDB(sqlStore, olap, zap.NewNop())
+	err = tr.Transfer(ctx, map[string]any{
+		"collection": "events",
+		"pipeline":   `[{"$match": {"name": {"$ne": "c"}}}, {"$project": {"_id": 0}}]`,
+	}, map[string]any{"table": "sink"}, &drivers.TransferOptions{})
+	require.NoError(t, err)
+
+	res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT name, amount, user_country, tags FROM sink ORDER BY name"})
+	require.NoError(t, err)
+	var rows [][]any
+	for res.Next() {
+		var name, country string
+		var amount float64
+		var tags *string
+		require.NoError(t, res.Scan(&name, &amount, &country, &tags))
+		rows = append(rows, []any{name, amount, country, tags})
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Len(t, rows, 2)
+	require.Equal(t, []any{"a", 1.0, "DK"}, rows[0][:3])
+	require.Equal(t, `["x","y"]`, *rows[0][3].(*string))
+	require.Equal(t, []any{"b", 2.5, "IN"}, rows[1][:3])
+	require.Nil(t, rows[1][3])
+
+	require.NoError(t, to.Close())
+}
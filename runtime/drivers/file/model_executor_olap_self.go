@@ -2,28 +2,20 @@ package file
 
 import (
 	"context"
-	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
-	"time"
 
-	"github.com/apache/arrow/go/v14/arrow"
-	"github.com/apache/arrow/go/v14/arrow/array"
-	"github.com/apache/arrow/go/v14/arrow/memory"
-	"github.com/apache/arrow/go/v14/parquet/pqarrow"
 	"github.com/c2h5oh/datasize"
 	"github.com/mitchellh/mapstructure"
-	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
 	"github.com/rilldata/rill/runtime/pkg/jsonval"
 	"github.com/xuri/excelize/v2"
 )
 
-const maxParquetRowGroupSize = 512 * int64(datasize.MB)
-
 type olapToSelfExecutor struct {
 	c    *connection
 	olap drivers.OLAPStore
@@ -83,9 +75,9 @@ func (e *olapToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 
 	switch outputProps.Format {
 	case drivers.FileFormatParquet:
-		err = writeParquet(res, fw)
+		err = rillblob.WriteParquet(res, fw, nil)
 	case drivers.FileFormatCSV:
-		err = writeCSV(res, fw)
+		err = rillblob.WriteCSV(res, fw)
 	case drivers.FileFormatJSON:
 		return nil, errors.New("json file output not currently supported")
 	case drivers.FileFormatXLSX:
@@ -116,62 +108,6 @@ func (e *olapToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExe
 	}, nil
 }
 
-func writeCSV(res *drivers.Result, fw io.Writer) error {
-	w := csv.NewWriter(fw)
-
-	strs := make([]string, len(res.Schema.Fields))
-	for i, f := range res.Schema.Fields {
-		strs[i] = f.Name
-	}
-	err := w.Write(strs)
-	if err != nil {
-		return err
-	}
-
-	vals := make([]any, len(res.Schema.Fields))
-	for i := range vals {
-		vals[i] = new(any)
-	}
-
-	for res.Next() {
-		err := res.Scan(vals...)
-		if err != nil {
-			return err
-		}
-
-		for i, v := range vals {
-			v := *(v.(*any))
-
-			v, err := jsonval.ToValue(v, res.Schema.Fields[i].Type)
-			if err != nil {
-				return fmt.Errorf("failed to convert to JSON value: %w", err)
-			}
-
-			var s string
-			if v != nil {
-				tmp, err := json.Marshal(v)
-				if err != nil {
-					return fmt.Errorf("failed to marshal JSON value: %w", err)
-				}
-				s = string(tmp)
-			}
-
-			strs[i] = s
-		}
-
-		err = w.Write(strs)
-		if err != nil {
-			return err
-		}
-	}
-	if res.Err() != nil {
-		return res.Err()
-	}
-
-	w.Flush()
-	return nil
-}
-
 func writeXLSX(res *drivers.Result, fw io.Writer) error {
 	xf := excelize.NewFile()
 	defer func() { _ = xf.Close() }()
@@ -246,133 +182,6 @@ func writeXLSX(res *drivers.Result, fw io.Writer) error {
 	return nil
 }
 
-func writeParquet(res *drivers.Result, fw io.Writer) error {
-	fields := make([]arrow.Field, 0, len(res.Schema.Fields))
-	for _, f := range res.Schema.Fields {
-		arrowField := arrow.Field{}
-		arrowField.Name = f.Name
-		switch f.Type.Code {
-		case runtimev1.Type_CODE_BOOL:
-			arrowField.Type = arrow.FixedWidthTypes.Boolean
-		case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
-			arrowField.Type = arrow.PrimitiveTypes.Int64
-		case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
-			arrowField.Type = arrow.PrimitiveTypes.Uint64
-		case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_DECIMAL:
-			arrowField.Type = arrow.PrimitiveTypes.Float64
-		case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
-			arrowField.Type = arrow.FixedWidthTypes.Timestamp_us
-		case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
-			arrowField.Type = arrow.BinaryTypes.String
-		case runtimev1.Type_CODE_BYTES:
-			arrowField.Type = arrow.BinaryTypes.Binary
-		}
-		fields = append(fields, arrowField)
-	}
-	schema := arrow.NewSchema(fields, nil)
-	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
-	recordBuilder := array.NewRecordBuilder(mem, schema)
-	defer recordBuilder.Release()
-
-	vals := make([]any, len(res.Schema.Fields))
-	for i := range vals {
-		vals[i] = new(any)
-	}
-
-	parquetwriter, err := pqarrow.NewFileWriter(schema, fw, nil, pqarrow.ArrowWriterProperties{})
-	if err != nil {
-		return err
-	}
-	defer parquetwriter.Close()
-	var rows int64
-	for res.Next() {
-		err := res.Scan(vals...)
-		if err != nil {
-			return err
-		}
-
-		for i, v := range vals {
-			t := res.Schema.Fields[i].Type
-			v := *(v.(*any))
-			v, err := jsonval.ToValue(v, res.Schema.Fields[i].Type)
-			if err != nil {
-				return fmt.Errorf("failed to convert to JSON value: %w", err)
-			}
-
-			switch t.Code {
-			case runtimev1.Type_CODE_BOOL:
-				v, _ := v.(bool)
-				recordBuilder.Field(i).(*array.BooleanBuilder).Append(v)
-			case runtimev1.Type_CODE_INT8, runtimev1.Type_CODE_INT16, runtimev1.Type_CODE_INT32, runtimev1.Type_CODE_INT64:
-				v, _ := v.(int64)
-				recordBuilder.Field(i).(*array.Int64Builder).Append(v)
-			case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_INT256:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_UINT8, runtimev1.Type_CODE_UINT16, runtimev1.Type_CODE_UINT32, runtimev1.Type_CODE_UINT64:
-				v, _ := v.(uint64)
-				recordBuilder.Field(i).(*array.Uint64Builder).Append(v)
-			case runtimev1.Type_CODE_UINT128, runtimev1.Type_CODE_UINT256:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_FLOAT32, runtimev1.Type_CODE_FLOAT64:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_DECIMAL:
-				v, _ := v.(float64)
-				recordBuilder.Field(i).(*array.Float64Builder).Append(v)
-			case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_TIME:
-				v, _ := v.(time.Time)
-				tmp, err := arrow.TimestampFromTime(v, arrow.Microsecond)
-				if err != nil {
-					return err
-				}
-				recordBuilder.Field(i).(*array.TimestampBuilder).Append(tmp)
-			case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_STRUCT, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
-				res, err := json.Marshal(v)
-				if err != nil {
-					return fmt.Errorf("failed to convert to JSON value: %w", err)
-				}
-				recordBuilder.Field(i).(*array.StringBuilder).Append(string(res))
-			case runtimev1.Type_CODE_BYTES:
-				v, _ := v.([]byte)
-				recordBuilder.Field(i).(*array.BinaryBuilder).Append(v)
-			}
-		}
-		rows++
-		if rows == 1000 {
-			rec := recordBuilder.NewRecord()
-			if err := parquetwriter.WriteBuffered(rec); err != nil {
-				rec.Release()
-				return err
-			}
-			rec.Release()
-			if parquetwriter.RowGroupTotalBytesWritten() >= maxParquetRowGroupSize {
-				// Also flushes the data to the disk freeing memory
-				parquetwriter.NewBufferedRowGroup()
-			}
-			rows = 0
-		}
-	}
-	if res.Err() != nil {
-		return res.Err()
-	}
-	if rows == 0 {
-		return nil
-	}
-	rec := recordBuilder.NewRecord()
-	err = parquetwriter.Write(rec)
-	// release the record before returning the error
-	rec.Release()
-	return err
-}
-
 // A limitedWriter writes to W but limits the amount of
 // data written to just N bytes.
 //
@@ -125,8 +125,13 @@ type sourceProperties struct {
 	GlobMaxObjectsListed  int64          `mapstructure:"glob.max_objects_listed"`
 	GlobPageSize          int            `mapstructure:"glob.page_size"`
 	BatchSize             string         `mapstructure:"batch_size"`
+	TableFormat           string         `mapstructure:"table_format"`
+	TableVersion          string         `mapstructure:"table_version"`
+	PartitionFilters      map[string]any `mapstructure:"partition_filters"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	tableOptions          *rillblob.TableOptions
+	partitionFilters      rillblob.PartitionFilters
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -162,6 +167,19 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 		return nil, fmt.Errorf("failed to parse extract config: %w", err)
 	}
 
+	conf.tableOptions, err = rillblob.ParseTableOptions(conf.TableFormat, conf.TableVersion, conf.PartitionFilters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse table config: %w", err)
+	}
+
+	// Without a table format, the partition filters apply to Hive partitions in the paths
+	if conf.tableOptions == nil {
+		conf.partitionFilters, err = rillblob.ParsePartitionFilters(conf.PartitionFilters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse partition filters: %w", err)
+		}
+	}
+
 	return conf, nil
 }
 
@@ -241,12 +259,19 @@ func (c *Connection) AsObjectStore() (drivers.ObjectStore, bool) {
 
 // AsModelExecutor implements drivers.Handle.
 func (c *Connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
-	return nil, false
+	if opts.OutputHandle != c {
+		return nil, false
+	}
+	olap, ok := opts.InputHandle.AsOLAP(instanceID)
+	if !ok {
+		return nil, false
+	}
+	return rillblob.NewModelExecutor(olap, c.openBucket)
 }
 
 // AsModelManager implements drivers.Handle.
 func (c *Connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
-	return nil, false
+	return c, true
 }
 
 // AsTransporter implements drivers.Connection.
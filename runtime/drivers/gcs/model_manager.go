@@ -0,0 +1,43 @@
+package gcs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	rillblob "github.com/rilldata/rill/runtime/drivers/blob"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/gcsblob"
+)
+
+var _ drivers.ModelManager = &Connection{}
+
+func (c *Connection) Rename(ctx context.Context, res *drivers.ModelResult, newName string, env *drivers.ModelEnv) (*drivers.ModelResult, error) {
+	return nil, nil
+}
+
+func (c *Connection) Exists(ctx context.Context, res *drivers.ModelResult) (bool, error) {
+	return true, nil
+}
+
+func (c *Connection) Delete(ctx context.Context, res *drivers.ModelResult) error {
+	return rillblob.DeleteModelResult(ctx, res, c.openBucket)
+}
+
+func (c *Connection) MergeSplitResults(a, b *drivers.ModelResult) (*drivers.ModelResult, error) {
+	return rillblob.MergeModelResults(a, b)
+}
+
+// openBucket opens a GCS bucket using the connector's credentials.
+func (c *Connection) openBucket(ctx context.Context, bucket string) (*blob.Bucket, error) {
+	client, err := c.newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := gcsblob.OpenBucket(ctx, client, bucket, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bucket %q, %w", bucket, err)
+	}
+	return b, nil
+}
@@ -38,7 +38,7 @@ func (c *Connection) ListObjects(ctx context.Context, propsMap map[string]any) (
 	}
 	defer bucket.Close()
 
-	return bucket.ListObjects(ctx, props.url.Path)
+	return bucket.ListObjects(ctx, props.url.Path, props.partitionFilters)
 }
 
 // DownloadFiles returns a file iterator over objects stored in gcs.
@@ -77,6 +77,8 @@ func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (d
 		GlobPageSize:          conf.GlobPageSize,
 		GlobPattern:           conf.url.Path,
 		ExtractPolicy:         conf.extractPolicy,
+		Table:                 conf.tableOptions,
+		PartitionFilters:      conf.partitionFilters,
 		BatchSizeBytes:        int64(batchSize.Bytes()),
 		KeepFilesUntilClose:   conf.BatchSize == "-1",
 		TempDir:               c.config.TempDir,
@@ -10,6 +10,7 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/olapwriter"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
 	"go.uber.org/zap"
@@ -73,6 +74,19 @@ type sourceProperties struct {
 	Path    string            `mapstructure:"path"`
 	URI     string            `mapstructure:"uri"`
 	Headers map[string]string `mapstructure:"headers"`
+	// The following properties are used for ingesting records from a JSON REST API (see rest.go).
+	Method      string          `mapstructure:"method"`
+	Params      map[string]any  `mapstructure:"params"`
+	Body        map[string]any  `mapstructure:"body"`
+	RecordsPath string          `mapstructure:"records_path"`
+	Pagination  *restPagination `mapstructure:"pagination"`
+	Auth        *restAuth       `mapstructure:"auth"`
+	RateLimit   float64         `mapstructure:"rate_limit"`
+	MaxRetries  *int            `mapstructure:"max_retries"`
+	// Timeout is the time limit for each request to the REST API, e.g. "30s".
+	Timeout string `mapstructure:"timeout"`
+
+	timeout time.Duration
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -164,7 +178,21 @@ func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
 
 // AsModelExecutor implements drivers.Handle.
 func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
-	return nil, false
+	if opts.InputHandle != c {
+		return nil, false
+	}
+	props, err := parseSourceProperties(opts.PreliminaryInputProperties)
+	if err != nil || !props.isREST() {
+		return nil, false
+	}
+	olap, ok := opts.OutputHandle.AsOLAP(instanceID)
+	if !ok {
+		return nil, false
+	}
+	if !olapwriter.SupportsDialect(olap.Dialect()) {
+		return nil, false
+	}
+	return &restExecutor{c: c, olap: olap}, true
 }
 
 // AsModelManager implements drivers.Handle.
@@ -203,6 +231,10 @@ func (c *connection) FilePaths(ctx context.Context, src map[string]any) ([]strin
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
+	if conf.isREST() {
+		return c.restFilePaths(ctx, conf)
+	}
+
 	extension, err := urlExtension(conf.Path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse path %s, %w", conf.Path, err)
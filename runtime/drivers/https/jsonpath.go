@@ -0,0 +1,144 @@
+package https
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a parsed JSONPath expression.
+// It supports the subset of the syntax needed to select records and cursors in API responses:
+// the root ($), child members (.name or ['name']), array indexes ([0] or [-1]) and wildcards (.* or [*]).
+// For convenience, the leading "$." may be omitted.
+type jsonPath []jsonPathSegment
+
+type jsonPathSegment struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+func parseJSONPath(s string) (jsonPath, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "$" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(s, "$") {
+		s = "$." + s
+	}
+
+	var res jsonPath
+	i := 1
+	for i < len(s) {
+		switch s[i] {
+		case '.':
+			i++
+			if i < len(s) && s[i] == '.' {
+				return nil, fmt.Errorf("invalid JSONPath %q: recursive descent is not supported", s)
+			}
+			start := i
+			for i < len(s) && s[i] != '.' && s[i] != '[' {
+				i++
+			}
+			name := s[start:i]
+			if name == "" {
+				return nil, fmt.Errorf("invalid JSONPath %q: empty member name", s)
+			}
+			if name == "*" {
+				res = append(res, jsonPathSegment{wildcard: true})
+			} else {
+				res = append(res, jsonPathSegment{key: name})
+			}
+		case '[':
+			i++
+			if i < len(s) && (s[i] == '\'' || s[i] == '"') {
+				quote := s[i]
+				end := strings.IndexByte(s[i+1:], quote)
+				if end < 0 || i+end+2 >= len(s) || s[i+end+2] != ']' {
+					return nil, fmt.Errorf("invalid JSONPath %q: unterminated member name", s)
+				}
+				res = append(res, jsonPathSegment{key: s[i+1 : i+1+end]})
+				i += end + 3
+				continue
+			}
+			end := strings.IndexByte(s[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid JSONPath %q: missing ]", s)
+			}
+			inner := strings.TrimSpace(s[i : i+end])
+			i += end + 1
+			if inner == "*" {
+				res = append(res, jsonPathSegment{wildcard: true})
+				continue
+			}
+			idx, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSONPath %q: unsupported selector [%s]", s, inner)
+			}
+			res = append(res, jsonPathSegment{index: idx, isIndex: true})
+		default:
+			return nil, fmt.Errorf("invalid JSONPath %q: unexpected character %q", s, s[i])
+		}
+	}
+	return res, nil
+}
+
+// hasWildcard returns true if the path can match more than one value.
+func (p jsonPath) hasWildcard() bool {
+	for _, seg := range p {
+		if seg.wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// eval returns the values matched by the path in v, which must be decoded JSON.
+func (p jsonPath) eval(v any) []any {
+	cur := []any{v}
+	for _, seg := range p {
+		var next []any
+		for _, c := range cur {
+			switch {
+			case seg.wildcard:
+				switch c := c.(type) {
+				case []any:
+					next = append(next, c...)
+				case map[string]any:
+					keys := make([]string, 0, len(c))
+					for k := range c {
+						keys = append(keys, k)
+					}
+					sort.Strings(keys)
+					for _, k := range keys {
+						next = append(next, c[k])
+					}
+				}
+			case seg.isIndex:
+				arr, ok := c.([]any)
+				if !ok {
+					continue
+				}
+				idx := seg.index
+				if idx < 0 {
+					idx += len(arr)
+				}
+				if idx >= 0 && idx < len(arr) {
+					next = append(next, arr[idx])
+				}
+			default:
+				m, ok := c.(map[string]any)
+				if !ok {
+					continue
+				}
+				if val, ok := m[seg.key]; ok {
+					next = append(next, val)
+				}
+			}
+		}
+		cur = next
+	}
+	return cur
+}
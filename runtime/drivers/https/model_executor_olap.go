@@ -0,0 +1,85 @@
+package https
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/olapwriter"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// _batchSize is the number of records to buffer before inserting them into the output table.
+const _batchSize = 10000
+
+// restExecutor fetches records from a paginated REST API and inserts them into a DuckDB or ClickHouse table in batches.
+// The position of the last page is persisted in the model's incremental state, so incremental runs continue where the previous run ended.
+type restExecutor struct {
+	c    *connection
+	olap drivers.OLAPStore
+}
+
+var _ drivers.ModelExecutor = &restExecutor{}
+
+func (e *restExecutor) Concurrency(desired int) (int, bool) {
+	if desired > 1 {
+		return 0, false
+	}
+	return 1, true
+}
+
+func (e *restExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
+	if opts.SplitRun {
+		return nil, errors.New("models that read from a REST API do not support splits")
+	}
+
+	inputProps, err := parseSourceProperties(opts.InputProperties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+
+	f, err := newRESTFetcher(ctx, inputProps)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+
+	// The pagination state is only carried over between incremental runs
+	var state *restState
+	if opts.IncrementalRun {
+		state, err = restStateFromMap(opts.IncrementalState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var n int
+	res, err := olapwriter.ExecuteModel(ctx, e.olap, opts, func(ctx context.Context, w *olapwriter.BatchWriter) error {
+		var err error
+		state, err = f.fetch(ctx, state, func(records []map[string]any) error {
+			for _, r := range records {
+				if err := w.Add(ctx, r); err != nil {
+					return err
+				}
+			}
+			n += len(records)
+			if w.Len() >= _batchSize {
+				return w.Flush(ctx)
+			}
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, olapwriter.ErrNoRows) {
+			return nil, fmt.Errorf("no records found at %q", inputProps.Path)
+		}
+		return nil, err
+	}
+
+	e.c.logger.Debug("https: fetched records", zap.String("model", opts.ModelName), zap.String("path", inputProps.Path), zap.Int("records", n), observability.ZapCtx(ctx))
+
+	res.IncrementalState = state.toMap()
+	return res, nil
+}
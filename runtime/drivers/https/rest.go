@@ -0,0 +1,614 @@
+package https
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/time/rate"
+)
+
+const (
+	_defaultRESTMaxRetries = 3
+	_defaultRESTTimeout    = 60 * time.Second
+	_restMaxRetryDelay     = 30 * time.Second
+	_restMaxErrorBodyBytes = 1024
+)
+
+// restRetryBaseDelay is the delay before the first retry of a failed request. It doubles with every retry.
+var restRetryBaseDelay = time.Second
+
+// restPagination configures how to request subsequent pages from a REST API.
+type restPagination struct {
+	// Type is the pagination strategy. It can be "cursor", "next_url", "link_header", "page" or "offset".
+	Type string `mapstructure:"type"`
+	// CursorPath is a JSONPath to the next cursor (for "cursor") or next URL (for "next_url") in the response body.
+	CursorPath string `mapstructure:"cursor_path"`
+	// CursorParam is the query parameter to pass the cursor in (for "cursor"). Defaults to "cursor".
+	CursorParam string `mapstructure:"cursor_param"`
+	// PageParam is the query parameter to pass the page number (for "page") or offset (for "offset") in.
+	// Defaults to "page" or "offset" respectively.
+	PageParam string `mapstructure:"page_param"`
+	// StartPage is the number of the first page (for "page"). Defaults to 1.
+	StartPage *int `mapstructure:"start_page"`
+	// PageSizeParam is an optional query parameter to pass the page size in.
+	PageSizeParam string `mapstructure:"page_size_param"`
+	// PageSize is the number of records to request per page. If set, a page with fewer records ends the pagination.
+	PageSize int `mapstructure:"page_size"`
+	// MaxPages optionally limits the number of pages requested in one run.
+	MaxPages int `mapstructure:"max_pages"`
+}
+
+// restAuth configures authentication against a REST API.
+type restAuth struct {
+	// Type is the authentication scheme. It can be "bearer", "basic" or "oauth2" (client credentials).
+	Type         string   `mapstructure:"type"`
+	Token        string   `mapstructure:"token"`
+	Username     string   `mapstructure:"username"`
+	Password     string   `mapstructure:"password"`
+	TokenURL     string   `mapstructure:"token_url"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+// isREST returns true if the properties configure a REST API instead of a single file download.
+func (p *sourceProperties) isREST() bool {
+	return p.RecordsPath != "" || p.Pagination != nil || p.Auth != nil || p.RateLimit > 0
+}
+
+func (p *sourceProperties) validateREST() error {
+	if p.Path == "" {
+		return errors.New(`missing property "path"`)
+	}
+
+	p.Method = strings.ToUpper(p.Method)
+	switch p.Method {
+	case "":
+		p.Method = http.MethodGet
+	case http.MethodGet, http.MethodPost:
+	default:
+		return fmt.Errorf("invalid method %q: must be GET or POST", p.Method)
+	}
+	if p.Body != nil && p.Method != http.MethodPost {
+		return errors.New(`"body" can only be set when "method" is POST`)
+	}
+
+	if p.RateLimit < 0 {
+		return errors.New(`"rate_limit" must be positive`)
+	}
+	if p.MaxRetries == nil {
+		n := _defaultRESTMaxRetries
+		p.MaxRetries = &n
+	} else if *p.MaxRetries < 0 {
+		return errors.New(`"max_retries" must be positive`)
+	}
+	p.timeout = _defaultRESTTimeout
+	if p.Timeout != "" {
+		d, err := time.ParseDuration(p.Timeout)
+		if err != nil {
+			return fmt.Errorf("invalid timeout: %w", err)
+		}
+		if d <= 0 {
+			return errors.New(`"timeout" must be positive`)
+		}
+		p.timeout = d
+	}
+
+	if pg := p.Pagination; pg != nil {
+		switch pg.Type {
+		case "cursor":
+			if pg.CursorPath == "" {
+				return errors.New(`"cursor_path" is required for cursor pagination`)
+			}
+			if pg.CursorParam == "" {
+				pg.CursorParam = "cursor"
+			}
+		case "next_url":
+			if pg.CursorPath == "" {
+				return errors.New(`"cursor_path" is required for next_url pagination`)
+			}
+		case "link_header":
+		case "page":
+			if pg.PageParam == "" {
+				pg.PageParam = "page"
+			}
+			if pg.StartPage == nil {
+				n := 1
+				pg.StartPage = &n
+			}
+		case "offset":
+			if pg.PageParam == "" {
+				pg.PageParam = "offset"
+			}
+		default:
+			return fmt.Errorf("invalid pagination type %q: must be cursor, next_url, link_header, page or offset", pg.Type)
+		}
+		if pg.PageSize < 0 || pg.MaxPages < 0 {
+			return errors.New(`"page_size" and "max_pages" must be positive`)
+		}
+		if pg.PageSizeParam != "" && pg.PageSize == 0 {
+			return errors.New(`"page_size" is required when "page_size_param" is set`)
+		}
+	}
+
+	if a := p.Auth; a != nil {
+		switch a.Type {
+		case "bearer":
+			if a.Token == "" {
+				return errors.New(`"token" is required for bearer auth`)
+			}
+		case "basic":
+			if a.Username == "" {
+				return errors.New(`"username" is required for basic auth`)
+			}
+		case "oauth2":
+			if a.TokenURL == "" || a.ClientID == "" || a.ClientSecret == "" {
+				return errors.New(`"token_url", "client_id" and "client_secret" are required for oauth2 auth`)
+			}
+		default:
+			return fmt.Errorf("invalid auth type %q: must be bearer, basic or oauth2", a.Type)
+		}
+	}
+
+	return nil
+}
+
+// restState is the incremental state of a paginated REST API.
+// It tracks where the next run should resume: the last cursor or next URL, the last page, or the next offset.
+// When resuming from a page that has already been read, Skip is the number of records on it that have already been ingested.
+type restState struct {
+	Cursor string `mapstructure:"cursor"`
+	Page   int    `mapstructure:"page"`
+	Offset int    `mapstructure:"offset"`
+	Skip   int    `mapstructure:"skip"`
+}
+
+func restStateFromMap(m map[string]any) (*restState, error) {
+	s := &restState{}
+	if err := mapstructure.WeakDecode(m, s); err != nil {
+		return nil, fmt.Errorf("invalid incremental state: %w", err)
+	}
+	return s, nil
+}
+
+func (s *restState) toMap() map[string]any {
+	if s == nil {
+		return nil
+	}
+	res := make(map[string]any)
+	if s.Cursor != "" {
+		res["cursor"] = s.Cursor
+	}
+	if s.Page != 0 {
+		res["page"] = s.Page
+	}
+	if s.Offset != 0 {
+		res["offset"] = s.Offset
+	}
+	if s.Skip != 0 {
+		res["skip"] = s.Skip
+	}
+	return res
+}
+
+// restFetcher requests the pages of a REST API and extracts the records from each page.
+type restFetcher struct {
+	props       *sourceProperties
+	client      *http.Client
+	limiter     *rate.Limiter
+	recordsPath jsonPath
+	cursorPath  jsonPath
+}
+
+func newRESTFetcher(ctx context.Context, props *sourceProperties) (*restFetcher, error) {
+	if err := props.validateREST(); err != nil {
+		return nil, err
+	}
+
+	recordsPath, err := parseJSONPath(props.RecordsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &restFetcher{
+		props:       props,
+		client:      &http.Client{Timeout: props.timeout},
+		recordsPath: recordsPath,
+	}
+
+	if props.Pagination != nil && props.Pagination.CursorPath != "" {
+		f.cursorPath, err = parseJSONPath(props.Pagination.CursorPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if props.RateLimit > 0 {
+		f.limiter = rate.NewLimiter(rate.Limit(props.RateLimit), 1)
+	}
+
+	if props.Auth != nil && props.Auth.Type == "oauth2" {
+		cfg := &clientcredentials.Config{
+			ClientID:     props.Auth.ClientID,
+			ClientSecret: props.Auth.ClientSecret,
+			TokenURL:     props.Auth.TokenURL,
+			Scopes:       props.Auth.Scopes,
+		}
+		// The token requests use the base client, so they are subject to the same timeout
+		f.client = cfg.Client(context.WithValue(ctx, oauth2.HTTPClient, f.client))
+		f.client.Timeout = props.timeout
+	}
+
+	return f, nil
+}
+
+// fetch requests pages starting at the given state and calls fn with the records of each page.
+// Records that are not JSON objects are wrapped in an object with a single "value" key.
+// It returns the state that the next incremental run should resume from, which is nil if the API is not paginated.
+// The resume state points to the page after the last page that was read if it is known, and otherwise to the last page that was read
+// along with the number of records on it, so the next run requests that page again and only passes on records that have been added to it since.
+func (f *restFetcher) fetch(ctx context.Context, state *restState, fn func(records []map[string]any) error) (*restState, error) {
+	pg := f.props.Pagination
+	req := restState{}
+	if state != nil {
+		req = *state
+	}
+	if pg != nil && pg.Type == "page" && req.Page == 0 {
+		req.Page = *pg.StartPage
+	}
+	res := req
+
+	for pages := 0; pg == nil || pg.MaxPages == 0 || pages < pg.MaxPages; pages++ {
+		reqURL, err := f.pageURL(&req)
+		if err != nil {
+			return nil, err
+		}
+
+		body, header, err := f.do(ctx, reqURL)
+		if err != nil {
+			return nil, err
+		}
+
+		records := f.records(body)
+		if len(records) == 0 {
+			break
+		}
+
+		// Skip the records that were already ingested by the previous run
+		if skip := min(req.Skip, len(records)); skip < len(records) {
+			if err := fn(records[skip:]); err != nil {
+				return nil, err
+			}
+		}
+
+		if pg == nil {
+			return nil, nil
+		}
+
+		// Find the next page. If there is none, resume from the current page after the records that were read.
+		next := req
+		next.Skip = 0
+		switch pg.Type {
+		case "cursor":
+			next.Cursor = jsonString(f.cursorPath.eval(body))
+		case "next_url":
+			next.Cursor, err = resolveURL(reqURL, jsonString(f.cursorPath.eval(body)))
+		case "link_header":
+			next.Cursor, err = resolveURL(reqURL, nextLink(header))
+		case "page":
+			next.Page++
+		case "offset":
+			next.Offset += len(records)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		res.Skip = len(records)
+		switch pg.Type {
+		case "cursor", "next_url", "link_header":
+			if next.Cursor == "" || next.Cursor == req.Cursor {
+				if pg.Type != "cursor" {
+					// For URL pagination, the state must point to a URL even if the first page was the last one
+					res.Cursor = reqURL
+				}
+				return &res, nil
+			}
+		case "page":
+			if pg.PageSize > 0 && len(records) < pg.PageSize {
+				return &res, nil
+			}
+		case "offset":
+			if pg.PageSize > 0 && len(records) < pg.PageSize {
+				return &next, nil
+			}
+		}
+		req = next
+		res = next
+	}
+
+	if pg == nil {
+		return nil, nil
+	}
+	return &res, nil
+}
+
+// restFilePaths fetches all pages of a REST API and writes the records to a newline-delimited JSON file.
+// It is used when a REST API is ingested as a source, which doesn't support incremental state.
+func (c *connection) restFilePaths(ctx context.Context, conf *sourceProperties) ([]string, error) {
+	f, err := newRESTFetcher(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.CreateTemp("", "rill-rest-*.ndjson")
+	if err != nil {
+		return nil, fmt.Errorf("os.Create: %w", err)
+	}
+
+	start := time.Now()
+	w := bufio.NewWriter(file)
+	enc := json.NewEncoder(w)
+	var n int
+	_, err = f.fetch(ctx, nil, func(records []map[string]any) error {
+		for _, r := range records {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		n += len(records)
+		return nil
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil && n == 0 {
+		err = fmt.Errorf("no records found at %q", conf.Path)
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	file.Close()
+	if err != nil {
+		os.Remove(file.Name())
+		return nil, err
+	}
+
+	// Collect metrics of download size and time
+	drivers.RecordDownloadMetrics(ctx, &drivers.DownloadMetrics{
+		Connector: "https",
+		Ext:       ".ndjson",
+		Duration:  time.Since(start),
+		Size:      info.Size(),
+	})
+
+	return []string{file.Name()}, nil
+}
+
+// pageURL returns the URL to request for the page identified by the state.
+func (f *restFetcher) pageURL(s *restState) (string, error) {
+	pg := f.props.Pagination
+	if pg != nil && (pg.Type == "next_url" || pg.Type == "link_header") && s.Cursor != "" {
+		return s.Cursor, nil
+	}
+
+	u, err := url.Parse(f.props.Path)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse path %s, %w", f.props.Path, err)
+	}
+
+	q := u.Query()
+	for k, v := range f.props.Params {
+		q.Set(k, fmt.Sprint(v))
+	}
+	if pg != nil {
+		if pg.PageSizeParam != "" {
+			q.Set(pg.PageSizeParam, strconv.Itoa(pg.PageSize))
+		}
+		switch pg.Type {
+		case "cursor":
+			if s.Cursor != "" {
+				q.Set(pg.CursorParam, s.Cursor)
+			}
+		case "page":
+			page := *pg.StartPage
+			if s.Page != 0 {
+				page = s.Page
+			}
+			q.Set(pg.PageParam, strconv.Itoa(page))
+		case "offset":
+			q.Set(pg.PageParam, strconv.Itoa(s.Offset))
+		}
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// do sends a request and decodes the JSON response body.
+// Requests that are rate limited (HTTP 429) or fail with a server error are retried with exponential backoff.
+func (f *restFetcher) do(ctx context.Context, reqURL string) (any, http.Header, error) {
+	var body []byte
+	if f.props.Body != nil {
+		var err error
+		body, err = json.Marshal(f.props.Body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode body: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if f.limiter != nil {
+			if err := f.limiter.Wait(ctx); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, f.props.Method, reqURL, bytes.NewReader(body))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch url %s:  %w", reqURL, err)
+		}
+		for k, v := range f.props.Headers {
+			req.Header.Set(k, v)
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if req.Header.Get("Accept") == "" {
+			req.Header.Set("Accept", "application/json")
+		}
+		if a := f.props.Auth; a != nil {
+			switch a.Type {
+			case "bearer":
+				req.Header.Set("Authorization", "Bearer "+a.Token)
+			case "basic":
+				req.SetBasicAuth(a.Username, a.Password)
+			}
+		}
+
+		resp, err := f.client.Do(req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch url %s:  %w", reqURL, err)
+		}
+
+		if (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500) && attempt < *f.props.MaxRetries {
+			delay := retryDelay(resp.Header, attempt)
+			resp.Body.Close()
+			select {
+			case <-ctx.Done():
+				return nil, nil, ctx.Err()
+			case <-time.After(delay):
+			}
+			continue
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			msg, _ := io.ReadAll(io.LimitReader(resp.Body, _restMaxErrorBodyBytes))
+			resp.Body.Close()
+			if len(msg) > 0 {
+				return nil, nil, fmt.Errorf("failed to fetch url %s: %s: %s", reqURL, resp.Status, strings.TrimSpace(string(msg)))
+			}
+			return nil, nil, fmt.Errorf("failed to fetch url %s: %s", reqURL, resp.Status)
+		}
+
+		var res any
+		dec := json.NewDecoder(resp.Body)
+		dec.UseNumber()
+		err = dec.Decode(&res)
+		resp.Body.Close()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, nil, fmt.Errorf("failed to decode response from %s: %w", reqURL, err)
+		}
+
+		return res, resp.Header, nil
+	}
+}
+
+// records returns the records selected by records_path in a response body.
+// If the path selects a single array, its elements are the records.
+func (f *restFetcher) records(body any) []map[string]any {
+	vals := f.recordsPath.eval(body)
+	if len(vals) == 1 && !f.recordsPath.hasWildcard() {
+		if arr, ok := vals[0].([]any); ok {
+			vals = arr
+		}
+	}
+
+	res := make([]map[string]any, 0, len(vals))
+	for _, v := range vals {
+		switch v := v.(type) {
+		case nil:
+		case map[string]any:
+			res = append(res, v)
+		default:
+			res = append(res, map[string]any{"value": v})
+		}
+	}
+	return res
+}
+
+// retryDelay returns how long to wait before retrying a request.
+// It uses the Retry-After header if present, and otherwise backs off exponentially.
+func retryDelay(header http.Header, attempt int) time.Duration {
+	if v := header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return min(time.Duration(secs)*time.Second, _restMaxRetryDelay)
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return min(max(time.Until(t), 0), _restMaxRetryDelay)
+		}
+	}
+	return min(restRetryBaseDelay*time.Duration(math.Pow(2, float64(attempt))), _restMaxRetryDelay)
+}
+
+// jsonString returns the first value as a string, or an empty string if there are no values or it is null.
+func jsonString(vals []any) string {
+	if len(vals) == 0 || vals[0] == nil {
+		return ""
+	}
+	if s, ok := vals[0].(string); ok {
+		return s
+	}
+	return fmt.Sprint(vals[0])
+}
+
+// resolveURL resolves a possibly relative URL against the URL of the request it was returned from.
+func resolveURL(base, ref string) (string, error) {
+	if ref == "" {
+		return "", nil
+	}
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	r, err := url.Parse(ref)
+	if err != nil {
+		return "", fmt.Errorf("invalid next page URL %q: %w", ref, err)
+	}
+	return b.ResolveReference(r).String(), nil
+}
+
+// nextLink returns the URL with rel="next" in a Link header (RFC 8288), or an empty string if there is none.
+func nextLink(header http.Header) string {
+	for _, v := range header.Values("Link") {
+		for _, link := range strings.Split(v, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range parts[1:] {
+				k, v, ok := strings.Cut(strings.TrimSpace(param), "=")
+				if !ok || !strings.EqualFold(strings.TrimSpace(k), "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(v), `"`)) {
+					if strings.EqualFold(rel, "next") {
+						return target[1 : len(target)-1]
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,313 @@
+package https
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestJSONPath(t *testing.T) {
+	doc := map[string]any{
+		"data": map[string]any{
+			"items": []any{
+				map[string]any{"id": "a", "tags": []any{"x", "y"}},
+				map[string]any{"id": "b", "tags": []any{"z"}},
+			},
+			"next.cursor": "c2",
+		},
+	}
+
+	tests := []struct {
+		path string
+		want []any
+	}{
+		{"$", []any{doc}},
+		{"$.data.items[0].id", []any{"a"}},
+		{"data.items[-1].id", []any{"b"}},
+		{"$.data.items[*].id", []any{"a", "b"}},
+		{"$.data.items.*.tags[0]", []any{"x", "z"}},
+		{"$['data']['next.cursor']", []any{"c2"}},
+		{"$.data.missing", nil},
+		{"$.data.items[5]", nil},
+	}
+	for _, tt := range tests {
+		p, err := parseJSONPath(tt.path)
+		require.NoError(t, err, tt.path)
+		require.Equal(t, tt.want, p.eval(doc), tt.path)
+	}
+
+	for _, path := range []string{"$..id", "$.data[", "$.data['x", "$.data[?(@.id)]", "$.data."} {
+		_, err := parseJSONPath(path)
+		require.Error(t, err, path)
+	}
+}
+
+func TestNextLink(t *testing.T) {
+	h := http.Header{}
+	h.Add("Link", `<https://api.example.com/items?page=1>; rel="prev", <https://api.example.com/items?page=3>; rel="next"`)
+	require.Equal(t, "https://api.example.com/items?page=3", nextLink(h))
+
+	h = http.Header{}
+	h.Add("Link", `<https://api.example.com/items?page=1>; rel="first"`)
+	require.Equal(t, "", nextLink(h))
+}
+
+func TestRESTCursorPagination(t *testing.T) {
+	pages := map[string]string{
+		"":   `{"data": {"items": [{"id": 1}, {"id": 2}]}, "meta": {"next": "c1"}}`,
+		"c1": `{"data": {"items": [{"id": 3}]}, "meta": {"next": null}}`,
+	}
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		require.Equal(t, "us", r.URL.Query().Get("region"))
+		body, ok := pages[r.URL.Query().Get("after")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	props := map[string]any{
+		"path":         srv.URL,
+		"params":       map[string]any{"region": "us"},
+		"records_path": "$.data.items",
+		"pagination":   map[string]any{"type": "cursor", "cursor_path": "$.meta.next", "cursor_param": "after"},
+		"auth":         map[string]any{"type": "bearer", "token": "secret"},
+	}
+
+	ids, state := fetchAll(t, props, nil)
+	require.Equal(t, []string{"1", "2", "3"}, ids)
+	require.Equal(t, "Bearer secret", auth)
+	// The last page didn't return a cursor, so the next run resumes at it after the records that were read
+	require.Equal(t, map[string]any{"cursor": "c1", "skip": 1}, state)
+
+	pages["c1"] = `{"data": {"items": [{"id": 3}, {"id": 4}]}, "meta": {"next": "c2"}}`
+	pages["c2"] = `{"data": {"items": []}, "meta": {"next": "c2"}}`
+	ids, state = fetchAll(t, props, state)
+	require.Equal(t, []string{"4"}, ids)
+	require.Equal(t, map[string]any{"cursor": "c2"}, state)
+}
+
+func TestRESTPagePagination(t *testing.T) {
+	total := 5
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("p"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		require.Equal(t, 2, size)
+		var items []string
+		for i := (page - 1) * size; i < page*size && i < total; i++ {
+			items = append(items, fmt.Sprintf(`{"id": %d}`, i))
+		}
+		_, _ = w.Write([]byte("[" + strings.Join(items, ",") + "]"))
+	}))
+	defer srv.Close()
+
+	props := map[string]any{
+		"path":       srv.URL,
+		"pagination": map[string]any{"type": "page", "page_param": "p", "page_size_param": "size", "page_size": 2},
+	}
+	ids, state := fetchAll(t, props, nil)
+	require.Equal(t, []string{"0", "1", "2", "3", "4"}, ids)
+	require.Equal(t, map[string]any{"page": 3, "skip": 1}, state)
+
+	// Resuming only returns records that have been added to the last page since
+	ids, _ = fetchAll(t, props, state)
+	require.Empty(t, ids)
+	total = 6
+	ids, next := fetchAll(t, props, state)
+	require.Equal(t, []string{"5"}, ids)
+	require.Equal(t, map[string]any{"page": 4}, next)
+
+	// Limit the number of pages per run
+	total = 5
+	props["pagination"].(map[string]any)["max_pages"] = 2
+	ids, state = fetchAll(t, props, nil)
+	require.Equal(t, []string{"0", "1", "2", "3"}, ids)
+	require.Equal(t, map[string]any{"page": 3}, state)
+	ids, _ = fetchAll(t, props, state)
+	require.Equal(t, []string{"4"}, ids)
+}
+
+func TestRESTOffsetPagination(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var items []string
+		for i := offset; i < offset+2 && i < 3; i++ {
+			items = append(items, strconv.Itoa(i))
+		}
+		_, _ = w.Write([]byte(`{"results": [` + strings.Join(items, ",") + `]}`))
+	}))
+	defer srv.Close()
+
+	props := map[string]any{
+		"path":         srv.URL,
+		"records_path": "results",
+		"pagination":   map[string]any{"type": "offset"},
+	}
+	values, state := fetchAll(t, props, nil)
+	require.Equal(t, []string{"0", "1", "2"}, values)
+	require.Equal(t, map[string]any{"offset": 3}, state)
+}
+
+func TestRESTLinkHeaderPagination(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Rate limit the first request
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", `</items?page=2>; rel="next"`)
+			_, _ = w.Write([]byte(`[{"id": 1}]`))
+		case "2":
+			_, _ = w.Write([]byte(`[{"id": 2}]`))
+		}
+	}))
+	defer srv.Close()
+
+	props := map[string]any{
+		"path":       srv.URL + "/items",
+		"rate_limit": 100,
+		"pagination": map[string]any{"type": "link_header"},
+	}
+	ids, state := fetchAll(t, props, nil)
+	require.Equal(t, []string{"1", "2"}, ids)
+	require.Equal(t, map[string]any{"cursor": srv.URL + "/items?page=2", "skip": 1}, state)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestRESTOAuth2(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token": "tkn", "token_type": "bearer", "expires_in": 3600}`))
+		case "/items":
+			if r.Header.Get("Authorization") != "Bearer tkn" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"id": 1}`))
+		}
+	}))
+	defer srv.Close()
+
+	props := map[string]any{
+		"path": srv.URL + "/items",
+		"auth": map[string]any{"type": "oauth2", "token_url": srv.URL + "/token", "client_id": "id", "client_secret": "secret"},
+	}
+	ids, state := fetchAll(t, props, nil)
+	require.Equal(t, []string{"1"}, ids)
+	require.Nil(t, state)
+
+	props["auth"] = map[string]any{"type": "bearer", "token": "wrong"}
+	_, err := parseAndFetch(props, nil)
+	require.ErrorContains(t, err, "401 Unauthorized")
+}
+
+func TestRESTTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		_, _ = w.Write([]byte(`[{"id": 1}]`))
+	}))
+	defer srv.Close()
+
+	props := map[string]any{
+		"path":       srv.URL,
+		"rate_limit": 100,
+		"timeout":    "50ms",
+	}
+	_, err := parseAndFetch(props, nil)
+	require.ErrorContains(t, err, "Client.Timeout exceeded")
+
+	props["timeout"] = "1s"
+	ids, _ := fetchAll(t, props, nil)
+	require.Equal(t, []string{"1"}, ids)
+
+	props["timeout"] = "soon"
+	_, err = parseAndFetch(props, nil)
+	require.ErrorContains(t, err, "invalid timeout")
+}
+
+func TestRESTFilePaths(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"items": [{"id": 1}, {"id": 2}]}`))
+	}))
+	defer srv.Close()
+
+	conn, err := driver{}.Open("default", nil, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	fs, ok := conn.AsFileStore()
+	require.True(t, ok)
+
+	paths, err := fs.FilePaths(context.Background(), map[string]any{"path": srv.URL, "records_path": "$.items"})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	defer os.Remove(paths[0])
+	require.True(t, strings.HasSuffix(paths[0], ".ndjson"))
+
+	data, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	require.Equal(t, "{\"id\":1}\n{\"id\":2}\n", string(data))
+}
+
+// fetchAll fetches records using the given source properties and returns the "id" of each record (or the value of non-object records) and the resume state.
+func fetchAll(t *testing.T, props, state map[string]any) ([]string, map[string]any) {
+	var ids []string
+	next, err := parseAndFetch(props, state, func(records []map[string]any) {
+		for _, r := range records {
+			v, ok := r["id"]
+			if !ok {
+				v = r["value"]
+			}
+			ids = append(ids, v.(json.Number).String())
+		}
+	})
+	require.NoError(t, err)
+	return ids, next
+}
+
+func parseAndFetch(props, state map[string]any, fns ...func(records []map[string]any)) (map[string]any, error) {
+	conf, err := parseSourceProperties(props)
+	if err != nil {
+		return nil, err
+	}
+	f, err := newRESTFetcher(context.Background(), conf)
+	if err != nil {
+		return nil, err
+	}
+	s, err := restStateFromMap(state)
+	if err != nil {
+		return nil, err
+	}
+	next, err := f.fetch(context.Background(), s, func(records []map[string]any) error {
+		for _, fn := range fns {
+			fn(records)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return next.toMap(), nil
+}
@@ -0,0 +1,348 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/olapwriter"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/avro"
+	"go.uber.org/zap"
+)
+
+func init() {
+	drivers.Register("kafka", driver{})
+	drivers.RegisterAsConnector("kafka", driver{})
+}
+
+var spec = drivers.Spec{
+	DisplayName: "Kafka",
+	Description: "Continuously ingest messages from a Kafka topic.",
+	DocsURL:     "https://docs.rilldata.com/reference/connectors/kafka",
+	ConfigProperties: []*drivers.PropertySpec{
+		{
+			Key:         "bootstrap_servers",
+			Type:        drivers.StringPropertyType,
+			Required:    true,
+			DisplayName: "Bootstrap servers",
+			Description: "Comma-separated list of Kafka brokers",
+			Placeholder: "localhost:9092",
+		},
+		{
+			Key:         "security_protocol",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Security protocol",
+			Description: "One of plaintext, ssl, sasl_plaintext or sasl_ssl",
+			Placeholder: "plaintext",
+		},
+		{
+			Key:         "sasl_mechanism",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SASL mechanism",
+			Description: "One of PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512",
+			Placeholder: "PLAIN",
+		},
+		{
+			Key:         "sasl_username",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SASL username",
+		},
+		{
+			Key:         "sasl_password",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SASL password",
+			Secret:      true,
+		},
+		{
+			Key:         "schema_registry_url",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Schema registry URL",
+			Description: "URL of a Confluent schema registry used to decode Avro messages",
+			Placeholder: "http://localhost:8081",
+		},
+		{
+			Key:         "schema_registry_username",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Schema registry username",
+		},
+		{
+			Key:         "schema_registry_password",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Schema registry password",
+			Secret:      true,
+		},
+	},
+}
+
+// metadataTimeout is the timeout for metadata requests to the brokers.
+const metadataTimeout = 10 * time.Second
+
+type driver struct{}
+
+type configProperties struct {
+	BootstrapServers       string `mapstructure:"bootstrap_servers"`
+	SecurityProtocol       string `mapstructure:"security_protocol"`
+	SASLMechanism          string `mapstructure:"sasl_mechanism"`
+	SASLUsername           string `mapstructure:"sasl_username"`
+	SASLPassword           string `mapstructure:"sasl_password"`
+	SchemaRegistryURL      string `mapstructure:"schema_registry_url"`
+	SchemaRegistryUsername string `mapstructure:"schema_registry_username"`
+	SchemaRegistryPassword string `mapstructure:"schema_registry_password"`
+}
+
+func (d driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if instanceID == "" {
+		return nil, errors.New("kafka driver can't be shared")
+	}
+
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.BootstrapServers == "" {
+		return nil, errors.New("kafka: bootstrap_servers is required")
+	}
+
+	conn := &connection{
+		instanceID:    instanceID,
+		config:        conf,
+		configMap:     config,
+		logger:        logger,
+		schemas:       make(map[int]*avro.Schema),
+		inlineSchemas: make(map[string]*avro.Schema),
+	}
+	return conn, nil
+}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, src map[string]any, logger *zap.Logger) (bool, error) {
+	return false, nil
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+type connection struct {
+	instanceID string
+	config     *configProperties
+	configMap  map[string]any
+	logger     *zap.Logger
+
+	// schemas caches Avro schemas fetched from the schema registry by ID
+	schemasMu     sync.Mutex
+	schemas       map[int]*avro.Schema
+	inlineSchemas map[string]*avro.Schema
+	registry      schemaregistry.Client
+}
+
+var _ drivers.Handle = &connection{}
+
+// Ping implements drivers.Handle.
+func (c *connection) Ping(ctx context.Context) error {
+	admin, err := kafka.NewAdminClient(c.kafkaConfig())
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+
+	_, err = admin.GetMetadata(nil, false, int(metadataTimeout.Milliseconds()))
+	if err != nil {
+		return fmt.Errorf("failed to connect to kafka: %w", err)
+	}
+	return nil
+}
+
+// Driver implements drivers.Connection.
+func (c *connection) Driver() string {
+	return "kafka"
+}
+
+// Config implements drivers.Connection.
+func (c *connection) Config() map[string]any {
+	return c.configMap
+}
+
+// Close implements drivers.Connection.
+func (c *connection) Close() error {
+	return nil
+}
+
+// AsRegistry implements drivers.Connection.
+func (c *connection) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Connection.
+func (c *connection) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Connection.
+func (c *connection) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (c *connection) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+// AsOLAP implements drivers.Connection.
+func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// AsAI implements drivers.Handle.
+func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+// Migrate implements drivers.Connection.
+func (c *connection) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Connection.
+func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// AsObjectStore implements drivers.Connection.
+func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsModelExecutor implements drivers.Handle.
+func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	if opts.InputHandle != c {
+		return nil, false
+	}
+	olap, ok := opts.OutputHandle.AsOLAP(instanceID)
+	if !ok {
+		return nil, false
+	}
+	if !olapwriter.SupportsDialect(olap.Dialect()) {
+		return nil, false
+	}
+	return &olapExecutor{c: c, olap: olap}, true
+}
+
+// AsModelManager implements drivers.Handle.
+func (c *connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Connection.
+func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+// AsFileStore implements drivers.Connection.
+func (c *connection) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsWarehouse implements drivers.Handle.
+func (c *connection) AsWarehouse() (drivers.Warehouse, bool) {
+	return nil, false
+}
+
+// AsSQLStore implements drivers.Connection.
+func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+// AsNotifier implements drivers.Connection.
+func (c *connection) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return nil, drivers.ErrNotNotifier
+}
+
+// kafkaConfig returns the librdkafka config for connecting to the brokers.
+func (c *connection) kafkaConfig() *kafka.ConfigMap {
+	cfg := &kafka.ConfigMap{
+		"bootstrap.servers": c.config.BootstrapServers,
+	}
+	if c.config.SecurityProtocol != "" {
+		_ = cfg.SetKey("security.protocol", c.config.SecurityProtocol)
+	}
+	if c.config.SASLMechanism != "" {
+		_ = cfg.SetKey("sasl.mechanism", c.config.SASLMechanism)
+	}
+	if c.config.SASLUsername != "" {
+		_ = cfg.SetKey("sasl.username", c.config.SASLUsername)
+		_ = cfg.SetKey("sasl.password", c.config.SASLPassword)
+	}
+	return cfg
+}
+
+// registrySchema returns the Avro schema with the given ID from the schema registry.
+func (c *connection) registrySchema(id int) (*avro.Schema, error) {
+	c.schemasMu.Lock()
+	defer c.schemasMu.Unlock()
+
+	if s, ok := c.schemas[id]; ok {
+		return s, nil
+	}
+
+	if c.config.SchemaRegistryURL == "" {
+		return nil, fmt.Errorf("message references schema ID %d, but schema_registry_url is not configured", id)
+	}
+
+	if c.registry == nil {
+		var cfg *schemaregistry.Config
+		if c.config.SchemaRegistryUsername != "" {
+			cfg = schemaregistry.NewConfigWithAuthentication(c.config.SchemaRegistryURL, c.config.SchemaRegistryUsername, c.config.SchemaRegistryPassword)
+		} else {
+			cfg = schemaregistry.NewConfig(c.config.SchemaRegistryURL)
+		}
+		client, err := schemaregistry.NewClient(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schema registry client: %w", err)
+		}
+		c.registry = client
+	}
+
+	info, err := c.registry.GetBySubjectAndID("", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema %d from schema registry: %w", id, err)
+	}
+	if info.SchemaType != "" && info.SchemaType != "AVRO" {
+		return nil, fmt.Errorf("schema %d has unsupported type %q", id, info.SchemaType)
+	}
+
+	s, err := avro.ParseSchema(info.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema %d: %w", id, err)
+	}
+	c.schemas[id] = s
+	return s, nil
+}
+
+// inlineAvroSchema returns the parsed Avro schema for a schema configured in a model's input properties.
+func (c *connection) inlineAvroSchema(schema string) (*avro.Schema, error) {
+	c.schemasMu.Lock()
+	defer c.schemasMu.Unlock()
+
+	if s, ok := c.inlineSchemas[schema]; ok {
+		return s, nil
+	}
+
+	s, err := avro.ParseSchema(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse avro_schema: %w", err)
+	}
+	c.inlineSchemas[schema] = s
+	return s, nil
+}
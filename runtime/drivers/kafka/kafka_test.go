@@ -0,0 +1,212 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"go.uber.org/zap"
+
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+)
+
+func TestKafkaToDuckDB(t *testing.T) {
+	if testing.Short() {
+		t.Skip("kafka: skipping test in short mode")
+	}
+
+	ctx := context.Background()
+	brokers := startBroker(t)
+	topic := "events"
+
+	// Create a topic with two partitions and produce the first messages
+	admin, err := kafka.NewAdminClient(&kafka.ConfigMap{"bootstrap.servers": brokers})
+	require.NoError(t, err)
+	_, err = admin.CreateTopics(ctx, []kafka.TopicSpecification{{Topic: topic, NumPartitions: 2, ReplicationFactor: 1}})
+	require.NoError(t, err)
+	admin.Close()
+	produce(t, brokers, topic, 0, 10)
+
+	// Open the connectors
+	kafkaHandle, err := drivers.Open("kafka", "default", map[string]any{"bootstrap_servers": brokers}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer kafkaHandle.Close()
+	require.NoError(t, kafkaHandle.Ping(ctx))
+
+	duckdbHandle, err := drivers.Open("duckdb", "default", map[string]any{"dsn": ":memory:"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer duckdbHandle.Close()
+	olap, ok := duckdbHandle.AsOLAP("default")
+	require.True(t, ok)
+
+	executorOpts := &drivers.ModelExecutorOptions{
+		Env:             &drivers.ModelEnv{StageChanges: true},
+		ModelName:       "events",
+		InputHandle:     kafkaHandle,
+		InputConnector:  "kafka",
+		OutputHandle:    duckdbHandle,
+		OutputConnector: "duckdb",
+	}
+	executor, ok := kafkaHandle.AsModelExecutor("default", executorOpts)
+	require.True(t, ok)
+
+	inputProps := map[string]any{"topic": topic, "idle_timeout": "5s", "include_metadata": true}
+	outputProps := map[string]any{"materialize": true}
+
+	// Initial run consumes all messages
+	res, err := executor.Execute(ctx, &drivers.ModelExecuteOptions{
+		ModelExecutorOptions: executorOpts,
+		InputProperties:      inputProps,
+		OutputProperties:     outputProps,
+		Incremental:          true,
+		IncrementalState:     map[string]any{"incremental": false},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "events", res.Table)
+	require.Equal(t, 10, countRows(t, olap, "SELECT COUNT(*) FROM events"))
+	require.Equal(t, 45, countRows(t, olap, "SELECT SUM(id) FROM events"))
+
+	// Incremental run only consumes new messages
+	produce(t, brokers, topic, 10, 15)
+	state := res.IncrementalState
+	state["incremental"] = true
+	res, err = executor.Execute(ctx, &drivers.ModelExecuteOptions{
+		ModelExecutorOptions: executorOpts,
+		InputProperties:      inputProps,
+		OutputProperties:     outputProps,
+		Incremental:          true,
+		IncrementalRun:       true,
+		PreviousResult:       res,
+		IncrementalState:     state,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 15, countRows(t, olap, "SELECT COUNT(*) FROM events"))
+	require.Equal(t, 15, countRows(t, olap, "SELECT COUNT(DISTINCT id) FROM events"))
+	require.Equal(t, 15, countRows(t, olap, "SELECT COUNT(DISTINCT (kafka_partition, kafka_offset)) FROM events"))
+
+	// The offsets reflect all consumed messages
+	offsets, err := offsetsFromState(res.IncrementalState)
+	require.NoError(t, err)
+	var total int64
+	for _, o := range offsets {
+		total += int64(o)
+	}
+	require.Equal(t, int64(15), total)
+
+	// A run that fails after flushing some batches doesn't write any rows, so retrying it doesn't duplicate them
+	produce(t, brokers, topic, 15, 20)
+	produceRaw(t, brokers, topic, []byte("not json"))
+	prevState := res.IncrementalState
+	_, err = executor.Execute(ctx, &drivers.ModelExecuteOptions{
+		ModelExecutorOptions: executorOpts,
+		InputProperties:      map[string]any{"topic": topic, "idle_timeout": "5s", "include_metadata": true, "batch_size": 1},
+		OutputProperties:     outputProps,
+		Incremental:          true,
+		IncrementalRun:       true,
+		PreviousResult:       res,
+		IncrementalState:     prevState,
+	})
+	require.ErrorContains(t, err, "failed to decode message")
+	require.Equal(t, 15, countRows(t, olap, "SELECT COUNT(*) FROM events"))
+}
+
+func TestOffsetsState(t *testing.T) {
+	offsets := map[int32]kafka.Offset{0: 10, 3: 42}
+	res, err := offsetsFromState(offsetsToState(offsets))
+	require.NoError(t, err)
+	require.Equal(t, offsets, res)
+
+	res, err = offsetsFromState(map[string]any{"incremental": true})
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	_, err = offsetsFromState(map[string]any{"offsets": map[string]any{"x": 1.0}})
+	require.Error(t, err)
+}
+
+// startBroker starts a single-node Kafka broker in a container and returns its address.
+func startBroker(t *testing.T) string {
+	// The broker must advertise the address that clients connect to, so we pick the host port upfront.
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	container, err := testcontainers.GenericContainer(context.Background(), testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "apache/kafka:3.7.0",
+			ExposedPorts: []string{fmt.Sprintf("%d:9092/tcp", port)},
+			WaitingFor:   wait.ForLog("Kafka Server started").WithStartupTimeout(time.Minute),
+			Env: map[string]string{
+				"KAFKA_NODE_ID":                                  "1",
+				"KAFKA_PROCESS_ROLES":                            "broker,controller",
+				"KAFKA_LISTENERS":                                "PLAINTEXT://:9092,CONTROLLER://:9093",
+				"KAFKA_ADVERTISED_LISTENERS":                     fmt.Sprintf("PLAINTEXT://localhost:%d", port),
+				"KAFKA_CONTROLLER_LISTENER_NAMES":                "CONTROLLER",
+				"KAFKA_LISTENER_SECURITY_PROTOCOL_MAP":           "CONTROLLER:PLAINTEXT,PLAINTEXT:PLAINTEXT",
+				"KAFKA_CONTROLLER_QUORUM_VOTERS":                 "1@localhost:9093",
+				"KAFKA_OFFSETS_TOPIC_REPLICATION_FACTOR":         "1",
+				"KAFKA_TRANSACTION_STATE_LOG_REPLICATION_FACTOR": "1",
+				"KAFKA_TRANSACTION_STATE_LOG_MIN_ISR":            "1",
+				"KAFKA_GROUP_INITIAL_REBALANCE_DELAY_MS":         "0",
+			},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(context.Background()))
+	})
+
+	return fmt.Sprintf("localhost:%d", port)
+}
+
+// produce produces JSON messages with IDs in the range [from, to) to the topic.
+func produce(t *testing.T, brokers, topic string, from, to int) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": brokers})
+	require.NoError(t, err)
+	defer p.Close()
+
+	for i := from; i < to; i++ {
+		err := p.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+			Key:            []byte(fmt.Sprintf("key%d", i)),
+			Value:          []byte(fmt.Sprintf(`{"id": %d, "name": "event %d"}`, i, i)),
+		}, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 0, p.Flush(10000))
+}
+
+// produceRaw produces a message with the given value to the topic.
+func produceRaw(t *testing.T, brokers, topic string, value []byte) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": brokers})
+	require.NoError(t, err)
+	defer p.Close()
+
+	err = p.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+		Value:          value,
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, p.Flush(10000))
+}
+
+func countRows(t *testing.T, olap drivers.OLAPStore, qry string) int {
+	res, err := olap.Execute(context.Background(), &drivers.Statement{Query: qry})
+	require.NoError(t, err)
+	defer res.Close()
+
+	var n int
+	require.True(t, res.Next())
+	require.NoError(t, res.Scan(&n))
+	return n
+}
@@ -0,0 +1,406 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/olapwriter"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+const (
+	_defaultBatchSize   = 10000
+	_defaultIdleTimeout = 10 * time.Second
+	_pollTimeout        = 100 * time.Millisecond
+)
+
+// ModelInputProperties are the input properties of a model that reads from Kafka.
+type ModelInputProperties struct {
+	// Topic is the topic to consume.
+	Topic string `mapstructure:"topic"`
+	// Format is the format of the message values. It can be "json" (default) or "avro".
+	Format string `mapstructure:"format"`
+	// AvroSchema is an inline Avro schema for messages that are not encoded with the Confluent schema registry wire format.
+	AvroSchema string `mapstructure:"avro_schema"`
+	// GroupID is the consumer group to commit offsets to. It defaults to a group derived from the instance and model name.
+	GroupID string `mapstructure:"group_id"`
+	// StartOffset is where to start consuming partitions that don't have a stored offset. It can be "earliest" (default) or "latest".
+	StartOffset string `mapstructure:"start_offset"`
+	// BatchSize is the maximum number of messages to insert into the output table in one batch.
+	BatchSize int `mapstructure:"batch_size"`
+	// MaxMessages optionally limits the number of messages consumed in one execution.
+	MaxMessages int `mapstructure:"max_messages"`
+	// IdleTimeout is how long to wait for new messages before ending the execution, e.g. "10s".
+	IdleTimeout string `mapstructure:"idle_timeout"`
+	// IncludeMetadata adds the message's partition, offset, timestamp and key as columns.
+	IncludeMetadata bool `mapstructure:"include_metadata"`
+
+	idleTimeout time.Duration
+}
+
+func (p *ModelInputProperties) Validate() error {
+	if p.Topic == "" {
+		return errors.New(`missing property "topic"`)
+	}
+	switch p.Format {
+	case "":
+		p.Format = "json"
+	case "json", "avro":
+	default:
+		return fmt.Errorf("invalid format %q: must be json or avro", p.Format)
+	}
+	if p.AvroSchema != "" && p.Format != "avro" {
+		return errors.New(`"avro_schema" can only be set when "format" is "avro"`)
+	}
+	switch p.StartOffset {
+	case "":
+		p.StartOffset = "earliest"
+	case "earliest", "latest":
+	default:
+		return fmt.Errorf("invalid start_offset %q: must be earliest or latest", p.StartOffset)
+	}
+	if p.BatchSize < 0 || p.MaxMessages < 0 {
+		return errors.New(`"batch_size" and "max_messages" must be positive`)
+	}
+	if p.BatchSize == 0 {
+		p.BatchSize = _defaultBatchSize
+	}
+	p.idleTimeout = _defaultIdleTimeout
+	if p.IdleTimeout != "" {
+		d, err := time.ParseDuration(p.IdleTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid idle_timeout: %w", err)
+		}
+		p.idleTimeout = d
+	}
+	return nil
+}
+
+// olapExecutor consumes messages from a Kafka topic and inserts them into a DuckDB or ClickHouse table in micro-batches.
+// The next offset of each partition is persisted in the model's incremental state, so incremental runs continue where the previous run ended.
+// The batches of an incremental run are staged until the run succeeds (see olapwriter.ExecuteModel), so a failed run doesn't duplicate messages when retried.
+// Offsets are also committed to the consumer group on a best-effort basis, which enables monitoring of consumer lag with standard Kafka tooling.
+type olapExecutor struct {
+	c    *connection
+	olap drivers.OLAPStore
+}
+
+var _ drivers.ModelExecutor = &olapExecutor{}
+
+func (e *olapExecutor) Concurrency(desired int) (int, bool) {
+	if desired > 1 {
+		return 0, false
+	}
+	return 1, true
+}
+
+func (e *olapExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
+	if opts.SplitRun {
+		return nil, errors.New("models that read from kafka do not support splits")
+	}
+
+	inputProps := &ModelInputProperties{}
+	if err := mapstructure.WeakDecode(opts.InputProperties, inputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if err := inputProps.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+
+	// Offsets are only carried over between incremental runs
+	var offsets map[int32]kafka.Offset
+	if opts.IncrementalRun {
+		var err error
+		offsets, err = offsetsFromState(opts.IncrementalState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var n int
+	res, err := olapwriter.ExecuteModel(ctx, e.olap, opts, func(ctx context.Context, w *olapwriter.BatchWriter) error {
+		var err error
+		offsets, n, err = e.consume(ctx, opts, inputProps, offsets, w)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, olapwriter.ErrNoRows) {
+			return nil, fmt.Errorf("no messages found in kafka topic %q", inputProps.Topic)
+		}
+		return nil, err
+	}
+
+	e.c.logger.Debug("kafka: consumed messages", zap.String("model", opts.ModelName), zap.String("topic", inputProps.Topic), zap.Int("messages", n), observability.ZapCtx(ctx))
+
+	res.IncrementalState = offsetsToState(offsets)
+	return res, nil
+}
+
+// consume reads messages from the topic starting at the given offsets and writes them to w in batches.
+// It stops when it has reached the end of every partition as of the start of the execution, when max_messages is reached, or when no messages are received for idle_timeout.
+// It returns the next offset of every partition and the number of messages consumed.
+func (e *olapExecutor) consume(ctx context.Context, opts *drivers.ModelExecuteOptions, props *ModelInputProperties, offsets map[int32]kafka.Offset, w *olapwriter.BatchWriter) (map[int32]kafka.Offset, int, error) {
+	groupID := props.GroupID
+	if groupID == "" {
+		groupID = fmt.Sprintf("rill-%s-%s", e.c.instanceID, opts.ModelName)
+	}
+
+	cfg := e.c.kafkaConfig()
+	_ = cfg.SetKey("group.id", groupID)
+	_ = cfg.SetKey("enable.auto.commit", false)
+	_ = cfg.SetKey("enable.auto.offset.store", false)
+	_ = cfg.SetKey("auto.offset.reset", props.StartOffset)
+	consumer, err := kafka.NewConsumer(cfg)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create kafka consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	// Find the partitions of the topic
+	md, err := consumer.GetMetadata(&props.Topic, false, int(metadataTimeout.Milliseconds()))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get metadata for kafka topic %q: %w", props.Topic, err)
+	}
+	topic, ok := md.Topics[props.Topic]
+	if !ok || topic.Error.Code() == kafka.ErrUnknownTopicOrPart || len(topic.Partitions) == 0 {
+		return nil, 0, fmt.Errorf("kafka topic %q not found", props.Topic)
+	}
+	if topic.Error.Code() != kafka.ErrNoError {
+		return nil, 0, fmt.Errorf("failed to get metadata for kafka topic %q: %w", props.Topic, topic.Error)
+	}
+
+	// Resolve the start offset of each partition and the end offset to consume up to.
+	// We only consume the messages that were available when the execution started, so a busy topic doesn't prevent the execution from completing.
+	next := make(map[int32]kafka.Offset, len(topic.Partitions))
+	end := make(map[int32]kafka.Offset, len(topic.Partitions))
+	var assignments []kafka.TopicPartition
+	for _, p := range topic.Partitions {
+		low, high, err := consumer.QueryWatermarkOffsets(props.Topic, p.ID, int(metadataTimeout.Milliseconds()))
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get offsets for kafka topic %q partition %d: %w", props.Topic, p.ID, err)
+		}
+
+		start, ok := offsets[p.ID]
+		if !ok {
+			if props.StartOffset == "latest" {
+				start = kafka.Offset(high)
+			} else {
+				start = kafka.Offset(low)
+			}
+		}
+		if start < kafka.Offset(low) {
+			// The messages at the stored offset have been deleted by the topic's retention policy
+			e.c.logger.Warn("kafka: stored offset is no longer available, skipping to the earliest offset", zap.String("model", opts.ModelName), zap.String("topic", props.Topic), zap.Int32("partition", p.ID), zap.Int64("offset", int64(start)), zap.Int64("earliest", low), observability.ZapCtx(ctx))
+			start = kafka.Offset(low)
+		}
+
+		next[p.ID] = start
+		end[p.ID] = kafka.Offset(high)
+		if start < kafka.Offset(high) {
+			assignments = append(assignments, kafka.TopicPartition{Topic: &props.Topic, Partition: p.ID, Offset: start})
+		}
+	}
+
+	// Carry over the offsets of partitions that no longer exist in the metadata
+	for p, o := range offsets {
+		if _, ok := next[p]; !ok {
+			next[p] = o
+		}
+	}
+
+	if len(assignments) == 0 {
+		return next, 0, nil
+	}
+
+	err = consumer.Assign(assignments)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to assign kafka partitions: %w", err)
+	}
+
+	remaining := len(assignments)
+	n := 0
+	lastMessage := time.Now()
+	for remaining > 0 {
+		if ctx.Err() != nil {
+			return nil, 0, ctx.Err()
+		}
+		if props.MaxMessages > 0 && n >= props.MaxMessages {
+			break
+		}
+		if time.Since(lastMessage) > props.idleTimeout {
+			e.c.logger.Debug("kafka: no messages received before idle timeout", zap.String("model", opts.ModelName), zap.String("topic", props.Topic), observability.ZapCtx(ctx))
+			break
+		}
+
+		ev := consumer.Poll(int(_pollTimeout.Milliseconds()))
+		switch ev := ev.(type) {
+		case *kafka.Message:
+			if ev.TopicPartition.Error != nil {
+				return nil, 0, fmt.Errorf("failed to consume from kafka: %w", ev.TopicPartition.Error)
+			}
+			lastMessage = time.Now()
+
+			row, err := e.decodeMessage(props, ev)
+			if err != nil {
+				return nil, 0, fmt.Errorf("failed to decode message at offset %d in kafka topic %q partition %d: %w", ev.TopicPartition.Offset, props.Topic, ev.TopicPartition.Partition, err)
+			}
+			err = w.Add(ctx, row)
+			if err != nil {
+				return nil, 0, err
+			}
+			n++
+
+			p := ev.TopicPartition.Partition
+			wasDone := next[p] >= end[p]
+			next[p] = ev.TopicPartition.Offset + 1
+			if !wasDone && next[p] >= end[p] {
+				remaining--
+			}
+
+			if w.Len() >= props.BatchSize {
+				err = w.Flush(ctx)
+				if err != nil {
+					return nil, 0, err
+				}
+			}
+		case kafka.Error:
+			if ev.IsFatal() {
+				return nil, 0, fmt.Errorf("kafka consumer failed: %w", ev)
+			}
+			e.c.logger.Warn("kafka: consumer error", zap.String("model", opts.ModelName), zap.Error(ev), observability.ZapCtx(ctx))
+		}
+	}
+
+	err = w.Flush(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Commit the offsets to the consumer group.
+	// The offsets in the model's incremental state take precedence, so this is only for monitoring.
+	var commits []kafka.TopicPartition
+	for _, tp := range assignments {
+		commits = append(commits, kafka.TopicPartition{Topic: &props.Topic, Partition: tp.Partition, Offset: next[tp.Partition]})
+	}
+	_, err = consumer.CommitOffsets(commits)
+	if err != nil {
+		e.c.logger.Warn("kafka: failed to commit offsets", zap.String("model", opts.ModelName), zap.String("group_id", groupID), zap.Error(err), observability.ZapCtx(ctx))
+	}
+
+	return next, n, nil
+}
+
+// decodeMessage decodes a message to a row.
+// Values that are not objects are put in a "value" column.
+func (e *olapExecutor) decodeMessage(props *ModelInputProperties, msg *kafka.Message) (map[string]any, error) {
+	var v any
+	if msg.Value != nil {
+		var err error
+		switch props.Format {
+		case "avro":
+			v, err = e.decodeAvro(props, msg.Value)
+		default:
+			v, err = decodeJSON(msg.Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	row, ok := v.(map[string]any)
+	if !ok {
+		row = map[string]any{"value": v}
+	}
+
+	if props.IncludeMetadata {
+		row["kafka_partition"] = msg.TopicPartition.Partition
+		row["kafka_offset"] = int64(msg.TopicPartition.Offset)
+		row["kafka_timestamp"] = msg.Timestamp.UTC()
+		row["kafka_key"] = string(msg.Key)
+	}
+
+	return row, nil
+}
+
+// decodeAvro decodes an Avro-encoded message.
+// If an inline schema is configured, the message must contain only the Avro-encoded value.
+// Otherwise, it must use the Confluent schema registry wire format (a zero byte, a 4-byte schema ID and the Avro-encoded value).
+func (e *olapExecutor) decodeAvro(props *ModelInputProperties, data []byte) (any, error) {
+	if props.AvroSchema != "" {
+		s, err := e.c.inlineAvroSchema(props.AvroSchema)
+		if err != nil {
+			return nil, err
+		}
+		return s.Decode(data)
+	}
+
+	if len(data) < 5 || data[0] != 0 {
+		return nil, errors.New("message is not in the schema registry wire format (set avro_schema to decode messages without a schema ID)")
+	}
+	id := int(uint32(data[1])<<24 | uint32(data[2])<<16 | uint32(data[3])<<8 | uint32(data[4]))
+	s, err := e.c.registrySchema(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.Decode(data[5:])
+}
+
+// decodeJSON decodes a JSON-encoded message.
+// Numbers are kept as json.Number to avoid losing precision for large integers.
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	err := dec.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// offsetsFromState parses the partition offsets stored in a model's incremental state.
+func offsetsFromState(state map[string]any) (map[int32]kafka.Offset, error) {
+	res := make(map[int32]kafka.Offset)
+	raw, ok := state["offsets"].(map[string]any)
+	if !ok {
+		return res, nil
+	}
+	for k, v := range raw {
+		p, err := strconv.ParseInt(k, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid partition %q in incremental state: %w", k, err)
+		}
+		var o int64
+		switch v := v.(type) {
+		case float64:
+			o = int64(v)
+		case int64:
+			o = v
+		case int:
+			o = int64(v)
+		default:
+			return nil, fmt.Errorf("invalid offset for partition %q in incremental state", k)
+		}
+		res[int32(p)] = kafka.Offset(o)
+	}
+	return res, nil
+}
+
+// offsetsToState returns an incremental state that stores the next offset of each partition.
+// Partition IDs are converted to strings since the state is serialized as JSON.
+func offsetsToState(offsets map[int32]kafka.Offset) map[string]any {
+	raw := make(map[string]any, len(offsets))
+	for p, o := range offsets {
+		raw[strconv.Itoa(int(p))] = float64(o)
+	}
+	return map[string]any{"offsets": raw}
+}
@@ -0,0 +1,185 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.uber.org/zap"
+)
+
+func init() {
+	drivers.Register("mock_admin", driver{})
+}
+
+type driver struct{}
+
+var _ drivers.Driver = driver{}
+
+// Spec implements drivers.Driver.
+func (driver) Spec() drivers.Spec {
+	return drivers.Spec{}
+}
+
+// Open implements drivers.Driver.
+func (driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	return &handle{}, nil
+}
+
+// HasAnonymousSourceAccess implements drivers.Driver.
+func (driver) HasAnonymousSourceAccess(ctx context.Context, srcProps map[string]any, logger *zap.Logger) (bool, error) {
+	return false, nil
+}
+
+// TertiarySourceConnectors implements drivers.Driver.
+func (driver) TertiarySourceConnectors(ctx context.Context, srcProps map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+// handle is an admin service for tests.
+// It returns links on example.com and, when querying for a user, only the user's ID or email as their attributes.
+// Like the real admin service, report metadata falls back to the attributes of the report's owner (from the "admin_owner_user_id" annotation).
+type handle struct{}
+
+var _ drivers.Handle = &handle{}
+
+// Ping implements drivers.Handle.
+func (h *handle) Ping(ctx context.Context) error {
+	return nil
+}
+
+// Driver implements drivers.Connection.
+func (h *handle) Driver() string {
+	return "mock_admin"
+}
+
+// Config implements drivers.Connection.
+func (h *handle) Config() map[string]any {
+	return nil
+}
+
+// Close implements drivers.Connection.
+func (h *handle) Close() error {
+	return nil
+}
+
+// AsRegistry implements drivers.Connection.
+func (h *handle) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Connection.
+func (h *handle) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Connection.
+func (h *handle) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (h *handle) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return h, true
+}
+
+// AsAI implements drivers.Handle.
+func (h *handle) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+// AsOLAP implements drivers.Connection.
+func (h *handle) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// Migrate implements drivers.Connection.
+func (h *handle) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Connection.
+func (h *handle) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// AsObjectStore implements drivers.Connection.
+func (h *handle) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsModelExecutor implements drivers.Handle.
+func (h *handle) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (h *handle) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Connection.
+func (h *handle) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+// AsFileStore implements drivers.Connection.
+func (h *handle) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsSQLStore implements drivers.Connection.
+func (h *handle) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+// AsWarehouse implements drivers.Handle.
+func (h *handle) AsWarehouse() (drivers.Warehouse, bool) {
+	return nil, false
+}
+
+// AsNotifier implements drivers.Connection.
+func (h *handle) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return nil, drivers.ErrNotNotifier
+}
+
+// GetReportMetadata implements drivers.AdminService.
+func (h *handle) GetReportMetadata(ctx context.Context, reportName string, annotations map[string]string, executionTime time.Time, queryForUserEmail string) (*drivers.ReportMetadata, error) {
+	return &drivers.ReportMetadata{
+		OpenURL:            fmt.Sprintf("https://example.com/reports/%s/open", reportName),
+		ExportURL:          fmt.Sprintf("https://example.com/reports/%s/export", reportName),
+		EditURL:            fmt.Sprintf("https://example.com/reports/%s/edit", reportName),
+		QueryForAttributes: reportUserAttributes(annotations, queryForUserEmail),
+	}, nil
+}
+
+// GetAlertMetadata implements drivers.AdminService.
+func (h *handle) GetAlertMetadata(ctx context.Context, alertName string, annotations map[string]string, queryForUserID, queryForUserEmail string) (*drivers.AlertMetadata, error) {
+	return &drivers.AlertMetadata{
+		OpenURL:            fmt.Sprintf("https://example.com/alerts/%s/open", alertName),
+		EditURL:            fmt.Sprintf("https://example.com/alerts/%s/edit", alertName),
+		QueryForAttributes: userAttributes(queryForUserID, queryForUserEmail),
+	}, nil
+}
+
+// reportUserAttributes returns the attributes to query a report for.
+// If no email is provided, it returns the attributes of the report's owner (if any).
+func reportUserAttributes(annotations map[string]string, email string) map[string]any {
+	if email != "" {
+		return userAttributes("", email)
+	}
+	return userAttributes(annotations["admin_owner_user_id"], "")
+}
+
+// userAttributes returns the attributes of the user with the given ID or email, or nil if neither is provided.
+func userAttributes(id, email string) map[string]any {
+	if email != "" {
+		return map[string]any{"email": email}
+	}
+	if id != "" {
+		return map[string]any{"id": id}
+	}
+	return nil
+}
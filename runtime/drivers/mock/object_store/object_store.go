@@ -176,8 +176,10 @@ func (h *handle) AsNotifier(properties map[string]any) (drivers.Notifier, error)
 }
 
 type sourceProperties struct {
-	Path string `mapstructure:"path"`
-	url  *globutil.URL
+	Path             string         `mapstructure:"path"`
+	PartitionFilters map[string]any `mapstructure:"partition_filters"`
+	url              *globutil.URL
+	partitionFilters rillblob.PartitionFilters
 }
 
 func parseSourceProperties(propsMap map[string]any) (*sourceProperties, error) {
@@ -197,6 +199,11 @@ func parseSourceProperties(propsMap map[string]any) (*sourceProperties, error) {
 	}
 	props.url = url
 
+	props.partitionFilters, err = rillblob.ParsePartitionFilters(props.PartitionFilters)
+	if err != nil {
+		return nil, err
+	}
+
 	return props, nil
 }
 
@@ -213,7 +220,7 @@ func (h *handle) ListObjects(ctx context.Context, propsMap map[string]any) ([]dr
 	}
 	defer bucket.Close()
 
-	return bucket.ListObjects(ctx, props.url.Path)
+	return bucket.ListObjects(ctx, props.url.Path, props.partitionFilters)
 }
 
 // DownloadFiles implements drivers.ObjectStore.
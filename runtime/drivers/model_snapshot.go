@@ -0,0 +1,346 @@
+package drivers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// SnapshotValidFromColumn is the column added to the output of snapshot models that holds the time a version of a row became valid.
+	SnapshotValidFromColumn = "valid_from"
+	// SnapshotValidToColumn is the column added to the output of snapshot models that holds the time a version of a row stopped being valid.
+	// It is NULL for the current version of a row.
+	SnapshotValidToColumn = "valid_to"
+)
+
+// ModelSnapshotStrategy determines how a snapshot model detects that a row has changed.
+type ModelSnapshotStrategy string
+
+const (
+	ModelSnapshotStrategyTimestamp ModelSnapshotStrategy = "timestamp"
+	ModelSnapshotStrategyCheck     ModelSnapshotStrategy = "check"
+)
+
+// ModelSnapshot configures a snapshot model.
+// A snapshot model tracks changes to the rows returned by the model's SQL as a history of versions (also known as a type 2 slowly changing dimension).
+// Each version has a SnapshotValidFromColumn and SnapshotValidToColumn column denoting when it was valid.
+//
+// Executors that support snapshots implement them by creating the output table from the query returned by PrepareSQL for non-incremental runs.
+// For incremental runs, they either replace the versions of the keys returned by PrepareSQL in place (using IncrementalStrategyDeleteInsert with KeyExpr as the partition expression),
+// or create a new table from the query returned by RebuildSQL and swap it with the output table.
+type ModelSnapshot struct {
+	// UniqueKey are the columns that uniquely identify a row in the model's SQL.
+	UniqueKey []string
+	// Strategy determines how changes are detected.
+	Strategy ModelSnapshotStrategy
+	// UpdatedAt is the column that holds the time a row was last updated. It is required for the timestamp strategy.
+	UpdatedAt string
+	// CheckColumns are the columns that are compared to detect changes. It is required for the check strategy.
+	CheckColumns []string
+	// InvalidateHardDeletes closes the current version of rows that are no longer returned by the model's SQL.
+	InvalidateHardDeletes bool
+}
+
+// Validate checks that the snapshot config is valid.
+func (s *ModelSnapshot) Validate() error {
+	if len(s.UniqueKey) == 0 {
+		return errors.New(`snapshot must specify a "unique_key"`)
+	}
+	switch s.Strategy {
+	case ModelSnapshotStrategyTimestamp:
+		if s.UpdatedAt == "" {
+			return fmt.Errorf(`snapshot must specify "updated_at" when "strategy" is %q`, s.Strategy)
+		}
+	case ModelSnapshotStrategyCheck:
+		if len(s.CheckColumns) == 0 {
+			return fmt.Errorf(`snapshot must specify "check_columns" when "strategy" is %q`, s.Strategy)
+		}
+	default:
+		return fmt.Errorf("invalid snapshot strategy %q", s.Strategy)
+	}
+	return nil
+}
+
+// KeyExpr returns an expression for the unique key of a row.
+func (s *ModelSnapshot) KeyExpr(d Dialect) string {
+	return s.keyExpr(d, "")
+}
+
+// PrepareSQL returns the query to execute instead of the model's SQL for a run of a snapshot model.
+// For incremental runs, it looks up the current columns of the output table.
+// It returns an error if the model's SQL returns a validity column or multiple rows for the same unique key.
+func (s *ModelSnapshot) PrepareSQL(ctx context.Context, olap OLAPStore, sql, table string, incrementalRun bool) (string, error) {
+	err := s.checkSource(ctx, olap, sql)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	if !incrementalRun {
+		return s.InitialSQL(olap.Dialect(), sql, now)
+	}
+
+	t, err := olap.InformationSchema().Lookup(ctx, "", "", table)
+	if err != nil {
+		return "", fmt.Errorf("failed to find snapshot table %q: %w", table, err)
+	}
+	var cols []string
+	for _, f := range t.Schema.Fields {
+		cols = append(cols, f.Name)
+	}
+	return s.IncrementalSQL(olap.Dialect(), sql, table, cols, now)
+}
+
+// RebuildSQL returns a query for the full new contents of table given a query returned by IncrementalSQL.
+// It is used by executors that can't atomically replace the versions of individual keys in the table.
+func (s *ModelSnapshot) RebuildSQL(d Dialect, incrementalSQL, table string) string {
+	changes := d.EscapeIdentifier("__rill_snapshot_changes")
+	return fmt.Sprintf(
+		"WITH %s AS (%s\n)\nSELECT * FROM %s WHERE %s NOT IN (SELECT %s FROM %s)\nUNION ALL\nSELECT * FROM %s",
+		changes,
+		incrementalSQL,
+		d.EscapeIdentifier(table),
+		s.keyExpr(d, ""),
+		s.keyExpr(d, ""),
+		changes,
+		changes,
+	)
+}
+
+// checkSource checks that the model's SQL doesn't return a validity column and returns at most one row per unique key.
+// Validity columns would collide with the columns added by the snapshot,
+// and duplicate keys would be indistinguishable from each other when detecting changes.
+func (s *ModelSnapshot) checkSource(ctx context.Context, olap OLAPStore, sql string) error {
+	d := olap.Dialect()
+
+	res, err := olap.Execute(ctx, &Statement{
+		Query:    fmt.Sprintf("SELECT * FROM (%s\n) AS src LIMIT 0", sql),
+		Priority: 1,
+	})
+	if err != nil {
+		return err
+	}
+	schema := res.Schema
+	err = res.Close()
+	if err != nil {
+		return err
+	}
+	for _, f := range schema.Fields {
+		if strings.EqualFold(f.Name, SnapshotValidFromColumn) || strings.EqualFold(f.Name, SnapshotValidToColumn) {
+			return fmt.Errorf("snapshot model's SQL must not return a column named %q since it is added by the snapshot (consider renaming it)", f.Name)
+		}
+	}
+
+	res, err = olap.Execute(ctx, &Statement{
+		Query:    fmt.Sprintf("SELECT 1 FROM (%s\n) AS src GROUP BY %s HAVING COUNT(*) > 1 LIMIT 1", sql, strings.Join(s.escapedUniqueKey(d), ", ")),
+		Priority: 1,
+	})
+	if err != nil {
+		return err
+	}
+	hasDuplicates := res.Next()
+	err = errors.Join(res.Err(), res.Close())
+	if err != nil {
+		return err
+	}
+	if hasDuplicates {
+		return fmt.Errorf("snapshot model's SQL returned multiple rows for the same unique key (%s)", strings.Join(s.UniqueKey, ", "))
+	}
+
+	return nil
+}
+
+// InitialSQL returns a query that adds the validity columns to the result of the model's SQL.
+// It is used on the first run (or full refresh) of a snapshot model.
+func (s *ModelSnapshot) InitialSQL(d Dialect, sql string, now time.Time) (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+
+	validFrom, err := s.validFromExpr(d, "", now)
+	if err != nil {
+		return "", err
+	}
+	validTo, err := snapshotNullTimestamp(d)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(
+		"SELECT *, %s AS %s, %s AS %s FROM (%s\n) AS src",
+		validFrom,
+		d.EscapeIdentifier(SnapshotValidFromColumn),
+		validTo,
+		d.EscapeIdentifier(SnapshotValidToColumn),
+		sql,
+	), nil
+}
+
+// IncrementalSQL returns a query that returns the full new history of every row that has changed since the previous snapshot in table.
+// The columns are the current columns of table (including the validity columns).
+// Every key returned by the query replaces all existing versions of that key in the table.
+func (s *ModelSnapshot) IncrementalSQL(d Dialect, sql, table string, columns []string, now time.Time) (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+
+	validFrom, err := s.validFromExpr(d, "s.", now)
+	if err != nil {
+		return "", err
+	}
+	validToOpen, err := snapshotNullTimestamp(d)
+	if err != nil {
+		return "", err
+	}
+	nowExpr, err := snapshotTimestampLiteral(d, now)
+	if err != nil {
+		return "", err
+	}
+
+	// Determine the columns to carry over from the model's SQL.
+	var cols []string
+	for _, c := range columns {
+		if c == SnapshotValidFromColumn || c == SnapshotValidToColumn {
+			continue
+		}
+		cols = append(cols, c)
+	}
+	if len(cols) == 0 {
+		return "", fmt.Errorf("table %q does not have any columns to snapshot", table)
+	}
+	for _, c := range s.UniqueKey {
+		if !slices.Contains(cols, c) {
+			return "", fmt.Errorf("unique key column %q not found in table %q", c, table)
+		}
+	}
+
+	colsSQL := func(prefix string) string {
+		var res []string
+		for _, c := range cols {
+			res = append(res, prefix+d.EscapeIdentifier(c))
+		}
+		return strings.Join(res, ", ")
+	}
+
+	keyCols := s.escapedUniqueKey(d)
+	keyColsSQL := strings.Join(keyCols, ", ")
+
+	var checkCols []string
+	if s.Strategy == ModelSnapshotStrategyTimestamp {
+		checkCols = append(checkCols, d.EscapeIdentifier(s.UpdatedAt))
+	} else {
+		for _, c := range s.CheckColumns {
+			checkCols = append(checkCols, d.EscapeIdentifier(c))
+		}
+	}
+	checkColsSQL := strings.Join(checkCols, ", ")
+
+	var joinOn []string
+	for _, c := range keyCols {
+		joinOn = append(joinOn, fmt.Sprintf("t.%s = s.%s", c, c))
+	}
+
+	tbl := d.EscapeIdentifier(table)
+	src := d.EscapeIdentifier("__rill_snapshot_src")
+	keys := d.EscapeIdentifier("__rill_snapshot_keys")
+	isSrc := d.EscapeIdentifier("__rill_snapshot_is_src")
+	validFromCol := d.EscapeIdentifier(SnapshotValidFromColumn)
+	validToCol := d.EscapeIdentifier(SnapshotValidToColumn)
+	inKeys := func(prefix string) string {
+		return fmt.Sprintf("%s IN (SELECT %s FROM %s)", s.keyExpr(d, prefix), s.keyExpr(d, ""), keys)
+	}
+
+	// A combination of key and checked values that only occurs in either the new data or the current versions denotes a new, changed or deleted row.
+	// Unless hard deletes are invalidated, we only consider keys that are present in the new data.
+	having := "COUNT(*) = 1"
+	if !s.InvalidateHardDeletes {
+		having += fmt.Sprintf(" AND MAX(%s) = 1", isSrc)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "WITH %s AS (%s\n),\n", src, sql)
+	fmt.Fprintf(&b, "%s AS (SELECT DISTINCT %s FROM (SELECT %s, %s, 1 AS %s FROM %s UNION ALL SELECT %s, %s, 0 AS %s FROM %s WHERE %s IS NULL) AS u GROUP BY %s, %s HAVING %s)\n", keys, keyColsSQL, keyColsSQL, checkColsSQL, isSrc, src, keyColsSQL, checkColsSQL, isSrc, tbl, validToCol, keyColsSQL, checkColsSQL, having)
+
+	// Previous versions of changed rows are kept as-is
+	fmt.Fprintf(&b, "SELECT %s, t.%s, t.%s FROM %s AS t WHERE %s AND t.%s IS NOT NULL\n", colsSQL("t."), validFromCol, validToCol, tbl, inKeys("t."), validToCol)
+
+	// The current versions of changed rows are closed when the new versions become valid
+	fmt.Fprintf(&b, "UNION ALL\nSELECT %s, t.%s, %s AS %s FROM %s AS t INNER JOIN %s AS s ON %s WHERE %s AND t.%s IS NULL\n", colsSQL("t."), validFromCol, validFrom, validToCol, tbl, src, strings.Join(joinOn, " AND "), inKeys("t."), validToCol)
+
+	// The current versions of deleted rows are closed
+	if s.InvalidateHardDeletes {
+		fmt.Fprintf(&b, "UNION ALL\nSELECT %s, t.%s, %s AS %s FROM %s AS t WHERE %s AND t.%s IS NULL AND %s NOT IN (SELECT %s FROM %s)\n", colsSQL("t."), validFromCol, nowExpr, validToCol, tbl, inKeys("t."), validToCol, s.keyExpr(d, "t."), s.keyExpr(d, ""), src)
+	}
+
+	// The new versions of changed rows
+	fmt.Fprintf(&b, "UNION ALL\nSELECT %s, %s AS %s, %s AS %s FROM %s AS s WHERE %s", colsSQL("s."), validFrom, validFromCol, validToOpen, validToCol, src, inKeys("s."))
+
+	return b.String(), nil
+}
+
+// escapedUniqueKey returns the escaped names of the unique key columns.
+func (s *ModelSnapshot) escapedUniqueKey(d Dialect) []string {
+	var cols []string
+	for _, c := range s.UniqueKey {
+		cols = append(cols, d.EscapeIdentifier(c))
+	}
+	return cols
+}
+
+// keyExpr returns an expression for the unique key with each column prefixed by the given table alias.
+func (s *ModelSnapshot) keyExpr(d Dialect, prefix string) string {
+	if len(s.UniqueKey) == 1 {
+		return prefix + d.EscapeIdentifier(s.UniqueKey[0])
+	}
+	var cols []string
+	for _, c := range s.UniqueKey {
+		cols = append(cols, prefix+d.EscapeIdentifier(c))
+	}
+	return fmt.Sprintf("(%s)", strings.Join(cols, ", "))
+}
+
+// validFromExpr returns the time a new version of a row becomes valid.
+// For the timestamp strategy, it is the row's updated at time. For the check strategy, it is the time of the snapshot.
+func (s *ModelSnapshot) validFromExpr(d Dialect, prefix string, now time.Time) (string, error) {
+	if s.Strategy != ModelSnapshotStrategyTimestamp {
+		return snapshotTimestampLiteral(d, now)
+	}
+	expr := prefix + d.EscapeIdentifier(s.UpdatedAt)
+	switch d {
+	case DialectDuckDB:
+		return fmt.Sprintf("CAST(%s AS TIMESTAMP)", expr), nil
+	case DialectClickHouse:
+		return fmt.Sprintf("toDateTime64(%s, 6, 'UTC')", expr), nil
+	default:
+		return "", fmt.Errorf("snapshots are not supported for dialect %q", d.String())
+	}
+}
+
+// snapshotTimestampLiteral returns a timestamp literal of the same type as the snapshot validity columns.
+func snapshotTimestampLiteral(d Dialect, t time.Time) (string, error) {
+	ts := t.UTC().Format("2006-01-02 15:04:05.999999")
+	switch d {
+	case DialectDuckDB:
+		return fmt.Sprintf("CAST('%s' AS TIMESTAMP)", ts), nil
+	case DialectClickHouse:
+		return fmt.Sprintf("toDateTime64('%s', 6, 'UTC')", ts), nil
+	default:
+		return "", fmt.Errorf("snapshots are not supported for dialect %q", d.String())
+	}
+}
+
+// snapshotNullTimestamp returns a NULL value of the same type as the snapshot validity columns.
+func snapshotNullTimestamp(d Dialect) (string, error) {
+	switch d {
+	case DialectDuckDB:
+		return "CAST(NULL AS TIMESTAMP)", nil
+	case DialectClickHouse:
+		return "CAST(NULL AS Nullable(DateTime64(6, 'UTC')))", nil
+	default:
+		return "", fmt.Errorf("snapshots are not supported for dialect %q", d.String())
+	}
+}
@@ -72,6 +72,15 @@ type ModelExecuteOptions struct {
 	// PreviousResult is the result of a previous execution.
 	// For concurrent split execution, it may not be the most recent previous result.
 	PreviousResult *ModelResult
+	// IncrementalState is the model's incremental state from the previous execution (see ModelResult.IncrementalState).
+	// By convention, it always contains an "incremental" key that mirrors IncrementalRun.
+	IncrementalState map[string]any
+	// Snapshot is set for snapshot models (see ModelSnapshot).
+	// It is only set for models where the input and output connector are the same.
+	Snapshot *ModelSnapshot
+	// PrePromote is an optional callback that executors that stage changes call with the staged result before it replaces the previous result.
+	// If it returns an error, the executor discards the staged result and returns the error.
+	PrePromote func(ctx context.Context, staged *ModelResult) error
 }
 
 // ModelEnv contains contextual info about the model's instance.
@@ -88,6 +97,10 @@ type ModelResult struct {
 	Connector  string
 	Properties map[string]any
 	Table      string
+	// IncrementalState is an optional new incremental state for the model.
+	// It enables executors to track their own progress across incremental runs (e.g. offsets in a stream).
+	// It is only used if the model does not have an incremental state resolver.
+	IncrementalState map[string]any
 }
 
 type FileFormat string
@@ -0,0 +1,229 @@
+package mongodb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/olapwriter"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+)
+
+// _batchSize is the number of documents to buffer before inserting them into the output table.
+const _batchSize = 10000
+
+// olapExecutor runs an aggregation pipeline and inserts the flattened documents into a DuckDB or ClickHouse table in batches.
+// If an incremental field is configured, the largest value of the field is persisted in the model's incremental state,
+// and incremental runs only extract documents with a larger value.
+type olapExecutor struct {
+	c    *connection
+	olap drivers.OLAPStore
+}
+
+var _ drivers.ModelExecutor = &olapExecutor{}
+
+func (e *olapExecutor) Concurrency(desired int) (int, bool) {
+	if desired > 1 {
+		return 0, false
+	}
+	return 1, true
+}
+
+func (e *olapExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
+	if opts.SplitRun {
+		return nil, errors.New("models that read from MongoDB do not support splits")
+	}
+
+	inputProps, err := parseSourceProperties(opts.InputProperties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+
+	pipeline, err := inputProps.parsePipeline()
+	if err != nil {
+		return nil, err
+	}
+
+	// The last value of the incremental field is only carried over between incremental runs
+	var last *bson.RawValue
+	if inputProps.IncrementalField != "" && opts.IncrementalRun {
+		last, err = lastValueFromState(opts.IncrementalState)
+		if err != nil {
+			return nil, err
+		}
+		if last != nil {
+			match := bson.D{{Key: "$match", Value: bson.D{{Key: inputProps.IncrementalField, Value: bson.D{{Key: "$gt", Value: *last}}}}}}
+			pipeline = append([]bson.D{match}, pipeline...)
+		}
+	}
+
+	iter, err := e.c.aggregate(ctx, inputProps, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err := iter.Close()
+		if err != nil && !errors.Is(err, ctx.Err()) {
+			e.c.logger.Warn("mongodb: failed to close cursor", zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}()
+
+	var n int
+	res, err := olapwriter.ExecuteModel(ctx, e.olap, opts, func(ctx context.Context, w *olapwriter.BatchWriter) error {
+		for {
+			row, err := iter.Next(ctx)
+			if err != nil {
+				if errors.Is(err, drivers.ErrIteratorDone) {
+					return nil
+				}
+				return err
+			}
+
+			rec := make(map[string]any, len(row))
+			for i, f := range iter.schema.fields {
+				v := row[i]
+				// NaN and infinity can't be serialized to JSON
+				if fv, ok := v.(float64); ok && (math.IsNaN(fv) || math.IsInf(fv, 0)) {
+					v = nil
+				}
+				rec[f.name] = v
+			}
+			if err := w.Add(ctx, rec); err != nil {
+				return err
+			}
+			n++
+
+			if inputProps.IncrementalField != "" {
+				last, err = maxIncrementalValue(iter.current, inputProps.IncrementalField, last)
+				if err != nil {
+					return err
+				}
+			}
+
+			if w.Len() >= _batchSize {
+				if err := w.Flush(ctx); err != nil {
+					return err
+				}
+			}
+		}
+	})
+	if err != nil {
+		if errors.Is(err, olapwriter.ErrNoRows) {
+			return nil, fmt.Errorf("no documents found in collection %q", inputProps.Collection)
+		}
+		return nil, err
+	}
+
+	e.c.logger.Debug("mongodb: extracted documents", zap.String("model", opts.ModelName), zap.String("collection", inputProps.Collection), zap.Int("documents", n), observability.ZapCtx(ctx))
+
+	if last != nil {
+		res.IncrementalState, err = lastValueToState(*last)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// maxIncrementalValue returns the larger of the incremental field's value in the document and the current max.
+// Documents without a value for the field are ignored.
+func maxIncrementalValue(doc bson.Raw, field string, current *bson.RawValue) (*bson.RawValue, error) {
+	v, err := doc.LookupErr(strings.Split(field, ".")...)
+	if err != nil || v.Type == bson.TypeNull || v.Type == bson.TypeUndefined {
+		return current, nil
+	}
+	if current != nil {
+		cmp, ok := compareValues(v, *current)
+		if !ok {
+			return nil, fmt.Errorf("incremental field %q has values of incomparable types %s and %s", field, v.Type, current.Type)
+		}
+		if cmp <= 0 {
+			return current, nil
+		}
+	}
+	// The value references the cursor's buffer, which is reused for the next document
+	return &bson.RawValue{Type: v.Type, Value: bytes.Clone(v.Value)}, nil
+}
+
+// compareValues compares two BSON values of the types that are commonly used for monotonically increasing fields.
+// It returns false if the values are not comparable.
+func compareValues(a, b bson.RawValue) (int, bool) {
+	if isIntType(a) && isIntType(b) {
+		return compare(a.AsInt64(), b.AsInt64()), true
+	}
+	if fa, ok := floatValue(a); ok {
+		if fb, ok := floatValue(b); ok {
+			return compare(fa, fb), true
+		}
+		return 0, false
+	}
+	if a.Type != b.Type {
+		return 0, false
+	}
+	switch a.Type {
+	case bson.TypeString:
+		return strings.Compare(a.StringValue(), b.StringValue()), true
+	case bson.TypeObjectID:
+		oa, ob := a.ObjectID(), b.ObjectID()
+		return bytes.Compare(oa[:], ob[:]), true
+	case bson.TypeDateTime:
+		return compare(a.DateTime(), b.DateTime()), true
+	case bson.TypeTimestamp:
+		ta, ia := a.Timestamp()
+		tb, ib := b.Timestamp()
+		if ta != tb {
+			return compare(ta, tb), true
+		}
+		return compare(ia, ib), true
+	default:
+		return 0, false
+	}
+}
+
+func isIntType(v bson.RawValue) bool {
+	return v.Type == bson.TypeInt32 || v.Type == bson.TypeInt64
+}
+
+func compare[T int64 | uint32 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// lastValueToState encodes the last value of the incremental field in the model's incremental state.
+// The value is stored as canonical extended JSON, so its BSON type is preserved between runs.
+func lastValueToState(v bson.RawValue) (map[string]any, error) {
+	data, err := bson.MarshalExtJSON(bson.D{{Key: "v", Value: v}}, true, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode incremental state: %w", err)
+	}
+	return map[string]any{"last_value": string(data)}, nil
+}
+
+// lastValueFromState decodes the last value of the incremental field from the model's incremental state.
+// It returns nil if the state doesn't contain a value.
+func lastValueFromState(state map[string]any) (*bson.RawValue, error) {
+	s, ok := state["last_value"].(string)
+	if !ok || s == "" {
+		return nil, nil
+	}
+	var doc struct {
+		V bson.RawValue `bson:"v"`
+	}
+	err := bson.UnmarshalExtJSON([]byte(s), true, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid incremental state: %w", err)
+	}
+	return &doc.V, nil
+}
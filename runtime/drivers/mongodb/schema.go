@@ -0,0 +1,320 @@
+package mongodb
+
+import (
+	sqldriver "database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// fieldSeparator joins the keys of nested documents in flattened column names, e.g. {"a": {"b": 1}} is flattened to the column "a_b".
+const fieldSeparator = "_"
+
+// schema is the schema of flattened documents.
+// Nested documents are flattened to one column per leaf field. Arrays are not flattened, but stored as JSON.
+type schema struct {
+	fields []*schemaField
+	index  map[string]int
+}
+
+type schemaField struct {
+	name string
+	code runtimev1.Type_Code
+}
+
+// inferSchema infers a schema from a sample of documents.
+// Columns are ordered by first appearance. If a field has different types in different documents, the types are
+// widened to a common numeric type if possible, and otherwise to a string. Fields that are only null in the sample are strings.
+func inferSchema(docs []bson.Raw) (*schema, error) {
+	s := &schema{index: make(map[string]int)}
+	for _, doc := range docs {
+		err := flatten(doc, "", func(name string, v bson.RawValue) {
+			i, ok := s.index[name]
+			if !ok {
+				i = len(s.fields)
+				s.index[name] = i
+				s.fields = append(s.fields, &schemaField{name: name})
+			}
+			s.fields[i].code = mergeTypes(s.fields[i].code, typeCode(v))
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, f := range s.fields {
+		if f.code == runtimev1.Type_CODE_UNSPECIFIED {
+			f.code = runtimev1.Type_CODE_STRING
+		}
+	}
+	return s, nil
+}
+
+func (s *schema) toPB() *runtimev1.StructType {
+	fields := make([]*runtimev1.StructType_Field, len(s.fields))
+	for i, f := range s.fields {
+		fields[i] = &runtimev1.StructType_Field{
+			Name: f.name,
+			Type: &runtimev1.Type{Code: f.code, Nullable: true},
+		}
+	}
+	return &runtimev1.StructType{Fields: fields}
+}
+
+// row flattens a document into dest, which must have a value for each field of the schema.
+// Fields that are not in the schema are dropped, and values that can't be converted to the field's type are null.
+func (s *schema) row(doc bson.Raw, dest []sqldriver.Value) error {
+	for i := range dest {
+		dest[i] = nil
+	}
+	return flatten(doc, "", func(name string, v bson.RawValue) {
+		if i, ok := s.index[name]; ok {
+			dest[i] = convertValue(v, s.fields[i].code)
+		}
+	})
+}
+
+// flatten calls fn for each leaf field of a document, recursing into nested documents.
+func flatten(doc bson.Raw, prefix string, fn func(name string, v bson.RawValue)) error {
+	elems, err := doc.Elements()
+	if err != nil {
+		return fmt.Errorf("invalid document: %w", err)
+	}
+	for _, e := range elems {
+		name := prefix + e.Key()
+		v := e.Value()
+		if v.Type == bson.TypeEmbeddedDocument {
+			err := flatten(v.Document(), name+fieldSeparator, fn)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		fn(name, v)
+	}
+	return nil
+}
+
+// typeCode returns the runtime type of a BSON value. It returns CODE_UNSPECIFIED for null values.
+func typeCode(v bson.RawValue) runtimev1.Type_Code {
+	switch v.Type {
+	case bson.TypeNull, bson.TypeUndefined:
+		return runtimev1.Type_CODE_UNSPECIFIED
+	case bson.TypeInt32:
+		return runtimev1.Type_CODE_INT32
+	case bson.TypeInt64:
+		return runtimev1.Type_CODE_INT64
+	case bson.TypeDouble, bson.TypeDecimal128:
+		return runtimev1.Type_CODE_FLOAT64
+	case bson.TypeBoolean:
+		return runtimev1.Type_CODE_BOOL
+	case bson.TypeDateTime, bson.TypeTimestamp:
+		return runtimev1.Type_CODE_TIMESTAMP
+	case bson.TypeArray:
+		return runtimev1.Type_CODE_JSON
+	case bson.TypeBinary:
+		if _, ok := uuidValue(v); ok {
+			return runtimev1.Type_CODE_STRING
+		}
+		return runtimev1.Type_CODE_BYTES
+	default:
+		return runtimev1.Type_CODE_STRING
+	}
+}
+
+// mergeTypes returns a type that can represent values of both types.
+func mergeTypes(a, b runtimev1.Type_Code) runtimev1.Type_Code {
+	if a == runtimev1.Type_CODE_UNSPECIFIED {
+		return b
+	}
+	if b == runtimev1.Type_CODE_UNSPECIFIED || a == b {
+		return a
+	}
+	if isInt(a) && isInt(b) {
+		return runtimev1.Type_CODE_INT64
+	}
+	if isNumeric(a) && isNumeric(b) {
+		return runtimev1.Type_CODE_FLOAT64
+	}
+	return runtimev1.Type_CODE_STRING
+}
+
+func isInt(c runtimev1.Type_Code) bool {
+	return c == runtimev1.Type_CODE_INT32 || c == runtimev1.Type_CODE_INT64
+}
+
+func isNumeric(c runtimev1.Type_Code) bool {
+	return isInt(c) || c == runtimev1.Type_CODE_FLOAT64
+}
+
+// convertValue converts a BSON value to a value of the given type. It returns nil if the value can't be converted.
+func convertValue(v bson.RawValue, code runtimev1.Type_Code) sqldriver.Value {
+	if v.Type == bson.TypeNull || v.Type == bson.TypeUndefined {
+		return nil
+	}
+
+	switch code {
+	case runtimev1.Type_CODE_INT32:
+		if i, ok := v.Int32OK(); ok {
+			return i
+		}
+	case runtimev1.Type_CODE_INT64:
+		if v.Type == bson.TypeInt32 || v.Type == bson.TypeInt64 {
+			return v.AsInt64()
+		}
+	case runtimev1.Type_CODE_FLOAT64:
+		if f, ok := floatValue(v); ok {
+			return f
+		}
+	case runtimev1.Type_CODE_BOOL:
+		if b, ok := v.BooleanOK(); ok {
+			return b
+		}
+	case runtimev1.Type_CODE_TIMESTAMP:
+		if t, ok := timeValue(v); ok {
+			return t
+		}
+	case runtimev1.Type_CODE_BYTES:
+		if _, data, ok := v.BinaryOK(); ok {
+			// The data references the cursor's buffer, which is reused for the next document
+			res := make([]byte, len(data))
+			copy(res, data)
+			return res
+		}
+	case runtimev1.Type_CODE_JSON:
+		data, err := json.Marshal(jsonValue(v))
+		if err == nil {
+			return string(data)
+		}
+	case runtimev1.Type_CODE_STRING:
+		return stringValue(v)
+	}
+	return nil
+}
+
+// floatValue returns a numeric BSON value as a float64.
+func floatValue(v bson.RawValue) (float64, bool) {
+	switch v.Type {
+	case bson.TypeDouble:
+		return v.Double(), true
+	case bson.TypeInt32, bson.TypeInt64:
+		return float64(v.AsInt64()), true
+	case bson.TypeDecimal128:
+		f, err := strconv.ParseFloat(v.Decimal128().String(), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// timeValue returns a date or timestamp BSON value as a UTC time.
+func timeValue(v bson.RawValue) (time.Time, bool) {
+	switch v.Type {
+	case bson.TypeDateTime:
+		return time.UnixMilli(v.DateTime()).UTC(), true
+	case bson.TypeTimestamp:
+		t, _ := v.Timestamp()
+		return time.Unix(int64(t), 0).UTC(), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// uuidValue returns the UUID of a binary BSON value with a UUID subtype.
+func uuidValue(v bson.RawValue) (uuid.UUID, bool) {
+	subtype, data, ok := v.BinaryOK()
+	if !ok || (subtype != bson.TypeBinaryUUID && subtype != bson.TypeBinaryUUIDOld) || len(data) != 16 {
+		return uuid.UUID{}, false
+	}
+	return uuid.UUID(data), true
+}
+
+// stringValue returns a string representation of a BSON value. Object IDs are returned as hex strings.
+func stringValue(v bson.RawValue) string {
+	switch v.Type {
+	case bson.TypeString:
+		return v.StringValue()
+	case bson.TypeObjectID:
+		return v.ObjectID().Hex()
+	case bson.TypeSymbol:
+		return v.Symbol()
+	case bson.TypeJavaScript:
+		return v.JavaScript()
+	case bson.TypeInt32, bson.TypeInt64:
+		return strconv.FormatInt(v.AsInt64(), 10)
+	case bson.TypeDouble:
+		return strconv.FormatFloat(v.Double(), 'g', -1, 64)
+	case bson.TypeDecimal128:
+		return v.Decimal128().String()
+	case bson.TypeBoolean:
+		return strconv.FormatBool(v.Boolean())
+	case bson.TypeDateTime, bson.TypeTimestamp:
+		t, _ := timeValue(v)
+		return t.Format(time.RFC3339Nano)
+	case bson.TypeBinary:
+		if u, ok := uuidValue(v); ok {
+			return u.String()
+		}
+		_, data := v.Binary()
+		return base64.StdEncoding.EncodeToString(data)
+	case bson.TypeRegex:
+		pattern, options := v.Regex()
+		return "/" + pattern + "/" + options
+	default:
+		data, err := json.Marshal(jsonValue(v))
+		if err != nil {
+			return v.String()
+		}
+		return string(data)
+	}
+}
+
+// jsonValue converts a BSON value to a value that can be serialized to plain (not extended) JSON.
+func jsonValue(v bson.RawValue) any {
+	switch v.Type {
+	case bson.TypeNull, bson.TypeUndefined:
+		return nil
+	case bson.TypeArray:
+		vals, err := v.Array().Values()
+		if err != nil {
+			return nil
+		}
+		res := make([]any, len(vals))
+		for i, val := range vals {
+			res[i] = jsonValue(val)
+		}
+		return res
+	case bson.TypeEmbeddedDocument:
+		elems, err := v.Document().Elements()
+		if err != nil {
+			return nil
+		}
+		res := make(map[string]any, len(elems))
+		for _, e := range elems {
+			res[e.Key()] = jsonValue(e.Value())
+		}
+		return res
+	case bson.TypeDouble:
+		f := v.Double()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil
+		}
+		return f
+	case bson.TypeInt32, bson.TypeInt64:
+		return v.AsInt64()
+	case bson.TypeBoolean:
+		return v.Boolean()
+	case bson.TypeDateTime, bson.TypeTimestamp:
+		t, _ := timeValue(v)
+		return t
+	default:
+		return stringValue(v)
+	}
+}
@@ -0,0 +1,167 @@
+package mongodb
+
+import (
+	sqldriver "database/sql/driver"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestInferSchema(t *testing.T) {
+	oid := primitive.NewObjectID()
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	docs := []bson.Raw{
+		mustMarshal(t, bson.D{
+			{Key: "_id", Value: oid},
+			{Key: "count", Value: int32(1)},
+			{Key: "price", Value: int32(2)},
+			{Key: "address", Value: bson.D{{Key: "city", Value: "Berlin"}, {Key: "geo", Value: bson.D{{Key: "lat", Value: 52.5}}}}},
+			{Key: "tags", Value: bson.A{"a", "b"}},
+			{Key: "created_on", Value: primitive.NewDateTimeFromTime(ts)},
+			{Key: "mixed", Value: "x"},
+			{Key: "empty", Value: nil},
+		}),
+		mustMarshal(t, bson.D{
+			{Key: "_id", Value: primitive.NewObjectID()},
+			{Key: "count", Value: int64(1 << 40)},
+			{Key: "price", Value: 2.5},
+			{Key: "mixed", Value: int32(1)},
+			{Key: "active", Value: true},
+		}),
+	}
+
+	s, err := inferSchema(docs)
+	require.NoError(t, err)
+
+	var names []string
+	types := map[string]runtimev1.Type_Code{}
+	for _, f := range s.fields {
+		names = append(names, f.name)
+		types[f.name] = f.code
+	}
+	require.Equal(t, []string{"_id", "count", "price", "address_city", "address_geo_lat", "tags", "created_on", "mixed", "empty", "active"}, names)
+	require.Equal(t, runtimev1.Type_CODE_STRING, types["_id"])
+	require.Equal(t, runtimev1.Type_CODE_INT64, types["count"])
+	require.Equal(t, runtimev1.Type_CODE_FLOAT64, types["price"])
+	require.Equal(t, runtimev1.Type_CODE_STRING, types["address_city"])
+	require.Equal(t, runtimev1.Type_CODE_FLOAT64, types["address_geo_lat"])
+	require.Equal(t, runtimev1.Type_CODE_JSON, types["tags"])
+	require.Equal(t, runtimev1.Type_CODE_TIMESTAMP, types["created_on"])
+	require.Equal(t, runtimev1.Type_CODE_STRING, types["mixed"])
+	require.Equal(t, runtimev1.Type_CODE_STRING, types["empty"])
+	require.Equal(t, runtimev1.Type_CODE_BOOL, types["active"])
+
+	row := make([]sqldriver.Value, len(s.fields))
+	require.NoError(t, s.row(docs[0], row))
+	require.Equal(t, []sqldriver.Value{oid.Hex(), int64(1), float64(2), "Berlin", 52.5, `["a","b"]`, ts, "x", nil, nil}, row)
+
+	// Values are reset between rows, and fields that are not in the schema are dropped
+	require.NoError(t, s.row(mustMarshal(t, bson.D{{Key: "mixed", Value: 1.5}, {Key: "unknown", Value: "y"}, {Key: "count", Value: "not a number"}}), row))
+	require.Equal(t, []sqldriver.Value{nil, nil, nil, nil, nil, nil, nil, "1.5", nil, nil}, row)
+}
+
+func TestParsePipeline(t *testing.T) {
+	props, err := parseSourceProperties(map[string]any{
+		"collection": "events",
+		"sql":        `[{"$match": {"created_on": {"$gte": {"$date": "2024-01-01T00:00:00Z"}}}}, {"$limit": 10}]`,
+	})
+	require.NoError(t, err)
+	pipeline, err := props.parsePipeline()
+	require.NoError(t, err)
+	require.Len(t, pipeline, 2)
+	require.Equal(t, "$match", pipeline[0][0].Key)
+	require.Equal(t, "$limit", pipeline[1][0].Key)
+
+	// A pipeline can also be passed as a list of stages in YAML
+	props, err = parseSourceProperties(map[string]any{
+		"collection": "events",
+		"pipeline":   []any{map[string]any{"$project": map[string]any{"name": 1}}},
+	})
+	require.NoError(t, err)
+	pipeline, err = props.parsePipeline()
+	require.NoError(t, err)
+	require.Len(t, pipeline, 1)
+
+	props, err = parseSourceProperties(map[string]any{"collection": "events"})
+	require.NoError(t, err)
+	pipeline, err = props.parsePipeline()
+	require.NoError(t, err)
+	require.Empty(t, pipeline)
+
+	props, err = parseSourceProperties(map[string]any{"collection": "events", "pipeline": `{"$match": {}}`})
+	require.NoError(t, err)
+	_, err = props.parsePipeline()
+	require.Error(t, err)
+
+	_, err = parseSourceProperties(map[string]any{"pipeline": `[]`})
+	require.Error(t, err)
+}
+
+func TestIncrementalState(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	docs := []bson.Raw{
+		mustMarshal(t, bson.D{{Key: "meta", Value: bson.D{{Key: "updated_on", Value: primitive.NewDateTimeFromTime(ts)}}}}),
+		mustMarshal(t, bson.D{{Key: "meta", Value: bson.D{{Key: "updated_on", Value: primitive.NewDateTimeFromTime(ts.Add(-time.Hour))}}}}),
+		mustMarshal(t, bson.D{{Key: "meta", Value: nil}}),
+	}
+
+	var last *bson.RawValue
+	var err error
+	for _, doc := range docs {
+		last, err = maxIncrementalValue(doc, "meta.updated_on", last)
+		require.NoError(t, err)
+	}
+	require.NotNil(t, last)
+	require.Equal(t, ts, last.Time().UTC())
+
+	// The state round trips with its BSON type
+	state, err := lastValueToState(*last)
+	require.NoError(t, err)
+	restored, err := lastValueFromState(state)
+	require.NoError(t, err)
+	require.Equal(t, bson.TypeDateTime, restored.Type)
+	require.True(t, last.Equal(*restored))
+
+	_, err = maxIncrementalValue(mustMarshal(t, bson.D{{Key: "meta", Value: bson.D{{Key: "updated_on", Value: "2024-01-03"}}}}), "meta.updated_on", last)
+	require.Error(t, err)
+
+	restored, err = lastValueFromState(map[string]any{"incremental": true})
+	require.NoError(t, err)
+	require.Nil(t, restored)
+}
+
+func TestCompareValues(t *testing.T) {
+	tests := []struct {
+		a, b any
+		want int
+	}{
+		{int32(1), int64(2), -1},
+		{int64(1 << 62), int64(1<<62 - 1), 1},
+		{int32(2), 1.5, 1},
+		{"b", "a", 1},
+		{primitive.Timestamp{T: 10, I: 2}, primitive.Timestamp{T: 10, I: 1}, 1},
+		{primitive.DateTime(5), primitive.DateTime(5), 0},
+	}
+	for _, tt := range tests {
+		cmp, ok := compareValues(mustMarshalValue(t, tt.a), mustMarshalValue(t, tt.b))
+		require.True(t, ok)
+		require.Equal(t, tt.want, cmp, "%v <=> %v", tt.a, tt.b)
+	}
+
+	_, ok := compareValues(mustMarshalValue(t, "a"), mustMarshalValue(t, int32(1)))
+	require.False(t, ok)
+}
+
+func mustMarshal(t *testing.T, doc bson.D) bson.Raw {
+	data, err := bson.Marshal(doc)
+	require.NoError(t, err)
+	return data
+}
+
+func mustMarshalValue(t *testing.T, v any) bson.RawValue {
+	return mustMarshal(t, bson.D{{Key: "v", Value: v}}).Lookup("v")
+}
@@ -0,0 +1,238 @@
+package mongodb
+
+import (
+	"context"
+	sqldriver "database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
+)
+
+// _defaultSampleSize is the default number of documents used to infer the schema.
+const _defaultSampleSize = 1000
+
+// Query implements drivers.SQLStore.
+// It runs the aggregation pipeline in the source properties and returns the resulting documents flattened to rows.
+func (c *connection) Query(ctx context.Context, props map[string]any) (drivers.RowIterator, error) {
+	srcProps, err := parseSourceProperties(props)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := srcProps.parsePipeline()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.aggregate(ctx, srcProps, pipeline)
+}
+
+// aggregate runs an aggregation pipeline on the collection in the source properties.
+func (c *connection) aggregate(ctx context.Context, props *sourceProperties, pipeline []bson.D) (*rowIterator, error) {
+	client, err := c.connect(ctx, props.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := props.database(c.config.URI)
+	if err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, err
+	}
+
+	coll := client.Database(db).Collection(props.Collection)
+	cursor, err := coll.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, err
+	}
+
+	iter := &rowIterator{
+		client:     client,
+		cursor:     cursor,
+		sampleSize: props.SampleSize,
+	}
+	if iter.sampleSize <= 0 {
+		iter.sampleSize = _defaultSampleSize
+	}
+	return iter, nil
+}
+
+type sourceProperties struct {
+	URI        string `mapstructure:"uri"`
+	Database   string `mapstructure:"database"`
+	Collection string `mapstructure:"collection"`
+	// Pipeline is an aggregation pipeline, either as a JSON array of stages or as a list of stages in YAML.
+	// Extended JSON, such as {"$date": "2024-01-01T00:00:00Z"}, can be used for values that don't have a JSON representation.
+	Pipeline any `mapstructure:"pipeline"`
+	// SQL is an alias for Pipeline, which allows a model's "sql" to contain the aggregation pipeline.
+	SQL string `mapstructure:"sql"`
+	// SampleSize is the number of documents used to infer the schema.
+	SampleSize int `mapstructure:"sample_size"`
+	// IncrementalField is a monotonically increasing field used to only extract new documents in incremental runs of a model.
+	IncrementalField string `mapstructure:"incremental_field"`
+}
+
+func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
+	conf := &sourceProperties{}
+	err := mapstructure.WeakDecode(props, conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.Collection == "" {
+		return nil, fmt.Errorf("property 'collection' is mandatory for connector \"mongodb\"")
+	}
+	if conf.Pipeline != nil && conf.SQL != "" {
+		return nil, fmt.Errorf("only one of 'pipeline' and 'sql' can be set for connector \"mongodb\"")
+	}
+	if conf.Pipeline == nil && conf.SQL != "" {
+		conf.Pipeline = conf.SQL
+	}
+	return conf, nil
+}
+
+// database returns the database of the collection. It defaults to the database in the connection string.
+func (p *sourceProperties) database(connectorURI string) (string, error) {
+	if p.Database != "" {
+		return p.Database, nil
+	}
+	uri := p.URI
+	if uri == "" {
+		uri = connectorURI
+	}
+	cs, err := connstring.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if cs.Database == "" {
+		return "", fmt.Errorf("property 'database' is required for MongoDB if the connection string doesn't contain a database")
+	}
+	return cs.Database, nil
+}
+
+// parsePipeline parses the aggregation pipeline. An empty pipeline returns all documents in the collection.
+func (p *sourceProperties) parsePipeline() ([]bson.D, error) {
+	var data []byte
+	switch v := p.Pipeline.(type) {
+	case nil:
+		return []bson.D{}, nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return []bson.D{}, nil
+		}
+		data = []byte(v)
+	default:
+		var err error
+		data, err = json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid aggregation pipeline: %w", err)
+		}
+	}
+
+	// Extended JSON can only be parsed as a document, so we wrap the array in one
+	var doc struct {
+		Pipeline []bson.D `bson:"pipeline"`
+	}
+	err := bson.UnmarshalExtJSON([]byte(`{"pipeline":`+string(data)+`}`), false, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid aggregation pipeline: must be a JSON array of stages: %w", err)
+	}
+	if doc.Pipeline == nil {
+		return []bson.D{}, nil
+	}
+	return doc.Pipeline, nil
+}
+
+// rowIterator flattens the documents returned by a cursor to rows.
+// The schema is inferred from the first sampleSize documents, which are buffered until they are consumed.
+type rowIterator struct {
+	client     *mongo.Client
+	cursor     *mongo.Cursor
+	sampleSize int
+
+	schema  *schema
+	sample  []bson.Raw
+	current bson.Raw
+	row     []sqldriver.Value
+}
+
+var _ drivers.RowIterator = &rowIterator{}
+
+// Close implements drivers.RowIterator.
+func (r *rowIterator) Close() error {
+	err := r.cursor.Close(context.Background())
+	return errors.Join(err, r.client.Disconnect(context.Background()))
+}
+
+// Next implements drivers.RowIterator.
+func (r *rowIterator) Next(ctx context.Context) ([]sqldriver.Value, error) {
+	if r.schema == nil {
+		if _, err := r.Schema(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(r.sample) > 0 {
+		r.current = r.sample[0]
+		r.sample = r.sample[1:]
+	} else {
+		if !r.cursor.Next(ctx) {
+			if err := r.cursor.Err(); err != nil {
+				return nil, err
+			}
+			return nil, drivers.ErrIteratorDone
+		}
+		r.current = r.cursor.Current
+	}
+
+	if r.row == nil {
+		r.row = make([]sqldriver.Value, len(r.schema.fields))
+	}
+	err := r.schema.row(r.current, r.row)
+	if err != nil {
+		return nil, err
+	}
+	return r.row, nil
+}
+
+// Schema implements drivers.RowIterator.
+// It returns drivers.ErrIteratorDone if the pipeline didn't return any documents.
+func (r *rowIterator) Schema(ctx context.Context) (*runtimev1.StructType, error) {
+	if r.schema != nil {
+		return r.schema.toPB(), nil
+	}
+
+	// Buffer the sample. The cursor reuses its buffer, so the documents must be copied.
+	for len(r.sample) < r.sampleSize && r.cursor.Next(ctx) {
+		doc := make(bson.Raw, len(r.cursor.Current))
+		copy(doc, r.cursor.Current)
+		r.sample = append(r.sample, doc)
+	}
+	if err := r.cursor.Err(); err != nil {
+		return nil, err
+	}
+	if len(r.sample) == 0 {
+		return nil, drivers.ErrIteratorDone
+	}
+
+	s, err := inferSchema(r.sample)
+	if err != nil {
+		return nil, err
+	}
+	r.schema = s
+	return s.toPB(), nil
+}
+
+// Size implements drivers.RowIterator.
+func (r *rowIterator) Size(unit drivers.ProgressUnit) (uint64, bool) {
+	return 0, false
+}
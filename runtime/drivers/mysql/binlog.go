@@ -0,0 +1,484 @@
+package mysql
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" // nolint:gosec // required by the MySQL authentication protocol
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// This file implements the parts of the MySQL client/server protocol needed to stream binlog events like a replica.
+// See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_replication.html.
+
+const (
+	_clientLongPassword     = 0x00000001
+	_clientLongFlag         = 0x00000004
+	_clientConnectWithDB    = 0x00000008
+	_clientProtocol41       = 0x00000200
+	_clientSSL              = 0x00000800
+	_clientTransactions     = 0x00002000
+	_clientSecureConnection = 0x00008000
+	_clientPluginAuth       = 0x00080000
+
+	_comQuery      = 0x03
+	_comBinlogDump = 0x12
+
+	// _binlogDumpNonBlock makes the server send an EOF packet at the end of the binlog instead of waiting for new events.
+	_binlogDumpNonBlock = 0x01
+
+	_maxPacketSize = 1<<24 - 1
+	_utf8mb4       = 45
+)
+
+// binlogConn is a connection to a MySQL server that streams binlog events.
+type binlogConn struct {
+	conn net.Conn
+	rd   *bufio.Reader
+	seq  byte
+}
+
+// dialBinlog opens and authenticates a connection for streaming binlog events.
+func dialBinlog(ctx context.Context, cfg *mysql.Config) (*binlogConn, error) {
+	d := net.Dialer{Timeout: cfg.Timeout}
+	conn, err := d.DialContext(ctx, cfg.Net, cfg.Addr)
+	if err != nil {
+		return nil, err
+	}
+	c := &binlogConn{conn: conn, rd: bufio.NewReader(conn)}
+
+	// Abort blocking reads and writes when the context is cancelled
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Unix(1, 0)) })
+	defer stop()
+
+	if err := c.handshake(cfg); err != nil {
+		conn.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *binlogConn) Close() error {
+	return c.conn.Close()
+}
+
+// handshake performs the connection phase of the protocol.
+func (c *binlogConn) handshake(cfg *mysql.Config) error {
+	data, err := c.readPacket()
+	if err != nil {
+		return err
+	}
+	if data[0] == 0xff {
+		return parseErrPacket(data)
+	}
+	if data[0] != 10 {
+		return fmt.Errorf("unsupported MySQL protocol version %d", data[0])
+	}
+
+	// Parse the initial handshake packet
+	r := &binReader{buf: data[1:]}
+	r.nullString() // server version
+	r.uint32()     // connection id
+	seed := append([]byte{}, r.next(8)...)
+	r.skip(1)
+	capabilities := uint32(r.uint16())
+	r.skip(3) // character set and status flags
+	capabilities |= uint32(r.uint16()) << 16
+	seedLen := int(r.uint8())
+	r.skip(10)
+	plugin := "mysql_native_password"
+	if capabilities&_clientSecureConnection != 0 {
+		n := max(13, seedLen-8)
+		part := r.next(n)
+		seed = append(seed, bytes.TrimRight(part, "\x00")...)
+	}
+	if capabilities&_clientPluginAuth != 0 {
+		plugin = r.nullString()
+	}
+	if r.err != nil {
+		return fmt.Errorf("failed to parse handshake: %w", r.err)
+	}
+	if capabilities&_clientProtocol41 == 0 || capabilities&_clientPluginAuth == 0 {
+		return errors.New("the MySQL server is too old to stream binlog events")
+	}
+
+	flags := uint32(_clientLongPassword | _clientLongFlag | _clientProtocol41 | _clientTransactions | _clientSecureConnection | _clientPluginAuth)
+	if cfg.DBName != "" {
+		flags |= _clientConnectWithDB
+	}
+
+	// Upgrade to TLS if configured
+	tlsConn := false
+	if cfg.TLS != nil {
+		if capabilities&_clientSSL != 0 {
+			flags |= _clientSSL
+			req := make([]byte, 32)
+			binary.LittleEndian.PutUint32(req, flags)
+			binary.LittleEndian.PutUint32(req[4:], _maxPacketSize)
+			req[8] = _utf8mb4
+			if err := c.writePacket(req); err != nil {
+				return err
+			}
+			tc := tls.Client(c.conn, cfg.TLS)
+			if err := tc.Handshake(); err != nil {
+				return err
+			}
+			c.conn = tc
+			c.rd = bufio.NewReader(tc)
+			tlsConn = true
+		} else if !cfg.AllowFallbackToPlaintext {
+			return errors.New("the MySQL server does not support TLS")
+		}
+	}
+
+	auth, err := authResponse(plugin, seed, cfg.Passwd)
+	if err != nil {
+		return err
+	}
+
+	// Send the handshake response
+	resp := make([]byte, 32, 128)
+	binary.LittleEndian.PutUint32(resp, flags)
+	binary.LittleEndian.PutUint32(resp[4:], _maxPacketSize)
+	resp[8] = _utf8mb4
+	resp = append(append(resp, cfg.User...), 0)
+	resp = append(append(resp, byte(len(auth))), auth...)
+	if cfg.DBName != "" {
+		resp = append(append(resp, cfg.DBName...), 0)
+	}
+	resp = append(append(resp, plugin...), 0)
+	if err := c.writePacket(resp); err != nil {
+		return err
+	}
+
+	// Handle the authentication exchange
+	for {
+		data, err := c.readPacket()
+		if err != nil {
+			return err
+		}
+		switch data[0] {
+		case 0x00: // OK
+			return nil
+		case 0xff:
+			return parseErrPacket(data)
+		case 0xfe: // Auth switch request
+			r := &binReader{buf: data[1:]}
+			plugin = r.nullString()
+			seed = bytes.TrimRight(r.rest(), "\x00")
+			auth, err := authResponse(plugin, seed, cfg.Passwd)
+			if err != nil {
+				return err
+			}
+			if err := c.writePacket(auth); err != nil {
+				return err
+			}
+		case 0x01: // More data for caching_sha2_password
+			if plugin != "caching_sha2_password" || len(data) < 2 {
+				return fmt.Errorf("unexpected authentication packet for plugin %q", plugin)
+			}
+			switch data[1] {
+			case 3: // Fast authentication succeeded, an OK packet follows
+			case 4: // Full authentication required
+				if tlsConn {
+					if err := c.writePacket(append([]byte(cfg.Passwd), 0)); err != nil {
+						return err
+					}
+					continue
+				}
+				// Request the server's public key to encrypt the password
+				if err := c.writePacket([]byte{2}); err != nil {
+					return err
+				}
+				data, err := c.readPacket()
+				if err != nil {
+					return err
+				}
+				if data[0] == 0xff {
+					return parseErrPacket(data)
+				}
+				enc, err := encryptPassword(cfg.Passwd, seed, data[1:])
+				if err != nil {
+					return err
+				}
+				if err := c.writePacket(enc); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("unexpected caching_sha2_password state %d", data[1])
+			}
+		default:
+			return fmt.Errorf("unexpected authentication packet type %d", data[0])
+		}
+	}
+}
+
+// exec runs a statement that doesn't return rows.
+func (c *binlogConn) exec(query string) error {
+	c.seq = 0
+	if err := c.writePacket(append([]byte{_comQuery}, query...)); err != nil {
+		return err
+	}
+	data, err := c.readPacket()
+	if err != nil {
+		return err
+	}
+	switch data[0] {
+	case 0x00:
+		return nil
+	case 0xff:
+		return parseErrPacket(data)
+	default:
+		return fmt.Errorf("unexpected response to %q", query)
+	}
+}
+
+// dump requests the binlog events starting at the given position.
+// The server sends an EOF packet when it reaches the end of the binlog.
+func (c *binlogConn) dump(file string, pos uint32, serverID uint32) error {
+	c.seq = 0
+	req := make([]byte, 11, 11+len(file))
+	req[0] = _comBinlogDump
+	binary.LittleEndian.PutUint32(req[1:], pos)
+	binary.LittleEndian.PutUint16(req[5:], _binlogDumpNonBlock)
+	binary.LittleEndian.PutUint32(req[7:], serverID)
+	req = append(req, file...)
+	return c.writePacket(req)
+}
+
+// readEvent returns the next binlog event. It returns io.EOF at the end of the binlog.
+func (c *binlogConn) readEvent() ([]byte, error) {
+	data, err := c.readPacket()
+	if err != nil {
+		return nil, err
+	}
+	switch data[0] {
+	case 0x00:
+		return data[1:], nil
+	case 0xfe:
+		if len(data) < 9 {
+			return nil, io.EOF
+		}
+	case 0xff:
+		return nil, parseErrPacket(data)
+	}
+	return nil, fmt.Errorf("unexpected binlog packet type %d", data[0])
+}
+
+// readPacket reads a packet, joining packets that were split because they exceed the max packet size.
+func (c *binlogConn) readPacket() ([]byte, error) {
+	var res []byte
+	for {
+		var header [4]byte
+		if _, err := io.ReadFull(c.rd, header[:]); err != nil {
+			return nil, err
+		}
+		n := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+		c.seq = header[3] + 1
+
+		start := len(res)
+		res = append(res, make([]byte, n)...)
+		if _, err := io.ReadFull(c.rd, res[start:]); err != nil {
+			return nil, err
+		}
+		if n < _maxPacketSize {
+			break
+		}
+	}
+	if len(res) == 0 {
+		return nil, errors.New("received an empty packet")
+	}
+	return res, nil
+}
+
+// writePacket writes a packet, splitting it if it exceeds the max packet size.
+func (c *binlogConn) writePacket(data []byte) error {
+	for {
+		n := min(len(data), _maxPacketSize)
+		header := []byte{byte(n), byte(n >> 8), byte(n >> 16), c.seq}
+		c.seq++
+		if _, err := c.conn.Write(append(header, data[:n]...)); err != nil {
+			return err
+		}
+		data = data[n:]
+		if n < _maxPacketSize {
+			return nil
+		}
+	}
+}
+
+// parseErrPacket converts an ERR packet to an error.
+func parseErrPacket(data []byte) error {
+	r := &binReader{buf: data[1:]}
+	code := r.uint16()
+	msg := r.rest()
+	if len(msg) > 0 && msg[0] == '#' && len(msg) >= 6 {
+		msg = msg[6:] // SQL state marker and SQL state
+	}
+	return &mysql.MySQLError{Number: code, Message: string(msg)}
+}
+
+// authResponse computes the response to an authentication challenge.
+func authResponse(plugin string, seed []byte, password string) ([]byte, error) {
+	if password == "" {
+		return nil, nil
+	}
+	switch plugin {
+	case "mysql_native_password":
+		// SHA1(password) XOR SHA1(seed + SHA1(SHA1(password)))
+		stage1 := sha1.Sum([]byte(password))
+		stage2 := sha1.Sum(stage1[:])
+		h := sha1.New()
+		h.Write(seed[:min(len(seed), 20)])
+		h.Write(stage2[:])
+		res := h.Sum(nil)
+		for i := range res {
+			res[i] ^= stage1[i]
+		}
+		return res, nil
+	case "caching_sha2_password":
+		// SHA256(password) XOR SHA256(SHA256(SHA256(password)) + seed)
+		m1 := sha256.Sum256([]byte(password))
+		m1h := sha256.Sum256(m1[:])
+		h := sha256.New()
+		h.Write(m1h[:])
+		h.Write(seed[:min(len(seed), 20)])
+		res := h.Sum(nil)
+		for i := range res {
+			res[i] ^= m1[i]
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported MySQL authentication plugin %q", plugin)
+	}
+}
+
+// encryptPassword encrypts the password with the server's public key for caching_sha2_password authentication over an insecure connection.
+func encryptPassword(password string, seed, pemKey []byte) ([]byte, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, errors.New("failed to decode the public key of the MySQL server")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the public key of the MySQL server: %w", err)
+	}
+	pub, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("the public key of the MySQL server is not an RSA key")
+	}
+
+	plain := append([]byte(password), 0)
+	for i := range plain {
+		plain[i] ^= seed[i%len(seed)]
+	}
+	return rsa.EncryptOAEP(sha1.New(), rand.Reader, pub, plain, nil)
+}
+
+// binReader reads little-endian values from a packet or binlog event.
+// It records the first error, after which all reads return zero values.
+type binReader struct {
+	buf []byte
+	err error
+}
+
+func (r *binReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(r.buf) {
+		r.err = errors.New("unexpected end of data")
+		return nil
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *binReader) skip(n int) {
+	r.next(n)
+}
+
+func (r *binReader) rest() []byte {
+	b := r.buf
+	r.buf = nil
+	return b
+}
+
+func (r *binReader) uint8() byte {
+	b := r.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *binReader) uint16() uint16 {
+	b := r.next(2)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(b)
+}
+
+func (r *binReader) uint32() uint32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+// uintN reads an unsigned little-endian integer of n bytes.
+func (r *binReader) uintN(n int) uint64 {
+	b := r.next(n)
+	var v uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	return v
+}
+
+// lenEncInt reads a length-encoded integer.
+func (r *binReader) lenEncInt() uint64 {
+	switch b := r.uint8(); b {
+	case 0xfc:
+		return r.uintN(2)
+	case 0xfd:
+		return r.uintN(3)
+	case 0xfe:
+		return r.uintN(8)
+	default:
+		return uint64(b)
+	}
+}
+
+func (r *binReader) nullString() string {
+	if r.err != nil {
+		return ""
+	}
+	i := bytes.IndexByte(r.buf, 0)
+	if i < 0 {
+		r.err = errors.New("unterminated string")
+		return ""
+	}
+	s := string(r.buf[:i])
+	r.buf = r.buf[i+1:]
+	return s
+}
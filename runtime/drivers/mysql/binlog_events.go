@@ -0,0 +1,753 @@
+package mysql
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// This file implements decoding of the binlog events needed for change data capture with row-based replication.
+// See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_replication_binlog_event.html.
+
+const (
+	_queryEvent              = 2
+	_rotateEvent             = 4
+	_formatDescriptionEvent  = 15
+	_xidEvent                = 16
+	_tableMapEvent           = 19
+	_writeRowsEventV1        = 23
+	_updateRowsEventV1       = 24
+	_deleteRowsEventV1       = 25
+	_writeRowsEventV2        = 30
+	_updateRowsEventV2       = 31
+	_deleteRowsEventV2       = 32
+	_transactionPayloadEvent = 40
+
+	_binlogEventHeaderSize = 19
+	_binlogChecksumSize    = 4
+)
+
+// Column types used in binlog events.
+const (
+	_typeTiny       = 1
+	_typeShort      = 2
+	_typeLong       = 3
+	_typeFloat      = 4
+	_typeDouble     = 5
+	_typeTimestamp  = 7
+	_typeLongLong   = 8
+	_typeInt24      = 9
+	_typeDate       = 10
+	_typeTime       = 11
+	_typeDatetime   = 12
+	_typeYear       = 13
+	_typeNewDate    = 14
+	_typeVarchar    = 15
+	_typeBit        = 16
+	_typeTimestamp2 = 17
+	_typeDatetime2  = 18
+	_typeTime2      = 19
+	_typeJSON       = 245
+	_typeNewDecimal = 246
+	_typeEnum       = 247
+	_typeSet        = 248
+	_typeTinyBlob   = 249
+	_typeMediumBlob = 250
+	_typeLongBlob   = 251
+	_typeBlob       = 252
+	_typeVarString  = 253
+	_typeString     = 254
+	_typeGeometry   = 255
+)
+
+// binlogEventHeader is the common header of binlog events.
+type binlogEventHeader struct {
+	typ byte
+	// logPos is the position of the next event in the binlog file.
+	logPos uint32
+}
+
+// parseBinlogEvent splits an event into its header and body, stripping the checksum if enabled.
+func parseBinlogEvent(data []byte, checksum bool) (binlogEventHeader, []byte, error) {
+	if len(data) < _binlogEventHeaderSize {
+		return binlogEventHeader{}, nil, errors.New("binlog event is too short")
+	}
+	h := binlogEventHeader{
+		typ:    data[4],
+		logPos: binary.LittleEndian.Uint32(data[13:]),
+	}
+	body := data[_binlogEventHeaderSize:]
+	if checksum && h.typ != _formatDescriptionEvent {
+		if len(body) < _binlogChecksumSize {
+			return binlogEventHeader{}, nil, errors.New("binlog event is too short")
+		}
+		body = body[:len(body)-_binlogChecksumSize]
+	}
+	return h, body, nil
+}
+
+// parseRotateEvent returns the binlog file and position that a rotate event points to.
+func parseRotateEvent(body []byte) (string, uint32, error) {
+	r := &binReader{buf: body}
+	pos := r.uintN(8)
+	file := string(r.rest())
+	if r.err != nil {
+		return "", 0, fmt.Errorf("failed to decode rotate event: %w", r.err)
+	}
+	return file, uint32(pos), nil
+}
+
+// parseQueryEvent returns the default schema and the statement of a query event.
+func parseQueryEvent(body []byte) (string, string, error) {
+	r := &binReader{buf: body}
+	r.skip(8) // thread id and execution time
+	schemaLen := int(r.uint8())
+	r.skip(2) // error code
+	statusLen := int(r.uint16())
+	r.skip(statusLen)
+	schema := string(r.next(schemaLen))
+	r.skip(1)
+	query := string(r.rest())
+	if r.err != nil {
+		return "", "", fmt.Errorf("failed to decode query event: %w", r.err)
+	}
+	return schema, query, nil
+}
+
+// binlogTableMap is decoded from a table map event, which precedes the rows events of a table.
+type binlogTableMap struct {
+	id          uint64
+	schema      string
+	table       string
+	columnTypes []byte
+	columnMeta  []uint16
+}
+
+func parseTableMapEvent(body []byte) (*binlogTableMap, error) {
+	r := &binReader{buf: body}
+	tm := &binlogTableMap{id: r.uintN(6)}
+	r.skip(2) // flags
+	tm.schema = string(r.next(int(r.uint8())))
+	r.skip(1)
+	tm.table = string(r.next(int(r.uint8())))
+	r.skip(1)
+	n := int(r.lenEncInt())
+	tm.columnTypes = append([]byte{}, r.next(n)...)
+	meta := &binReader{buf: r.next(int(r.lenEncInt()))}
+	if r.err != nil {
+		return nil, fmt.Errorf("failed to decode table map event: %w", r.err)
+	}
+
+	tm.columnMeta = make([]uint16, n)
+	for i, t := range tm.columnTypes {
+		switch t {
+		case _typeFloat, _typeDouble, _typeBlob, _typeTinyBlob, _typeMediumBlob, _typeLongBlob, _typeGeometry, _typeJSON, _typeTimestamp2, _typeDatetime2, _typeTime2:
+			tm.columnMeta[i] = uint16(meta.uint8())
+		case _typeVarchar, _typeVarString, _typeBit:
+			tm.columnMeta[i] = meta.uint16()
+		case _typeNewDecimal, _typeString, _typeEnum, _typeSet:
+			// Stored as two separate bytes, with the first one in the high byte
+			b := meta.next(2)
+			if b != nil {
+				tm.columnMeta[i] = uint16(b[0])<<8 | uint16(b[1])
+			}
+		}
+	}
+	if meta.err != nil {
+		return nil, fmt.Errorf("failed to decode table map event metadata: %w", meta.err)
+	}
+	return tm, nil
+}
+
+// rowsEventTableID returns the ID of the table that a rows event applies to.
+func rowsEventTableID(body []byte) uint64 {
+	r := &binReader{buf: body}
+	return r.uintN(6)
+}
+
+// binlogColumn describes a column of a table for decoding row values.
+// The binlog only contains the types of columns, so the names and other details are read from the information schema.
+type binlogColumn struct {
+	name string
+	// dataType is the lower case DATA_TYPE from the information schema, e.g. "varchar" or "blob".
+	dataType string
+	unsigned bool
+	// labels are the values of an enum or set column.
+	labels []string
+}
+
+// binlogRowChange is a row changed by a rows event. Inserts only have an after image, and deletes only have a before image.
+type binlogRowChange struct {
+	before map[string]any
+	after  map[string]any
+}
+
+// decodeRowsEvent decodes the rows of a write, update or delete rows event.
+func decodeRowsEvent(typ byte, body []byte, tm *binlogTableMap, cols []binlogColumn) ([]binlogRowChange, error) {
+	r := &binReader{buf: body}
+	r.skip(8) // table id and flags
+	if typ == _writeRowsEventV2 || typ == _updateRowsEventV2 || typ == _deleteRowsEventV2 {
+		r.skip(int(r.uint16()) - 2) // extra data, including its length
+	}
+	n := int(r.lenEncInt())
+	if r.err != nil {
+		return nil, fmt.Errorf("failed to decode rows event: %w", r.err)
+	}
+	if n != len(tm.columnTypes) || n != len(cols) {
+		return nil, fmt.Errorf("table %q has %d columns, but the rows event has %d: the table's schema may have changed, which requires a full refresh", tm.table, len(cols), n)
+	}
+	present := r.next((n + 7) / 8)
+	presentAfter := present
+	update := typ == _updateRowsEventV1 || typ == _updateRowsEventV2
+	if update {
+		presentAfter = r.next((n + 7) / 8)
+	}
+
+	var res []binlogRowChange
+	for len(r.buf) > 0 && r.err == nil {
+		row, err := decodeRowImage(r, tm, cols, present)
+		if err != nil {
+			return nil, err
+		}
+		switch typ {
+		case _writeRowsEventV1, _writeRowsEventV2:
+			res = append(res, binlogRowChange{after: row})
+		case _deleteRowsEventV1, _deleteRowsEventV2:
+			res = append(res, binlogRowChange{before: row})
+		default:
+			after, err := decodeRowImage(r, tm, cols, presentAfter)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, binlogRowChange{before: row, after: after})
+		}
+	}
+	if r.err != nil {
+		return nil, fmt.Errorf("failed to decode rows event: %w", r.err)
+	}
+	return res, nil
+}
+
+// decodeRowImage decodes the values of the present columns of a row.
+func decodeRowImage(r *binReader, tm *binlogTableMap, cols []binlogColumn, present []byte) (map[string]any, error) {
+	count := 0
+	for i := range cols {
+		if bitSet(present, i) {
+			count++
+		}
+	}
+	nulls := r.next((count + 7) / 8)
+
+	row := make(map[string]any, count)
+	j := 0
+	for i, col := range cols {
+		if !bitSet(present, i) {
+			continue
+		}
+		if bitSet(nulls, j) {
+			row[col.name] = nil
+			j++
+			continue
+		}
+		j++
+		v, err := decodeBinlogValue(r, tm.columnTypes[i], tm.columnMeta[i], col)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode column %q: %w", col.name, err)
+		}
+		if r.err != nil {
+			return nil, fmt.Errorf("failed to decode column %q: %w", col.name, r.err)
+		}
+		row[col.name] = v
+	}
+	return row, nil
+}
+
+func bitSet(bitmap []byte, i int) bool {
+	return i/8 < len(bitmap) && bitmap[i/8]&(1<<(i%8)) != 0
+}
+
+// decodeBinlogValue decodes a non-null value.
+// It returns values of the same types as the rows returned by the SQL store for a snapshot of the table.
+func decodeBinlogValue(r *binReader, typ byte, meta uint16, col binlogColumn) (any, error) {
+	// The real type of strings, enums and sets is stored in the metadata
+	if typ == _typeString && meta >= 256 {
+		b0, b1 := byte(meta>>8), byte(meta)
+		if b0&0x30 != 0x30 {
+			// The field length is larger than 255, so its two high bits are stored in the type byte
+			meta = uint16(b1) | uint16((b0&0x30)^0x30)<<4
+			typ = b0 | 0x30
+		} else {
+			meta = uint16(b1)
+			typ = b0
+		}
+	}
+
+	switch typ {
+	case _typeTiny:
+		v := r.uint8()
+		if col.unsigned {
+			return v, nil
+		}
+		return int8(v), nil
+	case _typeShort:
+		v := r.uint16()
+		if col.unsigned {
+			return v, nil
+		}
+		return int16(v), nil
+	case _typeInt24:
+		v := uint32(r.uintN(3))
+		if col.unsigned {
+			return v, nil
+		}
+		if v&0x800000 != 0 {
+			v |= 0xff000000
+		}
+		return int32(v), nil
+	case _typeLong:
+		v := r.uint32()
+		if col.unsigned {
+			return v, nil
+		}
+		return int32(v), nil
+	case _typeLongLong:
+		v := r.uintN(8)
+		if col.unsigned {
+			return v, nil
+		}
+		return int64(v), nil
+	case _typeFloat:
+		return math.Float32frombits(r.uint32()), nil
+	case _typeDouble:
+		return math.Float64frombits(r.uintN(8)), nil
+	case _typeYear:
+		v := r.uint8()
+		if v == 0 {
+			return int64(0), nil
+		}
+		return int64(v) + 1900, nil
+	case _typeNewDecimal:
+		return decodeBinlogDecimal(r, int(meta>>8), int(meta&0xff))
+	case _typeDate, _typeNewDate:
+		v := uint32(r.uintN(3))
+		return binlogDate(int(v>>9), int(v>>5)&15, int(v&31), 0, 0, 0, 0), nil
+	case _typeTimestamp:
+		return time.Unix(int64(r.uint32()), 0).UTC(), nil
+	case _typeTimestamp2:
+		sec := bigEndianUint(r.next(4))
+		usec := readFractionalSeconds(r, int(meta))
+		if sec == 0 && usec == 0 {
+			return time.Time{}, nil
+		}
+		return time.Unix(int64(sec), int64(usec)*1000).UTC(), nil
+	case _typeDatetime:
+		v := r.uintN(8)
+		d, t := v/1000000, v%1000000
+		return binlogDate(int(d/10000), int(d/100%100), int(d%100), int(t/10000), int(t/100%100), int(t%100), 0), nil
+	case _typeDatetime2:
+		v := int64(bigEndianUint(r.next(5))) - 0x8000000000
+		usec := readFractionalSeconds(r, int(meta))
+		ymd, hms := v>>17, v%(1<<17)
+		ym := ymd >> 5
+		return binlogDate(int(ym/13), int(ym%13), int(ymd%(1<<5)), int(hms>>12), int(hms>>6)%(1<<6), int(hms%(1<<6)), usec), nil
+	case _typeTime:
+		v := int32(r.uintN(3)<<8) >> 8 // sign extend
+		sign := ""
+		if v < 0 {
+			sign = "-"
+			v = -v
+		}
+		return fmt.Sprintf("%s%02d:%02d:%02d", sign, v/10000, v/100%100, v%100), nil
+	case _typeTime2:
+		return decodeBinlogTime2(r, int(meta)), nil
+	case _typeBit:
+		nbits := int(meta>>8)*8 + int(meta&0xff)
+		b := append([]byte{}, r.next((nbits+7)/8)...)
+		return bitMapper{}.value(&b)
+	case _typeVarchar, _typeVarString, _typeString:
+		var n int
+		if meta < 256 {
+			n = int(r.uint8())
+		} else {
+			n = int(r.uint16())
+		}
+		b := r.next(n)
+		if col.dataType == "binary" || col.dataType == "varbinary" {
+			return append([]byte{}, b...), nil
+		}
+		return string(b), nil
+	case _typeEnum:
+		idx := int(r.uintN(int(meta & 0xff)))
+		if idx == 0 {
+			return "", nil
+		}
+		if idx > len(col.labels) {
+			return nil, fmt.Errorf("enum index %d is out of range", idx)
+		}
+		return col.labels[idx-1], nil
+	case _typeSet:
+		bits := r.uintN(int(meta & 0xff))
+		var vals []string
+		for i, l := range col.labels {
+			if bits&(1<<i) != 0 {
+				vals = append(vals, l)
+			}
+		}
+		return strings.Join(vals, ","), nil
+	case _typeBlob, _typeTinyBlob, _typeMediumBlob, _typeLongBlob:
+		b := r.next(int(r.uintN(int(meta))))
+		if strings.HasSuffix(col.dataType, "text") {
+			return string(b), nil
+		}
+		return append([]byte{}, b...), nil
+	case _typeJSON:
+		b := r.next(int(r.uintN(int(meta))))
+		if r.err != nil {
+			return nil, r.err
+		}
+		return decodeBinaryJSON(b)
+	default:
+		return nil, fmt.Errorf("unsupported column type %d", typ)
+	}
+}
+
+// binlogDate returns a UTC time, or the zero time for zero dates like the SQL store.
+func binlogDate(year, month, day, hour, minute, second, usec int) time.Time {
+	if year == 0 && month == 0 && day == 0 {
+		return time.Time{}
+	}
+	return time.Date(year, time.Month(month), day, hour, minute, second, usec*1000, time.UTC)
+}
+
+// readFractionalSeconds reads the fractional seconds of a temporal value with the given precision and returns them in microseconds.
+func readFractionalSeconds(r *binReader, fsp int) int {
+	switch fsp {
+	case 1, 2:
+		return int(r.uint8()) * 10000
+	case 3, 4:
+		return int(bigEndianUint(r.next(2))) * 100
+	case 5, 6:
+		return int(bigEndianUint(r.next(3)))
+	default:
+		return 0
+	}
+}
+
+// decodeBinlogTime2 decodes a TIME value to a string like the SQL store returns, e.g. "-12:30:00.50".
+func decodeBinlogTime2(r *binReader, fsp int) string {
+	var packed int64
+	switch fsp {
+	case 1, 2:
+		intpart := int64(bigEndianUint(r.next(3))) - 0x800000
+		frac := int64(int8(r.uint8()))
+		if intpart < 0 && frac != 0 {
+			intpart++
+			frac -= 0x100
+		}
+		packed = intpart<<24 + frac*10000
+	case 3, 4:
+		intpart := int64(bigEndianUint(r.next(3))) - 0x800000
+		frac := int64(int16(bigEndianUint(r.next(2))))
+		if intpart < 0 && frac != 0 {
+			intpart++
+			frac -= 0x10000
+		}
+		packed = intpart<<24 + frac*100
+	case 5, 6:
+		packed = int64(bigEndianUint(r.next(6))) - 0x800000000000
+	default:
+		packed = (int64(bigEndianUint(r.next(3))) - 0x800000) << 24
+	}
+
+	sign := ""
+	if packed < 0 {
+		sign = "-"
+		packed = -packed
+	}
+	hms := packed >> 24
+	usec := packed % (1 << 24)
+	s := fmt.Sprintf("%s%02d:%02d:%02d", sign, (hms>>12)%(1<<10), (hms>>6)%(1<<6), hms%(1<<6))
+	if fsp > 0 {
+		s += "." + fmt.Sprintf("%06d", usec)[:fsp]
+	}
+	return s
+}
+
+func bigEndianUint(b []byte) uint64 {
+	var v uint64
+	for _, x := range b {
+		v = v<<8 | uint64(x)
+	}
+	return v
+}
+
+// decodeBinlogDecimal decodes a DECIMAL value to a string like the SQL store returns, e.g. "-1234.50".
+// Decimals are stored as groups of 9 digits in 4 bytes, with the leftover digits of the integer and fractional parts stored in fewer bytes.
+func decodeBinlogDecimal(r *binReader, precision, scale int) (string, error) {
+	digitsToBytes := [10]int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+	intg := precision - scale
+	intg0, intg0x := intg/9, intg%9
+	frac0, frac0x := scale/9, scale%9
+	size := intg0*4 + digitsToBytes[intg0x] + frac0*4 + digitsToBytes[frac0x]
+
+	b := append([]byte{}, r.next(size)...)
+	if r.err != nil || size == 0 {
+		return "", r.err
+	}
+	negative := b[0]&0x80 == 0
+	b[0] ^= 0x80
+	if negative {
+		for i := range b {
+			b[i] ^= 0xff
+		}
+	}
+
+	var sb strings.Builder
+	if negative {
+		sb.WriteByte('-')
+	}
+	var ip strings.Builder
+	pos := 0
+	if n := digitsToBytes[intg0x]; n > 0 {
+		ip.WriteString(strconv.FormatUint(bigEndianUint(b[pos:pos+n]), 10))
+		pos += n
+	}
+	for i := 0; i < intg0; i++ {
+		fmt.Fprintf(&ip, "%09d", bigEndianUint(b[pos:pos+4]))
+		pos += 4
+	}
+	is := strings.TrimLeft(ip.String(), "0")
+	if is == "" {
+		is = "0"
+	}
+	sb.WriteString(is)
+
+	if scale > 0 {
+		sb.WriteByte('.')
+		for i := 0; i < frac0; i++ {
+			fmt.Fprintf(&sb, "%09d", bigEndianUint(b[pos:pos+4]))
+			pos += 4
+		}
+		if n := digitsToBytes[frac0x]; n > 0 {
+			fmt.Fprintf(&sb, "%0*d", frac0x, bigEndianUint(b[pos:pos+n]))
+		}
+	}
+	return sb.String(), nil
+}
+
+// Value types of MySQL's binary JSON format.
+const (
+	_jsonSmallObject = 0x00
+	_jsonLargeObject = 0x01
+	_jsonSmallArray  = 0x02
+	_jsonLargeArray  = 0x03
+	_jsonLiteral     = 0x04
+	_jsonInt16       = 0x05
+	_jsonUint16      = 0x06
+	_jsonInt32       = 0x07
+	_jsonUint32      = 0x08
+	_jsonInt64       = 0x09
+	_jsonUint64      = 0x0a
+	_jsonDouble      = 0x0b
+	_jsonString      = 0x0c
+	_jsonOpaque      = 0x0f
+)
+
+// decodeBinaryJSON converts a JSON value in MySQL's binary format to JSON text.
+// See https://dev.mysql.com/doc/dev/mysql-server/latest/json__binary_8h.html.
+func decodeBinaryJSON(data []byte) (string, error) {
+	if len(data) == 0 {
+		return "null", nil
+	}
+	v, err := decodeJSONValue(data[0], data[1:])
+	if err != nil {
+		return "", fmt.Errorf("failed to decode JSON: %w", err)
+	}
+	res, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
+
+func decodeJSONValue(typ byte, data []byte) (any, error) {
+	switch typ {
+	case _jsonSmallObject, _jsonSmallArray:
+		return decodeJSONContainer(typ == _jsonSmallObject, false, data)
+	case _jsonLargeObject, _jsonLargeArray:
+		return decodeJSONContainer(typ == _jsonLargeObject, true, data)
+	case _jsonLiteral:
+		if len(data) < 1 {
+			return nil, errors.New("unexpected end of data")
+		}
+		switch data[0] {
+		case 0x00:
+			return nil, nil
+		case 0x01:
+			return true, nil
+		case 0x02:
+			return false, nil
+		}
+		return nil, fmt.Errorf("invalid literal %d", data[0])
+	}
+
+	r := &binReader{buf: data}
+	var v any
+	switch typ {
+	case _jsonInt16:
+		v = int16(r.uint16())
+	case _jsonUint16:
+		v = r.uint16()
+	case _jsonInt32:
+		v = int32(r.uint32())
+	case _jsonUint32:
+		v = r.uint32()
+	case _jsonInt64:
+		v = int64(r.uintN(8))
+	case _jsonUint64:
+		v = r.uintN(8)
+	case _jsonDouble:
+		v = math.Float64frombits(r.uintN(8))
+	case _jsonString:
+		v = string(r.next(readJSONVarLen(r)))
+	case _jsonOpaque:
+		fieldType := r.uint8()
+		b := r.next(readJSONVarLen(r))
+		if r.err != nil {
+			return nil, r.err
+		}
+		return decodeJSONOpaque(fieldType, b)
+	default:
+		return nil, fmt.Errorf("unknown JSON value type %d", typ)
+	}
+	return v, r.err
+}
+
+// decodeJSONContainer decodes an object or array. Offsets in the container are relative to the start of data.
+func decodeJSONContainer(object, large bool, data []byte) (any, error) {
+	offsetSize := 2
+	if large {
+		offsetSize = 4
+	}
+	r := &binReader{buf: data}
+	count := int(r.uintN(offsetSize))
+	size := int(r.uintN(offsetSize))
+	if r.err != nil || size > len(data) {
+		return nil, errors.New("invalid JSON container")
+	}
+
+	keys := make([]string, count)
+	if object {
+		for i := range keys {
+			off := int(r.uintN(offsetSize))
+			n := int(r.uint16())
+			if off+n > size {
+				return nil, errors.New("invalid JSON object key")
+			}
+			keys[i] = string(data[off : off+n])
+		}
+	}
+
+	vals := make([]any, count)
+	for i := range vals {
+		typ := r.uint8()
+		if r.err != nil {
+			return nil, r.err
+		}
+		// Small scalars are inlined in the value entry instead of an offset
+		inlined := typ == _jsonLiteral || typ == _jsonInt16 || typ == _jsonUint16 || (large && (typ == _jsonInt32 || typ == _jsonUint32))
+		if inlined {
+			v, err := decodeJSONValue(typ, r.next(offsetSize))
+			if err != nil {
+				return nil, err
+			}
+			vals[i] = v
+			continue
+		}
+		off := int(r.uintN(offsetSize))
+		if off >= size {
+			return nil, errors.New("invalid JSON value offset")
+		}
+		v, err := decodeJSONValue(typ, data[off:size])
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = v
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if !object {
+		return vals, nil
+	}
+	obj := make(map[string]any, count)
+	for i, k := range keys {
+		obj[k] = vals[i]
+	}
+	return obj, nil
+}
+
+// readJSONVarLen reads a variable-length integer used for lengths of strings in binary JSON.
+func readJSONVarLen(r *binReader) int {
+	var n int
+	for i := 0; i < 5; i++ {
+		b := r.uint8()
+		n |= int(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return n
+		}
+	}
+	if r.err == nil {
+		r.err = errors.New("invalid variable-length integer")
+	}
+	return 0
+}
+
+// decodeJSONOpaque decodes a MySQL value embedded in a JSON value, such as a decimal or a date.
+func decodeJSONOpaque(fieldType byte, data []byte) (any, error) {
+	switch fieldType {
+	case _typeNewDecimal:
+		if len(data) < 2 {
+			return nil, errors.New("invalid JSON decimal")
+		}
+		s, err := decodeBinlogDecimal(&binReader{buf: data[2:]}, int(data[0]), int(data[1]))
+		if err != nil {
+			return nil, err
+		}
+		return json.Number(s), nil
+	case _typeDate, _typeDatetime, _typeTimestamp, _typeTime:
+		if len(data) < 8 {
+			return nil, errors.New("invalid JSON temporal value")
+		}
+		packed := int64(binary.LittleEndian.Uint64(data))
+		neg := packed < 0
+		if neg {
+			packed = -packed
+		}
+		usec := int(packed % (1 << 24))
+		intpart := packed >> 24
+		if fieldType == _typeTime {
+			sign := ""
+			if neg {
+				sign = "-"
+			}
+			return fmt.Sprintf("%s%02d:%02d:%02d.%06d", sign, (intpart>>12)%(1<<10), (intpart>>6)%(1<<6), intpart%(1<<6), usec), nil
+		}
+		ymd, hms := intpart>>17, intpart%(1<<17)
+		ym := ymd >> 5
+		t := binlogDate(int(ym/13), int(ym%13), int(ymd%(1<<5)), int(hms>>12), int(hms>>6)%(1<<6), int(hms%(1<<6)), usec)
+		if fieldType == _typeDate {
+			return t.Format(time.DateOnly), nil
+		}
+		return t.Format("2006-01-02 15:04:05.000000"), nil
+	default:
+		// Other opaque values, such as binary strings, have no JSON representation
+		return data, nil
+	}
+}
@@ -0,0 +1,151 @@
+package mysql
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBinlogDecimal(t *testing.T) {
+	// Examples from the MySQL source code documentation of decimal2bin
+	s, err := decodeBinlogDecimal(&binReader{buf: mustHex(t, "810DFB38D204D2")}, 14, 4)
+	require.NoError(t, err)
+	require.Equal(t, "1234567890.1234", s)
+
+	s, err = decodeBinlogDecimal(&binReader{buf: mustHex(t, "7EF204C72DFB2D")}, 14, 4)
+	require.NoError(t, err)
+	require.Equal(t, "-1234567890.1234", s)
+
+	s, err = decodeBinlogDecimal(&binReader{buf: mustHex(t, "8000000005")}, 10, 2)
+	require.NoError(t, err)
+	require.Equal(t, "0.05", s)
+}
+
+func TestDecodeBinlogTemporal(t *testing.T) {
+	v, err := decodeBinlogValue(&binReader{buf: mustHex(t, "99b2cac8b81ed2")}, _typeDatetime2, 3, binlogColumn{})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 3, 5, 12, 34, 56, 789000000, time.UTC), v)
+
+	v, err = decodeBinlogValue(&binReader{buf: mustHex(t, "7fef7d")}, _typeTime2, 0, binlogColumn{})
+	require.NoError(t, err)
+	require.Equal(t, "-01:02:03", v)
+
+	v, err = decodeBinlogValue(&binReader{buf: mustHex(t, "80c78032")}, _typeTime2, 2, binlogColumn{})
+	require.NoError(t, err)
+	require.Equal(t, "12:30:00.50", v)
+
+	// DATE is stored as year*16*32 + month*32 + day
+	v, err = decodeBinlogValue(&binReader{buf: mustHex(t, "c5ce0f")}, _typeDate, 0, binlogColumn{})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 6, 5, 0, 0, 0, 0, time.UTC), v)
+
+	v, err = decodeBinlogValue(&binReader{buf: []byte{0, 0, 0}}, _typeDate, 0, binlogColumn{})
+	require.NoError(t, err)
+	require.Equal(t, time.Time{}, v)
+}
+
+func TestDecodeBinaryJSON(t *testing.T) {
+	// {"a": 1, "b": [true, "x"]}
+	data := []byte{
+		0x00,       // small object
+		0x02, 0x00, // count
+		0x20, 0x00, // size
+		0x12, 0x00, 0x01, 0x00, // key "a" at 18
+		0x13, 0x00, 0x01, 0x00, // key "b" at 19
+		0x05, 0x01, 0x00, // int16 1 (inlined)
+		0x02, 0x14, 0x00, // small array at 20
+		'a', 'b',
+		0x02, 0x00, // count
+		0x0c, 0x00, // size
+		0x04, 0x01, 0x00, // true (inlined)
+		0x0c, 0x0a, 0x00, // string at 10
+		0x01, 'x',
+	}
+	s, err := decodeBinaryJSON(data)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"a":1,"b":[true,"x"]}`, s)
+
+	s, err = decodeBinaryJSON([]byte{0x04, 0x00})
+	require.NoError(t, err)
+	require.Equal(t, "null", s)
+
+	_, err = decodeBinaryJSON([]byte{0x00, 0x02})
+	require.Error(t, err)
+}
+
+func TestDecodeRowsEvent(t *testing.T) {
+	tm, err := parseTableMapEvent([]byte{
+		0x42, 0, 0, 0, 0, 0, // table id
+		0x01, 0x00, // flags
+		4, 's', 'h', 'o', 'p', 0,
+		6, 'o', 'r', 'd', 'e', 'r', 's', 0,
+		4,                                                         // column count
+		_typeLongLong, _typeVarchar, _typeString, _typeNewDecimal, // column types
+		6,          // metadata length
+		0xfc, 0x03, // varchar(255) with 4 bytes per character
+		_typeEnum, 0x01, // enum stored in one byte
+		10, 2, // decimal(10,2)
+		0x0e, // null bitmap
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x42), tm.id)
+	require.Equal(t, "shop", tm.schema)
+	require.Equal(t, "orders", tm.table)
+	require.Equal(t, []uint16{0, 1020, _typeEnum<<8 | 1, 10<<8 | 2}, tm.columnMeta)
+
+	cols := []binlogColumn{
+		{name: "id", dataType: "bigint"},
+		{name: "name", dataType: "varchar"},
+		{name: "status", dataType: "enum", labels: []string{"new", "paid"}},
+		{name: "amount", dataType: "decimal"},
+	}
+	body := []byte{
+		0x42, 0, 0, 0, 0, 0, // table id
+		0x01, 0x00, // flags
+		0x02, 0x00, // extra data length
+		4,    // column count
+		0x0f, // columns present in the before image
+		0x0f, // columns present in the after image
+		// Before image
+		0x00,
+		1, 0, 0, 0, 0, 0, 0, 0,
+		2, 0, 'a', 'b',
+		1,
+		0x80, 0x00, 0x00, 0x0c, 0x32,
+		// After image with a null name
+		0x02,
+		1, 0, 0, 0, 0, 0, 0, 0,
+		2,
+		0x80, 0x00, 0x00, 0x0c, 0x33,
+	}
+	require.Equal(t, uint64(0x42), rowsEventTableID(body))
+	changes, err := decodeRowsEvent(_updateRowsEventV2, body, tm, cols)
+	require.NoError(t, err)
+	require.Equal(t, []binlogRowChange{{
+		before: map[string]any{"id": int64(1), "name": "ab", "status": "new", "amount": "12.50"},
+		after:  map[string]any{"id": int64(1), "name": nil, "status": "paid", "amount": "12.51"},
+	}}, changes)
+
+	_, err = decodeRowsEvent(_updateRowsEventV2, body, tm, cols[:3])
+	require.ErrorContains(t, err, "full refresh")
+}
+
+func TestCDCTable(t *testing.T) {
+	require.Equal(t, []string{"new", "it's", "a,b"}, parseEnumLabels("enum('new','it''s','a,b')"))
+
+	tbl := &cdcTable{schema: "shop", name: "orders"}
+	require.Equal(t, "`shop`.`orders`", tbl.identifier())
+	require.True(t, tbl.truncatedBy("shop", "TRUNCATE TABLE orders"))
+	require.True(t, tbl.truncatedBy("other", "truncate `shop`.`orders`"))
+	require.False(t, tbl.truncatedBy("other", "TRUNCATE TABLE orders"))
+	require.False(t, tbl.truncatedBy("shop", "TRUNCATE TABLE orders_archive"))
+	require.False(t, tbl.truncatedBy("shop", "DELETE FROM orders"))
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
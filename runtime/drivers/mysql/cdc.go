@@ -0,0 +1,497 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/olapwriter"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// CDCInputProperties are the input properties of a model that mirrors a MySQL table using change data capture.
+type CDCInputProperties struct {
+	// DSN optionally overrides the connector's dsn.
+	DSN string `mapstructure:"dsn"`
+	// CDC configures change data capture.
+	CDC struct {
+		// Table is the table to mirror, optionally qualified with a database, e.g. "shop.orders".
+		// Unqualified tables are looked up in the database of the DSN.
+		Table string `mapstructure:"table"`
+		// ServerID is the replica server ID used to read the binlog. It must be unique among the server's replicas.
+		// It defaults to an ID derived from the instance and model name.
+		ServerID uint32 `mapstructure:"server_id"`
+		// MaxChanges optionally limits the number of changes read from the binlog in one execution.
+		// Changes are always read up to the end of a transaction, so the limit may be exceeded.
+		MaxChanges int `mapstructure:"max_changes"`
+	} `mapstructure:"cdc"`
+}
+
+func (p *CDCInputProperties) Validate() error {
+	if p.CDC.Table == "" {
+		return errors.New(`missing property "cdc.table"`)
+	}
+	if p.CDC.MaxChanges < 0 {
+		return errors.New(`"cdc.max_changes" must be positive`)
+	}
+	return nil
+}
+
+// cdcExecutor mirrors a MySQL table into a DuckDB table using the binlog.
+// Full runs record the current binlog position and copy a snapshot of the table.
+// Incremental runs stream the row events since the recorded position like a replica and merge them into the table on the table's primary key.
+// The binlog position that changes have been applied up to is persisted in the model's incremental state.
+type cdcExecutor struct {
+	c    *connection
+	olap drivers.OLAPStore
+}
+
+var _ drivers.ModelExecutor = &cdcExecutor{}
+
+func (e *cdcExecutor) Concurrency(desired int) (int, bool) {
+	if desired > 1 {
+		return 0, false
+	}
+	return 1, true
+}
+
+func (e *cdcExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
+	if opts.SplitRun {
+		return nil, errors.New("models that use change data capture do not support splits")
+	}
+
+	props := &CDCInputProperties{}
+	if err := mapstructure.WeakDecode(opts.InputProperties, props); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if err := props.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+	serverID := props.CDC.ServerID
+	if serverID == 0 {
+		serverID = replicaServerID(e.c.instanceID, opts.ModelName)
+	}
+
+	// Changes are only read from the stored position on incremental runs
+	var pos binlogPosition
+	if opts.IncrementalRun {
+		if err := mapstructure.WeakDecode(opts.IncrementalState, &pos); err != nil || pos.File == "" {
+			return nil, errors.New("the incremental state does not contain a binlog position: run a full refresh of the model")
+		}
+	}
+
+	dsn, err := e.c.dsn(props.DSN)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	cfg.ParseTime = true
+	db, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	// Row events are only complete with row-based logging of full row images
+	var format, rowImage, checksum string
+	err = db.QueryRowContext(ctx, "SELECT @@global.binlog_format, @@global.binlog_row_image, @@global.binlog_checksum").Scan(&format, &rowImage, &checksum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read binlog settings: %w", err)
+	}
+	if !strings.EqualFold(format, "ROW") || !strings.EqualFold(rowImage, "FULL") {
+		return nil, fmt.Errorf("change data capture requires binlog_format=ROW and binlog_row_image=FULL, but the server has binlog_format=%s and binlog_row_image=%s", format, rowImage)
+	}
+
+	tbl, err := lookupCDCTable(ctx, db, cfg.DBName, props.CDC.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	// Merge on the output's unique key if set, otherwise on the table's primary key
+	uniqueKey := tbl.primaryKey
+	if uk, ok := opts.OutputProperties["unique_key"]; ok {
+		if err := mapstructure.WeakDecode(uk, &uniqueKey); err != nil {
+			return nil, fmt.Errorf("invalid unique_key: %w", err)
+		}
+	}
+	if len(uniqueKey) == 0 {
+		return nil, fmt.Errorf("table %q has no primary key: set the output property \"unique_key\" to mirror it", props.CDC.Table)
+	}
+
+	var n int
+	res, err := olapwriter.ExecuteChanges(ctx, e.olap, opts, uniqueKey,
+		func(ctx context.Context, add func(row map[string]any) error) error {
+			// The position is recorded before the snapshot is taken, so changes committed while copying the snapshot are applied on the next run
+			pos, err = currentBinlogPosition(ctx, db)
+			if err != nil {
+				return err
+			}
+			n, err = e.snapshot(ctx, dsn, tbl, add)
+			return err
+		},
+		func(ctx context.Context, cs *olapwriter.ChangeSet) error {
+			pos, n, err = e.readChanges(ctx, cfg, !strings.EqualFold(checksum, "NONE"), serverID, tbl, pos, props.CDC.MaxChanges, cs)
+			return err
+		},
+	)
+	if err != nil {
+		if errors.Is(err, olapwriter.ErrNoRows) {
+			return nil, fmt.Errorf("no rows found in table %q", props.CDC.Table)
+		}
+		return nil, err
+	}
+
+	if opts.IncrementalRun {
+		e.c.logger.Debug("mysql: applied changes", zap.String("model", opts.ModelName), zap.String("table", props.CDC.Table), zap.Int("changes", n), zap.String("file", pos.File), zap.Uint32("position", pos.Position), observability.ZapCtx(ctx))
+	} else {
+		e.c.logger.Debug("mysql: copied snapshot", zap.String("model", opts.ModelName), zap.String("table", props.CDC.Table), zap.Int("rows", n), zap.String("file", pos.File), zap.Uint32("position", pos.Position), observability.ZapCtx(ctx))
+	}
+
+	res.IncrementalState = map[string]any{"file": pos.File, "position": pos.Position}
+	return res, nil
+}
+
+// snapshot copies all the rows of the table. It returns the number of rows copied.
+func (e *cdcExecutor) snapshot(ctx context.Context, dsn string, tbl *cdcTable, add func(row map[string]any) error) (int, error) {
+	iter, err := e.c.Query(ctx, map[string]any{
+		"sql": fmt.Sprintf("SELECT * FROM %s", tbl.identifier()),
+		"dsn": dsn,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	schema, err := iter.Schema(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for {
+		vals, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, drivers.ErrIteratorDone) {
+				return n, nil
+			}
+			return 0, err
+		}
+		row := make(map[string]any, len(vals))
+		for i, f := range schema.Fields {
+			row[f.Name] = vals[i]
+		}
+		if err := add(row); err != nil {
+			return 0, err
+		}
+		n++
+	}
+}
+
+// readChanges streams the binlog from pos until its end and collects the changes to the table.
+// Changes are only collected for complete transactions. It returns the position after the last transaction read and the number of changes read.
+func (e *cdcExecutor) readChanges(ctx context.Context, cfg *mysql.Config, checksum bool, serverID uint32, tbl *cdcTable, pos binlogPosition, maxChanges int, cs *olapwriter.ChangeSet) (binlogPosition, int, error) {
+	conn, err := dialBinlog(ctx, cfg)
+	if err != nil {
+		return pos, 0, fmt.Errorf("failed to connect for reading the binlog: %w", err)
+	}
+	defer conn.Close()
+	stop := context.AfterFunc(ctx, func() { _ = conn.conn.SetDeadline(time.Unix(1, 0)) })
+	defer stop()
+
+	// Tell the server that we can handle checksums, otherwise it refuses to send events when they're enabled
+	if err := conn.exec("SET @master_binlog_checksum = @@global.binlog_checksum"); err != nil {
+		return pos, 0, err
+	}
+	if err := conn.dump(pos.File, pos.Position, serverID); err != nil {
+		return pos, 0, err
+	}
+
+	tables := make(map[uint64]*binlogTableMap)
+	file := pos.File
+	var pending []func() error
+	n := 0
+	for {
+		data, err := conn.readEvent()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if ctx.Err() != nil {
+				return pos, 0, ctx.Err()
+			}
+			return pos, 0, fmt.Errorf("failed to read the binlog from %s:%d (if the binlog has been purged, run a full refresh of the model): %w", file, pos.Position, err)
+		}
+		h, body, err := parseBinlogEvent(data, checksum)
+		if err != nil {
+			return pos, 0, err
+		}
+
+		commit := false
+		switch h.typ {
+		case _rotateEvent:
+			f, p, err := parseRotateEvent(body)
+			if err != nil {
+				return pos, 0, err
+			}
+			file = f
+			if len(pending) == 0 {
+				pos = binlogPosition{File: f, Position: p}
+			}
+			continue
+		case _tableMapEvent:
+			tm, err := parseTableMapEvent(body)
+			if err != nil {
+				return pos, 0, err
+			}
+			tables[tm.id] = tm
+		case _writeRowsEventV1, _updateRowsEventV1, _deleteRowsEventV1, _writeRowsEventV2, _updateRowsEventV2, _deleteRowsEventV2:
+			tm, ok := tables[rowsEventTableID(body)]
+			if !ok || tm.schema != tbl.schema || tm.table != tbl.name {
+				continue
+			}
+			changes, err := decodeRowsEvent(h.typ, body, tm, tbl.columns)
+			if err != nil {
+				return pos, 0, err
+			}
+			for _, c := range changes {
+				pending = append(pending, func() error {
+					if c.before != nil {
+						if err := cs.Delete(ctx, c.before); err != nil {
+							return err
+						}
+					}
+					if c.after != nil {
+						return cs.Upsert(ctx, c.after)
+					}
+					return nil
+				})
+			}
+		case _queryEvent:
+			schema, query, err := parseQueryEvent(body)
+			if err != nil {
+				return pos, 0, err
+			}
+			if strings.EqualFold(strings.TrimSpace(query), "BEGIN") {
+				continue
+			}
+			// Other statements, such as COMMIT for non-transactional tables and DDL, end the transaction
+			if tbl.truncatedBy(schema, query) {
+				pending = append(pending, func() error {
+					cs.Truncate()
+					return nil
+				})
+			}
+			commit = true
+		case _xidEvent:
+			commit = true
+		case _transactionPayloadEvent:
+			return pos, 0, errors.New("change data capture does not support binlog_transaction_compression")
+		}
+
+		if commit {
+			for _, fn := range pending {
+				if err := fn(); err != nil {
+					return pos, 0, err
+				}
+			}
+			n += len(pending)
+			pending = nil
+			pos = binlogPosition{File: file, Position: h.logPos}
+			if maxChanges > 0 && n >= maxChanges {
+				break
+			}
+		}
+	}
+	return pos, n, nil
+}
+
+// binlogPosition is a position in the binlog.
+type binlogPosition struct {
+	File     string `mapstructure:"file"`
+	Position uint32 `mapstructure:"position"`
+}
+
+// currentBinlogPosition returns the position of the end of the binlog.
+func currentBinlogPosition(ctx context.Context, db *sql.DB) (binlogPosition, error) {
+	// SHOW MASTER STATUS was renamed in MySQL 8.2
+	rows, err := db.QueryContext(ctx, "SHOW BINARY LOG STATUS")
+	if err != nil {
+		rows, err = db.QueryContext(ctx, "SHOW MASTER STATUS")
+		if err != nil {
+			return binlogPosition{}, fmt.Errorf("failed to read the binlog position: %w", err)
+		}
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return binlogPosition{}, err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return binlogPosition{}, err
+		}
+		return binlogPosition{}, errors.New("binary logging is not enabled on the MySQL server")
+	}
+	vals := make([]sql.RawBytes, len(cols))
+	dest := make([]any, len(cols))
+	for i := range vals {
+		dest[i] = &vals[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return binlogPosition{}, err
+	}
+
+	// The first two columns are File and Position
+	var pos binlogPosition
+	if len(vals) < 2 {
+		return binlogPosition{}, errors.New("unexpected binlog status")
+	}
+	pos.File = string(vals[0])
+	if _, err := fmt.Sscan(string(vals[1]), &pos.Position); err != nil {
+		return binlogPosition{}, fmt.Errorf("unexpected binlog position %q", vals[1])
+	}
+	return pos, rows.Err()
+}
+
+// cdcTable is a table mirrored with change data capture.
+type cdcTable struct {
+	schema     string
+	name       string
+	columns    []binlogColumn
+	primaryKey []string
+}
+
+// lookupCDCTable resolves the columns and primary key of a table.
+func lookupCDCTable(ctx context.Context, db *sql.DB, defaultSchema, table string) (*cdcTable, error) {
+	tbl := &cdcTable{schema: defaultSchema, name: table}
+	if s, t, ok := strings.Cut(table, "."); ok {
+		tbl.schema, tbl.name = s, t
+	}
+	tbl.schema = strings.Trim(tbl.schema, "`")
+	tbl.name = strings.Trim(tbl.name, "`")
+	if tbl.schema == "" {
+		return nil, fmt.Errorf("table %q must be qualified with a database, or the DSN must include a database", table)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT COLUMN_NAME, DATA_TYPE, COLUMN_TYPE FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION", tbl.schema, tbl.name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up columns of table %q: %w", table, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, dataType, columnType string
+		if err := rows.Scan(&name, &dataType, &columnType); err != nil {
+			return nil, err
+		}
+		col := binlogColumn{
+			name:     name,
+			dataType: strings.ToLower(dataType),
+			unsigned: strings.Contains(strings.ToLower(columnType), "unsigned"),
+		}
+		if col.dataType == "enum" || col.dataType == "set" {
+			col.labels = parseEnumLabels(columnType)
+		}
+		tbl.columns = append(tbl.columns, col)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(tbl.columns) == 0 {
+		return nil, fmt.Errorf("table %q not found", table)
+	}
+
+	rows, err = db.QueryContext(ctx, "SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY' ORDER BY ORDINAL_POSITION", tbl.schema, tbl.name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up primary key of table %q: %w", table, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tbl.primaryKey = append(tbl.primaryKey, name)
+	}
+	return tbl, rows.Err()
+}
+
+func (t *cdcTable) identifier() string {
+	return fmt.Sprintf("`%s`.`%s`", strings.ReplaceAll(t.schema, "`", "``"), strings.ReplaceAll(t.name, "`", "``"))
+}
+
+var truncateRegexp = regexp.MustCompile("(?is)^\\s*TRUNCATE\\s+(?:TABLE\\s+)?(?:`?([^`.\\s]+)`?\\.)?`?([^`.\\s;]+)`?")
+
+// truncatedBy returns true if the statement in a query event truncates the table.
+func (t *cdcTable) truncatedBy(schema, query string) bool {
+	m := truncateRegexp.FindStringSubmatch(query)
+	if m == nil {
+		return false
+	}
+	if m[1] != "" {
+		schema = m[1]
+	}
+	return schema == t.schema && m[2] == t.name
+}
+
+// parseEnumLabels parses the labels from an enum or set column type, e.g. "enum('a','b')".
+func parseEnumLabels(columnType string) []string {
+	start := strings.IndexByte(columnType, '(')
+	end := strings.LastIndexByte(columnType, ')')
+	if start < 0 || end < start {
+		return nil
+	}
+	s := columnType[start+1 : end]
+
+	var labels []string
+	var sb strings.Builder
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\'' && !quoted:
+			quoted = true
+		case s[i] == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			sb.WriteByte('\'')
+			i++
+		case s[i] == '\'':
+			quoted = false
+			labels = append(labels, sb.String())
+			sb.Reset()
+		case quoted:
+			sb.WriteByte(s[i])
+		}
+	}
+	return labels
+}
+
+// dsn returns the DSN from the source properties or the connector's config.
+func (c *connection) dsn(dsn string) (string, error) {
+	if dsn != "" {
+		return dsn, nil
+	}
+	if dsn, ok := c.config["dsn"].(string); ok && dsn != "" {
+		return dsn, nil
+	}
+	return "", fmt.Errorf("the property 'dsn' is required for MySQL. Provide 'dsn' in the YAML properties or pass '--var connector.mysql.dsn=...' to 'rill start'")
+}
+
+// replicaServerID returns the default replica server ID for a model.
+// The high bit is set to avoid conflicts with the small IDs that are usually assigned to servers.
+func replicaServerID(instanceID, model string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(instanceID + "/" + model))
+	return h.Sum32() | 1<<31
+}
@@ -63,7 +63,9 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 	}
 	// actual db connection is opened during query
 	return &connection{
-		config: config,
+		config:     config,
+		instanceID: instanceID,
+		logger:     logger,
 	}, nil
 }
 
@@ -80,7 +82,9 @@ func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any
 }
 
 type connection struct {
-	config map[string]any
+	config     map[string]any
+	instanceID string
+	logger     *zap.Logger
 }
 
 // Ping implements drivers.Handle.
@@ -150,7 +154,17 @@ func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
 
 // AsModelExecutor implements drivers.Handle.
 func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
-	return nil, false
+	if opts.InputHandle != c {
+		return nil, false
+	}
+	if _, ok := opts.PreliminaryInputProperties["cdc"]; !ok {
+		return nil, false
+	}
+	olap, ok := opts.OutputHandle.AsOLAP(instanceID)
+	if !ok || olap.Dialect() != drivers.DialectDuckDB {
+		return nil, false
+	}
+	return &cdcExecutor{c: c, olap: olap}, true
 }
 
 // AsModelManager implements drivers.Handle.
@@ -14,8 +14,10 @@ type Notifier interface {
 
 type AlertStatus struct {
 	// TODO: Remove ToEmail, ToName once email notifier is created
-	ToEmail        string
-	ToName         string
+	ToEmail string
+	ToName  string
+	// Name is the resource name of the alert. Notifiers can use it to correlate notifications for the same alert.
+	Name           string
 	Title          string
 	ExecutionTime  time.Time
 	Status         runtimev1.AssertionStatus
@@ -27,10 +29,31 @@ type AlertStatus struct {
 }
 
 type ScheduledReport struct {
+	// Name is the resource name of the report.
+	Name           string
 	Title          string
 	ReportTime     time.Time
 	DownloadFormat string
 	OpenLink       string
 	DownloadLink   string
 	EditLink       string
+	// Attachment is the exported report data. It is nil if attachments are not enabled for the report or if the export exceeded the size limit.
+	Attachment *ReportAttachment
+	// Preview contains the first rows of the report data formatted for display. It is nil if previews are not enabled for the report.
+	Preview *ReportPreview
+}
+
+// ReportAttachment is an exported file attached to a scheduled report.
+type ReportAttachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// ReportPreview is a table of formatted values from the first rows of a scheduled report.
+type ReportPreview struct {
+	Columns []string
+	Rows    [][]string
+	// Truncated is true if the report contains more rows than the preview.
+	Truncated bool
 }
@@ -36,6 +36,12 @@ type FileIterator interface {
 type ObjectStoreModelOutputProperties struct {
 	Path   string     `mapstructure:"path"`
 	Format FileFormat `mapstructure:"format"`
+	// Compression is the compression codec for the output files. Supported values depend on the format and executor.
+	// Executors that don't support it must return an error if it is set.
+	Compression string `mapstructure:"compression"`
+	// PartitionBy is a list of columns to partition the output files by, using Hive-style directories (e.g. "path/country=DK/").
+	// Executors that don't support it must return an error if it is set.
+	PartitionBy []string `mapstructure:"partition_by"`
 }
 
 type ObjectStoreModelResultProperties struct {
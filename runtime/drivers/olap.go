@@ -35,7 +35,7 @@ type OLAPStore interface {
 	InformationSchema() InformationSchema
 
 	CreateTableAsSelect(ctx context.Context, name string, view bool, sql string, tableOpts map[string]any) error
-	InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy IncrementalStrategy, uniqueKey []string) error
+	InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy IncrementalStrategy, uniqueKey []string, partitionBy string) error
 	DropTable(ctx context.Context, name string, view bool) error
 	RenameTable(ctx context.Context, name, newName string, view bool) error
 	AddTableColumn(ctx context.Context, tableName, columnName string, typ string) error
@@ -163,6 +163,11 @@ const (
 	IncrementalStrategyUnspecified IncrementalStrategy = ""
 	IncrementalStrategyAppend      IncrementalStrategy = "append"
 	IncrementalStrategyMerge       IncrementalStrategy = "merge"
+	// IncrementalStrategyDeleteInsert deletes the rows in the partitions (given by a partition expression) present in the new data before inserting it.
+	IncrementalStrategyDeleteInsert IncrementalStrategy = "delete+insert"
+	// IncrementalStrategyReplacePartition atomically replaces the partitions present in the new data.
+	// OLAPs without native partitions implement it like IncrementalStrategyDeleteInsert.
+	IncrementalStrategyReplacePartition IncrementalStrategy = "replace_partition"
 )
 
 // Dialect enumerates OLAP query languages.
@@ -174,6 +179,8 @@ const (
 	DialectDruid
 	DialectClickHouse
 	DialectPinot
+	DialectPostgres
+	DialectStarRocks
 )
 
 func (d Dialect) String() string {
@@ -188,13 +195,19 @@ func (d Dialect) String() string {
 		return "clickhouse"
 	case DialectPinot:
 		return "pinot"
+	case DialectPostgres:
+		return "postgres"
+	case DialectStarRocks:
+		return "starrocks"
 	default:
 		panic("not implemented")
 	}
 }
 
+// CanPivot returns true if the dialect can execute pivot queries natively.
+// DuckDB supports the PIVOT statement, and ClickHouse supports pivoting using conditional aggregation.
 func (d Dialect) CanPivot() bool {
-	return d == DialectDuckDB
+	return d == DialectDuckDB || d == DialectClickHouse
 }
 
 // EscapeIdentifier returns an escaped SQL identifier in the dialect.
@@ -202,6 +215,10 @@ func (d Dialect) EscapeIdentifier(ident string) string {
 	if ident == "" {
 		return ident
 	}
+	if d == DialectStarRocks {
+		// StarRocks uses MySQL-style identifiers
+		return fmt.Sprintf("`%s`", strings.ReplaceAll(ident, "`", "``"))
+	}
 	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(ident, "\"", "\"\"")) // nolint:gocritic // Because SQL escaping is different
 }
 
@@ -232,19 +249,19 @@ func (d Dialect) ConvertToDateTruncSpecifier(grain runtimev1.TimeGrain) string {
 		str = "YEAR"
 	}
 
-	if d == DialectClickHouse {
+	if d == DialectClickHouse || d == DialectStarRocks {
 		return strings.ToLower(str)
 	}
 	return str
 }
 
 func (d Dialect) SupportsILike() bool {
-	return d != DialectDruid && d != DialectPinot
+	return d != DialectDruid && d != DialectPinot && d != DialectStarRocks
 }
 
 // RequiresCastForLike returns true if the dialect requires an expression used in a LIKE or ILIKE condition to explicitly be cast to type TEXT.
 func (d Dialect) RequiresCastForLike() bool {
-	return d == DialectClickHouse
+	return d == DialectClickHouse || d == DialectPostgres
 }
 
 // EscapeTable returns an esacped fully qualified table name
@@ -276,10 +293,10 @@ func (d Dialect) DimensionSelect(db, dbSchema, table string, dim *runtimev1.Metr
 	sel := fmt.Sprintf(`%s as %s`, unnestColName, colName)
 	if dim.Expression == "" {
 		// select "unnested_colName" as "colName" ... FROM "mv_table", LATERAL UNNEST("mv_table"."colName") tbl_name("unnested_colName") ...
-		return sel, fmt.Sprintf(`, LATERAL UNNEST(%s.%s) %s(%s)`, d.EscapeTable(db, dbSchema, table), colName, unnestTableName, unnestColName)
+		return sel, ", " + d.unnestFrom(fmt.Sprintf("%s.%s", d.EscapeTable(db, dbSchema, table), colName), unnestTableName, unnestColName)
 	}
 
-	return sel, fmt.Sprintf(`, LATERAL UNNEST(%s) %s(%s)`, dim.Expression, unnestTableName, unnestColName)
+	return sel, ", " + d.unnestFrom(dim.Expression, unnestTableName, unnestColName)
 }
 
 func (d Dialect) DimensionSelectPair(db, dbSchema, table string, dim *runtimev1.MetricsViewSpec_DimensionV2) (expr, alias, unnestClause string) {
@@ -292,10 +309,10 @@ func (d Dialect) DimensionSelectPair(db, dbSchema, table string, dim *runtimev1.
 	unnestTableName := tempName("tbl")
 	if dim.Expression == "" {
 		// select "unnested_colName" as "colName" ... FROM "mv_table", LATERAL UNNEST("mv_table"."colName") tbl_name("unnested_colName") ...
-		return unnestColName, colName, fmt.Sprintf(`, LATERAL UNNEST(%s.%s) %s(%s)`, d.EscapeTable(db, dbSchema, table), colName, unnestTableName, unnestColName)
+		return unnestColName, colName, ", " + d.unnestFrom(fmt.Sprintf("%s.%s", d.EscapeTable(db, dbSchema, table), colName), unnestTableName, unnestColName)
 	}
 
-	return unnestColName, colName, fmt.Sprintf(`, LATERAL UNNEST(%s) %s(%s)`, dim.Expression, unnestTableName, unnestColName)
+	return unnestColName, colName, ", " + d.unnestFrom(dim.Expression, unnestTableName, unnestColName)
 }
 
 func (d Dialect) LateralUnnest(expr, tableAlias, colName string) (tbl string, auto bool, err error) {
@@ -303,7 +320,17 @@ func (d Dialect) LateralUnnest(expr, tableAlias, colName string) (tbl string, au
 		return "", true, nil
 	}
 
-	return fmt.Sprintf(`LATERAL UNNEST(%s) %s(%s)`, expr, tableAlias, d.EscapeIdentifier(colName)), false, nil
+	return d.unnestFrom(expr, tableAlias, d.EscapeIdentifier(colName)), false, nil
+}
+
+// unnestFrom returns a FROM item that unnests an array expression into a table with a single column.
+// The column name must already be escaped.
+func (d Dialect) unnestFrom(expr, tableAlias, colName string) string {
+	if d == DialectStarRocks {
+		// StarRocks implicitly joins table functions laterally
+		return fmt.Sprintf(`unnest(%s) AS %s(%s)`, expr, tableAlias, colName)
+	}
+	return fmt.Sprintf(`LATERAL UNNEST(%s) %s(%s)`, expr, tableAlias, colName)
 }
 
 func (d Dialect) AutoUnnest(expr string) string {
@@ -329,6 +356,9 @@ func (d Dialect) SafeDivideExpression(numExpr, denExpr string) string {
 	switch d {
 	case DialectDruid:
 		return fmt.Sprintf("SAFE_DIVIDE(%s, CAST(%s AS DOUBLE))", numExpr, denExpr)
+	case DialectPostgres:
+		// Postgres errors on division by zero instead of returning NULL or Inf
+		return fmt.Sprintf("(%s)/NULLIF(CAST(%s AS DOUBLE PRECISION), 0)", numExpr, denExpr)
 	default:
 		return fmt.Sprintf("(%s)/CAST(%s AS DOUBLE)", numExpr, denExpr)
 	}
@@ -339,7 +369,7 @@ func (d Dialect) OrderByExpression(name string, desc bool) string {
 	if desc {
 		res += " DESC"
 	}
-	if d == DialectDuckDB {
+	if d == DialectDuckDB || d == DialectPostgres || d == DialectStarRocks {
 		res += " NULLS LAST"
 	}
 	return res
@@ -349,6 +379,9 @@ func (d Dialect) JoinOnExpression(lhs, rhs string) string {
 	if d == DialectClickHouse {
 		return fmt.Sprintf("isNotDistinctFrom(%s, %s)", lhs, rhs)
 	}
+	if d == DialectStarRocks {
+		return fmt.Sprintf("%s <=> %s", lhs, rhs)
+	}
 	return fmt.Sprintf("%s IS NOT DISTINCT FROM %s", lhs, rhs)
 }
 
@@ -456,6 +489,52 @@ func (d Dialect) DateTruncExpr(dim *runtimev1.MetricsViewSpec_DimensionV2, grain
 		// TODO: Handle tz instead of ignoring it.
 		// TODO: Handle firstDayOfWeek and firstMonthOfYear. NOTE: We currently error when configuring these for Pinot in runtime/validate.go.
 		return fmt.Sprintf("ToDateTime(date_trunc('%s', %s, 'MILLISECONDS', '%s'), 'yyyy-MM-dd''T''HH:mm:ss''Z''')", specifier, expr, tz), nil
+	case DialectPostgres:
+		var shift string
+		if grain == runtimev1.TimeGrain_TIME_GRAIN_WEEK && firstDayOfWeek > 1 {
+			offset := 8 - firstDayOfWeek
+			shift = fmt.Sprintf("%d DAY", offset)
+		} else if grain == runtimev1.TimeGrain_TIME_GRAIN_YEAR && firstMonthOfYear > 1 {
+			offset := 13 - firstMonthOfYear
+			shift = fmt.Sprintf("%d MONTH", offset)
+		}
+
+		if tz == "" {
+			if shift == "" {
+				return fmt.Sprintf("date_trunc('%s', %s::TIMESTAMP)::TIMESTAMP", specifier, expr), nil
+			}
+			return fmt.Sprintf("date_trunc('%s', %s::TIMESTAMP + INTERVAL '%s')::TIMESTAMP - INTERVAL '%s'", specifier, expr, shift, shift), nil
+		}
+
+		// Truncate the local time in the time zone and convert it back to an absolute timestamp
+		if shift == "" {
+			return fmt.Sprintf("timezone('%s', date_trunc('%s', timezone('%s', %s::TIMESTAMPTZ)))", tz, specifier, tz, expr), nil
+		}
+		return fmt.Sprintf("timezone('%s', date_trunc('%s', timezone('%s', %s::TIMESTAMPTZ) + INTERVAL '%s') - INTERVAL '%s')", tz, specifier, tz, expr, shift, shift), nil
+	case DialectStarRocks:
+		var shift string
+		if grain == runtimev1.TimeGrain_TIME_GRAIN_WEEK && firstDayOfWeek > 1 {
+			offset := 8 - firstDayOfWeek
+			shift = fmt.Sprintf("%d DAY", offset)
+		} else if grain == runtimev1.TimeGrain_TIME_GRAIN_YEAR && firstMonthOfYear > 1 {
+			offset := 13 - firstMonthOfYear
+			shift = fmt.Sprintf("%d MONTH", offset)
+		}
+
+		// StarRocks' DATETIME has no time zone, so timestamps are assumed to be in UTC
+		if tz != "" {
+			expr = fmt.Sprintf("convert_tz(%s, 'UTC', '%s')", expr, tz)
+		}
+		var res string
+		if shift == "" {
+			res = fmt.Sprintf("date_trunc('%s', %s)", specifier, expr)
+		} else {
+			res = fmt.Sprintf("date_trunc('%s', %s + INTERVAL %s) - INTERVAL %s", specifier, expr, shift, shift)
+		}
+		if tz != "" {
+			res = fmt.Sprintf("convert_tz(%s, '%s', 'UTC')", res, tz)
+		}
+		return res, nil
 	default:
 		return "", fmt.Errorf("unsupported dialect %q", d)
 	}
@@ -470,11 +549,63 @@ func (d Dialect) DateDiff(grain runtimev1.TimeGrain, t1, t2 time.Time) (string,
 		return fmt.Sprintf("TIMESTAMPDIFF(%q, TIME_PARSE('%s'), TIME_PARSE('%s'))", unit, t1.Format(time.RFC3339), t2.Format(time.RFC3339)), nil
 	case DialectDuckDB:
 		return fmt.Sprintf("DATEDIFF('%s', TIMESTAMP '%s', TIMESTAMP '%s')", unit, t1.Format(time.RFC3339), t2.Format(time.RFC3339)), nil
+	case DialectPostgres:
+		return postgresDateDiff(grain, fmt.Sprintf("TIMESTAMPTZ '%s'", t1.Format(time.RFC3339)), fmt.Sprintf("TIMESTAMPTZ '%s'", t2.Format(time.RFC3339)))
+	case DialectStarRocks:
+		return d.DateDiffExpr(grain, fmt.Sprintf("'%s'", t1.UTC().Format(time.DateTime)), fmt.Sprintf("'%s'", t2.UTC().Format(time.DateTime)))
+	default:
+		return "", fmt.Errorf("unsupported dialect %q", d)
+	}
+}
+
+// DateDiffExpr returns a SQL expression that computes the number of periods of the given grain between two time expressions.
+func (d Dialect) DateDiffExpr(grain runtimev1.TimeGrain, expr1, expr2 string) (string, error) {
+	unit := d.ConvertToDateTruncSpecifier(grain)
+	switch d {
+	case DialectClickHouse, DialectDuckDB:
+		return fmt.Sprintf("DATEDIFF('%s', %s, %s)", unit, expr1, expr2), nil
+	case DialectDruid:
+		return fmt.Sprintf("TIMESTAMPDIFF(%s, %s, %s)", unit, expr1, expr2), nil
+	case DialectPostgres:
+		return postgresDateDiff(grain, expr1, expr2)
+	case DialectStarRocks:
+		// TIMESTAMPDIFF counts whole periods, so the timestamps are truncated first to count the grain boundaries like DATEDIFF in DuckDB
+		return fmt.Sprintf("TIMESTAMPDIFF(%s, date_trunc('%s', %s), date_trunc('%s', %s))", strings.ToUpper(unit), unit, expr1, unit, expr2), nil
 	default:
 		return "", fmt.Errorf("unsupported dialect %q", d)
 	}
 }
 
+// postgresDateDiff returns a Postgres expression that counts the grain boundaries crossed between two timestamp expressions.
+// Postgres has no DATEDIFF function, so it's emulated to match the semantics of DATEDIFF in DuckDB.
+func postgresDateDiff(grain runtimev1.TimeGrain, expr1, expr2 string) (string, error) {
+	var seconds string
+	switch grain {
+	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
+		seconds = "0.001"
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		seconds = "1"
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		seconds = "60"
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		seconds = "3600"
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		seconds = "86400"
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		seconds = "604800"
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return fmt.Sprintf("CAST((EXTRACT(YEAR FROM %[2]s) - EXTRACT(YEAR FROM %[1]s)) * 12 + EXTRACT(MONTH FROM %[2]s) - EXTRACT(MONTH FROM %[1]s) AS BIGINT)", expr1, expr2), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER:
+		return fmt.Sprintf("CAST((EXTRACT(YEAR FROM %[2]s) - EXTRACT(YEAR FROM %[1]s)) * 4 + EXTRACT(QUARTER FROM %[2]s) - EXTRACT(QUARTER FROM %[1]s) AS BIGINT)", expr1, expr2), nil
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return fmt.Sprintf("CAST(EXTRACT(YEAR FROM %[2]s) - EXTRACT(YEAR FROM %[1]s) AS BIGINT)", expr1, expr2), nil
+	default:
+		return "", fmt.Errorf("unsupported time grain %q", grain)
+	}
+	unit := DialectPostgres.ConvertToDateTruncSpecifier(grain)
+	return fmt.Sprintf("CAST(EXTRACT(EPOCH FROM date_trunc('%[1]s', %[3]s) - date_trunc('%[1]s', %[2]s)) / %[4]s AS BIGINT)", unit, expr1, expr2, seconds), nil
+}
+
 func druidTimeFloorSpecifier(grain runtimev1.TimeGrain) string {
 	switch grain {
 	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
@@ -0,0 +1,245 @@
+package olapwriter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// DeletedColumn is a column added to tables that mirror a source table with change data capture (CDC).
+// Deleted rows are merged into the table with the column set to true, and then removed.
+const DeletedColumn = "_rill_cdc_deleted"
+
+// _changesBatchSize is the number of rows to buffer before flushing when applying changes.
+// It also bounds the number of changes a ChangeSet created by ExecuteChanges buffers before they are applied.
+const _changesBatchSize = 10000
+
+// ChangeSet collects the changes to a table captured since the previous run.
+// It only keeps the last change for each unique key, since the merge strategy requires unique keys in the new data.
+type ChangeSet struct {
+	uniqueKey []string
+	index     map[string]int
+	rows      []map[string]any
+	truncated bool
+	deletes   bool
+	// apply applies and resets the buffered changes when there are _changesBatchSize of them. If nil, all the changes are buffered.
+	apply func(ctx context.Context, cs *ChangeSet) error
+}
+
+// NewChangeSet creates a ChangeSet for a table with the given unique key.
+func NewChangeSet(uniqueKey []string) *ChangeSet {
+	return &ChangeSet{
+		uniqueKey: uniqueKey,
+		index:     make(map[string]int),
+	}
+}
+
+// Len returns the number of rows to apply.
+func (c *ChangeSet) Len() int {
+	return len(c.rows)
+}
+
+// Upsert records an inserted or updated row.
+func (c *ChangeSet) Upsert(ctx context.Context, row map[string]any) error {
+	row[DeletedColumn] = false
+	return c.set(ctx, row)
+}
+
+// Delete records a deleted row. Only the unique key columns of the row are used.
+func (c *ChangeSet) Delete(ctx context.Context, row map[string]any) error {
+	tombstone := make(map[string]any, len(c.uniqueKey)+1)
+	for _, k := range c.uniqueKey {
+		tombstone[k] = row[k]
+	}
+	tombstone[DeletedColumn] = true
+	c.deletes = true
+	return c.set(ctx, tombstone)
+}
+
+// Truncate records that all the rows of the table were deleted.
+// It discards the changes recorded so far, since they are superseded by the truncation.
+func (c *ChangeSet) Truncate() {
+	c.reset()
+	c.truncated = true
+}
+
+func (c *ChangeSet) reset() {
+	c.index = make(map[string]int)
+	c.rows = nil
+	c.truncated = false
+	c.deletes = false
+}
+
+func (c *ChangeSet) set(ctx context.Context, row map[string]any) error {
+	vals := make([]any, len(c.uniqueKey))
+	for i, k := range c.uniqueKey {
+		v, ok := row[k]
+		if !ok {
+			return fmt.Errorf("change is missing unique key column %q", k)
+		}
+		vals[i] = v
+	}
+	key, err := json.Marshal(vals)
+	if err != nil {
+		return fmt.Errorf("failed to encode unique key: %w", err)
+	}
+
+	if i, ok := c.index[string(key)]; ok {
+		c.rows[i] = row
+		return nil
+	}
+	c.index[string(key)] = len(c.rows)
+	c.rows = append(c.rows, row)
+
+	if c.apply != nil && len(c.rows) >= _changesBatchSize {
+		return c.apply(ctx, c)
+	}
+	return nil
+}
+
+// ExecuteChanges executes a model that mirrors a source table using change data capture.
+// Non-incremental runs call snapshot to write all the rows of the source table.
+// Incremental runs call changes to collect the changes since the previous run, which are applied with the merge strategy on uniqueKey.
+// The changes are applied in batches of _changesBatchSize, and a truncation is applied by replacing the table with the changes after it.
+// The output table has an extra DeletedColumn, which is always false after a run completes.
+//
+// The caller is responsible for setting the result's incremental state to the replication position that the changes were read up to.
+// Since changes are merged on the unique key, it's safe to apply changes that are already reflected in the table,
+// so a run that fails after applying some batches is completed by reading the changes again from the previous position.
+func ExecuteChanges(ctx context.Context, olap drivers.OLAPStore, opts *drivers.ModelExecuteOptions, uniqueKey []string, snapshot func(ctx context.Context, add func(row map[string]any) error) error, changes func(ctx context.Context, cs *ChangeSet) error) (*drivers.ModelResult, error) {
+	if len(uniqueKey) == 0 {
+		return nil, errors.New("change data capture requires a unique key")
+	}
+
+	outputProps := &modelOutputProperties{}
+	if err := mapstructure.WeakDecode(opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if outputProps.IncrementalStrategy != drivers.IncrementalStrategyUnspecified && outputProps.IncrementalStrategy != drivers.IncrementalStrategyMerge {
+		return nil, fmt.Errorf("change data capture requires the %q incremental strategy", drivers.IncrementalStrategyMerge)
+	}
+	table := outputProps.Table
+	if table == "" {
+		table = opts.ModelName
+	}
+
+	// Apply the changes with the merge strategy on the unique key
+	props := make(map[string]any, len(opts.OutputProperties)+2)
+	for k, v := range opts.OutputProperties {
+		props[k] = v
+	}
+	props["incremental_strategy"] = drivers.IncrementalStrategyMerge
+	props["unique_key"] = uniqueKey
+	execOpts := *opts
+	execOpts.OutputProperties = props
+
+	if !execOpts.IncrementalRun {
+		return ExecuteModel(ctx, olap, &execOpts, func(ctx context.Context, w *BatchWriter) error {
+			return snapshot(ctx, func(row map[string]any) error {
+				row[DeletedColumn] = false
+				return addRow(ctx, w, row)
+			})
+		})
+	}
+
+	cs := NewChangeSet(uniqueKey)
+	cs.apply = func(ctx context.Context, cs *ChangeSet) error {
+		defer cs.reset()
+		if cs.truncated {
+			return replaceTable(ctx, olap, table, execOpts.OutputProperties, cs.rows)
+		}
+		if len(cs.rows) == 0 {
+			return nil
+		}
+
+		_, err := ExecuteModel(ctx, olap, &execOpts, func(ctx context.Context, w *BatchWriter) error {
+			for _, row := range cs.rows {
+				if err := addRow(ctx, w, row); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// Remove the tombstones of deleted rows
+		if cs.deletes {
+			err = olap.Exec(ctx, &drivers.Statement{
+				Query:    fmt.Sprintf("DELETE FROM %s WHERE %s", olap.Dialect().EscapeIdentifier(table), olap.Dialect().EscapeIdentifier(DeletedColumn)),
+				Priority: 1,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to remove deleted rows: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := changes(ctx, cs); err != nil {
+		return nil, err
+	}
+	if err := cs.apply(ctx, cs); err != nil {
+		return nil, err
+	}
+
+	return modelResult(opts.OutputConnector, table, outputProps.Table == "")
+}
+
+// replaceTable replaces the rows of a table, which applies a truncation together with the changes after it.
+// The rows are written to a staging table with the same columns, which then replaces the table. Tombstones are skipped.
+func replaceTable(ctx context.Context, olap drivers.OLAPStore, table string, tableOpts map[string]any, rows []map[string]any) error {
+	stagingTable := stagingTableNameFor(table)
+	if t, err := olap.InformationSchema().Lookup(ctx, "", "", stagingTable); err == nil {
+		_ = olap.DropTable(ctx, stagingTable, t.View)
+	}
+
+	w := &BatchWriter{
+		olap:      olap,
+		table:     stagingTable,
+		create:    true,
+		tableOpts: tableOpts,
+		likeTable: table,
+	}
+	err := func() error {
+		for _, row := range rows {
+			if row[DeletedColumn] == true {
+				continue
+			}
+			if err := addRow(ctx, w, row); err != nil {
+				return err
+			}
+		}
+		if err := w.Flush(ctx); err != nil {
+			return err
+		}
+		if w.create {
+			// There are no rows left, so create an empty table
+			return olap.CreateTableAsSelect(ctx, stagingTable, false, fmt.Sprintf("SELECT * FROM %s LIMIT 0", olap.Dialect().EscapeIdentifier(table)), tableOpts)
+		}
+		return nil
+	}()
+	if err == nil {
+		err = olapForceRenameTable(ctx, olap, stagingTable, table)
+	}
+	if err != nil {
+		_ = olap.DropTable(ctx, stagingTable, false)
+		return fmt.Errorf("failed to replace truncated table: %w", err)
+	}
+	return nil
+}
+
+// addRow adds a row to the writer and flushes it when it has buffered _changesBatchSize rows.
+func addRow(ctx context.Context, w *BatchWriter, row map[string]any) error {
+	if err := w.Add(ctx, row); err != nil {
+		return err
+	}
+	if w.Len() >= _changesBatchSize {
+		return w.Flush(ctx)
+	}
+	return nil
+}
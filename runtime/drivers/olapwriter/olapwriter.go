@@ -0,0 +1,359 @@
+// Package olapwriter implements model executors that write rows produced in Go (such as messages or API responses) to a DuckDB or ClickHouse table.
+package olapwriter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// _clickhouseMaxBatchBytes caps the size of a batch for ClickHouse, where the data is inlined in the query and subject to max_query_size (256 KiB by default).
+const _clickhouseMaxBatchBytes = 200 * 1024
+
+// ErrNoRows is returned by ExecuteModel when a non-incremental run didn't write any rows, so the output table could not be created.
+var ErrNoRows = errors.New("no rows to write")
+
+// SupportsDialect returns true if rows can be written to an OLAP with the given dialect.
+func SupportsDialect(d drivers.Dialect) bool {
+	return d == drivers.DialectDuckDB || d == drivers.DialectClickHouse
+}
+
+// modelOutputProperties are the output properties that ExecuteModel uses.
+// They are a subset of the output properties of the DuckDB and ClickHouse drivers, which are also passed through when creating the output table.
+type modelOutputProperties struct {
+	Table               string                      `mapstructure:"table"`
+	Materialize         *bool                       `mapstructure:"materialize"`
+	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+	UniqueKey           []string                    `mapstructure:"unique_key"`
+	PartitionBy         string                      `mapstructure:"partition_by"`
+}
+
+// modelResultProperties matches the result properties of the DuckDB and ClickHouse drivers, so their model managers can manage the output table.
+type modelResultProperties struct {
+	Table         string `mapstructure:"table"`
+	View          bool   `mapstructure:"view"`
+	UsedModelName bool   `mapstructure:"used_model_name"`
+}
+
+// ExecuteModel executes a model by calling write with a BatchWriter for the model's output table in olap.
+// Non-incremental runs (re)create the table, optionally in a staging table that replaces the table when done.
+// Incremental runs stage the new data in a separate table that is inserted into the table (using the model's incremental strategy) when done.
+// This ensures a run that fails after writing some batches doesn't leave partial data in the table,
+// so callers can safely persist their progress (e.g. offsets) in the incremental state only when the run succeeds.
+//
+// It returns ErrNoRows if a non-incremental run didn't write any rows. The caller is responsible for setting the result's incremental state.
+func ExecuteModel(ctx context.Context, olap drivers.OLAPStore, opts *drivers.ModelExecuteOptions, write func(ctx context.Context, w *BatchWriter) error) (*drivers.ModelResult, error) {
+	outputProps := &modelOutputProperties{}
+	if err := mapstructure.WeakDecode(opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if outputProps.Materialize != nil && !*outputProps.Materialize {
+		return nil, fmt.Errorf("models must be materialized when reading from %q", opts.InputConnector)
+	}
+	if outputProps.IncrementalStrategy == drivers.IncrementalStrategyUnspecified {
+		outputProps.IncrementalStrategy = drivers.IncrementalStrategyAppend
+	}
+
+	usedModelName := false
+	if outputProps.Table == "" {
+		outputProps.Table = opts.ModelName
+		usedModelName = true
+	}
+	tableName := outputProps.Table
+
+	var insertTable string
+	if !opts.IncrementalRun {
+		insertTable = tableName
+		if opts.Env.StageChanges {
+			insertTable = stagingTableNameFor(tableName)
+		}
+	} else {
+		insertTable = stagingTableNameFor(tableName)
+		defer func() { _ = olap.DropTable(context.Background(), insertTable, false) }()
+	}
+
+	// NOTE: This intentionally drops the end table if not staging changes.
+	if t, err := olap.InformationSchema().Lookup(ctx, "", "", insertTable); err == nil {
+		_ = olap.DropTable(ctx, insertTable, t.View)
+	}
+
+	w := &BatchWriter{
+		olap:      olap,
+		table:     insertTable,
+		create:    true,
+		tableOpts: opts.OutputProperties,
+	}
+	if opts.IncrementalRun {
+		w.likeTable = tableName
+	}
+	err := write(ctx, w)
+	if err == nil {
+		err = w.Flush(ctx)
+	}
+	if err != nil {
+		if !opts.IncrementalRun {
+			_ = olap.DropTable(ctx, insertTable, false)
+		}
+		return nil, err
+	}
+
+	if !opts.IncrementalRun {
+		// We were supposed to create the table, but didn't get any data
+		if w.create {
+			return nil, ErrNoRows
+		}
+
+		// Give the caller a chance to reject the staged result before it replaces the previous result
+		if insertTable != tableName && opts.PrePromote != nil {
+			err = opts.PrePromote(ctx, &drivers.ModelResult{Connector: opts.OutputConnector, Table: insertTable})
+			if err != nil {
+				_ = olap.DropTable(ctx, insertTable, false)
+				return nil, err
+			}
+		}
+
+		if insertTable != tableName {
+			err = olapForceRenameTable(ctx, olap, insertTable, tableName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to rename staged model: %w", err)
+			}
+		}
+	} else if !w.create {
+		// There is new data, so we insert it into the table
+		err := olap.InsertTableAsSelect(ctx, tableName, fmt.Sprintf("SELECT * FROM %s", olap.Dialect().EscapeIdentifier(insertTable)), true, true, outputProps.IncrementalStrategy, outputProps.UniqueKey, outputProps.PartitionBy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to incrementally insert into table: %w", err)
+		}
+	}
+
+	return modelResult(opts.OutputConnector, tableName, usedModelName)
+}
+
+// modelResult returns the result of a model that wrote to the given table.
+func modelResult(connector, tableName string, usedModelName bool) (*drivers.ModelResult, error) {
+	resultProps := &modelResultProperties{
+		Table:         tableName,
+		UsedModelName: usedModelName,
+	}
+	resultPropsMap := map[string]interface{}{}
+	err := mapstructure.WeakDecode(resultProps, &resultPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	return &drivers.ModelResult{
+		Connector:  connector,
+		Properties: resultPropsMap,
+		Table:      tableName,
+	}, nil
+}
+
+// BatchWriter buffers rows and writes them to a table in the OLAP.
+// If create is true, the table is created from the first batch.
+type BatchWriter struct {
+	olap      drivers.OLAPStore
+	table     string
+	create    bool
+	tableOpts map[string]any
+	// likeTable is an optional table to copy the columns from when creating the table.
+	likeTable string
+
+	buf  bytes.Buffer
+	rows int
+	// structure is the ClickHouse column structure of the table, used to parse the inlined data.
+	structure string
+}
+
+// Len returns the number of buffered rows.
+// For ClickHouse, it returns a large number if the buffer is close to the max query size, which causes callers to flush.
+func (w *BatchWriter) Len() int {
+	if w.olap.Dialect() == drivers.DialectClickHouse && w.buf.Len() >= _clickhouseMaxBatchBytes {
+		return _clickhouseMaxBatchBytes
+	}
+	return w.rows
+}
+
+// Add buffers a row. Values must be serializable to JSON.
+func (w *BatchWriter) Add(ctx context.Context, row map[string]any) error {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return fmt.Errorf("failed to encode row: %w", err)
+	}
+
+	// Flush before the ClickHouse query would exceed the max query size
+	if w.olap.Dialect() == drivers.DialectClickHouse && w.rows > 0 && w.buf.Len()+len(data) > _clickhouseMaxBatchBytes {
+		err := w.Flush(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	w.buf.Write(data)
+	w.buf.WriteByte('\n')
+	w.rows++
+	return nil
+}
+
+// Flush writes the buffered rows to the table.
+func (w *BatchWriter) Flush(ctx context.Context) error {
+	if w.rows == 0 {
+		return nil
+	}
+
+	var err error
+	switch w.olap.Dialect() {
+	case drivers.DialectDuckDB:
+		err = w.flushDuckDB(ctx)
+	case drivers.DialectClickHouse:
+		err = w.flushClickHouse(ctx)
+	default:
+		err = fmt.Errorf("unsupported dialect %q", w.olap.Dialect().String())
+	}
+	if err != nil {
+		return err
+	}
+
+	w.buf.Reset()
+	w.rows = 0
+	return nil
+}
+
+// flushDuckDB writes the batch to a newline-delimited JSON file and reads it into the table.
+func (w *BatchWriter) flushDuckDB(ctx context.Context) error {
+	dir, err := os.MkdirTemp("", "rill-batch-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "batch.ndjson")
+	err = os.WriteFile(path, w.buf.Bytes(), 0o600)
+	if err != nil {
+		return err
+	}
+
+	qry := fmt.Sprintf("SELECT * FROM read_ndjson_auto(%s)", drivers.DialectDuckDB.EscapeStringValue(path))
+	if w.create && w.likeTable != "" {
+		// Create the table with the columns of likeTable, so the staged rows have the same types as the rows they are merged with
+		err = w.olap.CreateTableAsSelect(ctx, w.table, false, fmt.Sprintf("SELECT * FROM %s LIMIT 0", drivers.DialectDuckDB.EscapeIdentifier(w.likeTable)), w.tableOpts)
+		if err != nil {
+			return fmt.Errorf("failed to create table: %w", err)
+		}
+		w.create = false
+	}
+	if w.create {
+		err = w.olap.CreateTableAsSelect(ctx, w.table, false, qry, w.tableOpts)
+		if err != nil {
+			return fmt.Errorf("failed to create table: %w", err)
+		}
+		w.create = false
+		return nil
+	}
+
+	err = w.olap.InsertTableAsSelect(ctx, w.table, qry, true, true, drivers.IncrementalStrategyAppend, nil, "")
+	if err != nil {
+		return fmt.Errorf("failed to insert into table: %w", err)
+	}
+	return nil
+}
+
+// flushClickHouse inlines the batch in the query using the format table function.
+// The table is created with columns inferred from the first batch, and the data is always parsed with the table's structure,
+// so the JSON keys are matched to the columns by name.
+func (w *BatchWriter) flushClickHouse(ctx context.Context) error {
+	data := clickhouseStringLiteral(w.buf.String())
+
+	if w.create {
+		from := fmt.Sprintf("format(JSONEachRow, %s)", data)
+		if w.likeTable != "" {
+			from = drivers.DialectClickHouse.EscapeIdentifier(w.likeTable)
+		}
+		err := w.olap.CreateTableAsSelect(ctx, w.table, false, fmt.Sprintf("SELECT * FROM %s LIMIT 0", from), w.tableOpts)
+		if err != nil {
+			return fmt.Errorf("failed to create table: %w", err)
+		}
+		w.create = false
+	}
+
+	if w.structure == "" {
+		structure, err := clickhouseTableStructure(ctx, w.olap, w.table)
+		if err != nil {
+			return err
+		}
+		w.structure = structure
+	}
+
+	qry := fmt.Sprintf("SELECT * FROM format(JSONEachRow, %s, %s)", clickhouseStringLiteral(w.structure), data)
+	err := w.olap.InsertTableAsSelect(ctx, w.table, qry, false, true, drivers.IncrementalStrategyAppend, nil, "")
+	if err != nil {
+		return fmt.Errorf("failed to insert into table: %w", err)
+	}
+	return nil
+}
+
+// clickhouseTableStructure returns the columns of a ClickHouse table in the format expected by the format table function, e.g. "a Int64, b String".
+func clickhouseTableStructure(ctx context.Context, olap drivers.OLAPStore, table string) (string, error) {
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    "SELECT name, type FROM system.columns WHERE database = currentDatabase() AND table = ? ORDER BY position",
+		Args:     []any{table},
+		Priority: 100,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get columns of table %q: %w", table, err)
+	}
+	defer res.Close()
+
+	var cols []string
+	for res.Next() {
+		var name, typ string
+		if err := res.Scan(&name, &typ); err != nil {
+			return "", err
+		}
+		cols = append(cols, fmt.Sprintf("%s %s", drivers.DialectClickHouse.EscapeIdentifier(name), typ))
+	}
+	if err := res.Err(); err != nil {
+		return "", err
+	}
+	if len(cols) == 0 {
+		return "", fmt.Errorf("table %q not found", table)
+	}
+	return strings.Join(cols, ", "), nil
+}
+
+// clickhouseStringLiteral returns a ClickHouse string literal. Unlike standard SQL, ClickHouse interprets backslash escapes in string literals.
+func clickhouseStringLiteral(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `\'`)
+	return "'" + s + "'"
+}
+
+func stagingTableNameFor(table string) string {
+	return "__rill_tmp_model_" + table
+}
+
+// olapForceRenameTable renames a table in the OLAP connector, overwriting any existing table with the new name.
+func olapForceRenameTable(ctx context.Context, olap drivers.OLAPStore, fromName, toName string) error {
+	if fromName == toName {
+		return nil
+	}
+
+	// Renaming a table to the same name with different casing is not supported. Workaround by renaming to a temporary name first.
+	if strings.EqualFold(fromName, toName) {
+		tmpName := fmt.Sprintf("__rill_tmp_rename_TABLE_%s", toName)
+		err := olap.RenameTable(ctx, fromName, tmpName, false)
+		if err != nil {
+			return err
+		}
+		fromName = tmpName
+	}
+
+	return olap.RenameTable(ctx, fromName, toName, false)
+}
@@ -0,0 +1,83 @@
+package olapwriter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/olapwriter"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+)
+
+func TestExecuteModelIncrementalFailure(t *testing.T) {
+	ctx := context.Background()
+	handle, err := drivers.Open("duckdb", "default", map[string]any{"dsn": ":memory:"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer handle.Close()
+	olap, ok := handle.AsOLAP("default")
+	require.True(t, ok)
+
+	opts := func(incremental bool) *drivers.ModelExecuteOptions {
+		return &drivers.ModelExecuteOptions{
+			ModelExecutorOptions: &drivers.ModelExecutorOptions{
+				Env:             &drivers.ModelEnv{StageChanges: true},
+				ModelName:       "events",
+				OutputConnector: "duckdb",
+			},
+			OutputProperties: map[string]any{"materialize": true},
+			Incremental:      true,
+			IncrementalRun:   incremental,
+		}
+	}
+	write := func(from, to int, failAfter bool) func(ctx context.Context, w *olapwriter.BatchWriter) error {
+		return func(ctx context.Context, w *olapwriter.BatchWriter) error {
+			for i := from; i < to; i++ {
+				err := w.Add(ctx, map[string]any{"id": i})
+				if err != nil {
+					return err
+				}
+				err = w.Flush(ctx)
+				if err != nil {
+					return err
+				}
+			}
+			if failAfter {
+				return errors.New("failed")
+			}
+			return nil
+		}
+	}
+
+	// Initial run
+	_, err = olapwriter.ExecuteModel(ctx, olap, opts(false), write(0, 3, false))
+	require.NoError(t, err)
+	require.Equal(t, 3, countRows(t, olap))
+
+	// An incremental run that fails after flushing some batches doesn't write any rows
+	_, err = olapwriter.ExecuteModel(ctx, olap, opts(true), write(3, 6, true))
+	require.ErrorContains(t, err, "failed")
+	require.Equal(t, 3, countRows(t, olap))
+	_, err = olap.InformationSchema().Lookup(ctx, "", "", "__rill_tmp_model_events")
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+
+	// Retrying the run writes the rows once
+	_, err = olapwriter.ExecuteModel(ctx, olap, opts(true), write(3, 6, false))
+	require.NoError(t, err)
+	require.Equal(t, 6, countRows(t, olap))
+}
+
+func countRows(t *testing.T, olap drivers.OLAPStore) int {
+	res, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT COUNT(*) FROM events"})
+	require.NoError(t, err)
+	defer res.Close()
+
+	var n int
+	require.True(t, res.Next())
+	require.NoError(t, res.Scan(&n))
+	return n
+}
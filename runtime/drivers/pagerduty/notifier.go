@@ -0,0 +1,235 @@
+package pagerduty
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+const (
+	defaultEventsURL = "https://events.pagerduty.com"
+	defaultSeverity  = "error"
+	// requestTimeout bounds each request since the notifier interface doesn't pass a context.
+	requestTimeout = 10 * time.Second
+)
+
+type notifier struct {
+	instanceID string
+	eventsURL  string
+	props      *NotifierProperties
+	client     *http.Client
+}
+
+type NotifierProperties struct {
+	// RoutingKeys overrides the routing key configured for the connector.
+	RoutingKeys []string `mapstructure:"routing_keys"`
+	// Severity of the triggered alerts. One of "critical", "error", "warning" or "info".
+	Severity string `mapstructure:"severity"`
+}
+
+func newNotifier(instanceID string, conf *configProperties, propsMap map[string]any) (*notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+	if len(props.RoutingKeys) == 0 {
+		if conf.RoutingKey == "" {
+			return nil, fmt.Errorf("pagerduty routing key is not configured, consider setting a routing key for the connector")
+		}
+		props.RoutingKeys = []string{conf.RoutingKey}
+	}
+
+	eventsURL := conf.EventsURL
+	if eventsURL == "" {
+		eventsURL = defaultEventsURL
+	}
+
+	n := &notifier{
+		instanceID: instanceID,
+		eventsURL:  strings.TrimSuffix(eventsURL, "/"),
+		props:      props,
+		client:     &http.Client{Timeout: requestTimeout},
+	}
+	return n, nil
+}
+
+// SendAlertStatus triggers an incident for failed and errored alerts, and resolves it when the alert recovers.
+// All events for an alert use the same dedup key, so repeated failures are grouped into one incident.
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	e := &event{
+		DedupKey: n.dedupKey(s),
+		Client:   "Rill",
+		Links:    links(eventLink{Text: "Open in browser", Href: s.OpenLink}, eventLink{Text: "Edit alert", Href: s.EditLink}),
+	}
+
+	switch s.Status {
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
+		if !s.IsRecover {
+			// There is no incident to resolve
+			return nil
+		}
+		e.EventAction = "resolve"
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL:
+		e.EventAction = "trigger"
+		e.Payload = &eventPayload{
+			Summary:       fmt.Sprintf("%s: alert triggered", s.Title),
+			Source:        "rill",
+			Severity:      n.props.Severity,
+			Timestamp:     s.ExecutionTime.Format(time.RFC3339),
+			Component:     s.Name,
+			CustomDetails: s.FailRow,
+		}
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
+		e.EventAction = "trigger"
+		e.Payload = &eventPayload{
+			Summary:       fmt.Sprintf("%s: alert failed to evaluate", s.Title),
+			Source:        "rill",
+			Severity:      n.props.Severity,
+			Timestamp:     s.ExecutionTime.Format(time.RFC3339),
+			Component:     s.Name,
+			CustomDetails: map[string]any{"error": s.ExecutionError},
+		}
+	default:
+		return fmt.Errorf("unknown assertion status: %v", s.Status)
+	}
+
+	return n.send("/v2/enqueue", e)
+}
+
+// SendScheduledReport sends a change event, which shows up in the service's timeline without paging anyone.
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	e := &event{
+		Payload: &eventPayload{
+			Summary:   fmt.Sprintf("%s: report for %s is ready", s.Title, s.ReportTime.Format(time.RFC1123)),
+			Source:    "rill",
+			Timestamp: s.ReportTime.Format(time.RFC3339),
+			CustomDetails: map[string]any{
+				"download_format": s.DownloadFormat,
+			},
+		},
+		Links: links(
+			eventLink{Text: "Open in browser", Href: s.OpenLink},
+			eventLink{Text: fmt.Sprintf("Download %s", s.DownloadFormat), Href: s.DownloadLink},
+			eventLink{Text: "Edit report", Href: s.EditLink},
+		),
+	}
+	return n.send("/v2/change/enqueue", e)
+}
+
+// dedupKey returns a key that identifies an alert across evaluations.
+func (n *notifier) dedupKey(s *drivers.AlertStatus) string {
+	name := s.Name
+	if name == "" {
+		name = s.Title
+	}
+	return fmt.Sprintf("rill:%s:%s", n.instanceID, name)
+}
+
+func (n *notifier) send(path string, e *event) error {
+	for _, key := range n.props.RoutingKeys {
+		e.RoutingKey = key
+		body, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("pagerduty payload error: %w", err)
+		}
+		err = n.post(n.eventsURL+path, body)
+		if err != nil {
+			return fmt.Errorf("pagerduty api error: %w", err)
+		}
+	}
+	return nil
+}
+
+func (n *notifier) post(url string, body []byte) error {
+	resp, err := n.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// event is an event for the PagerDuty Events API v2.
+// Change events use the same shape, but without an action and dedup key.
+type event struct {
+	RoutingKey  string        `json:"routing_key"`
+	EventAction string        `json:"event_action,omitempty"`
+	DedupKey    string        `json:"dedup_key,omitempty"`
+	Client      string        `json:"client,omitempty"`
+	Payload     *eventPayload `json:"payload,omitempty"`
+	Links       []eventLink   `json:"links,omitempty"`
+}
+
+type eventPayload struct {
+	Summary       string         `json:"summary"`
+	Source        string         `json:"source"`
+	Severity      string         `json:"severity,omitempty"`
+	Timestamp     string         `json:"timestamp,omitempty"`
+	Component     string         `json:"component,omitempty"`
+	CustomDetails map[string]any `json:"custom_details,omitempty"`
+}
+
+type eventLink struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+// links returns the links that have a URL. The URLs are empty outside of Rill Cloud.
+func links(ls ...eventLink) []eventLink {
+	var res []eventLink
+	for _, l := range ls {
+		if l.Href != "" {
+			res = append(res, l)
+		}
+	}
+	return res
+}
+
+// IsValidSeverity returns true if s is a severity supported by PagerDuty.
+func IsValidSeverity(s string) bool {
+	switch s {
+	case "critical", "error", "warning", "info":
+		return true
+	default:
+		return false
+	}
+}
+
+func EncodeProps(routingKeys []string, severity string) map[string]any {
+	props := map[string]any{
+		"routing_keys": pbutil.ToSliceAny(routingKeys),
+	}
+	if severity != "" {
+		props["severity"] = severity
+	}
+	return props
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	if props.Severity == "" {
+		props.Severity = defaultSeverity
+	}
+	if !IsValidSeverity(props.Severity) {
+		return nil, fmt.Errorf("invalid pagerduty severity %q", props.Severity)
+	}
+	return props, nil
+}
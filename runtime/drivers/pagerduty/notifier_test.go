@@ -0,0 +1,127 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+type request struct {
+	path  string
+	event map[string]any
+}
+
+func newTestServer(t *testing.T) (*httptest.Server, *[]request) {
+	var reqs []request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+		reqs = append(reqs, request{path: r.URL.Path, event: e})
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &reqs
+}
+
+func TestSendAlertStatus(t *testing.T) {
+	srv, reqs := newTestServer(t)
+
+	n, err := newNotifier("inst", &configProperties{RoutingKey: "key", EventsURL: srv.URL + "/"}, EncodeProps(nil, "critical"))
+	require.NoError(t, err)
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		Name:          "my_alert",
+		Title:         "My alert",
+		ExecutionTime: ts,
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"country": "DK"},
+		OpenLink:      "https://example.com/open",
+	})
+	require.NoError(t, err)
+
+	// A pass that isn't a recovery doesn't send anything
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		Name:          "my_alert",
+		Title:         "My alert",
+		ExecutionTime: ts,
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_PASS,
+	})
+	require.NoError(t, err)
+
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		Name:          "my_alert",
+		Title:         "My alert",
+		ExecutionTime: ts.Add(time.Hour),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_PASS,
+		IsRecover:     true,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, *reqs, 2)
+	trigger, resolve := (*reqs)[0], (*reqs)[1]
+
+	require.Equal(t, "/v2/enqueue", trigger.path)
+	require.Equal(t, "key", trigger.event["routing_key"])
+	require.Equal(t, "trigger", trigger.event["event_action"])
+	require.Equal(t, "rill:inst:my_alert", trigger.event["dedup_key"])
+	require.Equal(t, map[string]any{
+		"summary":        "My alert: alert triggered",
+		"source":         "rill",
+		"severity":       "critical",
+		"timestamp":      "2024-01-02T03:04:05Z",
+		"component":      "my_alert",
+		"custom_details": map[string]any{"country": "DK"},
+	}, trigger.event["payload"])
+	require.Equal(t, []any{map[string]any{"href": "https://example.com/open", "text": "Open in browser"}}, trigger.event["links"])
+
+	// The resolve event must use the same dedup key as the trigger
+	require.Equal(t, "/v2/enqueue", resolve.path)
+	require.Equal(t, "resolve", resolve.event["event_action"])
+	require.Equal(t, trigger.event["dedup_key"], resolve.event["dedup_key"])
+}
+
+func TestSendScheduledReport(t *testing.T) {
+	srv, reqs := newTestServer(t)
+
+	// Routing keys in the notifier properties override the connector's routing key
+	n, err := newNotifier("inst", &configProperties{RoutingKey: "key", EventsURL: srv.URL}, EncodeProps([]string{"a", "b"}, ""))
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{
+		Name:           "weekly",
+		Title:          "Weekly",
+		ReportTime:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		DownloadFormat: "CSV",
+		DownloadLink:   "https://example.com/download",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, *reqs, 2)
+	for i, key := range []string{"a", "b"} {
+		req := (*reqs)[i]
+		require.Equal(t, "/v2/change/enqueue", req.path)
+		require.Equal(t, key, req.event["routing_key"])
+		require.NotContains(t, req.event, "event_action")
+		require.Equal(t, []any{map[string]any{"href": "https://example.com/download", "text": "Download CSV"}}, req.event["links"])
+	}
+}
+
+func TestNewNotifier(t *testing.T) {
+	_, err := newNotifier("inst", &configProperties{}, EncodeProps(nil, ""))
+	require.ErrorContains(t, err, "routing key is not configured")
+
+	_, err = newNotifier("inst", &configProperties{RoutingKey: "key"}, EncodeProps(nil, "urgent"))
+	require.ErrorContains(t, err, `invalid pagerduty severity "urgent"`)
+
+	n, err := newNotifier("inst", &configProperties{RoutingKey: "key"}, map[string]any{})
+	require.NoError(t, err)
+	require.Equal(t, "https://events.pagerduty.com", n.eventsURL)
+	require.Equal(t, "error", n.props.Severity)
+}
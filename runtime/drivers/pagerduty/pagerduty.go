@@ -0,0 +1,167 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.uber.org/zap"
+)
+
+var spec = drivers.Spec{
+	DisplayName: "PagerDuty",
+	Description: "PagerDuty Notifier",
+	ConfigProperties: []*drivers.PropertySpec{
+		{
+			Key:         "routing_key",
+			Type:        drivers.StringPropertyType,
+			Description: "Integration key of a PagerDuty service using the Events API v2",
+			Secret:      true,
+		},
+		{
+			Key:         "events_url",
+			Type:        drivers.StringPropertyType,
+			Description: "Base URL of the Events API. Defaults to https://events.pagerduty.com.",
+		},
+	},
+	ImplementsNotifier: true,
+}
+
+func init() {
+	drivers.Register("pagerduty", driver{})
+	drivers.RegisterAsConnector("pagerduty", driver{})
+}
+
+type driver struct{}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if instanceID == "" {
+		return nil, fmt.Errorf("pagerduty driver can't be shared")
+	}
+	conf := &configProperties{}
+	err := mapstructure.Decode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &handle{
+		instanceID: instanceID,
+		config:     conf,
+		logger:     logger,
+	}
+	return conn, nil
+}
+
+func (d driver) Drop(config map[string]any, logger *zap.Logger) error {
+	return nil
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, props map[string]any, logger *zap.Logger) (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+type handle struct {
+	instanceID string
+	config     *configProperties
+	logger     *zap.Logger
+}
+
+var _ drivers.Handle = &handle{}
+
+// Ping implements drivers.Handle.
+func (h *handle) Ping(ctx context.Context) error {
+	return drivers.ErrNotImplemented
+}
+
+func (h *handle) Driver() string {
+	return "pagerduty"
+}
+
+func (h *handle) Config() map[string]any {
+	return map[string]any{}
+}
+
+func (h *handle) Migrate(ctx context.Context) error {
+	return nil
+}
+
+func (h *handle) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+func (h *handle) Close() error {
+	return nil
+}
+
+func (h *handle) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+func (h *handle) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+func (h *handle) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsWarehouse implements drivers.Handle.
+func (h *handle) AsWarehouse() (drivers.Warehouse, bool) {
+	return nil, false
+}
+
+func (h *handle) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (h *handle) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+func (h *handle) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+func (h *handle) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return newNotifier(h.instanceID, h.config, properties)
+}
+
+type configProperties struct {
+	RoutingKey string `mapstructure:"routing_key"`
+	EventsURL  string `mapstructure:"events_url"`
+}
@@ -0,0 +1,187 @@
+package pinot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	_segmentGenerationTaskType = "SegmentGenerationAndPushTask"
+	_taskPollInterval          = 2 * time.Second
+	_taskDeleteTimeout         = 10 * time.Second
+)
+
+// executeTask runs an ad hoc minion task for an offline table and returns the task's name.
+func (c *connection) executeTask(ctx context.Context, tableNameWithType, taskName string, taskConfigs map[string]string) (string, error) {
+	body := map[string]any{
+		"taskType":    _segmentGenerationTaskType,
+		"tableName":   tableNameWithType,
+		"taskName":    taskName,
+		"taskConfigs": taskConfigs,
+	}
+	var res map[string]string
+	err := c.controllerRequest(ctx, http.MethodPost, "/tasks/execute", body, &res)
+	if err != nil {
+		return "", fmt.Errorf("failed to submit ingestion task: %w", err)
+	}
+	// The response maps the table name to the name of the scheduled task
+	name, ok := res[tableNameWithType]
+	if !ok || name == "" {
+		return "", fmt.Errorf("failed to submit ingestion task: no task was scheduled for table %q", tableNameWithType)
+	}
+	return name, nil
+}
+
+// waitForTask polls the state of a minion task until it completes or fails.
+// If ctx is cancelled, it deletes the task before returning.
+func (c *connection) waitForTask(ctx context.Context, taskName string) error {
+	path := fmt.Sprintf("/tasks/task/%s/state", url.PathEscape(taskName))
+	for first := true; ; first = false {
+		if !first {
+			select {
+			case <-ctx.Done():
+				c.deleteTask(taskName)
+				return ctx.Err()
+			case <-time.After(_taskPollInterval):
+			}
+		}
+
+		var state string
+		err := c.controllerRequest(ctx, http.MethodGet, path, nil, &state)
+		if err != nil {
+			if ctx.Err() != nil {
+				c.deleteTask(taskName)
+				return ctx.Err()
+			}
+			return fmt.Errorf("failed to get state of ingestion task %q: %w", taskName, err)
+		}
+
+		switch state {
+		case "COMPLETED":
+			return nil
+		case "FAILED", "ABORTED", "TIMED_OUT", "STOPPED":
+			return fmt.Errorf("ingestion task %q ended in state %s, see the minion logs in Pinot for details", taskName, state)
+		}
+	}
+}
+
+// deleteTask deletes a minion task, which stops it if it is running. It is best-effort.
+func (c *connection) deleteTask(taskName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), _taskDeleteTimeout)
+	defer cancel()
+	_ = c.controllerRequest(ctx, http.MethodDelete, fmt.Sprintf("/tasks/task/%s?forceDelete=true", url.PathEscape(taskName)), nil, nil)
+}
+
+// offlineSegments returns the names of the segments of an offline table.
+func (c *connection) offlineSegments(ctx context.Context, table string) ([]string, error) {
+	var res []map[string][]string
+	err := c.controllerRequest(ctx, http.MethodGet, fmt.Sprintf("/segments/%s?type=OFFLINE", url.PathEscape(table)), nil, &res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list segments of table %q: %w", table, err)
+	}
+	var names []string
+	for _, m := range res {
+		names = append(names, m["OFFLINE"]...)
+	}
+	return names, nil
+}
+
+// startReplaceSegments starts replacing segments of an offline table using Pinot's segment lineage, and returns the ID of the lineage entry.
+// Queries keep reading the segments in from until endReplaceSegments is called, and the segments in to are not queried until then, so they can be pushed first.
+// Unfinished replacements, such as those of a previous run that failed, are cleaned up.
+func (c *connection) startReplaceSegments(ctx context.Context, table string, from, to []string) (string, error) {
+	if from == nil {
+		from = []string{}
+	}
+	body := map[string][]string{
+		"segmentsFrom": from,
+		"segmentsTo":   to,
+	}
+	var res struct {
+		SegmentLineageEntryID string `json:"segmentLineageEntryId"`
+	}
+	err := c.controllerRequest(ctx, http.MethodPost, fmt.Sprintf("/segments/%s/startReplaceSegments?type=OFFLINE&forceCleanup=true", url.PathEscape(table)), body, &res)
+	if err != nil {
+		return "", fmt.Errorf("failed to start replacing segments of table %q: %w", table, err)
+	}
+	if res.SegmentLineageEntryID == "" {
+		return "", fmt.Errorf("failed to start replacing segments of table %q: no segment lineage entry was created", table)
+	}
+	return res.SegmentLineageEntryID, nil
+}
+
+// endReplaceSegments completes a replacement started with startReplaceSegments, which atomically switches queries to the new segments.
+// Pinot's retention manager deletes the replaced segments afterwards.
+func (c *connection) endReplaceSegments(ctx context.Context, table, lineageEntryID string) error {
+	err := c.controllerRequest(ctx, http.MethodPost, fmt.Sprintf("/segments/%s/endReplaceSegments?type=OFFLINE&segmentLineageEntryId=%s", url.PathEscape(table), url.QueryEscape(lineageEntryID)), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to end replacing segments of table %q: %w", table, err)
+	}
+	return nil
+}
+
+// revertReplaceSegments reverts a replacement started with startReplaceSegments, so queries keep reading the previous segments. It is best-effort.
+func (c *connection) revertReplaceSegments(table, lineageEntryID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), _taskDeleteTimeout)
+	defer cancel()
+	_ = c.controllerRequest(ctx, http.MethodPost, fmt.Sprintf("/segments/%s/revertReplaceSegments?type=OFFLINE&segmentLineageEntryId=%s&forceRevert=true", url.PathEscape(table), url.QueryEscape(lineageEntryID)), nil, nil)
+}
+
+// controllerRequest sends a request with an optional JSON body to the controller and decodes the JSON response into out (if not nil).
+func (c *connection) controllerRequest(ctx context.Context, method, path string, body, out any) error {
+	reqBody := io.Reader(http.NoBody)
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.baseURL, "/")+path, reqBody)
+	if err != nil {
+		return err
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		// The controller returns errors as {"code": 400, "error": "..."}
+		var res struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(respBody, &res) == nil && res.Error != "" {
+			return fmt.Errorf("unexpected status code: %d: %s", resp.StatusCode, res.Error)
+		}
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	if out != nil && len(respBody) > 0 {
+		err = json.Unmarshal(respBody, out)
+		if err != nil {
+			return fmt.Errorf("invalid response from controller: %w", err)
+		}
+	}
+
+	return nil
+}
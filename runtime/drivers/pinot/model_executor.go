@@ -0,0 +1,351 @@
+package pinot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/s3"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// ModelInputProperties are the input properties of a model that ingests files from object storage into Pinot.
+// They match the result properties of object store model outputs, so they are usually set by a stage connector.
+type ModelInputProperties struct {
+	// Path is a file, directory or glob in object storage, e.g. "s3://bucket/path/".
+	Path string `mapstructure:"path"`
+	// Format is the format of the files. It is inferred from the path's extension if not set.
+	Format drivers.FileFormat `mapstructure:"format"`
+}
+
+func (p *ModelInputProperties) Validate() error {
+	if p.Path == "" {
+		return errors.New(`missing property "path"`)
+	}
+	if p.Format == drivers.FileFormatUnspecified {
+		ext := fileutil.FullExt(p.Path)
+		switch {
+		case strings.Contains(ext, ".parquet"):
+			p.Format = drivers.FileFormatParquet
+		case strings.Contains(ext, ".csv"):
+			p.Format = drivers.FileFormatCSV
+		case strings.Contains(ext, ".json"):
+			p.Format = drivers.FileFormatJSON
+		}
+	}
+	switch p.Format {
+	case drivers.FileFormatParquet, drivers.FileFormatCSV, drivers.FileFormatJSON:
+	case drivers.FileFormatUnspecified:
+		return fmt.Errorf("could not infer the format of %q, set the format explicitly", p.Path)
+	default:
+		return fmt.Errorf("unsupported format %q: must be parquet, csv or json", p.Format)
+	}
+	return nil
+}
+
+// ModelOutputProperties are the output properties of a model that ingests data into Pinot.
+type ModelOutputProperties struct {
+	// Table is the offline table to ingest into. It defaults to the model name. The table and its schema must already exist.
+	Table string `mapstructure:"table"`
+	// TaskConfigs are additional configs for the SegmentGenerationAndPushTask, such as "input.fs.prop.region".
+	// They take precedence over the configs set by Rill.
+	TaskConfigs map[string]string `mapstructure:"task_configs"`
+	// IncrementalStrategy must be "append" (the default) for incremental models.
+	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+}
+
+func (p *ModelOutputProperties) Validate() error {
+	switch p.IncrementalStrategy {
+	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend:
+		p.IncrementalStrategy = drivers.IncrementalStrategyAppend
+	default:
+		return fmt.Errorf("invalid incremental strategy %q: pinot only supports %q", p.IncrementalStrategy, drivers.IncrementalStrategyAppend)
+	}
+	return nil
+}
+
+type ModelResultProperties struct {
+	Table         string `mapstructure:"table"`
+	UsedModelName bool   `mapstructure:"used_model_name"`
+}
+
+// objectStoreToSelfExecutor ingests files from S3 or GCS into an offline Pinot table with an ad hoc SegmentGenerationAndPushTask.
+// It requires a minion in the Pinot cluster. It is usually used with a stage connector, which writes the output of a model in another OLAP to object storage first.
+//
+// Every run creates segments with unique names. On incremental runs, the new segments are appended to the table.
+// On full runs, the new segments replace the segments that existed before the run using Pinot's segment lineage (see replaceSegments).
+type objectStoreToSelfExecutor struct {
+	store drivers.Handle
+	c     *connection
+}
+
+var _ drivers.ModelExecutor = &objectStoreToSelfExecutor{}
+
+func (e *objectStoreToSelfExecutor) Concurrency(desired int) (int, bool) {
+	if desired > 1 {
+		return 0, false
+	}
+	return 1, true
+}
+
+func (e *objectStoreToSelfExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
+	if opts.SplitRun {
+		return nil, errors.New("models that output to pinot do not support splits")
+	}
+
+	inputProps := &ModelInputProperties{}
+	if err := mapstructure.WeakDecode(opts.InputProperties, inputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if err := inputProps.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+
+	outputProps := &ModelOutputProperties{}
+	if err := mapstructure.WeakDecode(opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if err := outputProps.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid output properties: %w", err)
+	}
+
+	usedModelName := false
+	if outputProps.Table == "" {
+		outputProps.Table = opts.ModelName
+		usedModelName = true
+	}
+	table := outputProps.Table
+
+	// The run ID makes the names of the new segments unique, so they never replace segments that we delete after a full run
+	runID := fmt.Sprintf("rill_%d", time.Now().UnixMilli())
+	taskConfigs, err := e.taskConfigs(inputProps, runID)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range outputProps.TaskConfigs {
+		taskConfigs[k] = v
+	}
+
+	if opts.IncrementalRun {
+		err = e.runTasks(ctx, opts.ModelName, table, map[string]map[string]string{table + "_" + runID: taskConfigs})
+	} else {
+		err = e.replaceSegments(ctx, opts.ModelName, table, runID, inputProps, taskConfigs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resultProps := &ModelResultProperties{
+		Table:         table,
+		UsedModelName: usedModelName,
+	}
+	resultPropsMap := map[string]any{}
+	err = mapstructure.WeakDecode(resultProps, &resultPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	return &drivers.ModelResult{
+		Connector:  opts.OutputConnector,
+		Properties: resultPropsMap,
+		Table:      table,
+	}, nil
+}
+
+// replaceSegments replaces all the segments of the table with segments generated from the input files.
+// The names of the new segments must be known before they are pushed to register them with startReplaceSegments,
+// so it runs a task for each input file that generates a single segment with a fixed name.
+// Queries keep reading the previous segments until all the tasks have completed, and the replacement is reverted if a task fails.
+func (e *objectStoreToSelfExecutor) replaceSegments(ctx context.Context, model, table, runID string, props *ModelInputProperties, taskConfigs map[string]string) error {
+	files, err := e.inputFiles(ctx, props, taskConfigs["inputFormat"])
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no files found at %q", props.Path)
+	}
+	u, err := globutil.ParseBucketURL(props.Path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q: %w", props.Path, err)
+	}
+
+	tasks := make(map[string]map[string]string, len(files))
+	segments := make([]string, 0, len(files))
+	for i, f := range files {
+		segment := fmt.Sprintf("%s_%s_%d", table, runID, i)
+		configs := maps.Clone(taskConfigs)
+		configs["inputDirURI"] = dirURI(u, path.Dir(f.Path))
+		configs["includeFileNamePattern"] = "glob:**/" + escapeGlob(path.Base(f.Path))
+		configs["segmentNameGenerator.type"] = "fixed"
+		configs["segmentNameGenerator.configs.segment.name"] = segment
+		delete(configs, "segmentNameGenerator.configs.segment.name.postfix")
+		tasks[segment] = configs
+		segments = append(segments, segment)
+	}
+
+	previousSegments, err := e.c.offlineSegments(ctx, table)
+	if err != nil {
+		return err
+	}
+	lineageEntryID, err := e.c.startReplaceSegments(ctx, table, previousSegments, segments)
+	if err != nil {
+		return err
+	}
+
+	err = e.runTasks(ctx, model, table, tasks)
+	if err == nil {
+		err = e.c.endReplaceSegments(ctx, table, lineageEntryID)
+	}
+	if err != nil {
+		e.c.revertReplaceSegments(table, lineageEntryID)
+		return err
+	}
+	return nil
+}
+
+// runTasks submits ingestion tasks with the given names and configs and waits for them to complete.
+// If a task fails, the remaining tasks are deleted.
+func (e *objectStoreToSelfExecutor) runTasks(ctx context.Context, model, table string, tasks map[string]map[string]string) error {
+	var names []string
+	err := func() error {
+		for name, configs := range tasks {
+			taskName, err := e.c.executeTask(ctx, table+"_OFFLINE", name, configs)
+			if err != nil {
+				return err
+			}
+			names = append(names, taskName)
+			e.c.logger.Info("pinot: submitted ingestion task", zap.String("model", model), zap.String("table", table), zap.String("task_name", taskName), observability.ZapCtx(ctx))
+		}
+		for _, taskName := range names {
+			if err := e.c.waitForTask(ctx, taskName); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		for _, taskName := range names {
+			e.c.deleteTask(taskName)
+		}
+		return err
+	}
+	return nil
+}
+
+// inputFiles lists the files that the ingestion task for the input properties reads.
+func (e *objectStoreToSelfExecutor) inputFiles(ctx context.Context, props *ModelInputProperties, inputFormat string) ([]drivers.ObjectStoreEntry, error) {
+	store, ok := e.store.AsObjectStore()
+	if !ok {
+		return nil, fmt.Errorf("connector %q is not an object store", e.store.Driver())
+	}
+	u, err := globutil.ParseBucketURL(props.Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", props.Path, err)
+	}
+
+	glob := props.Path
+	if !fileutil.IsGlob(u.Path) && path.Ext(u.Path) == "" {
+		glob = strings.TrimSuffix(props.Path, "/") + "/**/*." + inputFormat
+	}
+	entries, err := store.ListObjects(ctx, map[string]any{"path": glob})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files at %q: %w", props.Path, err)
+	}
+
+	files := make([]drivers.ObjectStoreEntry, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir {
+			files = append(files, entry)
+		}
+	}
+	return files, nil
+}
+
+// taskConfigs returns the configs of a SegmentGenerationAndPushTask that reads the input files (see https://docs.pinot.apache.org/basics/data-import/batch-ingestion).
+// The minion reads the files with Pinot's file system plugins. Only S3 credentials are passed in the configs;
+// for GCS, the "input.fs.prop.projectId" and "input.fs.prop.gcpKey" configs must be set with the model's task_configs.
+func (e *objectStoreToSelfExecutor) taskConfigs(props *ModelInputProperties, runID string) (map[string]string, error) {
+	u, err := globutil.ParseBucketURL(props.Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", props.Path, err)
+	}
+
+	configs := map[string]string{
+		"segmentNameGenerator.type":                         "simple",
+		"segmentNameGenerator.configs.segment.name.postfix": runID,
+	}
+	switch props.Format {
+	case drivers.FileFormatParquet:
+		configs["inputFormat"] = "parquet"
+	case drivers.FileFormatCSV:
+		configs["inputFormat"] = "csv"
+	default:
+		configs["inputFormat"] = "json"
+	}
+
+	switch u.Scheme {
+	case "s3", "s3a":
+		configs["input.fs.className"] = "org.apache.pinot.plugin.filesystem.S3PinotFS"
+		conf := &s3.ConfigProperties{}
+		if err := mapstructure.WeakDecode(e.store.Config(), conf); err != nil {
+			return nil, err
+		}
+		configs["input.fs.prop.region"] = "us-east-1"
+		if conf.AccessKeyID != "" {
+			configs["input.fs.prop.accessKey"] = conf.AccessKeyID
+			configs["input.fs.prop.secretKey"] = conf.SecretAccessKey
+		}
+	case "gs":
+		configs["input.fs.className"] = "org.apache.pinot.plugin.filesystem.GcsPinotFS"
+	default:
+		return nil, fmt.Errorf("unsupported path %q: pinot can only ingest from s3:// and gs:// paths", props.Path)
+	}
+
+	// The task reads a directory, optionally filtered by a glob
+	base, pattern := doublestar.SplitPattern(u.Path)
+	switch {
+	case fileutil.IsGlob(pattern):
+		configs["inputDirURI"] = dirURI(u, base)
+		configs["includeFileNamePattern"] = "glob:**/" + pattern
+	case path.Ext(u.Path) != "":
+		configs["inputDirURI"] = dirURI(u, path.Dir(u.Path))
+		configs["includeFileNamePattern"] = "glob:**/" + path.Base(u.Path)
+	default:
+		configs["inputDirURI"] = props.Path
+		configs["includeFileNamePattern"] = "glob:**/*." + configs["inputFormat"]
+	}
+
+	return configs, nil
+}
+
+// escapeGlob escapes the special characters of a Java glob pattern, which Pinot uses to match file names.
+func escapeGlob(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`\*?[]{}`, r) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// dirURI returns the URI of a directory in the bucket of u. It ends with a slash.
+func dirURI(u *globutil.URL, dir string) string {
+	res := fmt.Sprintf("%s://%s/", u.Scheme, u.Host)
+	dir = strings.Trim(dir, "/")
+	if dir != "" && dir != "." {
+		res += dir + "/"
+	}
+	return res
+}
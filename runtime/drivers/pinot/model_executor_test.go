@@ -0,0 +1,200 @@
+package pinot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTaskConfigs(t *testing.T) {
+	s3, err := drivers.Open("s3", "default", map[string]any{"aws_access_key_id": "id", "aws_secret_access_key": "secret"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer s3.Close()
+	e := &objectStoreToSelfExecutor{store: s3}
+
+	configs, err := e.taskConfigs(&ModelInputProperties{Path: "s3://bucket/path/rill-tmp-123/", Format: drivers.FileFormatParquet}, "rill_1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"inputDirURI":               "s3://bucket/path/rill-tmp-123/",
+		"includeFileNamePattern":    "glob:**/*.parquet",
+		"inputFormat":               "parquet",
+		"input.fs.className":        "org.apache.pinot.plugin.filesystem.S3PinotFS",
+		"input.fs.prop.region":      "us-east-1",
+		"input.fs.prop.accessKey":   "id",
+		"input.fs.prop.secretKey":   "secret",
+		"segmentNameGenerator.type": "simple",
+		"segmentNameGenerator.configs.segment.name.postfix": "rill_1",
+	}, configs)
+
+	configs, err = e.taskConfigs(&ModelInputProperties{Path: "s3://bucket/data/2024-*.csv", Format: drivers.FileFormatCSV}, "rill_1")
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/data/", configs["inputDirURI"])
+	require.Equal(t, "glob:**/2024-*.csv", configs["includeFileNamePattern"])
+
+	configs, err = e.taskConfigs(&ModelInputProperties{Path: "s3://bucket/data/file.json", Format: drivers.FileFormatJSON}, "rill_1")
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/data/", configs["inputDirURI"])
+	require.Equal(t, "glob:**/file.json", configs["includeFileNamePattern"])
+
+	_, err = e.taskConfigs(&ModelInputProperties{Path: "azure://container/file.json", Format: drivers.FileFormatJSON}, "rill_1")
+	require.ErrorContains(t, err, "unsupported path")
+}
+
+func TestExecute(t *testing.T) {
+	var mu sync.Mutex
+	var tasks []map[string]any
+	var replacements []map[string][]string
+	var ended, reverted []string
+	states := map[string]string{}
+	failTasks := false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /tasks/execute", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		var task map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&task))
+		tasks = append(tasks, task)
+		name := "Task_SegmentGenerationAndPushTask_" + task["taskName"].(string)
+		states[name] = "COMPLETED"
+		if failTasks {
+			states[name] = "FAILED"
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]string{"events_OFFLINE": name}))
+	})
+	mux.HandleFunc("GET /tasks/task/{name}/state", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		require.NoError(t, json.NewEncoder(w).Encode(states[r.PathValue("name")]))
+	})
+	mux.HandleFunc("DELETE /tasks/task/{name}", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("GET /segments/events", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "OFFLINE", r.URL.Query().Get("type"))
+		_, _ = w.Write([]byte(`[{"OFFLINE":["events_0_1_0_rill_1"]}]`))
+	})
+	mux.HandleFunc("POST /segments/events/startReplaceSegments", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		require.Equal(t, "OFFLINE", r.URL.Query().Get("type"))
+		var req map[string][]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		replacements = append(replacements, req)
+		_, _ = w.Write([]byte(`{"segmentLineageEntryId":"lineage1"}`))
+	})
+	mux.HandleFunc("POST /segments/events/endReplaceSegments", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		ended = append(ended, r.URL.Query().Get("segmentLineageEntryId"))
+	})
+	mux.HandleFunc("POST /segments/events/revertReplaceSegments", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		reverted = append(reverted, r.URL.Query().Get("segmentLineageEntryId"))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	s3, err := drivers.Open("s3", "default", map[string]any{}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer s3.Close()
+	store := &fakeObjectStore{Handle: s3, files: []drivers.ObjectStoreEntry{
+		{Path: "rill-tmp-1/country=DK"},
+		{Path: "rill-tmp-1/country=DK/data.parquet"},
+		{Path: "rill-tmp-1/country=US/data.parquet"},
+	}}
+	store.files[0].IsDir = true
+
+	c := &connection{baseURL: srv.URL, logger: zap.NewNop()}
+	e := &objectStoreToSelfExecutor{store: store, c: c}
+
+	execOpts := &drivers.ModelExecutorOptions{ModelName: "events", OutputConnector: "pinot", InputHandle: store, OutputHandle: c}
+	res, err := e.Execute(context.Background(), &drivers.ModelExecuteOptions{
+		ModelExecutorOptions: execOpts,
+		InputProperties:      map[string]any{"path": "s3://bucket/rill-tmp-1/", "format": "parquet"},
+		OutputProperties:     map[string]any{"task_configs": map[string]any{"input.fs.prop.region": "eu-west-1"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "events", res.Table)
+	require.Equal(t, "s3://bucket/rill-tmp-1/**/*.parquet", store.listed)
+
+	// The full run runs a task per file that replaces the previous segments
+	require.Len(t, tasks, 2)
+	segments := make(map[string]string)
+	for _, task := range tasks {
+		require.Equal(t, "SegmentGenerationAndPushTask", task["taskType"])
+		require.Equal(t, "events_OFFLINE", task["tableName"])
+		taskConfigs := task["taskConfigs"].(map[string]any)
+		require.Equal(t, "eu-west-1", taskConfigs["input.fs.prop.region"])
+		require.Equal(t, "glob:**/data.parquet", taskConfigs["includeFileNamePattern"])
+		require.Equal(t, "fixed", taskConfigs["segmentNameGenerator.type"])
+		require.Equal(t, task["taskName"], taskConfigs["segmentNameGenerator.configs.segment.name"])
+		segments[taskConfigs["inputDirURI"].(string)] = taskConfigs["segmentNameGenerator.configs.segment.name"].(string)
+	}
+	require.Len(t, segments, 2)
+	require.Contains(t, segments, "s3://bucket/rill-tmp-1/country=DK/")
+	require.Contains(t, segments, "s3://bucket/rill-tmp-1/country=US/")
+	require.Len(t, replacements, 1)
+	require.Equal(t, []string{"events_0_1_0_rill_1"}, replacements[0]["segmentsFrom"])
+	require.ElementsMatch(t, []string{segments["s3://bucket/rill-tmp-1/country=DK/"], segments["s3://bucket/rill-tmp-1/country=US/"]}, replacements[0]["segmentsTo"])
+	require.Equal(t, []string{"lineage1"}, ended)
+
+	// Incremental runs append with a single task without replacing segments
+	tasks = nil
+	_, err = e.Execute(context.Background(), &drivers.ModelExecuteOptions{
+		ModelExecutorOptions: execOpts,
+		InputProperties:      map[string]any{"path": "s3://bucket/rill-tmp-2/", "format": "parquet"},
+		Incremental:          true,
+		IncrementalRun:       true,
+	})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "s3://bucket/rill-tmp-2/", tasks[0]["taskConfigs"].(map[string]any)["inputDirURI"])
+	require.Len(t, replacements, 1)
+
+	// A full run with a failed task reverts the replacement
+	mu.Lock()
+	failTasks = true
+	mu.Unlock()
+	_, err = e.Execute(context.Background(), &drivers.ModelExecuteOptions{
+		ModelExecutorOptions: execOpts,
+		InputProperties:      map[string]any{"path": "s3://bucket/rill-tmp-3/", "format": "parquet"},
+	})
+	require.ErrorContains(t, err, "FAILED")
+	require.Len(t, replacements, 2)
+	require.Equal(t, []string{"lineage1"}, ended)
+	require.Equal(t, []string{"lineage1"}, reverted)
+}
+
+func TestEscapeGlob(t *testing.T) {
+	require.Equal(t, "data.parquet", escapeGlob("data.parquet"))
+	require.Equal(t, `a\*b\?\[c\]\{d\}\\`, escapeGlob(`a*b?[c]{d}\`))
+}
+
+// fakeObjectStore wraps a connector to list the given files instead of the files in the bucket.
+type fakeObjectStore struct {
+	drivers.Handle
+	files  []drivers.ObjectStoreEntry
+	listed string
+}
+
+func (s *fakeObjectStore) AsObjectStore() (drivers.ObjectStore, bool) {
+	return s, true
+}
+
+func (s *fakeObjectStore) ListObjects(ctx context.Context, props map[string]any) ([]drivers.ObjectStoreEntry, error) {
+	s.listed = props["path"].(string)
+	return s.files, nil
+}
+
+func (s *fakeObjectStore) DownloadFiles(ctx context.Context, props map[string]any) (drivers.FileIterator, error) {
+	return nil, errors.New("not implemented")
+}
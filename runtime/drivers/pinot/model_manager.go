@@ -0,0 +1,39 @@
+package pinot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+var _ drivers.ModelManager = &connection{}
+
+// Rename implements drivers.ModelManager.
+// Pinot tables are created outside of Rill, so the model keeps ingesting into its current table.
+func (c *connection) Rename(ctx context.Context, res *drivers.ModelResult, newName string, env *drivers.ModelEnv) (*drivers.ModelResult, error) {
+	return res, nil
+}
+
+// Exists implements drivers.ModelManager.
+func (c *connection) Exists(ctx context.Context, res *drivers.ModelResult) (bool, error) {
+	segments, err := c.offlineSegments(ctx, res.Table)
+	if err != nil {
+		return false, err
+	}
+	return len(segments) > 0, nil
+}
+
+// Delete implements drivers.ModelManager.
+// Pinot tables are created outside of Rill and may contain data that Rill didn't ingest, so their segments are not deleted.
+func (c *connection) Delete(ctx context.Context, res *drivers.ModelResult) error {
+	return nil
+}
+
+// MergeSplitResults implements drivers.ModelManager.
+func (c *connection) MergeSplitResults(a, b *drivers.ModelResult) (*drivers.ModelResult, error) {
+	if a.Table != b.Table {
+		return nil, fmt.Errorf("cannot merge split results that output to different table names (%q != %q)", a.Table, b.Table)
+	}
+	return a, nil
+}
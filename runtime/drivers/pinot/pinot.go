@@ -163,6 +163,7 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 		config:  config,
 		baseURL: controller,
 		headers: headers,
+		logger:  logger,
 	}
 	return conn, nil
 }
@@ -184,6 +185,7 @@ type connection struct {
 	config  map[string]any
 	baseURL string
 	headers map[string]string
+	logger  *zap.Logger
 }
 
 // Ping implements drivers.Handle.
@@ -243,12 +245,19 @@ func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
 }
 
 func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	if opts.OutputHandle != c {
+		return nil, false
+	}
+	switch opts.InputHandle.Driver() {
+	case "s3", "gcs":
+		return &objectStoreToSelfExecutor{store: opts.InputHandle, c: c}, true
+	}
 	return nil, false
 }
 
 // AsModelManager implements drivers.Handle.
 func (c *connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
-	return nil, false
+	return c, true
 }
 
 func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
@@ -0,0 +1,442 @@
+package postgres
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/olapwriter"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// CDCInputProperties are the input properties of a model that mirrors a Postgres table using change data capture.
+type CDCInputProperties struct {
+	// DatabaseURL optionally overrides the connector's database_url.
+	DatabaseURL string `mapstructure:"database_url"`
+	// CDC configures change data capture.
+	CDC struct {
+		// Table is the table to mirror, optionally qualified with a schema, e.g. "public.orders".
+		Table string `mapstructure:"table"`
+		// Slot is the logical replication slot to read changes from. It defaults to a slot derived from the instance and model name.
+		Slot string `mapstructure:"slot"`
+		// Publication is the publication to read changes from. It defaults to the slot name and is created if it doesn't exist.
+		Publication string `mapstructure:"publication"`
+		// MaxChanges optionally limits the number of changes read from the slot in one execution.
+		MaxChanges int `mapstructure:"max_changes"`
+	} `mapstructure:"cdc"`
+}
+
+func (p *CDCInputProperties) Validate() error {
+	if p.CDC.Table == "" {
+		return errors.New(`missing property "cdc.table"`)
+	}
+	if p.CDC.MaxChanges < 0 {
+		return errors.New(`"cdc.max_changes" must be positive`)
+	}
+	return nil
+}
+
+// cdcExecutor mirrors a Postgres table into a DuckDB table using logical replication.
+// Full runs create a replication slot and copy a snapshot of the table.
+// Incremental runs read the changes from the slot with the pgoutput plugin and merge them into the table on the table's primary key.
+// The LSN that changes have been applied up to is persisted in the model's incremental state, and the slot is advanced to it after each run.
+type cdcExecutor struct {
+	c    *connection
+	olap drivers.OLAPStore
+}
+
+var _ drivers.ModelExecutor = &cdcExecutor{}
+
+func (e *cdcExecutor) Concurrency(desired int) (int, bool) {
+	if desired > 1 {
+		return 0, false
+	}
+	return 1, true
+}
+
+func (e *cdcExecutor) Execute(ctx context.Context, opts *drivers.ModelExecuteOptions) (*drivers.ModelResult, error) {
+	if opts.SplitRun {
+		return nil, errors.New("models that use change data capture do not support splits")
+	}
+
+	props := &CDCInputProperties{}
+	if err := mapstructure.WeakDecode(opts.InputProperties, props); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if err := props.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+	slot := props.CDC.Slot
+	if slot == "" {
+		slot = replicationSlotName(e.c.instanceID, opts.ModelName)
+	}
+	publication := props.CDC.Publication
+	if publication == "" {
+		publication = slot
+	}
+
+	// Changes are only read from the stored position on incremental runs
+	var startLSN pgLSN
+	if opts.IncrementalRun {
+		var err error
+		startLSN, err = lsnFromState(opts.IncrementalState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dsn, err := e.c.dsn(props.DatabaseURL)
+	if err != nil {
+		return nil, err
+	}
+	config, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	config.DefaultQueryExecMode = pgx.QueryExecModeSimpleProtocol
+	conn, err := pgx.ConnectConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close(context.Background())
+
+	tbl, err := lookupCDCTable(ctx, conn, props.CDC.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	// Merge on the output's unique key if set, otherwise on the table's primary key
+	uniqueKey := tbl.primaryKey
+	if uk, ok := opts.OutputProperties["unique_key"]; ok {
+		if err := mapstructure.WeakDecode(uk, &uniqueKey); err != nil {
+			return nil, fmt.Errorf("invalid unique_key: %w", err)
+		}
+	}
+	if len(uniqueKey) == 0 {
+		return nil, fmt.Errorf("table %q has no primary key: set the output property \"unique_key\" to mirror it", props.CDC.Table)
+	}
+
+	endLSN := startLSN
+	var n int
+	res, err := olapwriter.ExecuteChanges(ctx, e.olap, opts, uniqueKey,
+		func(ctx context.Context, add func(row map[string]any) error) error {
+			endLSN, err = e.createSlot(ctx, conn, tbl, slot, publication)
+			if err != nil {
+				return err
+			}
+			n, err = e.snapshot(ctx, dsn, tbl, add)
+			return err
+		},
+		func(ctx context.Context, cs *olapwriter.ChangeSet) error {
+			endLSN, n, err = e.readChanges(ctx, conn, tbl, slot, publication, props.CDC.MaxChanges, startLSN, cs)
+			return err
+		},
+	)
+	if err != nil {
+		if errors.Is(err, olapwriter.ErrNoRows) {
+			return nil, fmt.Errorf("no rows found in table %q", props.CDC.Table)
+		}
+		return nil, err
+	}
+
+	// The changes have been applied, so the slot can release the WAL up to the end LSN.
+	// If advancing fails, the changes are read again on the next run, which is safe since they are merged on the unique key.
+	if endLSN > startLSN && opts.IncrementalRun {
+		_, err := conn.Exec(ctx, "SELECT pg_replication_slot_advance($1, $2::pg_lsn)", slot, endLSN.String())
+		if err != nil {
+			e.c.logger.Warn("postgres: failed to advance replication slot", zap.String("model", opts.ModelName), zap.String("slot", slot), zap.Error(err), observability.ZapCtx(ctx))
+		}
+	}
+
+	if opts.IncrementalRun {
+		e.c.logger.Debug("postgres: applied changes", zap.String("model", opts.ModelName), zap.String("table", props.CDC.Table), zap.Int("changes", n), zap.String("lsn", endLSN.String()), observability.ZapCtx(ctx))
+	} else {
+		e.c.logger.Debug("postgres: copied snapshot", zap.String("model", opts.ModelName), zap.String("table", props.CDC.Table), zap.Int("rows", n), zap.String("lsn", endLSN.String()), observability.ZapCtx(ctx))
+	}
+
+	res.IncrementalState = map[string]any{"lsn": endLSN.String()}
+	return res, nil
+}
+
+// createSlot ensures the publication exists and (re)creates the replication slot.
+// It returns the LSN from which the slot will return changes.
+// The slot is created before the snapshot is taken, so changes committed while copying the snapshot are applied on the next run.
+func (e *cdcExecutor) createSlot(ctx context.Context, conn *pgx.Conn, tbl *cdcTable, slot, publication string) (pgLSN, error) {
+	var exists bool
+	err := conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_publication WHERE pubname = $1)", publication).Scan(&exists)
+	if err != nil {
+		return 0, fmt.Errorf("failed to look up publication: %w", err)
+	}
+	if !exists {
+		_, err = conn.Exec(ctx, fmt.Sprintf("CREATE PUBLICATION %s FOR TABLE %s", pgx.Identifier{publication}.Sanitize(), tbl.identifier()))
+		if err != nil {
+			return 0, fmt.Errorf("failed to create publication %q: %w", publication, err)
+		}
+	}
+	err = conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM pg_publication_tables WHERE pubname = $1 AND schemaname = $2 AND tablename = $3)", publication, tbl.schema, tbl.name).Scan(&exists)
+	if err != nil {
+		return 0, fmt.Errorf("failed to look up publication: %w", err)
+	}
+	if !exists {
+		return 0, fmt.Errorf("publication %q does not include table %q", publication, tbl.schema+"."+tbl.name)
+	}
+
+	_, err = conn.Exec(ctx, "SELECT pg_drop_replication_slot(slot_name) FROM pg_replication_slots WHERE slot_name = $1", slot)
+	if err != nil {
+		return 0, fmt.Errorf("failed to drop replication slot %q: %w", slot, err)
+	}
+	var lsn string
+	err = conn.QueryRow(ctx, "SELECT lsn::text FROM pg_create_logical_replication_slot($1, 'pgoutput')", slot).Scan(&lsn)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create replication slot %q: %w", slot, err)
+	}
+	return parsePgLSN(lsn)
+}
+
+// snapshot copies all the rows of the table. It returns the number of rows copied.
+func (e *cdcExecutor) snapshot(ctx context.Context, dsn string, tbl *cdcTable, add func(row map[string]any) error) (int, error) {
+	iter, err := e.c.Query(ctx, map[string]any{
+		"sql":          fmt.Sprintf("SELECT * FROM %s", tbl.identifier()),
+		"database_url": dsn,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	schema, err := iter.Schema(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for {
+		vals, err := iter.Next(ctx)
+		if err != nil {
+			if errors.Is(err, drivers.ErrIteratorDone) {
+				return n, nil
+			}
+			return 0, err
+		}
+		row := make(map[string]any, len(vals))
+		for i, f := range schema.Fields {
+			row[f.Name] = cdcValue(vals[i])
+		}
+		if err := add(row); err != nil {
+			return 0, err
+		}
+		n++
+	}
+}
+
+// readChanges reads the changes to the table from the replication slot without consuming them.
+// Transactions that committed before startLSN have already been applied and are skipped.
+// It returns the end LSN of the last transaction read and the number of changes read.
+func (e *cdcExecutor) readChanges(ctx context.Context, conn *pgx.Conn, tbl *cdcTable, slot, publication string, maxChanges int, startLSN pgLSN, cs *olapwriter.ChangeSet) (pgLSN, int, error) {
+	var upto any
+	if maxChanges > 0 {
+		upto = maxChanges
+	}
+	rows, err := conn.Query(ctx, "SELECT data FROM pg_logical_slot_peek_binary_changes($1, NULL, $2, 'proto_version', '1', 'publication_names', $3)", slot, upto, publication)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read changes from replication slot %q: %w", slot, err)
+	}
+	defer rows.Close()
+
+	typeMap := conn.TypeMap()
+	mappers := getOidToMapperMap()
+	relations := make(map[uint32]*pgRelation)
+	endLSN := startLSN
+	skip := false
+	n := 0
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return 0, 0, err
+		}
+		msg, err := decodePgoutput(data)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		switch msg := msg.(type) {
+		case *pgBegin:
+			skip = msg.finalLSN < startLSN
+		case *pgCommit:
+			if !skip && msg.endLSN > endLSN {
+				endLSN = msg.endLSN
+			}
+		case *pgRelation:
+			relations[msg.id] = msg
+		case *pgInsert:
+			rel, ok := relations[msg.relationID]
+			if skip || !ok || !tbl.matches(rel) {
+				continue
+			}
+			row, err := rel.row(typeMap, mappers, msg.newTuple, nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			if err := cs.Upsert(ctx, cdcRow(row)); err != nil {
+				return 0, 0, err
+			}
+			n++
+		case *pgUpdate:
+			rel, ok := relations[msg.relationID]
+			if skip || !ok || !tbl.matches(rel) {
+				continue
+			}
+			// The old tuple is sent if the key changed (or the replica identity is FULL), in which case the old key must be deleted.
+			// Columns that are not part of the replica identity are sent as nulls, so only the key columns are meaningful.
+			if msg.oldTuple != nil {
+				old, err := rel.row(typeMap, mappers, msg.oldTuple, nil)
+				if err != nil {
+					return 0, 0, err
+				}
+				if err := cs.Delete(ctx, cdcRow(old)); err != nil {
+					return 0, 0, err
+				}
+			}
+			row, err := rel.row(typeMap, mappers, msg.newTuple, msg.oldTuple)
+			if err != nil {
+				return 0, 0, err
+			}
+			if err := cs.Upsert(ctx, cdcRow(row)); err != nil {
+				return 0, 0, err
+			}
+			n++
+		case *pgDelete:
+			rel, ok := relations[msg.relationID]
+			if skip || !ok || !tbl.matches(rel) {
+				continue
+			}
+			old, err := rel.row(typeMap, mappers, msg.oldTuple, nil)
+			if err != nil {
+				return 0, 0, err
+			}
+			if err := cs.Delete(ctx, cdcRow(old)); err != nil {
+				return 0, 0, err
+			}
+			n++
+		case *pgTruncate:
+			if skip {
+				continue
+			}
+			for _, id := range msg.relationIDs {
+				if rel, ok := relations[id]; ok && tbl.matches(rel) {
+					cs.Truncate()
+					n++
+				}
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, 0, fmt.Errorf("failed to read changes from replication slot %q: %w", slot, err)
+	}
+	return endLSN, n, nil
+}
+
+// cdcTable is a table mirrored with change data capture.
+type cdcTable struct {
+	schema     string
+	name       string
+	primaryKey []string
+}
+
+// lookupCDCTable resolves the schema, name and primary key of a table.
+func lookupCDCTable(ctx context.Context, conn *pgx.Conn, table string) (*cdcTable, error) {
+	tbl := &cdcTable{}
+	err := conn.QueryRow(ctx, "SELECT n.nspname, c.relname FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace WHERE c.oid = $1::regclass", table).Scan(&tbl.schema, &tbl.name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up table %q: %w", table, err)
+	}
+
+	rows, err := conn.Query(ctx, `
+		SELECT a.attname
+		FROM pg_index i
+		JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		WHERE i.indrelid = $1::regclass AND i.indisprimary
+		ORDER BY array_position(i.indkey::int2[], a.attnum)
+	`, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up primary key of table %q: %w", table, err)
+	}
+	tbl.primaryKey, err = pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up primary key of table %q: %w", table, err)
+	}
+	return tbl, nil
+}
+
+func (t *cdcTable) identifier() string {
+	return pgx.Identifier{t.schema, t.name}.Sanitize()
+}
+
+func (t *cdcTable) matches(rel *pgRelation) bool {
+	return rel.namespace == t.schema && rel.name == t.name
+}
+
+// dsn returns the connection string from the source properties or the connector's config.
+func (c *connection) dsn(databaseURL string) (string, error) {
+	if databaseURL != "" {
+		return databaseURL, nil
+	}
+	if url, ok := c.config["database_url"].(string); ok && url != "" {
+		return url, nil
+	}
+	return "", fmt.Errorf("the property 'database_url' is required for Postgres. Provide 'database_url' in the YAML properties or pass '--var connector.postgres.database_url=...' to 'rill start'")
+}
+
+var slotNameRegexp = regexp.MustCompile(`[^a-z0-9_]`)
+
+// replicationSlotName returns the default replication slot name for a model.
+// Slot names can only contain lower case letters, numbers and underscores, and can be at most 63 characters long.
+func replicationSlotName(instanceID, model string) string {
+	name := slotNameRegexp.ReplaceAllString(strings.ToLower(fmt.Sprintf("rill_%s_%s", instanceID, model)), "_")
+	if len(name) > 63 {
+		h := sha256.Sum256([]byte(name))
+		name = name[:46] + "_" + hex.EncodeToString(h[:8])
+	}
+	return name
+}
+
+// lsnFromState returns the LSN stored in a model's incremental state.
+func lsnFromState(state map[string]any) (pgLSN, error) {
+	s, ok := state["lsn"].(string)
+	if !ok || s == "" {
+		return 0, errors.New("the incremental state does not contain a replication position: run a full refresh of the model")
+	}
+	return parsePgLSN(s)
+}
+
+// cdcRow converts the values of a row to values that can be serialized to JSON for the output table.
+func cdcRow(row map[string]any) map[string]any {
+	for k, v := range row {
+		row[k] = cdcValue(v)
+	}
+	return row
+}
+
+// cdcValue converts UUIDs, which the mappers return as byte arrays, to strings.
+func cdcValue(v any) any {
+	switch v := v.(type) {
+	case [16]byte:
+		return uuid.UUID(v).String()
+	case []any:
+		for i, x := range v {
+			v[i] = cdcValue(x)
+		}
+		return v
+	default:
+		return v
+	}
+}
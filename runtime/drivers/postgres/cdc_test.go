@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/olapwriter"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+)
+
+func TestCDC(t *testing.T) {
+	if testing.Short() {
+		t.Skip("postgres: skipping test in short mode")
+	}
+
+	pg := pgtestcontainer.New(t)
+	defer pg.Terminate(t)
+
+	conn, err := drivers.Open("postgres", "default", map[string]any{"database_url": pg.DatabaseURL}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	duckdb, err := drivers.Open("duckdb", "default", map[string]any{"dsn": ":memory:"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer duckdb.Close()
+	olap, ok := duckdb.AsOLAP("default")
+	require.True(t, ok)
+
+	ctx := context.Background()
+	db := conn.(*connection).db
+	_, err = db.ExecContext(ctx, `
+		CREATE TABLE orders (id INT PRIMARY KEY, name VARCHAR);
+		INSERT INTO orders VALUES (1, 'a'), (2, 'b'), (3, 'c');
+	`)
+	require.NoError(t, err)
+
+	executorOpts := &drivers.ModelExecutorOptions{
+		Env:                        &drivers.ModelEnv{StageChanges: true},
+		ModelName:                  "orders",
+		InputHandle:                conn,
+		InputConnector:             "postgres",
+		PreliminaryInputProperties: map[string]any{"cdc": map[string]any{"table": "orders"}},
+		OutputHandle:               duckdb,
+		OutputConnector:            "duckdb",
+	}
+	executor, ok := conn.AsModelExecutor("default", executorOpts)
+	require.True(t, ok)
+
+	var state map[string]any
+	run := func() {
+		res, err := executor.Execute(ctx, &drivers.ModelExecuteOptions{
+			ModelExecutorOptions: executorOpts,
+			InputProperties:      executorOpts.PreliminaryInputProperties,
+			OutputProperties:     map[string]any{"materialize": true},
+			Incremental:          true,
+			IncrementalRun:       state != nil,
+			IncrementalState:     state,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "orders", res.Table)
+		state = res.IncrementalState
+	}
+
+	// The first run copies a snapshot
+	run()
+	require.Equal(t, map[int]string{1: "a", 2: "b", 3: "c"}, cdcRows(t, olap))
+
+	// Inserts, updates (including of the key) and deletes are merged
+	_, err = db.ExecContext(ctx, `
+		UPDATE orders SET name = 'x' WHERE id = 1;
+		DELETE FROM orders WHERE id = 2;
+		INSERT INTO orders VALUES (4, 'd');
+		UPDATE orders SET id = 5 WHERE id = 4;
+	`)
+	require.NoError(t, err)
+	run()
+	require.Equal(t, map[int]string{1: "x", 3: "c", 5: "d"}, cdcRows(t, olap))
+
+	// Changes that don't fit in one batch are applied in several batches
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO orders SELECT g, 'bulk' FROM generate_series(100, 25099) g;
+		DELETE FROM orders WHERE id = 3;
+	`)
+	require.NoError(t, err)
+	run()
+	rows := cdcRows(t, olap)
+	require.Len(t, rows, 25002)
+	require.Equal(t, "x", rows[1])
+	require.Equal(t, "bulk", rows[25099])
+	require.NotContains(t, rows, 3)
+
+	// A truncation is applied together with the changes after it
+	_, err = db.ExecContext(ctx, `
+		TRUNCATE orders;
+		INSERT INTO orders VALUES (6, 'e'), (7, 'f');
+		DELETE FROM orders WHERE id = 7;
+	`)
+	require.NoError(t, err)
+	run()
+	require.Equal(t, map[int]string{6: "e"}, cdcRows(t, olap))
+
+	// A truncation without changes after it empties the table
+	_, err = db.ExecContext(ctx, "TRUNCATE orders")
+	require.NoError(t, err)
+	run()
+	require.Empty(t, cdcRows(t, olap))
+
+	// Runs without changes don't change the table
+	_, err = db.ExecContext(ctx, "INSERT INTO orders VALUES (8, 'g')")
+	require.NoError(t, err)
+	run()
+	run()
+	require.Equal(t, map[int]string{8: "g"}, cdcRows(t, olap))
+}
+
+// cdcRows returns the rows of the "orders" table in olap by ID. It checks that no rows are marked as deleted.
+func cdcRows(t *testing.T, olap drivers.OLAPStore) map[int]string {
+	res, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT id, name, " + olapwriter.DeletedColumn + " FROM orders"})
+	require.NoError(t, err)
+	defer res.Close()
+
+	rows := make(map[int]string)
+	for res.Next() {
+		var id int
+		var name string
+		var deleted bool
+		require.NoError(t, res.Scan(&id, &name, &deleted))
+		require.False(t, deleted)
+		rows[id] = name
+	}
+	require.NoError(t, res.Err())
+	return rows
+}
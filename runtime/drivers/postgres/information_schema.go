@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+type informationSchema struct {
+	c *connection
+}
+
+func (c *connection) InformationSchema() drivers.InformationSchema {
+	return informationSchema{c: c}
+}
+
+func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
+	db, err := i.c.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	// Postgres doesn't support queries across databases, so we only list the schemas of the connected database.
+	q := `
+		SELECT
+			T.table_schema,
+			T.table_schema = current_schema() AS is_default_schema,
+			T.table_name,
+			T.table_type,
+			C.column_name,
+			C.udt_name,
+			C.is_nullable = 'YES' AS nullable
+		FROM information_schema.tables T
+		JOIN information_schema.columns C ON T.table_schema = C.table_schema AND T.table_name = C.table_name
+		WHERE T.table_schema NOT IN ('information_schema', 'pg_catalog') AND T.table_schema NOT LIKE 'pg\_%'
+		ORDER BY T.table_schema, T.table_name, C.ordinal_position
+	`
+
+	rows, err := db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables, err := i.scanTables(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+func (i informationSchema) Lookup(ctx context.Context, db, schema, name string) (*drivers.Table, error) {
+	conn, err := i.c.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	q := `
+		SELECT
+			T.table_schema,
+			T.table_schema = current_schema() AS is_default_schema,
+			T.table_name,
+			T.table_type,
+			C.column_name,
+			C.udt_name,
+			C.is_nullable = 'YES' AS nullable
+		FROM information_schema.tables T
+		JOIN information_schema.columns C ON T.table_schema = C.table_schema AND T.table_name = C.table_name
+		WHERE T.table_schema = coalesce($1::TEXT, current_schema()) AND T.table_name = $2
+		ORDER BY T.table_schema, T.table_name, C.ordinal_position
+	`
+	var args []any
+	if schema == "" {
+		args = append(args, nil, name)
+	} else {
+		args = append(args, schema, name)
+	}
+
+	rows, err := conn.QueryxContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables, err := i.scanTables(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tables) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+
+	return tables[0], nil
+}
+
+func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error) {
+	var res []*drivers.Table
+
+	for rows.Next() {
+		var databaseSchema string
+		var isDefaultSchema bool
+		var name string
+		var tableType string
+		var columnName string
+		var columnType string
+		var nullable bool
+
+		err := rows.Scan(&databaseSchema, &isDefaultSchema, &name, &tableType, &columnName, &columnType, &nullable)
+		if err != nil {
+			return nil, err
+		}
+
+		// set t to res[len(res)-1] if it's the same table, else set t to a new table and append it
+		var t *drivers.Table
+		if len(res) > 0 {
+			t = res[len(res)-1]
+			if !(t.DatabaseSchema == databaseSchema && t.Name == name) {
+				t = nil
+			}
+		}
+		if t == nil {
+			t = &drivers.Table{
+				DatabaseSchema:          databaseSchema,
+				IsDefaultDatabaseSchema: isDefaultSchema,
+				Name:                    name,
+				View:                    tableType == "VIEW",
+				Schema:                  &runtimev1.StructType{},
+			}
+			res = append(res, t)
+		}
+
+		t.Schema.Fields = append(t.Schema.Fields, &runtimev1.StructType_Field{
+			Name: columnName,
+			Type: databaseTypeToPB(columnType, nullable),
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
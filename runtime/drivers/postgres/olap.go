@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+var _ drivers.OLAPStore = &connection{}
+
+// AddTableColumn implements drivers.OLAPStore.
+func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// AlterTableColumn implements drivers.OLAPStore.
+func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName, newType string) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// CreateTableAsSelect implements drivers.OLAPStore.
+func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string, tableOpts map[string]any) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// DropTable implements drivers.OLAPStore.
+func (c *connection) DropTable(ctx context.Context, name string, view bool) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// InsertTableAsSelect implements drivers.OLAPStore.
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string, partitionBy string) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+// RenameTable implements drivers.OLAPStore.
+func (c *connection) RenameTable(ctx context.Context, name, newName string, view bool) error {
+	return fmt.Errorf("postgres: data transformation not yet supported")
+}
+
+func (c *connection) Dialect() drivers.Dialect {
+	return drivers.DialectPostgres
+}
+
+func (c *connection) WithConnection(ctx context.Context, priority int, longRunning, tx bool, fn drivers.WithConnectionFunc) error {
+	return fmt.Errorf("postgres: WithConnection not supported")
+}
+
+func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	res, err := c.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if stmt.DryRun {
+		return nil
+	}
+	return res.Close()
+}
+
+func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
+	db, err := c.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	query := stmt.Query
+	if len(stmt.Args) > 0 {
+		query = rewritePlaceholders(query)
+	}
+
+	if stmt.DryRun {
+		rows, err := db.QueryxContext(ctx, "EXPLAIN "+query, stmt.Args...)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, rows.Close()
+	}
+
+	var cancelFunc context.CancelFunc
+	if stmt.ExecutionTimeout != 0 {
+		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+	}
+
+	rows, err := db.QueryxContext(ctx, query, stmt.Args...)
+	if err != nil {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil, err
+	}
+
+	schema, err := rowsToSchema(rows)
+	if err != nil {
+		rows.Close()
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil, err
+	}
+
+	r := &drivers.Result{Rows: rows, Schema: schema}
+	r.SetCleanupFunc(func() error {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil
+	})
+
+	return r, nil
+}
+
+func (c *connection) MayBeScaledToZero(ctx context.Context) bool {
+	return false
+}
+
+// getDB returns a connection pool for the database configured in the connector's database_url.
+// The pool is opened lazily since the connector may only be used for ingestion with a database_url set in the source properties.
+func (c *connection) getDB() (*sqlx.DB, error) {
+	c.dbMu.Lock()
+	defer c.dbMu.Unlock()
+
+	if c.db != nil {
+		return c.db, nil
+	}
+
+	dsn, ok := c.config["database_url"].(string)
+	if !ok || dsn == "" {
+		return nil, errors.New("postgres: the property 'database_url' is required to use Postgres as an OLAP connector. Set it in the connector's YAML or pass '--var connector.postgres.database_url=...' to 'rill start'")
+	}
+
+	config, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: %w", err)
+	}
+	// Use the simple protocol for compatibility with proxies like pgbouncer (see Query in sql_store.go).
+	// Query args are still safely interpolated by pgx.
+	config.DefaultQueryExecMode = pgx.QueryExecModeSimpleProtocol
+	// Time truncation and casts between TIMESTAMP and TIMESTAMPTZ depend on the session time zone
+	config.RuntimeParams["timezone"] = "UTC"
+
+	c.db = sqlx.NewDb(stdlib.OpenDB(*config), "pgx")
+	return c.db, nil
+}
+
+// rewritePlaceholders rewrites the ? placeholders used by the runtime's query builders to Postgres' positional $N placeholders.
+// It is only applied to queries that have args, so queries without args are passed to Postgres unchanged.
+// Question marks in string literals (including escape and dollar-quoted strings), quoted identifiers and comments are left untouched.
+// So are the JSONB operators ?| and ?& (the JSONB operator ? can't be told apart from a placeholder, so jsonb_exists must be used instead).
+func rewritePlaceholders(query string) string {
+	var sb strings.Builder
+	sb.Grow(len(query) + 8)
+	n := 0
+	for i := 0; i < len(query); i++ {
+		ch := query[i]
+		switch {
+		case ch == '\'' && i > 0 && (query[i-1] == 'E' || query[i-1] == 'e') && (i == 1 || !isIdentChar(query[i-2])):
+			// Copy the escape string constant, in which quotes may also be escaped with a backslash
+			j := i + 1
+			for ; j < len(query); j++ {
+				if query[j] == '\\' {
+					j++
+				} else if query[j] == '\'' {
+					break
+				}
+			}
+			if j >= len(query) {
+				sb.WriteString(query[i:])
+				return sb.String()
+			}
+			sb.WriteString(query[i : j+1])
+			i = j
+		case ch == '\'' || ch == '"':
+			// Copy the literal or identifier. Escaped quotes ('' or "") are handled as two adjacent literals.
+			end := strings.IndexByte(query[i+1:], ch)
+			if end < 0 {
+				sb.WriteString(query[i:])
+				return sb.String()
+			}
+			sb.WriteString(query[i : i+end+2])
+			i += end + 1
+		case ch == '$' && (i == 0 || !isIdentChar(query[i-1])):
+			// Copy the dollar-quoted string, such as $$...$$ or $tag$...$tag$
+			tagEnd := i + 1
+			for tagEnd < len(query) && query[tagEnd] != '$' && isIdentChar(query[tagEnd]) && (tagEnd > i+1 || query[tagEnd] < '0' || query[tagEnd] > '9') {
+				tagEnd++
+			}
+			if tagEnd >= len(query) || query[tagEnd] != '$' {
+				// Not a dollar quote (e.g. a positional parameter)
+				sb.WriteByte(ch)
+				continue
+			}
+			tag := query[i : tagEnd+1]
+			end := strings.Index(query[tagEnd+1:], tag)
+			if end < 0 {
+				sb.WriteString(query[i:])
+				return sb.String()
+			}
+			sb.WriteString(query[i : tagEnd+1+end+len(tag)])
+			i = tagEnd + end + len(tag)
+		case ch == '-' && i+1 < len(query) && query[i+1] == '-':
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				sb.WriteString(query[i:])
+				return sb.String()
+			}
+			sb.WriteString(query[i : i+end+1])
+			i += end
+		case ch == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				sb.WriteString(query[i:])
+				return sb.String()
+			}
+			sb.WriteString(query[i : i+end+4])
+			i += end + 3
+		case ch == '?' && i+1 < len(query) && query[i+1] == '&',
+			ch == '?' && i+1 < len(query) && query[i+1] == '|' && (i+2 >= len(query) || query[i+2] != '|'):
+			// The JSONB ?& or ?| operator (but not a placeholder followed by the || operator)
+			sb.WriteString(query[i : i+2])
+			i++
+		case ch == '?':
+			n++
+			sb.WriteByte('$')
+			sb.WriteString(strconv.Itoa(n))
+		default:
+			sb.WriteByte(ch)
+		}
+	}
+	return sb.String()
+}
+
+// isIdentChar returns true if ch can be part of an unquoted identifier.
+func isIdentChar(ch byte) bool {
+	return ch == '_' || ch == '$' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9') || ch >= 0x80
+}
+
+func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	cts, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*runtimev1.StructType_Field, len(cts))
+	for i, ct := range cts {
+		nullable, ok := ct.Nullable()
+		if !ok {
+			nullable = true
+		}
+
+		fields[i] = &runtimev1.StructType_Field{
+			Name: ct.Name(),
+			Type: databaseTypeToPB(ct.DatabaseTypeName(), nullable),
+		}
+	}
+
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+// databaseTypeToPB maps a Postgres type name (as returned by pgx or the udt_name column in information_schema) to a runtime type.
+// The mapping follows the values returned by pgx's database/sql driver, which returns the text representation for types it doesn't decode natively.
+func databaseTypeToPB(dbt string, nullable bool) *runtimev1.Type {
+	t := &runtimev1.Type{Nullable: nullable}
+	switch strings.ToUpper(dbt) {
+	case "BOOL":
+		t.Code = runtimev1.Type_CODE_BOOL
+	case "INT2":
+		t.Code = runtimev1.Type_CODE_INT16
+	case "INT4":
+		t.Code = runtimev1.Type_CODE_INT32
+	case "INT8":
+		t.Code = runtimev1.Type_CODE_INT64
+	case "FLOAT4":
+		t.Code = runtimev1.Type_CODE_FLOAT32
+	case "FLOAT8":
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case "NUMERIC":
+		t.Code = runtimev1.Type_CODE_DECIMAL
+	case "DATE":
+		t.Code = runtimev1.Type_CODE_DATE
+	case "TIMESTAMP", "TIMESTAMPTZ":
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case "UUID":
+		t.Code = runtimev1.Type_CODE_UUID
+	case "JSON", "JSONB":
+		t.Code = runtimev1.Type_CODE_JSON
+	case "BYTEA":
+		t.Code = runtimev1.Type_CODE_BYTES
+	default:
+		// Includes TEXT, VARCHAR, BPCHAR, TIME, INTERVAL, enums and arrays, which are all returned as strings
+		t.Code = runtimev1.Type_CODE_STRING
+	}
+	return t
+}
@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRewritePlaceholders(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"SELECT 1", "SELECT 1"},
+		{"SELECT * FROM t WHERE a = ? AND b IN (?, ?)", "SELECT * FROM t WHERE a = $1 AND b IN ($2, $3)"},
+		{"SELECT '?', \"?\" FROM t WHERE a = ?", "SELECT '?', \"?\" FROM t WHERE a = $1"},
+		{"SELECT 'it''s?' WHERE a = ?", "SELECT 'it''s?' WHERE a = $1"},
+		{"SELECT 1 -- what?\nWHERE a = ?", "SELECT 1 -- what?\nWHERE a = $1"},
+		{"SELECT /* what? */ ? /* unterminated ?", "SELECT /* what? */ $1 /* unterminated ?"},
+		{"SELECT 'unterminated ?", "SELECT 'unterminated ?"},
+		{"SELECT E'it\\'s?' WHERE a = ?", "SELECT E'it\\'s?' WHERE a = $1"},
+		{"SELECT e'\\\\' || ?, te'\\' || ?", "SELECT e'\\\\' || $1, te'\\' || $2"},
+		{"SELECT $$it's?$$, $fn$ $$ ? $fn$ WHERE a = ?", "SELECT $$it's?$$, $fn$ $$ ? $fn$ WHERE a = $1"},
+		{"SELECT a$b FROM t WHERE a = ? AND b = $1", "SELECT a$b FROM t WHERE a = $1 AND b = $1"},
+		{"SELECT * FROM t WHERE j ?| array['a'] AND j ?& array['b'] AND a = ?", "SELECT * FROM t WHERE j ?| array['a'] AND j ?& array['b'] AND a = $1"},
+		{"SELECT ?||'a'", "SELECT $1||'a'"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, rewritePlaceholders(tt.query))
+	}
+}
+
+func TestOLAP(t *testing.T) {
+	if testing.Short() {
+		t.Skip("postgres: skipping test in short mode")
+	}
+
+	pg := pgtestcontainer.New(t)
+	defer pg.Terminate(t)
+
+	conn, err := drivers.Open("postgres", "default", map[string]any{"database_url": pg.DatabaseURL}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.Ping(context.Background()))
+
+	olap, ok := conn.AsOLAP("default")
+	require.True(t, ok)
+	require.Equal(t, drivers.DialectPostgres, olap.Dialect())
+
+	ctx := context.Background()
+	_, err = olap.(*connection).db.ExecContext(ctx, `
+		CREATE TABLE events (
+			id INT NOT NULL,
+			name VARCHAR,
+			tags TEXT[],
+			amount NUMERIC,
+			ts TIMESTAMPTZ
+		);
+		INSERT INTO events VALUES
+			(1, 'a', '{x,y}', 1.5, '2024-01-01T10:00:00Z'),
+			(2, 'b', '{y}', 2.5, '2024-01-08T23:30:00Z'),
+			(3, NULL, NULL, NULL, '2024-03-15T00:00:00Z');
+		CREATE VIEW events_view AS SELECT id FROM events;
+	`)
+	require.NoError(t, err)
+
+	t.Run("InformationSchema", func(t *testing.T) {
+		tbl, err := olap.InformationSchema().Lookup(ctx, "", "", "events")
+		require.NoError(t, err)
+		require.Equal(t, "public", tbl.DatabaseSchema)
+		require.True(t, tbl.IsDefaultDatabaseSchema)
+		require.False(t, tbl.View)
+		require.Len(t, tbl.Schema.Fields, 5)
+		require.Equal(t, runtimev1.Type_CODE_INT32, tbl.Schema.Fields[0].Type.Code)
+		require.False(t, tbl.Schema.Fields[0].Type.Nullable)
+		require.Equal(t, runtimev1.Type_CODE_STRING, tbl.Schema.Fields[1].Type.Code)
+		require.Equal(t, runtimev1.Type_CODE_DECIMAL, tbl.Schema.Fields[3].Type.Code)
+		require.Equal(t, runtimev1.Type_CODE_TIMESTAMP, tbl.Schema.Fields[4].Type.Code)
+
+		tbl, err = olap.InformationSchema().Lookup(ctx, "", "public", "events_view")
+		require.NoError(t, err)
+		require.True(t, tbl.View)
+
+		_, err = olap.InformationSchema().Lookup(ctx, "", "", "missing")
+		require.ErrorIs(t, err, drivers.ErrNotFound)
+
+		tbls, err := olap.InformationSchema().All(ctx)
+		require.NoError(t, err)
+		require.Len(t, tbls, 2)
+	})
+
+	t.Run("Execute", func(t *testing.T) {
+		res, err := olap.Execute(ctx, &drivers.Statement{
+			Query: "SELECT id, SUM(amount) AS total FROM events WHERE name = ? OR name = ? GROUP BY id ORDER BY id",
+			Args:  []any{"a", "b"},
+		})
+		require.NoError(t, err)
+		defer res.Close()
+		require.Equal(t, runtimev1.Type_CODE_INT32, res.Schema.Fields[0].Type.Code)
+		require.Equal(t, runtimev1.Type_CODE_DECIMAL, res.Schema.Fields[1].Type.Code)
+
+		var n int
+		for res.Next() {
+			n++
+		}
+		require.NoError(t, res.Err())
+		require.Equal(t, 2, n)
+
+		err = olap.Exec(ctx, &drivers.Statement{Query: "SELECT missing FROM events", DryRun: true})
+		require.Error(t, err)
+
+		err = olap.CreateTableAsSelect(ctx, "foo", false, "SELECT 1", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("DateTrunc", func(t *testing.T) {
+		dim := &runtimev1.MetricsViewSpec_DimensionV2{Column: "ts"}
+		tests := []struct {
+			grain            runtimev1.TimeGrain
+			tz               string
+			firstDayOfWeek   int
+			firstMonthOfYear int
+			want             time.Time
+		}{
+			{runtimev1.TimeGrain_TIME_GRAIN_DAY, "", 1, 1, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)},
+			{runtimev1.TimeGrain_TIME_GRAIN_DAY, "Asia/Kolkata", 1, 1, time.Date(2024, 1, 8, 18, 30, 0, 0, time.UTC)},
+			{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "", 1, 1, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)},
+			{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "", 7, 1, time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+			{runtimev1.TimeGrain_TIME_GRAIN_YEAR, "", 1, 4, time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)},
+		}
+		for _, tt := range tests {
+			expr, err := drivers.DialectPostgres.DateTruncExpr(dim, tt.grain, tt.tz, tt.firstDayOfWeek, tt.firstMonthOfYear)
+			require.NoError(t, err)
+
+			res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT " + expr + " FROM events WHERE id = 2"})
+			require.NoError(t, err)
+			require.True(t, res.Next())
+			var got time.Time
+			require.NoError(t, res.Scan(&got))
+			require.NoError(t, res.Close())
+			require.True(t, tt.want.Equal(got), "grain %s, tz %q: expected %s, got %s", tt.grain, tt.tz, tt.want, got)
+		}
+	})
+
+	t.Run("DateDiff", func(t *testing.T) {
+		t1 := time.Date(2024, 1, 31, 23, 0, 0, 0, time.UTC)
+		t2 := time.Date(2024, 2, 1, 1, 0, 0, 0, time.UTC)
+		tests := []struct {
+			grain runtimev1.TimeGrain
+			want  int64
+		}{
+			{runtimev1.TimeGrain_TIME_GRAIN_HOUR, 2},
+			{runtimev1.TimeGrain_TIME_GRAIN_DAY, 1},
+			{runtimev1.TimeGrain_TIME_GRAIN_MONTH, 1},
+			{runtimev1.TimeGrain_TIME_GRAIN_QUARTER, 0},
+			{runtimev1.TimeGrain_TIME_GRAIN_YEAR, 0},
+		}
+		for _, tt := range tests {
+			expr, err := drivers.DialectPostgres.DateDiff(tt.grain, t1, t2)
+			require.NoError(t, err)
+
+			res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT " + expr})
+			require.NoError(t, err)
+			require.True(t, res.Next())
+			var got int64
+			require.NoError(t, res.Scan(&got))
+			require.NoError(t, res.Close())
+			require.Equal(t, tt.want, got, "grain %s", tt.grain)
+		}
+	})
+}
@@ -0,0 +1,309 @@
+package postgres
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// This file implements a decoder for version 1 of the pgoutput logical replication protocol.
+// See https://www.postgresql.org/docs/current/protocol-logicalrep-message-formats.html.
+
+// pgLSN is a Postgres log sequence number (a position in the write-ahead log).
+type pgLSN uint64
+
+// parsePgLSN parses a LSN in the textual "XXX/XXX" format.
+func parsePgLSN(s string) (pgLSN, error) {
+	hi, lo, ok := strings.Cut(s, "/")
+	if !ok {
+		return 0, fmt.Errorf("invalid LSN %q", s)
+	}
+	h, err := strconv.ParseUint(hi, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid LSN %q", s)
+	}
+	l, err := strconv.ParseUint(lo, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid LSN %q", s)
+	}
+	return pgLSN(h<<32 | l), nil
+}
+
+func (l pgLSN) String() string {
+	return fmt.Sprintf("%X/%X", uint32(l>>32), uint32(l))
+}
+
+type pgBegin struct {
+	// finalLSN is the LSN of the transaction's commit record.
+	finalLSN pgLSN
+}
+
+type pgCommit struct {
+	commitLSN pgLSN
+	endLSN    pgLSN
+}
+
+type pgRelation struct {
+	id        uint32
+	namespace string
+	name      string
+	columns   []pgRelationColumn
+}
+
+type pgRelationColumn struct {
+	key     bool
+	name    string
+	typeOID uint32
+}
+
+// pgTupleColumn is a column value in a tuple.
+// The kind is 'n' for nulls, 'u' for unchanged TOASTed values that are not sent, and 't' for values in text format.
+type pgTupleColumn struct {
+	kind byte
+	data []byte
+}
+
+type pgInsert struct {
+	relationID uint32
+	newTuple   []pgTupleColumn
+}
+
+type pgUpdate struct {
+	relationID uint32
+	// oldTuple is the old row or its replica identity, if the replica identity changed or is FULL. It may be nil.
+	oldTuple []pgTupleColumn
+	newTuple []pgTupleColumn
+}
+
+type pgDelete struct {
+	relationID uint32
+	// oldTuple contains the replica identity of the deleted row (or the full row if the replica identity is FULL).
+	oldTuple []pgTupleColumn
+}
+
+type pgTruncate struct {
+	relationIDs []uint32
+}
+
+// decodePgoutput decodes a pgoutput message.
+// It returns nil for message types that are not needed for change data capture (such as type, origin and logical decoding messages).
+func decodePgoutput(msg []byte) (any, error) {
+	if len(msg) == 0 {
+		return nil, errors.New("empty pgoutput message")
+	}
+	r := &pgReader{buf: msg[1:]}
+	var res any
+	switch msg[0] {
+	case 'B':
+		res = &pgBegin{finalLSN: pgLSN(r.uint64())}
+	case 'C':
+		r.uint8() // flags
+		res = &pgCommit{commitLSN: pgLSN(r.uint64()), endLSN: pgLSN(r.uint64())}
+	case 'R':
+		rel := &pgRelation{id: r.uint32(), namespace: r.string(), name: r.string()}
+		r.uint8() // replica identity setting
+		n := int(r.uint16())
+		for i := 0; i < n && r.err == nil; i++ {
+			flags := r.uint8()
+			col := pgRelationColumn{key: flags&1 == 1, name: r.string(), typeOID: r.uint32()}
+			r.uint32() // type modifier
+			rel.columns = append(rel.columns, col)
+		}
+		res = rel
+	case 'I':
+		ins := &pgInsert{relationID: r.uint32()}
+		if kind := r.uint8(); kind != 'N' && r.err == nil {
+			return nil, fmt.Errorf("unexpected tuple type %q in insert message", kind)
+		}
+		ins.newTuple = r.tuple()
+		res = ins
+	case 'U':
+		upd := &pgUpdate{relationID: r.uint32()}
+		kind := r.uint8()
+		if kind == 'K' || kind == 'O' {
+			upd.oldTuple = r.tuple()
+			kind = r.uint8()
+		}
+		if kind != 'N' && r.err == nil {
+			return nil, fmt.Errorf("unexpected tuple type %q in update message", kind)
+		}
+		upd.newTuple = r.tuple()
+		res = upd
+	case 'D':
+		del := &pgDelete{relationID: r.uint32()}
+		if kind := r.uint8(); kind != 'K' && kind != 'O' && r.err == nil {
+			return nil, fmt.Errorf("unexpected tuple type %q in delete message", kind)
+		}
+		del.oldTuple = r.tuple()
+		res = del
+	case 'T':
+		n := int(r.uint32())
+		r.uint8() // options
+		trunc := &pgTruncate{}
+		for i := 0; i < n && r.err == nil; i++ {
+			trunc.relationIDs = append(trunc.relationIDs, r.uint32())
+		}
+		res = trunc
+	case 'Y', 'O', 'M':
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown pgoutput message type %q", msg[0])
+	}
+	if r.err != nil {
+		return nil, fmt.Errorf("failed to decode pgoutput message of type %q: %w", msg[0], r.err)
+	}
+	return res, nil
+}
+
+// row converts a tuple of the relation to a row with values of the same types as the snapshot query returns.
+// Unchanged TOASTed values are taken from the old tuple if available.
+func (rel *pgRelation) row(typeMap *pgtype.Map, mappers map[string]mapper, tuple, oldTuple []pgTupleColumn) (map[string]any, error) {
+	if len(tuple) != len(rel.columns) {
+		return nil, fmt.Errorf("tuple has %d columns, but relation %q has %d", len(tuple), rel.name, len(rel.columns))
+	}
+
+	row := make(map[string]any, len(tuple))
+	for i, col := range rel.columns {
+		tc := tuple[i]
+		if tc.kind == 'u' {
+			if i >= len(oldTuple) || oldTuple[i].kind == 'u' {
+				return nil, fmt.Errorf("the change to column %q of table %q is not available because the value is TOASTed and unchanged: set REPLICA IDENTITY FULL on the table to capture it", col.name, rel.name)
+			}
+			tc = oldTuple[i]
+		}
+		if tc.kind == 'n' {
+			row[col.name] = nil
+			continue
+		}
+
+		v, err := decodePgText(typeMap, mappers, col.typeOID, tc.data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode column %q: %w", col.name, err)
+		}
+		row[col.name] = v
+	}
+	return row, nil
+}
+
+// decodePgText decodes a value in the text format.
+// Types without a mapper, such as enums and other custom types, are returned as strings.
+func decodePgText(typeMap *pgtype.Map, mappers map[string]mapper, oid uint32, data []byte) (any, error) {
+	dt, ok := typeMap.TypeForOID(oid)
+	if !ok {
+		return string(data), nil
+	}
+	name := strings.ToLower(dt.Name)
+	// Keep JSON as text like the snapshot, which avoids decoding and re-encoding it
+	if name == "json" || name == "jsonb" {
+		return string(data), nil
+	}
+	m, ok := mappers[name]
+	if !ok {
+		return string(data), nil
+	}
+	v, err := dt.Codec.DecodeValue(typeMap, oid, pgtype.TextFormatCode, data)
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, nil
+	}
+	return m.value(v)
+}
+
+// pgReader reads big-endian values from a pgoutput message.
+// It records the first error, after which all reads return zero values.
+type pgReader struct {
+	buf []byte
+	err error
+}
+
+func (r *pgReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > len(r.buf) {
+		r.err = errors.New("unexpected end of message")
+		return nil
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *pgReader) uint8() byte {
+	b := r.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *pgReader) uint16() uint16 {
+	b := r.next(2)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint16(b)
+}
+
+func (r *pgReader) uint32() uint32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+func (r *pgReader) uint64() uint64 {
+	b := r.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// string reads a null-terminated string.
+func (r *pgReader) string() string {
+	if r.err != nil {
+		return ""
+	}
+	i := 0
+	for i < len(r.buf) && r.buf[i] != 0 {
+		i++
+	}
+	if i == len(r.buf) {
+		r.err = errors.New("unterminated string")
+		return ""
+	}
+	s := string(r.buf[:i])
+	r.buf = r.buf[i+1:]
+	return s
+}
+
+func (r *pgReader) tuple() []pgTupleColumn {
+	n := int(r.uint16())
+	cols := make([]pgTupleColumn, 0, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		kind := r.uint8()
+		switch kind {
+		case 'n', 'u':
+			cols = append(cols, pgTupleColumn{kind: kind})
+		case 't', 'b':
+			l := int(r.uint32())
+			cols = append(cols, pgTupleColumn{kind: 't', data: r.next(l)})
+			if kind == 'b' && r.err == nil {
+				r.err = errors.New("binary tuple data is not supported")
+			}
+		default:
+			if r.err == nil {
+				r.err = fmt.Errorf("unknown tuple data type %q", kind)
+			}
+		}
+	}
+	return cols
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgLSN(t *testing.T) {
+	lsn, err := parsePgLSN("16/B374D848")
+	require.NoError(t, err)
+	require.Equal(t, pgLSN(0x16B374D848), lsn)
+	require.Equal(t, "16/B374D848", lsn.String())
+
+	_, err = parsePgLSN("16B374D848")
+	require.Error(t, err)
+}
+
+func TestDecodePgoutput(t *testing.T) {
+	typeMap := pgtype.NewMap()
+	mappers := getOidToMapperMap()
+
+	msg, err := decodePgoutput(pgMsg('B').u64(0x100).u64(0).u32(7).bytes())
+	require.NoError(t, err)
+	require.Equal(t, &pgBegin{finalLSN: 0x100}, msg)
+
+	msg, err = decodePgoutput(pgMsg('C').u8(0).u64(0x100).u64(0x128).u64(0).bytes())
+	require.NoError(t, err)
+	require.Equal(t, &pgCommit{commitLSN: 0x100, endLSN: 0x128}, msg)
+
+	msg, err = decodePgoutput(pgMsg('R').u32(42).str("public").str("orders").u8('d').u16(3).
+		u8(1).str("id").u32(pgtype.Int8OID).u32(0).
+		u8(0).str("note").u32(pgtype.TextOID).u32(0).
+		u8(0).str("id2").u32(pgtype.UUIDOID).u32(0).
+		bytes())
+	require.NoError(t, err)
+	rel := msg.(*pgRelation)
+	require.Equal(t, uint32(42), rel.id)
+	require.Equal(t, "public", rel.namespace)
+	require.Equal(t, "orders", rel.name)
+	require.Equal(t, []pgRelationColumn{{key: true, name: "id", typeOID: pgtype.Int8OID}, {name: "note", typeOID: pgtype.TextOID}, {name: "id2", typeOID: pgtype.UUIDOID}}, rel.columns)
+
+	msg, err = decodePgoutput(pgMsg('I').u32(42).u8('N').u16(3).text("1").null().text("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11").bytes())
+	require.NoError(t, err)
+	ins := msg.(*pgInsert)
+	row, err := rel.row(typeMap, mappers, ins.newTuple, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"id": int64(1), "note": nil, "id2": "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"}, cdcRow(row))
+
+	// An update that changes the key and leaves a TOASTed value unchanged
+	msg, err = decodePgoutput(pgMsg('U').u32(42).u8('O').u16(3).text("1").text("hello").null().u8('N').u16(3).text("2").unchanged().null().bytes())
+	require.NoError(t, err)
+	upd := msg.(*pgUpdate)
+	row, err = rel.row(typeMap, mappers, upd.newTuple, upd.oldTuple)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"id": int64(2), "note": "hello", "id2": nil}, row)
+
+	// Without the old tuple, the unchanged value is not available
+	msg, err = decodePgoutput(pgMsg('U').u32(42).u8('N').u16(3).text("2").unchanged().null().bytes())
+	require.NoError(t, err)
+	upd = msg.(*pgUpdate)
+	require.Nil(t, upd.oldTuple)
+	_, err = rel.row(typeMap, mappers, upd.newTuple, upd.oldTuple)
+	require.ErrorContains(t, err, "REPLICA IDENTITY FULL")
+
+	msg, err = decodePgoutput(pgMsg('D').u32(42).u8('K').u16(3).text("2").null().null().bytes())
+	require.NoError(t, err)
+	del := msg.(*pgDelete)
+	row, err = rel.row(typeMap, mappers, del.oldTuple, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), row["id"])
+
+	msg, err = decodePgoutput(pgMsg('T').u32(2).u8(0).u32(42).u32(43).bytes())
+	require.NoError(t, err)
+	require.Equal(t, &pgTruncate{relationIDs: []uint32{42, 43}}, msg)
+
+	msg, err = decodePgoutput(pgMsg('O').u64(0).str("origin").bytes())
+	require.NoError(t, err)
+	require.Nil(t, msg)
+
+	_, err = decodePgoutput(pgMsg('I').u32(42).u8('N').u16(1).bytes())
+	require.ErrorContains(t, err, "unexpected end of message")
+}
+
+func TestReplicationSlotName(t *testing.T) {
+	require.Equal(t, "rill_default_orders_mirror", replicationSlotName("default", "Orders-Mirror"))
+
+	name := replicationSlotName("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", strings.Repeat("m", 100))
+	require.Len(t, name, 63)
+	require.NotEqual(t, name, replicationSlotName("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", strings.Repeat("m", 101)))
+}
+
+// pgMsgBuilder builds pgoutput messages for tests.
+type pgMsgBuilder struct {
+	buf []byte
+}
+
+func pgMsg(typ byte) *pgMsgBuilder {
+	return &pgMsgBuilder{buf: []byte{typ}}
+}
+
+func (b *pgMsgBuilder) u8(v byte) *pgMsgBuilder {
+	b.buf = append(b.buf, v)
+	return b
+}
+
+func (b *pgMsgBuilder) u16(v uint16) *pgMsgBuilder {
+	b.buf = binary.BigEndian.AppendUint16(b.buf, v)
+	return b
+}
+
+func (b *pgMsgBuilder) u32(v uint32) *pgMsgBuilder {
+	b.buf = binary.BigEndian.AppendUint32(b.buf, v)
+	return b
+}
+
+func (b *pgMsgBuilder) u64(v uint64) *pgMsgBuilder {
+	b.buf = binary.BigEndian.AppendUint64(b.buf, v)
+	return b
+}
+
+func (b *pgMsgBuilder) str(s string) *pgMsgBuilder {
+	b.buf = append(append(b.buf, s...), 0)
+	return b
+}
+
+func (b *pgMsgBuilder) text(s string) *pgMsgBuilder {
+	b.buf = append(b.buf, 't')
+	b.buf = binary.BigEndian.AppendUint32(b.buf, uint32(len(s)))
+	b.buf = append(b.buf, s...)
+	return b
+}
+
+func (b *pgMsgBuilder) null() *pgMsgBuilder {
+	return b.u8('n')
+}
+
+func (b *pgMsgBuilder) unchanged() *pgMsgBuilder {
+	return b.u8('u')
+}
+
+func (b *pgMsgBuilder) bytes() []byte {
+	return b.buf
+}
@@ -3,7 +3,9 @@ package postgres
 import (
 	"context"
 	"errors"
+	"sync"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"go.uber.org/zap"
@@ -51,6 +53,7 @@ var spec = drivers.Spec{
 		},
 	},
 	ImplementsSQLStore: true,
+	ImplementsOLAP:     true,
 }
 
 type driver struct{}
@@ -61,7 +64,9 @@ func (d driver) Open(instanceID string, config map[string]any, client *activity.
 	}
 	// actual db connection is opened during query
 	return &connection{
-		config: config,
+		config:     config,
+		instanceID: instanceID,
+		logger:     logger,
 	}, nil
 }
 
@@ -78,12 +83,26 @@ func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any
 }
 
 type connection struct {
-	config map[string]any
+	config     map[string]any
+	instanceID string
+	logger     *zap.Logger
+
+	// db is the connection pool used for OLAP queries, which is opened on first use
+	db   *sqlx.DB
+	dbMu sync.Mutex
 }
 
 // Ping implements drivers.Handle.
 func (c *connection) Ping(ctx context.Context) error {
-	return drivers.ErrNotImplemented
+	if url, ok := c.config["database_url"].(string); !ok || url == "" {
+		// The database_url may be set in source properties instead
+		return drivers.ErrNotImplemented
+	}
+	db, err := c.getDB()
+	if err != nil {
+		return err
+	}
+	return db.PingContext(ctx)
 }
 
 // Migrate implements drivers.Connection.
@@ -108,6 +127,11 @@ func (c *connection) Config() map[string]any {
 
 // Close implements drivers.Connection.
 func (c *connection) Close() error {
+	c.dbMu.Lock()
+	defer c.dbMu.Unlock()
+	if c.db != nil {
+		return c.db.Close()
+	}
 	return nil
 }
 
@@ -138,7 +162,7 @@ func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
 
 // AsOLAP implements drivers.Connection.
 func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
-	return nil, false
+	return c, true
 }
 
 // AsObjectStore implements drivers.Connection.
@@ -148,7 +172,17 @@ func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
 
 // AsModelExecutor implements drivers.Handle.
 func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
-	return nil, false
+	if opts.InputHandle != c {
+		return nil, false
+	}
+	if _, ok := opts.PreliminaryInputProperties["cdc"]; !ok {
+		return nil, false
+	}
+	olap, ok := opts.OutputHandle.AsOLAP(instanceID)
+	if !ok || olap.Dialect() != drivers.DialectDuckDB {
+		return nil, false
+	}
+	return &cdcExecutor{c: c, olap: olap}, true
 }
 
 // AsModelManager implements drivers.Handle.
@@ -0,0 +1,108 @@
+package drivers
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+// NewInMemoryResult returns a Result that serves the provided rows from memory.
+// It is useful for serving results that were computed outside of an OLAP store (for example, after post-processing query results in Go).
+// Each row must have one value per field in the schema. Values must be of a type supported by database/sql (see driver.Value).
+func NewInMemoryResult(schema *runtimev1.StructType, data [][]any) (*Result, error) {
+	cols := make([]string, len(schema.Fields))
+	for i, f := range schema.Fields {
+		cols[i] = f.Name
+	}
+	for _, row := range data {
+		if len(row) != len(cols) {
+			return nil, errors.New("in-memory result: row length does not match the schema")
+		}
+	}
+
+	db := sqlx.NewDb(sql.OpenDB(&memoryConnector{cols: cols, data: data}), "memory")
+	rows, err := db.Queryx("")
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	res := &Result{Rows: rows, Schema: schema}
+	res.SetCleanupFunc(db.Close)
+	return res, nil
+}
+
+// memoryConnector is a driver.Connector for a database/sql driver that serves a fixed set of rows for any query.
+type memoryConnector struct {
+	cols []string
+	data [][]any
+}
+
+var _ driver.Connector = (*memoryConnector)(nil)
+
+func (c *memoryConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	return &memoryConn{c: c}, nil
+}
+
+func (c *memoryConnector) Driver() driver.Driver {
+	return memoryDriver{}
+}
+
+type memoryDriver struct{}
+
+func (memoryDriver) Open(name string) (driver.Conn, error) {
+	return nil, errors.New("in-memory result: open not supported")
+}
+
+type memoryConn struct {
+	c *memoryConnector
+}
+
+var _ driver.QueryerContext = (*memoryConn)(nil)
+
+func (c *memoryConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("in-memory result: prepare not supported")
+}
+
+func (c *memoryConn) Close() error {
+	return nil
+}
+
+func (c *memoryConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("in-memory result: transactions not supported")
+}
+
+func (c *memoryConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &memoryRows{cols: c.c.cols, data: c.c.data}, nil
+}
+
+type memoryRows struct {
+	cols []string
+	data [][]any
+	idx  int
+}
+
+func (r *memoryRows) Columns() []string {
+	return r.cols
+}
+
+func (r *memoryRows) Close() error {
+	r.idx = len(r.data)
+	return nil
+}
+
+func (r *memoryRows) Next(dest []driver.Value) error {
+	if r.idx >= len(r.data) {
+		return io.EOF
+	}
+	for i, v := range r.data[r.idx] {
+		dest[i] = v
+	}
+	r.idx++
+	return nil
+}
@@ -35,8 +35,13 @@ type sourceProperties struct {
 	GlobPageSize          int            `mapstructure:"glob.page_size"`
 	Extract               map[string]any `mapstructure:"extract"`
 	BatchSize             string         `mapstructure:"batch_size"`
+	TableFormat           string         `mapstructure:"table_format"`
+	TableVersion          string         `mapstructure:"table_version"`
+	PartitionFilters      map[string]any `mapstructure:"partition_filters"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	tableOptions          *rillblob.TableOptions
+	partitionFilters      rillblob.PartitionFilters
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -70,6 +75,19 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 		return nil, fmt.Errorf("failed to parse extract config: %w", err)
 	}
 
+	conf.tableOptions, err = rillblob.ParseTableOptions(conf.TableFormat, conf.TableVersion, conf.PartitionFilters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse table config: %w", err)
+	}
+
+	// Without a table format, the partition filters apply to Hive partitions in the paths
+	if conf.tableOptions == nil {
+		conf.partitionFilters, err = rillblob.ParsePartitionFilters(conf.PartitionFilters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse partition filters: %w", err)
+		}
+	}
+
 	return conf, nil
 }
 
@@ -96,7 +114,7 @@ func (c *Connection) ListObjects(ctx context.Context, propsMap map[string]any) (
 	}
 	defer bucket.Close()
 
-	return bucket.ListObjects(ctx, props.url.Path)
+	return bucket.ListObjects(ctx, props.url.Path, props.partitionFilters)
 }
 
 // DownloadFiles implements drivers.ObjectStore.
@@ -133,6 +151,8 @@ func (c *Connection) DownloadFiles(ctx context.Context, src map[string]any) (dri
 		GlobPageSize:          conf.GlobPageSize,
 		GlobPattern:           conf.url.Path,
 		ExtractPolicy:         conf.extractPolicy,
+		Table:                 conf.tableOptions,
+		PartitionFilters:      conf.partitionFilters,
 		BatchSizeBytes:        int64(batchSize.Bytes()),
 		KeepFilesUntilClose:   conf.BatchSize == "-1",
 		RetainFiles:           c.config.RetainFiles,
@@ -48,19 +48,40 @@ func newNotifier(token string, propsMap map[string]any) (*notifier, error) {
 }
 
 func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	data := &ScheduledReportData{
+		Title:            s.Title,
+		ReportTimeString: s.ReportTime.Format(time.RFC1123),
+		DownloadFormat:   s.DownloadFormat,
+		OpenLink:         htemplate.URL(s.OpenLink),
+		DownloadLink:     htemplate.URL(s.DownloadLink),
+		EditLink:         htemplate.URL(s.EditLink),
+		HasAttachment:    s.Attachment != nil,
+	}
+
 	buf := new(bytes.Buffer)
-	err := n.templates.Lookup("scheduled_report.slack").Execute(buf, s)
+	err := n.templates.Lookup("scheduled_report.slack").Execute(buf, data)
 	if err != nil {
 		return fmt.Errorf("slack template error: %w", err)
 	}
 	txt := buf.String()
 
-	if err := n.sendTextToChannels(txt); err != nil {
+	if err := n.sendToChannels(txt, s.Attachment); err != nil {
 		return err
 	}
-	if err := n.sendTextToUsers(txt); err != nil {
+	if err := n.sendToUsers(txt, s.Attachment); err != nil {
 		return err
 	}
+
+	// Files can't be sent via webhooks, so webhook recipients only get the links.
+	if data.HasAttachment && len(n.props.Webhooks) > 0 {
+		data.HasAttachment = false
+		buf.Reset()
+		err := n.templates.Lookup("scheduled_report.slack").Execute(buf, data)
+		if err != nil {
+			return fmt.Errorf("slack template error: %w", err)
+		}
+		txt = buf.String()
+	}
 	return n.sendTextViaWebhooks(txt)
 }
 
@@ -111,10 +132,10 @@ func (n *notifier) sendAlertStatus(data *AlertStatusData) error {
 	}
 	txt := buf.String()
 
-	if err := n.sendTextToChannels(txt); err != nil {
+	if err := n.sendToChannels(txt, nil); err != nil {
 		return err
 	}
-	if err := n.sendTextToUsers(txt); err != nil {
+	if err := n.sendToUsers(txt, nil); err != nil {
 		return err
 	}
 	return n.sendTextViaWebhooks(txt)
@@ -130,16 +151,18 @@ func (n *notifier) sendAlertFail(data *AlertFailData) error {
 	}
 	txt := buf.String()
 
-	if err := n.sendTextToChannels(txt); err != nil {
+	if err := n.sendToChannels(txt, nil); err != nil {
 		return err
 	}
-	if err := n.sendTextToUsers(txt); err != nil {
+	if err := n.sendToUsers(txt, nil); err != nil {
 		return err
 	}
 	return n.sendTextViaWebhooks(txt)
 }
 
-func (n *notifier) sendTextToChannels(txt string) error {
+// sendToChannels posts the text to the configured channels.
+// If file is not nil, it is uploaded to the channels after the text.
+func (n *notifier) sendToChannels(txt string, file *drivers.ReportAttachment) error {
 	if len(n.props.Channels) == 0 {
 		return nil
 	}
@@ -149,15 +172,16 @@ func (n *notifier) sendTextToChannels(txt string) error {
 	}
 
 	for _, channel := range n.props.Channels {
-		_, _, err := n.api.PostMessage(channel, slack.MsgOptionText(txt, false), slack.MsgOptionDisableLinkUnfurl())
-		if err != nil {
-			return fmt.Errorf("slack api error: %w", err)
+		if err := n.post(channel, txt, file); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func (n *notifier) sendTextToUsers(txt string) error {
+// sendToUsers sends the text as a direct message to the configured users.
+// If file is not nil, it is uploaded to the direct message conversations after the text.
+func (n *notifier) sendToUsers(txt string, file *drivers.ReportAttachment) error {
 	if len(n.props.Users) == 0 {
 		return nil
 	}
@@ -171,14 +195,37 @@ func (n *notifier) sendTextToUsers(txt string) error {
 		if err != nil {
 			return fmt.Errorf("slack api error: %w", err)
 		}
-		_, _, err = n.api.PostMessage(user.ID, slack.MsgOptionText(txt, false), slack.MsgOptionDisableLinkUnfurl())
-		if err != nil {
-			return fmt.Errorf("slack api error: %w", err)
+		if err := n.post(user.ID, txt, file); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// post posts a message and optionally uploads a file to the same conversation.
+// The file upload API requires a conversation ID, so it uses the ID returned when posting the message (which resolves channel names and user IDs).
+func (n *notifier) post(channel, txt string, file *drivers.ReportAttachment) error {
+	channelID, _, err := n.api.PostMessage(channel, slack.MsgOptionText(txt, false), slack.MsgOptionDisableLinkUnfurl())
+	if err != nil {
+		return fmt.Errorf("slack api error: %w", err)
+	}
+	if file == nil {
+		return nil
+	}
+
+	_, err = n.api.UploadFileV2(slack.UploadFileV2Parameters{
+		Reader:   bytes.NewReader(file.Data),
+		FileSize: len(file.Data),
+		Filename: file.Filename,
+		Title:    file.Filename,
+		Channel:  channelID,
+	})
+	if err != nil {
+		return fmt.Errorf("slack file upload error: %w", err)
+	}
+	return nil
+}
+
 func (n *notifier) sendTextViaWebhooks(txt string) error {
 	for _, webhook := range n.props.Webhooks {
 		payload := slack.WebhookMessage{
@@ -209,6 +256,16 @@ func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
 	return props, nil
 }
 
+type ScheduledReportData struct {
+	Title            string
+	ReportTimeString string // Will be inferred from ReportTime
+	DownloadFormat   string
+	OpenLink         htemplate.URL
+	DownloadLink     htemplate.URL
+	EditLink         htemplate.URL
+	HasAttachment    bool
+}
+
 type AlertStatusData struct {
 	Subject             string
 	Title               string
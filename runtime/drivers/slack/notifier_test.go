@@ -0,0 +1,102 @@
+package slack
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSlack struct {
+	messages map[string]string // Channel ID -> text
+	uploads  map[string]string // Channel ID -> file content
+	pending  string            // Content of the last file uploaded to the upload URL
+	webhook  []string
+}
+
+func newFakeSlack(t *testing.T) (*httptest.Server, *fakeSlack) {
+	f := &fakeSlack{messages: map[string]string{}, uploads: map[string]string{}}
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		write := func(v any) {
+			w.Header().Set("Content-Type", "application/json")
+			require.NoError(t, json.NewEncoder(w).Encode(v))
+		}
+
+		switch r.URL.Path {
+		case "/chat.postMessage":
+			require.NoError(t, r.ParseForm())
+			channel := r.Form.Get("channel")
+			if strings.HasPrefix(channel, "U") {
+				channel = "D" + channel // Direct messages are posted to a separate conversation
+			} else {
+				channel = "C" + strings.TrimPrefix(channel, "#")
+			}
+			f.messages[channel] = r.Form.Get("text")
+			write(map[string]any{"ok": true, "channel": channel, "ts": "1.1"})
+		case "/users.lookupByEmail":
+			require.NoError(t, r.ParseForm())
+			write(map[string]any{"ok": true, "user": map[string]any{"id": "U" + strings.Split(r.Form.Get("email"), "@")[0]}})
+		case "/files.getUploadURLExternal":
+			write(map[string]any{"ok": true, "upload_url": srv.URL + "/upload", "file_id": "F1"})
+		case "/upload":
+			file, _, err := r.FormFile("file")
+			require.NoError(t, err)
+			data, err := io.ReadAll(file)
+			require.NoError(t, err)
+			f.pending = string(data)
+			write(map[string]any{"ok": true})
+		case "/files.completeUploadExternal":
+			require.NoError(t, r.ParseForm())
+			f.uploads[r.Form.Get("channel_id")] = f.pending
+			write(map[string]any{"ok": true, "files": []any{map[string]any{"id": "F1", "title": "report.csv"}}})
+		case "/webhook":
+			var msg slack.WebhookMessage
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+			f.webhook = append(f.webhook, msg.Text)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, f
+}
+
+func TestSendScheduledReport(t *testing.T) {
+	srv, f := newFakeSlack(t)
+
+	n, err := newNotifier("", EncodeProps([]string{"alice@example.com"}, []string{"#reports"}, []string{srv.URL + "/webhook"}))
+	require.NoError(t, err)
+	n.api = slack.New("token", slack.OptionAPIURL(srv.URL+"/"))
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{
+		Title:          "Weekly",
+		ReportTime:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		DownloadFormat: "CSV",
+		OpenLink:       "https://example.com/open",
+		DownloadLink:   "https://example.com/download",
+		EditLink:       "https://example.com/edit",
+		Attachment:     &drivers.ReportAttachment{Filename: "report.csv", ContentType: "text/csv", Data: []byte("a,b\n1,2\n")},
+	})
+	require.NoError(t, err)
+
+	// The file is uploaded to the conversations the messages were posted to
+	require.Len(t, f.messages, 2)
+	require.Contains(t, f.messages["Creports"], "*Weekly*")
+	require.Contains(t, f.messages["Creports"], "Tue, 02 Jan 2024 03:04:05 UTC")
+	require.Contains(t, f.messages["Creports"], "The file is also attached below.")
+	require.Equal(t, map[string]string{"Creports": "a,b\n1,2\n", "DUalice": "a,b\n1,2\n"}, f.uploads)
+
+	// Webhooks can't receive files
+	require.Len(t, f.webhook, 1)
+	require.Contains(t, f.webhook[0], "<https://example.com/download|download the CSV file>")
+	require.NotContains(t, f.webhook[0], "attached")
+}
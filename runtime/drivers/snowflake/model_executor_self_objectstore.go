@@ -2,6 +2,7 @@ package snowflake
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -31,6 +32,9 @@ func (e *selfToObjectStoreExecutor) Execute(ctx context.Context, opts *drivers.M
 	if err := mapstructure.Decode(opts.OutputProperties, props); err != nil {
 		return nil, err
 	}
+	if props.Compression != "" || len(props.PartitionBy) != 0 {
+		return nil, errors.New(`the "compression" and "partition_by" output properties are not supported for Snowflake exports`)
+	}
 	var format drivers.FileFormat
 	if props.Format != "" {
 		format = props.Format
@@ -0,0 +1,142 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+type informationSchema struct {
+	c *connection
+}
+
+func (c *connection) InformationSchema() drivers.InformationSchema {
+	return informationSchema{c: c}
+}
+
+// All lists the tables in the databases of the default catalog. Tables in external catalogs can be queried, but are not listed.
+func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
+	q := `
+		SELECT
+			T.TABLE_SCHEMA,
+			T.TABLE_SCHEMA = DATABASE() AS is_default_schema,
+			T.TABLE_NAME,
+			T.TABLE_TYPE,
+			C.COLUMN_NAME,
+			C.DATA_TYPE,
+			C.IS_NULLABLE = 'YES' AS nullable
+		FROM information_schema.tables T
+		JOIN information_schema.columns C ON T.TABLE_SCHEMA = C.TABLE_SCHEMA AND T.TABLE_NAME = C.TABLE_NAME
+		WHERE T.TABLE_SCHEMA NOT IN ('information_schema', '_statistics_', 'sys')
+		ORDER BY T.TABLE_SCHEMA, T.TABLE_NAME, C.ORDINAL_POSITION
+	`
+
+	rows, err := i.c.db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return i.scanTables(rows, "")
+}
+
+// Lookup finds a table. The db is an optional catalog name, and the schema is the StarRocks database (which defaults to the connection's database).
+func (i informationSchema) Lookup(ctx context.Context, db, schema, name string) (*drivers.Table, error) {
+	infoSchema := "information_schema"
+	if db != "" {
+		infoSchema = fmt.Sprintf("%s.information_schema", drivers.DialectStarRocks.EscapeIdentifier(db))
+	}
+
+	q := fmt.Sprintf(`
+		SELECT
+			T.TABLE_SCHEMA,
+			T.TABLE_SCHEMA = DATABASE() AS is_default_schema,
+			T.TABLE_NAME,
+			T.TABLE_TYPE,
+			C.COLUMN_NAME,
+			C.DATA_TYPE,
+			C.IS_NULLABLE = 'YES' AS nullable
+		FROM %[1]s.tables T
+		JOIN %[1]s.columns C ON T.TABLE_SCHEMA = C.TABLE_SCHEMA AND T.TABLE_NAME = C.TABLE_NAME
+		WHERE T.TABLE_SCHEMA = coalesce(?, DATABASE()) AND T.TABLE_NAME = ?
+		ORDER BY T.TABLE_SCHEMA, T.TABLE_NAME, C.ORDINAL_POSITION
+	`, infoSchema)
+
+	var args []any
+	if schema == "" {
+		args = append(args, nil, name)
+	} else {
+		args = append(args, schema, name)
+	}
+
+	rows, err := i.c.db.QueryxContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables, err := i.scanTables(rows, db)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tables) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+
+	return tables[0], nil
+}
+
+func (i informationSchema) scanTables(rows *sqlx.Rows, db string) ([]*drivers.Table, error) {
+	var res []*drivers.Table
+
+	for rows.Next() {
+		var databaseSchema string
+		var isDefaultSchema bool
+		var name string
+		var tableType string
+		var columnName string
+		var columnType string
+		var nullable bool
+
+		err := rows.Scan(&databaseSchema, &isDefaultSchema, &name, &tableType, &columnName, &columnType, &nullable)
+		if err != nil {
+			return nil, err
+		}
+
+		// set t to res[len(res)-1] if it's the same table, else set t to a new table and append it
+		var t *drivers.Table
+		if len(res) > 0 {
+			t = res[len(res)-1]
+			if !(t.DatabaseSchema == databaseSchema && t.Name == name) {
+				t = nil
+			}
+		}
+		if t == nil {
+			t = &drivers.Table{
+				Database:                db,
+				DatabaseSchema:          databaseSchema,
+				IsDefaultDatabase:       db == "",
+				IsDefaultDatabaseSchema: isDefaultSchema,
+				Name:                    name,
+				View:                    tableType == "VIEW",
+				Schema:                  &runtimev1.StructType{},
+			}
+			res = append(res, t)
+		}
+
+		t.Schema.Fields = append(t.Schema.Fields, &runtimev1.StructType_Field{
+			Name: columnName,
+			Type: databaseTypeToPB(columnType, nullable),
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
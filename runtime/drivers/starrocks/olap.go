@@ -0,0 +1,191 @@
+package starrocks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+var _ drivers.OLAPStore = &connection{}
+
+// AddTableColumn implements drivers.OLAPStore.
+func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
+	return fmt.Errorf("starrocks: data transformation not yet supported")
+}
+
+// AlterTableColumn implements drivers.OLAPStore.
+func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName, newType string) error {
+	return fmt.Errorf("starrocks: data transformation not yet supported")
+}
+
+// CreateTableAsSelect implements drivers.OLAPStore.
+func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string, tableOpts map[string]any) error {
+	return fmt.Errorf("starrocks: data transformation not yet supported")
+}
+
+// DropTable implements drivers.OLAPStore.
+func (c *connection) DropTable(ctx context.Context, name string, view bool) error {
+	return fmt.Errorf("starrocks: data transformation not yet supported")
+}
+
+// InsertTableAsSelect implements drivers.OLAPStore.
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string, partitionBy string) error {
+	return fmt.Errorf("starrocks: data transformation not yet supported")
+}
+
+// RenameTable implements drivers.OLAPStore.
+func (c *connection) RenameTable(ctx context.Context, name, newName string, view bool) error {
+	return fmt.Errorf("starrocks: data transformation not yet supported")
+}
+
+func (c *connection) Dialect() drivers.Dialect {
+	return drivers.DialectStarRocks
+}
+
+func (c *connection) WithConnection(ctx context.Context, priority int, longRunning, tx bool, fn drivers.WithConnectionFunc) error {
+	return fmt.Errorf("starrocks: WithConnection not supported")
+}
+
+func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	res, err := c.Execute(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if stmt.DryRun {
+		return nil
+	}
+	return res.Close()
+}
+
+func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
+	// Log query if enabled (usually disabled)
+	if c.config.LogQueries {
+		c.logger.Info("starrocks query", zap.String("sql", stmt.Query), zap.Any("args", stmt.Args))
+	}
+
+	if stmt.DryRun {
+		rows, err := c.db.QueryxContext(ctx, "EXPLAIN "+stmt.Query, stmt.Args...)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, rows.Close()
+	}
+
+	var cancelFunc context.CancelFunc
+	if stmt.ExecutionTimeout != 0 {
+		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+	}
+
+	rows, err := c.db.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	if err != nil {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil, err
+	}
+
+	schema, err := rowsToSchema(rows)
+	if err != nil {
+		rows.Close()
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil, err
+	}
+
+	r := &drivers.Result{Rows: rows, Schema: schema}
+	r.SetCleanupFunc(func() error {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return nil
+	})
+
+	return r, nil
+}
+
+func (c *connection) MayBeScaledToZero(ctx context.Context) bool {
+	return false
+}
+
+func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	cts, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*runtimev1.StructType_Field, len(cts))
+	for i, ct := range cts {
+		nullable, ok := ct.Nullable()
+		if !ok {
+			nullable = true
+		}
+
+		fields[i] = &runtimev1.StructType_Field{
+			Name: ct.Name(),
+			Type: databaseTypeToPB(ct.DatabaseTypeName(), nullable),
+		}
+	}
+
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+// databaseTypeToPB maps a type name to a runtime type. It handles both the type names reported by the MySQL driver for query results
+// and the StarRocks type names in the DATA_TYPE column of information_schema.columns.
+func databaseTypeToPB(dbt string, nullable bool) *runtimev1.Type {
+	t := &runtimev1.Type{Nullable: nullable}
+	dbt = strings.ToUpper(dbt)
+	if i := strings.IndexAny(dbt, "(<"); i >= 0 {
+		dbt = dbt[:i]
+	}
+	switch dbt {
+	case "BOOLEAN":
+		t.Code = runtimev1.Type_CODE_BOOL
+	case "TINYINT":
+		t.Code = runtimev1.Type_CODE_INT8
+	case "SMALLINT":
+		t.Code = runtimev1.Type_CODE_INT16
+	case "INT", "MEDIUMINT", "YEAR":
+		t.Code = runtimev1.Type_CODE_INT32
+	case "BIGINT":
+		t.Code = runtimev1.Type_CODE_INT64
+	case "LARGEINT":
+		t.Code = runtimev1.Type_CODE_INT128
+	case "UNSIGNED TINYINT":
+		t.Code = runtimev1.Type_CODE_UINT8
+	case "UNSIGNED SMALLINT":
+		t.Code = runtimev1.Type_CODE_UINT16
+	case "UNSIGNED INT":
+		t.Code = runtimev1.Type_CODE_UINT32
+	case "UNSIGNED BIGINT":
+		t.Code = runtimev1.Type_CODE_UINT64
+	case "FLOAT":
+		t.Code = runtimev1.Type_CODE_FLOAT32
+	case "DOUBLE":
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case "DECIMAL", "DECIMALV2", "DECIMAL32", "DECIMAL64", "DECIMAL128":
+		t.Code = runtimev1.Type_CODE_DECIMAL
+	case "DATE":
+		t.Code = runtimev1.Type_CODE_DATE
+	case "DATETIME", "TIMESTAMP":
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case "JSON":
+		t.Code = runtimev1.Type_CODE_JSON
+	case "BINARY", "VARBINARY", "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB":
+		t.Code = runtimev1.Type_CODE_BYTES
+	default:
+		// Includes CHAR, VARCHAR and STRING, and ARRAY, MAP and STRUCT, which are returned as JSON-formatted strings over the MySQL protocol
+		t.Code = runtimev1.Type_CODE_STRING
+	}
+	return t
+}
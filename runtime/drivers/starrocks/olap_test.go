@@ -0,0 +1,138 @@
+package starrocks_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestOLAP(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starrocks: skipping test in short mode")
+	}
+
+	dsn := testruntime.StarRocks(t)
+	conn, err := drivers.Open("starrocks", "default", map[string]any{"dsn": dsn}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.Ping(context.Background()))
+
+	olap, ok := conn.AsOLAP("default")
+	require.True(t, ok)
+	require.Equal(t, drivers.DialectStarRocks, olap.Dialect())
+
+	ctx := context.Background()
+
+	t.Run("InformationSchema", func(t *testing.T) {
+		tbl, err := olap.InformationSchema().Lookup(ctx, "", "", "ad_bids")
+		require.NoError(t, err)
+		require.Equal(t, "rill", tbl.DatabaseSchema)
+		require.True(t, tbl.IsDefaultDatabaseSchema)
+		require.False(t, tbl.View)
+		require.Len(t, tbl.Schema.Fields, 5)
+		require.Equal(t, runtimev1.Type_CODE_INT64, tbl.Schema.Fields[0].Type.Code)
+		require.Equal(t, runtimev1.Type_CODE_TIMESTAMP, tbl.Schema.Fields[1].Type.Code)
+		require.Equal(t, runtimev1.Type_CODE_STRING, tbl.Schema.Fields[2].Type.Code)
+		require.Equal(t, runtimev1.Type_CODE_FLOAT64, tbl.Schema.Fields[4].Type.Code)
+
+		_, err = olap.InformationSchema().Lookup(ctx, "", "", "missing")
+		require.ErrorIs(t, err, drivers.ErrNotFound)
+
+		tbls, err := olap.InformationSchema().All(ctx)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(tbls), 2)
+	})
+
+	t.Run("Execute", func(t *testing.T) {
+		res, err := olap.Execute(ctx, &drivers.Statement{
+			Query: "SELECT publisher, COUNT(*) AS cnt, AVG(bid_prices) AS avg_bid FROM ad_bids WHERE domain = ? GROUP BY publisher ORDER BY publisher NULLS LAST",
+			Args:  []any{"google.com"},
+		})
+		require.NoError(t, err)
+		defer res.Close()
+		require.Equal(t, runtimev1.Type_CODE_STRING, res.Schema.Fields[0].Type.Code)
+		require.Equal(t, runtimev1.Type_CODE_INT64, res.Schema.Fields[1].Type.Code)
+		require.Equal(t, runtimev1.Type_CODE_FLOAT64, res.Schema.Fields[2].Type.Code)
+
+		var n int
+		for res.Next() {
+			row := map[string]any{}
+			require.NoError(t, res.MapScan(row))
+			require.IsType(t, int64(0), row["cnt"])
+			require.IsType(t, float64(0), row["avg_bid"])
+			n++
+		}
+		require.NoError(t, res.Err())
+		require.Greater(t, n, 0)
+
+		err = olap.Exec(ctx, &drivers.Statement{Query: "SELECT missing FROM ad_bids", DryRun: true})
+		require.Error(t, err)
+
+		err = olap.CreateTableAsSelect(ctx, "foo", false, "SELECT 1", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("DateTrunc", func(t *testing.T) {
+		dim := &runtimev1.MetricsViewSpec_DimensionV2{Column: "ts"}
+		tests := []struct {
+			grain            runtimev1.TimeGrain
+			tz               string
+			firstDayOfWeek   int
+			firstMonthOfYear int
+			want             time.Time
+		}{
+			{runtimev1.TimeGrain_TIME_GRAIN_DAY, "", 1, 1, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)},
+			{runtimev1.TimeGrain_TIME_GRAIN_DAY, "Asia/Kolkata", 1, 1, time.Date(2024, 1, 8, 18, 30, 0, 0, time.UTC)},
+			{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "", 1, 1, time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)},
+			{runtimev1.TimeGrain_TIME_GRAIN_WEEK, "", 7, 1, time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+			{runtimev1.TimeGrain_TIME_GRAIN_YEAR, "", 1, 4, time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)},
+		}
+		for _, tt := range tests {
+			expr, err := drivers.DialectStarRocks.DateTruncExpr(dim, tt.grain, tt.tz, tt.firstDayOfWeek, tt.firstMonthOfYear)
+			require.NoError(t, err)
+
+			res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT " + expr + " FROM (SELECT CAST('2024-01-08 23:30:00' AS DATETIME) AS ts) t"})
+			require.NoError(t, err)
+			require.True(t, res.Next())
+			var got time.Time
+			require.NoError(t, res.Scan(&got))
+			require.NoError(t, res.Close())
+			require.True(t, tt.want.Equal(got), "grain %s, tz %q: expected %s, got %s", tt.grain, tt.tz, tt.want, got)
+		}
+	})
+
+	t.Run("DateDiff", func(t *testing.T) {
+		t1 := time.Date(2024, 1, 31, 23, 0, 0, 0, time.UTC)
+		t2 := time.Date(2024, 2, 1, 1, 0, 0, 0, time.UTC)
+		tests := []struct {
+			grain runtimev1.TimeGrain
+			want  int64
+		}{
+			{runtimev1.TimeGrain_TIME_GRAIN_HOUR, 2},
+			{runtimev1.TimeGrain_TIME_GRAIN_DAY, 1},
+			{runtimev1.TimeGrain_TIME_GRAIN_MONTH, 1},
+			{runtimev1.TimeGrain_TIME_GRAIN_QUARTER, 0},
+			{runtimev1.TimeGrain_TIME_GRAIN_YEAR, 0},
+		}
+		for _, tt := range tests {
+			expr, err := drivers.DialectStarRocks.DateDiff(tt.grain, t1, t2)
+			require.NoError(t, err)
+
+			res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT " + expr})
+			require.NoError(t, err)
+			require.True(t, res.Next())
+			var got int64
+			require.NoError(t, res.Scan(&got))
+			require.NoError(t, res.Close())
+			require.Equal(t, tt.want, got, "grain %s", tt.grain)
+		}
+	})
+}
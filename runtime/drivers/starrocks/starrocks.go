@@ -0,0 +1,291 @@
+package starrocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.uber.org/zap"
+)
+
+func init() {
+	drivers.Register("starrocks", driver{})
+	drivers.RegisterAsConnector("starrocks", driver{})
+}
+
+var spec = drivers.Spec{
+	DisplayName: "StarRocks",
+	Description: "Connect to StarRocks.",
+	DocsURL:     "https://docs.rilldata.com/reference/olap-engines/starrocks",
+	ConfigProperties: []*drivers.PropertySpec{
+		{
+			Key:         "dsn",
+			Type:        drivers.StringPropertyType,
+			Required:    false,
+			DisplayName: "Connection string",
+			Placeholder: "username:password@tcp(localhost:9030)/my_db",
+			DocsURL:     "https://github.com/go-sql-driver/mysql?tab=readme-ov-file#dsn-data-source-name",
+			Secret:      true,
+			NoPrompt:    true,
+		},
+		{
+			Key:         "host",
+			Type:        drivers.StringPropertyType,
+			Required:    true,
+			DisplayName: "Host",
+			Description: "Hostname or IP address of the StarRocks frontend",
+			Placeholder: "localhost",
+		},
+		{
+			Key:         "port",
+			Type:        drivers.NumberPropertyType,
+			Required:    false,
+			DisplayName: "Port",
+			Description: "MySQL protocol port of the StarRocks frontend",
+			Placeholder: "9030",
+		},
+		{
+			Key:         "username",
+			Type:        drivers.StringPropertyType,
+			Required:    false,
+			DisplayName: "Username",
+			Description: "Username to connect to StarRocks",
+			Placeholder: "root",
+		},
+		{
+			Key:         "password",
+			Type:        drivers.StringPropertyType,
+			Required:    false,
+			DisplayName: "Password",
+			Description: "Password to connect to StarRocks",
+			Placeholder: "password",
+			Secret:      true,
+		},
+		{
+			Key:         "database",
+			Type:        drivers.StringPropertyType,
+			Required:    false,
+			DisplayName: "Database",
+			Description: "Default database for unqualified table names",
+			Placeholder: "my_db",
+		},
+	},
+	ImplementsOLAP: true,
+}
+
+// maxOpenConnections caps the connections to the frontend. StarRocks is designed for high query concurrency, so it's higher than for the other OLAPs.
+var maxOpenConnections = 32
+
+type driver struct{}
+
+type configProperties struct {
+	// DSN is a MySQL connection string. Either DSN can be passed or the individual properties below can be set.
+	DSN      string `mapstructure:"dsn"`
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	// Database is the default database for unqualified table names.
+	Database string `mapstructure:"database"`
+	// LogQueries controls whether to log the raw SQL passed to OLAP.Execute.
+	LogQueries bool `mapstructure:"log_queries"`
+}
+
+// mysqlConfig returns the config of the MySQL client used to connect to the StarRocks frontend.
+func (c *configProperties) mysqlConfig() (*mysql.Config, error) {
+	var conf *mysql.Config
+	if c.DSN != "" {
+		var err error
+		conf, err = mysql.ParseDSN(c.DSN)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse DSN: %w", err)
+		}
+	} else if c.Host != "" {
+		port := c.Port
+		if port == 0 {
+			port = 9030
+		}
+		conf = mysql.NewConfig()
+		conf.Net = "tcp"
+		conf.Addr = c.Host + ":" + strconv.Itoa(port)
+		conf.User = c.Username
+		conf.Passwd = c.Password
+		conf.DBName = c.Database
+	} else {
+		return nil, errors.New("either 'dsn' or 'host' must be set")
+	}
+
+	// StarRocks' DATETIME has no time zone, so we treat all timestamps as UTC (see drivers.Dialect.DateTruncExpr).
+	conf.ParseTime = true
+	conf.Loc = time.UTC
+	if conf.Params == nil {
+		conf.Params = map[string]string{}
+	}
+	conf.Params["time_zone"] = "'+00:00'"
+	// Query args are interpolated client-side, so queries with args don't need a prepared statement round trip
+	conf.InterpolateParams = true
+	return conf, nil
+}
+
+// Open connects to the StarRocks frontend using the MySQL protocol.
+func (d driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if instanceID == "" {
+		return nil, errors.New("starrocks driver can't be shared")
+	}
+
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	mysqlConf, err := conf.mysqlConfig()
+	if err != nil {
+		return nil, fmt.Errorf("starrocks: %w", err)
+	}
+	connector, err := mysql.NewConnector(mysqlConf)
+	if err != nil {
+		return nil, fmt.Errorf("starrocks: %w", err)
+	}
+
+	// The connection is established lazily on the first query
+	db := sqlx.NewDb(newTypedDB(connector), "mysql")
+	db.SetMaxOpenConns(maxOpenConnections)
+	db.SetMaxIdleConns(maxOpenConnections)
+	db.SetConnMaxIdleTime(5 * time.Minute)
+
+	return &connection{
+		db:         db,
+		config:     conf,
+		configMap:  config,
+		instanceID: instanceID,
+		logger:     logger,
+	}, nil
+}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, src map[string]any, logger *zap.Logger) (bool, error) {
+	return false, nil
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+type connection struct {
+	db         *sqlx.DB
+	config     *configProperties
+	configMap  map[string]any
+	instanceID string
+	logger     *zap.Logger
+}
+
+// Ping implements drivers.Handle.
+func (c *connection) Ping(ctx context.Context) error {
+	return c.db.PingContext(ctx)
+}
+
+// Migrate implements drivers.Connection.
+func (c *connection) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Handle.
+func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// Driver implements drivers.Connection.
+func (c *connection) Driver() string {
+	return "starrocks"
+}
+
+// Config implements drivers.Connection.
+func (c *connection) Config() map[string]any {
+	return c.configMap
+}
+
+// Close implements drivers.Connection.
+func (c *connection) Close() error {
+	return c.db.Close()
+}
+
+// AsRegistry implements drivers.Connection.
+func (c *connection) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Connection.
+func (c *connection) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Connection.
+func (c *connection) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (c *connection) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+// AsAI implements drivers.Handle.
+func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+// AsOLAP implements drivers.Connection.
+func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return c, true
+}
+
+// AsObjectStore implements drivers.Connection.
+func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsModelExecutor implements drivers.Handle.
+func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (c *connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Connection.
+func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+// AsFileStore implements drivers.Connection.
+func (c *connection) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsWarehouse implements drivers.Handle.
+func (c *connection) AsWarehouse() (drivers.Warehouse, bool) {
+	return nil, false
+}
+
+// AsSQLStore implements drivers.Connection.
+func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+// AsNotifier implements drivers.Connection.
+func (c *connection) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return nil, drivers.ErrNotNotifier
+}
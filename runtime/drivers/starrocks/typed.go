@@ -0,0 +1,231 @@
+package starrocks
+
+import (
+	"context"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"strconv"
+)
+
+// newTypedDB returns a database handle for the connector that returns typed values for numeric and text columns.
+// The MySQL driver returns most values of text protocol results as raw bytes, which the runtime can't serialize.
+func newTypedDB(c sqldriver.Connector) *sql.DB {
+	return sql.OpenDB(&typedConnector{Connector: c})
+}
+
+type typedConnector struct {
+	sqldriver.Connector
+}
+
+func (c *typedConnector) Connect(ctx context.Context) (sqldriver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &typedConn{Conn: conn}, nil
+}
+
+// typedConn wraps a MySQL driver connection to wrap the rows it returns.
+// It forwards the optional interfaces implemented by the MySQL driver.
+type typedConn struct {
+	sqldriver.Conn
+}
+
+var (
+	_ sqldriver.QueryerContext     = &typedConn{}
+	_ sqldriver.ExecerContext      = &typedConn{}
+	_ sqldriver.ConnPrepareContext = &typedConn{}
+	_ sqldriver.ConnBeginTx        = &typedConn{}
+	_ sqldriver.Pinger             = &typedConn{}
+	_ sqldriver.SessionResetter    = &typedConn{}
+	_ sqldriver.Validator          = &typedConn{}
+	_ sqldriver.NamedValueChecker  = &typedConn{}
+)
+
+func (c *typedConn) QueryContext(ctx context.Context, query string, args []sqldriver.NamedValue) (sqldriver.Rows, error) {
+	q, ok := c.Conn.(sqldriver.QueryerContext)
+	if !ok {
+		return nil, sqldriver.ErrSkip
+	}
+	rows, err := q.QueryContext(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return newTypedRows(rows), nil
+}
+
+func (c *typedConn) ExecContext(ctx context.Context, query string, args []sqldriver.NamedValue) (sqldriver.Result, error) {
+	e, ok := c.Conn.(sqldriver.ExecerContext)
+	if !ok {
+		return nil, sqldriver.ErrSkip
+	}
+	return e.ExecContext(ctx, query, args)
+}
+
+func (c *typedConn) PrepareContext(ctx context.Context, query string) (sqldriver.Stmt, error) {
+	var stmt sqldriver.Stmt
+	var err error
+	if p, ok := c.Conn.(sqldriver.ConnPrepareContext); ok {
+		stmt, err = p.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &typedStmt{Stmt: stmt}, nil
+}
+
+func (c *typedConn) BeginTx(ctx context.Context, opts sqldriver.TxOptions) (sqldriver.Tx, error) {
+	if b, ok := c.Conn.(sqldriver.ConnBeginTx); ok {
+		return b.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin() // nolint:staticcheck // Fallback for drivers without BeginTx
+}
+
+func (c *typedConn) Ping(ctx context.Context) error {
+	if p, ok := c.Conn.(sqldriver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *typedConn) ResetSession(ctx context.Context) error {
+	if r, ok := c.Conn.(sqldriver.SessionResetter); ok {
+		return r.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *typedConn) IsValid() bool {
+	if v, ok := c.Conn.(sqldriver.Validator); ok {
+		return v.IsValid()
+	}
+	return true
+}
+
+func (c *typedConn) CheckNamedValue(nv *sqldriver.NamedValue) error {
+	if n, ok := c.Conn.(sqldriver.NamedValueChecker); ok {
+		return n.CheckNamedValue(nv)
+	}
+	return sqldriver.ErrSkip
+}
+
+type typedStmt struct {
+	sqldriver.Stmt
+}
+
+func (s *typedStmt) QueryContext(ctx context.Context, args []sqldriver.NamedValue) (sqldriver.Rows, error) {
+	q, ok := s.Stmt.(sqldriver.StmtQueryContext)
+	if !ok {
+		return nil, sqldriver.ErrSkip
+	}
+	rows, err := q.QueryContext(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return newTypedRows(rows), nil
+}
+
+func (s *typedStmt) ExecContext(ctx context.Context, args []sqldriver.NamedValue) (sqldriver.Result, error) {
+	e, ok := s.Stmt.(sqldriver.StmtExecContext)
+	if !ok {
+		return nil, sqldriver.ErrSkip
+	}
+	return e.ExecContext(ctx, args)
+}
+
+func (s *typedStmt) CheckNamedValue(nv *sqldriver.NamedValue) error {
+	if n, ok := s.Stmt.(sqldriver.NamedValueChecker); ok {
+		return n.CheckNamedValue(nv)
+	}
+	return sqldriver.ErrSkip
+}
+
+// typedRows converts the raw bytes returned for a column to a value matching the column's database type.
+type typedRows struct {
+	sqldriver.Rows
+	types []string
+}
+
+func newTypedRows(rows sqldriver.Rows) *typedRows {
+	r := &typedRows{Rows: rows, types: make([]string, len(rows.Columns()))}
+	if tn, ok := rows.(sqldriver.RowsColumnTypeDatabaseTypeName); ok {
+		for i := range r.types {
+			r.types[i] = tn.ColumnTypeDatabaseTypeName(i)
+		}
+	}
+	return r
+}
+
+func (r *typedRows) Next(dest []sqldriver.Value) error {
+	err := r.Rows.Next(dest)
+	if err != nil {
+		return err
+	}
+	for i, v := range dest {
+		if b, ok := v.([]byte); ok {
+			dest[i] = convertValue(r.types[i], b)
+		}
+	}
+	return nil
+}
+
+func (r *typedRows) ColumnTypeDatabaseTypeName(i int) string {
+	return r.types[i]
+}
+
+func (r *typedRows) ColumnTypeNullable(i int) (nullable, ok bool) {
+	if n, ok := r.Rows.(sqldriver.RowsColumnTypeNullable); ok {
+		return n.ColumnTypeNullable(i)
+	}
+	return false, false
+}
+
+func (r *typedRows) ColumnTypePrecisionScale(i int) (precision, scale int64, ok bool) {
+	if p, ok := r.Rows.(sqldriver.RowsColumnTypePrecisionScale); ok {
+		return p.ColumnTypePrecisionScale(i)
+	}
+	return 0, 0, false
+}
+
+func (r *typedRows) HasNextResultSet() bool {
+	if n, ok := r.Rows.(sqldriver.RowsNextResultSet); ok {
+		return n.HasNextResultSet()
+	}
+	return false
+}
+
+func (r *typedRows) NextResultSet() error {
+	if n, ok := r.Rows.(sqldriver.RowsNextResultSet); ok {
+		err := n.NextResultSet()
+		if err != nil {
+			return err
+		}
+		*r = *newTypedRows(r.Rows)
+		return nil
+	}
+	return sqldriver.ErrSkip
+}
+
+// convertValue converts the raw bytes of a value to a Go value for the database type name reported by the MySQL driver.
+// Decimals are returned as strings to preserve their precision. Values that fail to parse are returned as strings.
+func convertValue(dbType string, b []byte) sqldriver.Value {
+	switch dbType {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "BIGINT", "YEAR":
+		if v, err := strconv.ParseInt(string(b), 10, 64); err == nil {
+			return v
+		}
+	case "UNSIGNED TINYINT", "UNSIGNED SMALLINT", "UNSIGNED INT", "UNSIGNED BIGINT":
+		if v, err := strconv.ParseUint(string(b), 10, 64); err == nil {
+			return v
+		}
+	case "FLOAT", "DOUBLE":
+		if v, err := strconv.ParseFloat(string(b), 64); err == nil {
+			return v
+		}
+	case "BIT", "BINARY", "VARBINARY", "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB", "GEOMETRY":
+		return b
+	}
+	return string(b)
+}
@@ -0,0 +1,46 @@
+package starrocks
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertValue(t *testing.T) {
+	require.Equal(t, int64(-42), convertValue("BIGINT", []byte("-42")))
+	require.Equal(t, uint64(42), convertValue("UNSIGNED INT", []byte("42")))
+	require.Equal(t, 1.5, convertValue("DOUBLE", []byte("1.5")))
+	require.Equal(t, "1.50", convertValue("DECIMAL", []byte("1.50")))
+	require.Equal(t, "foo", convertValue("VARCHAR", []byte("foo")))
+	require.Equal(t, `["a","b"]`, convertValue("JSON", []byte(`["a","b"]`)))
+	require.Equal(t, []byte{0x01}, convertValue("VARBINARY", []byte{0x01}))
+	// LARGEINT values don't fit in an int64, so they fall back to a string
+	require.Equal(t, "170141183460469231731687303715884105727", convertValue("BIGINT", []byte("170141183460469231731687303715884105727")))
+}
+
+func TestDatabaseTypeToPB(t *testing.T) {
+	tests := []struct {
+		dbt  string
+		want runtimev1.Type_Code
+	}{
+		{"tinyint", runtimev1.Type_CODE_INT8},
+		{"INT", runtimev1.Type_CODE_INT32},
+		{"bigint", runtimev1.Type_CODE_INT64},
+		{"largeint", runtimev1.Type_CODE_INT128},
+		{"UNSIGNED BIGINT", runtimev1.Type_CODE_UINT64},
+		{"double", runtimev1.Type_CODE_FLOAT64},
+		{"decimal(10,2)", runtimev1.Type_CODE_DECIMAL},
+		{"decimal64", runtimev1.Type_CODE_DECIMAL},
+		{"datetime", runtimev1.Type_CODE_TIMESTAMP},
+		{"date", runtimev1.Type_CODE_DATE},
+		{"varchar(256)", runtimev1.Type_CODE_STRING},
+		{"array<varchar(256)>", runtimev1.Type_CODE_STRING},
+		{"json", runtimev1.Type_CODE_JSON},
+	}
+	for _, tt := range tests {
+		typ := databaseTypeToPB(tt.dbt, true)
+		require.Equal(t, tt.want, typ.Code, tt.dbt)
+		require.True(t, typ.Nullable)
+	}
+}
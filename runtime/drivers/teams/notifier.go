@@ -0,0 +1,197 @@
+package teams
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+// requestTimeout bounds each webhook request since the notifier interface doesn't pass a context.
+const requestTimeout = 10 * time.Second
+
+type notifier struct {
+	props  *NotifierProperties
+	client *http.Client
+}
+
+type NotifierProperties struct {
+	Webhooks []string `mapstructure:"webhooks"`
+}
+
+func newNotifier(propsMap map[string]any) (*notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+	n := &notifier{
+		props:  props,
+		client: &http.Client{Timeout: requestTimeout},
+	}
+	return n, nil
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	executionTime := s.ExecutionTime.Format(time.RFC1123)
+	c := newCard(s.Title)
+	switch s.Status {
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
+		if s.IsRecover {
+			c.addText(fmt.Sprintf("The alert has recovered on **%s** from a previous failure.", executionTime), "good")
+		} else {
+			c.addText(fmt.Sprintf("The alert has passed on **%s**.", executionTime), "good")
+		}
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL:
+		c.addText(fmt.Sprintf("Your alert triggered for **%s**. The first row that matched your alert criteria is:", executionTime), "attention")
+		c.addFacts(s.FailRow)
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
+		c.addText(fmt.Sprintf("The alert failed to evaluate on **%s**. It failed with the following error message: _%s_", executionTime, s.ExecutionError), "attention")
+	default:
+		return fmt.Errorf("unknown assertion status: %v", s.Status)
+	}
+	c.addLink("Open in browser", s.OpenLink)
+	c.addLink("Edit or unsubscribe", s.EditLink)
+	return n.send(c)
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	c := newCard(s.Title)
+	c.addText(fmt.Sprintf("Your report for **%s** is ready to view.", s.ReportTime.Format(time.RFC1123)), "")
+	c.addLink("Open in browser", s.OpenLink)
+	c.addLink(fmt.Sprintf("Download %s", s.DownloadFormat), s.DownloadLink)
+	c.addLink("Edit or unsubscribe", s.EditLink)
+	return n.send(c)
+}
+
+func (n *notifier) send(c *card) error {
+	// Incoming webhooks and workflows accept a message with Adaptive Card attachments
+	body, err := json.Marshal(map[string]any{
+		"type": "message",
+		"attachments": []any{
+			map[string]any{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content":     c,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("teams payload error: %w", err)
+	}
+
+	for _, webhook := range n.props.Webhooks {
+		err := n.post(webhook, body)
+		if err != nil {
+			return fmt.Errorf("teams webhook error: %w", err)
+		}
+	}
+	return nil
+}
+
+func (n *notifier) post(url string, body []byte) error {
+	resp, err := n.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// card is an Adaptive Card (https://adaptivecards.io) with a title, text blocks, facts and links.
+type card struct {
+	Schema  string           `json:"$schema"`
+	Type    string           `json:"type"`
+	Version string           `json:"version"`
+	Body    []map[string]any `json:"body"`
+	Actions []map[string]any `json:"actions,omitempty"`
+}
+
+func newCard(title string) *card {
+	return &card{
+		Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+		Type:    "AdaptiveCard",
+		Version: "1.4",
+		Body: []map[string]any{
+			{
+				"type":   "TextBlock",
+				"text":   title,
+				"size":   "Medium",
+				"weight": "Bolder",
+				"wrap":   true,
+			},
+		},
+	}
+}
+
+func (c *card) addText(text, color string) {
+	block := map[string]any{
+		"type": "TextBlock",
+		"text": text,
+		"wrap": true,
+	}
+	if color != "" {
+		block["color"] = color
+	}
+	c.Body = append(c.Body, block)
+}
+
+// addFacts adds a fact set with the values of a row, ordered by key.
+func (c *card) addFacts(row map[string]any) {
+	if len(row) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(row))
+	for k := range row {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	facts := make([]any, len(keys))
+	for i, k := range keys {
+		facts[i] = map[string]any{"title": k, "value": fmt.Sprint(row[k])}
+	}
+	c.Body = append(c.Body, map[string]any{
+		"type":  "FactSet",
+		"facts": facts,
+	})
+}
+
+// addLink adds a button that opens the URL. Links are omitted outside of Rill Cloud, where the URLs are empty.
+func (c *card) addLink(title, url string) {
+	if url == "" {
+		return
+	}
+	c.Actions = append(c.Actions, map[string]any{
+		"type":  "Action.OpenUrl",
+		"title": title,
+		"url":   url,
+	})
+}
+
+func EncodeProps(webhooks []string) map[string]any {
+	return map[string]any{
+		"webhooks": pbutil.ToSliceAny(webhooks),
+	}
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
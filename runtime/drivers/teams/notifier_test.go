@@ -0,0 +1,65 @@
+package teams
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendAlertStatus(t *testing.T) {
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier(EncodeProps([]string{srv.URL}))
+	require.NoError(t, err)
+
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		Title:         "My alert",
+		ExecutionTime: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"country": "DK", "bids": 10},
+		OpenLink:      "https://example.com/open",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "message", got["type"])
+	attachment := got["attachments"].([]any)[0].(map[string]any)
+	require.Equal(t, "application/vnd.microsoft.card.adaptive", attachment["contentType"])
+	content := attachment["content"].(map[string]any)
+	require.Equal(t, "AdaptiveCard", content["type"])
+
+	body := content["body"].([]any)
+	require.Len(t, body, 3)
+	require.Equal(t, "My alert", body[0].(map[string]any)["text"])
+	require.Equal(t, []any{
+		map[string]any{"title": "bids", "value": "10"},
+		map[string]any{"title": "country", "value": "DK"},
+	}, body[2].(map[string]any)["facts"])
+
+	// The edit link is empty, so only the open link is added
+	actions := content["actions"].([]any)
+	require.Len(t, actions, 1)
+	require.Equal(t, "https://example.com/open", actions[0].(map[string]any)["url"])
+}
+
+func TestSendError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad card", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier(map[string]any{"webhooks": []any{srv.URL}})
+	require.NoError(t, err)
+	err = n.SendScheduledReport(&drivers.ScheduledReport{Title: "Weekly", DownloadFormat: "CSV"})
+	require.ErrorContains(t, err, "unexpected status code 400: bad card")
+}
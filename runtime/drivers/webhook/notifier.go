@@ -0,0 +1,186 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+const (
+	// TimestampHeader contains the Unix time in seconds at which a payload was sent.
+	TimestampHeader = "X-Rill-Timestamp"
+	// SignatureHeader contains the signature of a payload if the connector has a secret.
+	// It has the format "sha256=<hex>", where <hex> is the HMAC-SHA256 of "<timestamp>.<body>" keyed with the secret.
+	SignatureHeader = "X-Rill-Signature"
+)
+
+// requestTimeout bounds each webhook request since the notifier interface doesn't pass a context.
+const requestTimeout = 10 * time.Second
+
+type notifier struct {
+	secret string
+	props  *NotifierProperties
+	client *http.Client
+}
+
+type NotifierProperties struct {
+	URLs []string `mapstructure:"urls"`
+}
+
+func newNotifier(secret string, propsMap map[string]any) (*notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+	n := &notifier{
+		secret: secret,
+		props:  props,
+		client: &http.Client{Timeout: requestTimeout},
+	}
+	return n, nil
+}
+
+// AlertPayload is the JSON payload sent for alert notifications.
+type AlertPayload struct {
+	Type          string         `json:"type"`
+	Name          string         `json:"name,omitempty"`
+	Title         string         `json:"title"`
+	Status        string         `json:"status"`
+	IsRecover     bool           `json:"is_recover"`
+	ExecutionTime time.Time      `json:"execution_time"`
+	FailRow       map[string]any `json:"fail_row,omitempty"`
+	Error         string         `json:"error,omitempty"`
+	OpenURL       string         `json:"open_url,omitempty"`
+	EditURL       string         `json:"edit_url,omitempty"`
+}
+
+// ReportPayload is the JSON payload sent for scheduled reports.
+type ReportPayload struct {
+	Type           string    `json:"type"`
+	Name           string    `json:"name,omitempty"`
+	Title          string    `json:"title"`
+	ReportTime     time.Time `json:"report_time"`
+	DownloadFormat string    `json:"download_format"`
+	OpenURL        string    `json:"open_url,omitempty"`
+	DownloadURL    string    `json:"download_url,omitempty"`
+	EditURL        string    `json:"edit_url,omitempty"`
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	var status string
+	switch s.Status {
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
+		status = "pass"
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL:
+		status = "fail"
+	case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
+		status = "error"
+	default:
+		return fmt.Errorf("unknown assertion status: %v", s.Status)
+	}
+
+	return n.send(&AlertPayload{
+		Type:          "alert",
+		Name:          s.Name,
+		Title:         s.Title,
+		Status:        status,
+		IsRecover:     s.IsRecover,
+		ExecutionTime: s.ExecutionTime,
+		FailRow:       s.FailRow,
+		Error:         s.ExecutionError,
+		OpenURL:       s.OpenLink,
+		EditURL:       s.EditLink,
+	})
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	return n.send(&ReportPayload{
+		Type:           "report",
+		Name:           s.Name,
+		Title:          s.Title,
+		ReportTime:     s.ReportTime,
+		DownloadFormat: s.DownloadFormat,
+		OpenURL:        s.OpenLink,
+		DownloadURL:    s.DownloadLink,
+		EditURL:        s.EditLink,
+	})
+}
+
+func (n *notifier) send(payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("webhook payload error: %w", err)
+	}
+
+	for _, u := range n.props.URLs {
+		err := n.post(u, body)
+		if err != nil {
+			return fmt.Errorf("webhook error: %w", err)
+		}
+	}
+	return nil
+}
+
+func (n *notifier) post(url string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(TimestampHeader, ts)
+	if n.secret != "" {
+		req.Header.Set(SignatureHeader, Sign(n.secret, ts, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// Sign returns the value of the signature header for a payload.
+// Receivers should recompute it with their copy of the secret and compare it in constant time.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func EncodeProps(urls []string) map[string]any {
+	return map[string]any{
+		"urls": pbutil.ToSliceAny(urls),
+	}
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendAlertStatus(t *testing.T) {
+	var gotBody []byte
+	var gotHeader http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		gotBody, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+		gotHeader = r.Header
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier("s3cret", EncodeProps([]string{srv.URL}))
+	require.NoError(t, err)
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		Name:          "my_alert",
+		Title:         "My alert",
+		ExecutionTime: ts,
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"country": "DK"},
+		OpenLink:      "https://example.com/open",
+	})
+	require.NoError(t, err)
+
+	// The signature covers the timestamp and the raw body
+	require.Equal(t, "application/json", gotHeader.Get("Content-Type"))
+	sig := Sign("s3cret", gotHeader.Get(TimestampHeader), gotBody)
+	require.True(t, hmac.Equal([]byte(sig), []byte(gotHeader.Get(SignatureHeader))))
+	require.NotEqual(t, sig, Sign("other", gotHeader.Get(TimestampHeader), gotBody))
+
+	var payload AlertPayload
+	require.NoError(t, json.Unmarshal(gotBody, &payload))
+	require.Equal(t, AlertPayload{
+		Type:          "alert",
+		Name:          "my_alert",
+		Title:         "My alert",
+		Status:        "fail",
+		ExecutionTime: ts,
+		FailRow:       map[string]any{"country": "DK"},
+		OpenURL:       "https://example.com/open",
+	}, payload)
+}
+
+func TestSendScheduledReport(t *testing.T) {
+	var gotPayload ReportPayload
+	var gotSignature string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get(SignatureHeader)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotPayload))
+	}))
+	defer srv.Close()
+
+	// Payloads are not signed without a secret
+	n, err := newNotifier("", map[string]any{"urls": []any{srv.URL}})
+	require.NoError(t, err)
+	err = n.SendScheduledReport(&drivers.ScheduledReport{
+		Title:          "Weekly",
+		DownloadFormat: "CSV",
+		DownloadLink:   "https://example.com/download",
+	})
+	require.NoError(t, err)
+	require.Empty(t, gotSignature)
+	require.Equal(t, "report", gotPayload.Type)
+	require.Equal(t, "Weekly", gotPayload.Title)
+	require.Equal(t, "https://example.com/download", gotPayload.DownloadURL)
+}
+
+func TestSendError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier("", EncodeProps([]string{srv.URL}))
+	require.NoError(t, err)
+	err = n.SendScheduledReport(&drivers.ScheduledReport{Title: "Weekly"})
+	require.ErrorContains(t, err, "unexpected status code 403: nope")
+}
@@ -20,10 +20,12 @@ type AST struct {
 
 	// Cached internal state for building the AST
 	underlyingTable     *string
+	underlyingTableArgs []any
 	underlyingWhere     *ExprNode
 	dimFields           []FieldNode
 	comparisonDimFields []FieldNode
 	unnests             []string
+	cohortColumns       []cohortColumn
 	nextIdentifier      int
 
 	// Contextual info for building the AST
@@ -44,6 +46,7 @@ type SelectNode struct {
 	DimFields            []FieldNode      // Dimensions fields to select
 	MeasureFields        []FieldNode      // Measure fields to select
 	FromTable            *string          // Underlying table expression to select from (if set, FromSelect must not be set)
+	FromTableArgs        []any            // Query args for placeholders in FromTable
 	FromSelect           *SelectNode      // Sub-select to select from (if set, FromTable must not be set)
 	SpineSelect          *SelectNode      // Sub-select that returns a spine of dimensions. Currently it will be right-joined onto FromSelect.
 	LeftJoinSelects      []*SelectNode    // Sub-selects to left join onto FromSelect, to enable "per-dimension" measures
@@ -94,6 +97,14 @@ func (n *ExprNode) and(expr string, args []any) *ExprNode {
 	}
 }
 
+// cohortColumn represents a column added to the underlying table that contains the first time an entity was seen.
+// It is used to resolve cohort computed dimensions.
+type cohortColumn struct {
+	Name       string // Name of the entity dimension
+	Alias      string // Alias of the column in the underlying table
+	EntityExpr string // Expression that identifies the entity
+}
+
 // OrderFieldNode represents a field in an ORDER BY clause.
 type OrderFieldNode struct {
 	Name string
@@ -216,6 +227,14 @@ func NewAST(mv *runtimev1.MetricsViewSpec, sec *runtime.ResolvedSecurity, qry *Q
 	ast.underlyingTable = &tbl
 	ast.underlyingWhere = where
 
+	// If the query contains cohort dimensions, the underlying table must be extended with the first time each entity was seen.
+	if len(ast.cohortColumns) > 0 {
+		err := ast.addCohortColumnsToUnderlyingTable()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Build initial root node (empty query against the base select)
 	n, err := ast.buildBaseSelect(ast.generateIdentifier(), false)
 	if err != nil {
@@ -278,6 +297,15 @@ func (a *AST) resolveDimension(qd Dimension, visible bool) (*runtimev1.MetricsVi
 		return a.lookupDimension(qd.Name, visible)
 	}
 
+	if err := qd.Compute.Validate(); err != nil {
+		return nil, fmt.Errorf(`invalid "compute": %w`, err)
+	}
+
+	// Handle cohort dimensions
+	if qd.Compute.Cohort != nil || qd.Compute.CohortOffset != nil {
+		return a.resolveCohortDimension(qd, visible)
+	}
+
 	// Handle computed dimension. This means "compute.time_floor" must be configured.
 
 	if qd.Compute.TimeFloor == nil {
@@ -323,6 +351,90 @@ func (a *AST) resolveDimension(qd Dimension, visible bool) (*runtimev1.MetricsVi
 	}, nil
 }
 
+// resolveCohortDimension returns a dimension spec for a cohort or cohort offset computed dimension.
+// It registers a cohort column for the entity dimension, which is added to the underlying table after all dimensions have been resolved.
+func (a *AST) resolveCohortDimension(qd Dimension, visible bool) (*runtimev1.MetricsViewSpec_DimensionV2, error) {
+	var entity string
+	var grain TimeGrain
+	var offset bool
+	if qd.Compute.Cohort != nil {
+		entity = qd.Compute.Cohort.Dimension
+		grain = qd.Compute.Cohort.Grain
+	} else {
+		entity = qd.Compute.CohortOffset.Dimension
+		grain = qd.Compute.CohortOffset.Grain
+		offset = true
+	}
+
+	// Cohorts are computed with a window function over the underlying table.
+	if a.dialect != drivers.DialectDuckDB && a.dialect != drivers.DialectClickHouse && a.dialect != drivers.DialectPostgres && a.dialect != drivers.DialectStarRocks {
+		return nil, fmt.Errorf("cohorts are not supported for the %q dialect", a.dialect.String())
+	}
+
+	if a.metricsView.TimeDimension == "" {
+		return nil, errors.New("cohorts require the metrics view to have a time dimension")
+	}
+
+	if !grain.Valid() {
+		return nil, errors.New(`invalid "grain"`)
+	}
+	if grain == TimeGrainUnspecified {
+		return nil, errors.New(`"grain" must be specified for cohorts`)
+	}
+
+	dim, err := a.lookupDimension(entity, visible)
+	if err != nil {
+		return nil, err
+	}
+	if dim.Unnest {
+		return nil, fmt.Errorf("cannot compute cohorts for dimension %q because it is unnested", entity)
+	}
+
+	err = a.checkNameForComputedField(qd.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	col := a.addCohortColumn(dim)
+	cohortExpr, err := a.dialect.DateTruncExpr(&runtimev1.MetricsViewSpec_DimensionV2{Column: col.Alias}, grain.ToProto(), a.query.TimeZone, int(a.metricsView.FirstDayOfWeek), int(a.metricsView.FirstMonthOfYear))
+	if err != nil {
+		return nil, fmt.Errorf(`failed to compute cohort: %w`, err)
+	}
+
+	label := dim.Label
+	if label == "" {
+		label = dim.Name
+	}
+
+	if !offset {
+		return &runtimev1.MetricsViewSpec_DimensionV2{
+			Name:       qd.Name,
+			Expression: cohortExpr,
+			Label:      fmt.Sprintf("%s cohort (%s)", label, grain),
+		}, nil
+	}
+
+	td, err := a.lookupDimension(a.metricsView.TimeDimension, visible)
+	if err != nil {
+		return nil, err
+	}
+	timeExpr, err := a.dialect.DateTruncExpr(td, grain.ToProto(), a.query.TimeZone, int(a.metricsView.FirstDayOfWeek), int(a.metricsView.FirstMonthOfYear))
+	if err != nil {
+		return nil, fmt.Errorf(`failed to compute cohort offset: %w`, err)
+	}
+
+	expr, err := a.dialect.DateDiffExpr(grain.ToProto(), cohortExpr, timeExpr)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to compute cohort offset: %w`, err)
+	}
+
+	return &runtimev1.MetricsViewSpec_DimensionV2{
+		Name:       qd.Name,
+		Expression: expr,
+		Label:      fmt.Sprintf("%s cohort offset (%s)", label, grain),
+	}, nil
+}
+
 // resolveMeasure returns a measure spec for the given measure query.
 // If the measure query specifies a computed measure, it constructs a measure spec to match it.
 func (a *AST) resolveMeasure(qm Measure, visible bool) (*runtimev1.MetricsViewSpec_MeasureV2, error) {
@@ -454,9 +566,173 @@ func (a *AST) resolveMeasure(qm Measure, visible bool) (*runtimev1.MetricsViewSp
 		}, nil
 	}
 
+	if qm.Compute.RunningTotal != nil || qm.Compute.MovingAverage != nil || qm.Compute.PeriodToDate != nil {
+		return a.resolveWindowMeasure(qm, visible)
+	}
+
+	if qm.Compute.Retention != nil {
+		m, err := a.lookupMeasure(qm.Compute.Retention.Measure, visible)
+		if err != nil {
+			return nil, err
+		}
+
+		// The retention is relative to the value at offset 0 within the same group of the other dimensions (usually just the cohort).
+		var offsetDim string
+		var partition []string
+		for _, qd := range a.query.Dimensions {
+			if qd.Compute != nil && qd.Compute.CohortOffset != nil {
+				if offsetDim != "" {
+					return nil, errors.New("retention requires exactly one cohort offset dimension")
+				}
+				offsetDim = qd.Name
+				continue
+			}
+			partition = append(partition, a.dialect.EscapeIdentifier(qd.Name))
+		}
+		if offsetDim == "" {
+			return nil, errors.New("retention requires a cohort offset dimension in the query")
+		}
+
+		var over string
+		if len(partition) > 0 {
+			over = "PARTITION BY " + strings.Join(partition, ", ")
+		}
+
+		name := a.dialect.EscapeIdentifier(m.Name)
+		first := fmt.Sprintf("MAX(CASE WHEN %s = 0 THEN %s END) OVER (%s)", a.dialect.EscapeIdentifier(offsetDim), name, over)
+
+		return &runtimev1.MetricsViewSpec_MeasureV2{
+			Name:               qm.Name,
+			Expression:         a.dialect.SafeDivideExpression(name, first),
+			Type:               runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED,
+			ReferencedMeasures: []string{qm.Compute.Retention.Measure},
+			Label:              fmt.Sprintf("%s (retention)", m.Label),
+		}, nil
+	}
+
 	return nil, errors.New("unhandled compute operation")
 }
 
+// resolveWindowMeasure returns a measure spec for a running total, moving average or period-to-date computed measure.
+// The measure is computed with a window function over the grouped rows. The window is ordered by the query's time dimension and partitioned by its other dimensions.
+func (a *AST) resolveWindowMeasure(qm Measure, visible bool) (*runtimev1.MetricsViewSpec_MeasureV2, error) {
+	// Druid and Pinot don't support window functions (or only in experimental query engines).
+	if a.dialect == drivers.DialectDruid || a.dialect == drivers.DialectPinot {
+		return nil, fmt.Errorf("running totals, moving averages and period-to-date measures are not supported for the %q dialect", a.dialect.String())
+	}
+
+	var measure, fn, frame, suffix string
+	var periodGrain TimeGrain
+	switch {
+	case qm.Compute.RunningTotal != nil:
+		measure = qm.Compute.RunningTotal.Measure
+		fn = "SUM"
+		frame = "ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW"
+		suffix = "running total"
+	case qm.Compute.MovingAverage != nil:
+		periods := qm.Compute.MovingAverage.Periods
+		if periods < 1 {
+			return nil, errors.New(`"periods" must be at least 1 for a moving average`)
+		}
+		measure = qm.Compute.MovingAverage.Measure
+		fn = "AVG"
+		frame = fmt.Sprintf("ROWS BETWEEN %d PRECEDING AND CURRENT ROW", periods-1)
+		suffix = fmt.Sprintf("%d period moving avg", periods)
+	case qm.Compute.PeriodToDate != nil:
+		periodGrain = qm.Compute.PeriodToDate.Grain
+		if !periodGrain.Valid() {
+			return nil, errors.New(`invalid "grain"`)
+		}
+		if periodGrain == TimeGrainUnspecified {
+			return nil, errors.New(`"grain" must be specified for period-to-date`)
+		}
+		measure = qm.Compute.PeriodToDate.Measure
+		fn = "SUM"
+		frame = "ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW"
+		suffix = fmt.Sprintf("%s to date", periodGrain)
+	}
+
+	m, err := a.lookupMeasure(measure, visible)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the time dimension to order by. If the query contains the time dimension at multiple grains, we order by the finest one.
+	var timeDim string
+	var timeGrain TimeGrain
+	for _, qd := range a.query.Dimensions {
+		if qd.Compute == nil {
+			if strings.EqualFold(qd.Name, a.metricsView.TimeDimension) {
+				// The raw time dimension is finer than any grain.
+				timeDim = qd.Name
+				timeGrain = TimeGrainUnspecified
+				break
+			}
+			continue
+		}
+		if qd.Compute.TimeFloor == nil || !strings.EqualFold(qd.Compute.TimeFloor.Dimension, a.metricsView.TimeDimension) {
+			continue
+		}
+		if timeDim == "" || qd.Compute.TimeFloor.Grain.ToTimeutil() < timeGrain.ToTimeutil() {
+			timeDim = qd.Name
+			timeGrain = qd.Compute.TimeFloor.Grain
+		}
+	}
+	if timeDim == "" {
+		return nil, fmt.Errorf("%s requires the time dimension %q to be included in the query", suffix, a.metricsView.TimeDimension)
+	}
+
+	// Partition by all the other dimensions
+	var partition []string
+	for _, qd := range a.query.Dimensions {
+		if qd.Name != timeDim {
+			partition = append(partition, a.dialect.EscapeIdentifier(qd.Name))
+		}
+	}
+
+	// For period-to-date, also partition by the period
+	if periodGrain != TimeGrainUnspecified {
+		if timeGrain != TimeGrainUnspecified && periodGrain.ToTimeutil() < timeGrain.ToTimeutil() {
+			return nil, fmt.Errorf("the period-to-date grain %q must be coarser than or equal to the query's time grain %q", periodGrain, timeGrain)
+		}
+
+		expr, err := a.dialect.DateTruncExpr(&runtimev1.MetricsViewSpec_DimensionV2{Expression: a.dialect.EscapeIdentifier(timeDim)}, periodGrain.ToProto(), a.query.TimeZone, int(a.metricsView.FirstDayOfWeek), int(a.metricsView.FirstMonthOfYear))
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute period-to-date: %w", err)
+		}
+		partition = append(partition, expr)
+	}
+
+	b := &strings.Builder{}
+	b.WriteString(fn)
+	b.WriteByte('(')
+	b.WriteString(a.dialect.EscapeIdentifier(m.Name))
+	b.WriteString(") OVER (")
+	if len(partition) > 0 {
+		b.WriteString("PARTITION BY ")
+		b.WriteString(strings.Join(partition, ", "))
+		b.WriteByte(' ')
+	}
+	b.WriteString("ORDER BY ")
+	b.WriteString(a.dialect.EscapeIdentifier(timeDim))
+	b.WriteByte(' ')
+	b.WriteString(frame)
+	b.WriteByte(')')
+
+	label := m.Label
+	if label == "" {
+		label = m.Name
+	}
+
+	return &runtimev1.MetricsViewSpec_MeasureV2{
+		Name:               qm.Name,
+		Expression:         b.String(),
+		Type:               runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED,
+		ReferencedMeasures: []string{m.Name},
+		Label:              fmt.Sprintf("%s (%s)", label, suffix),
+	}, nil
+}
+
 // lookupDimension finds a dimension spec in the metrics view.
 // If visible is true, it returns an error if the security policy does not grant access to the dimension.
 func (a *AST) lookupDimension(name string, visible bool) (*runtimev1.MetricsViewSpec_DimensionV2, error) {
@@ -605,9 +881,25 @@ func (a *AST) buildUnderlyingWhere() (*ExprNode, error) {
 	}
 	res = res.and(expr, args)
 
+	sec, err := a.buildSecurityWhere()
+	if err != nil {
+		return nil, err
+	}
+	if sec != nil {
+		res = res.and(sec.Expr, sec.Args)
+	}
+
+	return res, nil
+}
+
+// buildSecurityWhere constructs a WHERE clause for the security policy's row filters.
+// It returns nil if the security policy does not filter rows.
+func (a *AST) buildSecurityWhere() (*ExprNode, error) {
+	var res *ExprNode
+
 	if qf := a.security.QueryFilter(); qf != nil {
 		e := NewExpressionFromProto(qf)
-		expr, args, err = a.sqlForExpression(e, nil, false, false)
+		expr, args, err := a.sqlForExpression(e, nil, false, false)
 		if err != nil {
 			return nil, fmt.Errorf("failed to compile the security policy's query filter: %w", err)
 		}
@@ -621,15 +913,72 @@ func (a *AST) buildUnderlyingWhere() (*ExprNode, error) {
 	return res, nil
 }
 
+// addCohortColumn registers a cohort column for the given entity dimension (if not already registered) and returns it.
+func (a *AST) addCohortColumn(dim *runtimev1.MetricsViewSpec_DimensionV2) cohortColumn {
+	for _, c := range a.cohortColumns {
+		if c.Name == dim.Name {
+			return c
+		}
+	}
+
+	c := cohortColumn{
+		Name:       dim.Name,
+		Alias:      fmt.Sprintf("__rill_cohort_%s", dim.Name),
+		EntityExpr: a.dialect.MetricsViewDimensionExpression(dim),
+	}
+	a.cohortColumns = append(a.cohortColumns, c)
+	return c
+}
+
+// addCohortColumnsToUnderlyingTable wraps the underlying table in a sub-select that adds the registered cohort columns.
+// Each cohort column contains the first time the row's entity was seen. It is computed before the query's filters are applied,
+// so an entity's cohort doesn't depend on the time range or filters of the query. The security policy's row filters are still applied.
+func (a *AST) addCohortColumnsToUnderlyingTable() error {
+	b := &strings.Builder{}
+	b.WriteString("(SELECT *")
+	for _, c := range a.cohortColumns {
+		b.WriteString(", MIN(")
+		b.WriteString(a.dialect.EscapeIdentifier(a.metricsView.TimeDimension))
+		b.WriteString(") OVER (PARTITION BY ")
+		b.WriteString(c.EntityExpr)
+		b.WriteString(") AS ")
+		b.WriteString(a.dialect.EscapeIdentifier(c.Alias))
+	}
+	b.WriteString(" FROM ")
+	b.WriteString(*a.underlyingTable)
+
+	// The security filters must be compiled against the raw table, not the query's dimension fields (which may reference unnested columns).
+	dimFields := a.dimFields
+	a.dimFields = nil
+	sec, err := a.buildSecurityWhere()
+	a.dimFields = dimFields
+	if err != nil {
+		return err
+	}
+	if sec != nil {
+		b.WriteString(" WHERE ")
+		b.WriteString(sec.Expr)
+		a.underlyingTableArgs = sec.Args
+	}
+
+	b.WriteString(") ")
+	b.WriteString(a.generateIdentifier())
+
+	tbl := b.String()
+	a.underlyingTable = &tbl
+	return nil
+}
+
 // buildBaseSelect constructs a base SELECT node against the underlying table.
 func (a *AST) buildBaseSelect(alias string, comparison bool) (*SelectNode, error) {
 	n := &SelectNode{
-		Alias:     alias,
-		DimFields: a.dimFields,
-		Unnests:   a.unnests,
-		Group:     true,
-		FromTable: a.underlyingTable,
-		Where:     a.underlyingWhere,
+		Alias:         alias,
+		DimFields:     a.dimFields,
+		Unnests:       a.unnests,
+		Group:         true,
+		FromTable:     a.underlyingTable,
+		FromTableArgs: a.underlyingTableArgs,
+		Where:         a.underlyingWhere,
 	}
 
 	tr := a.query.TimeRange
@@ -676,11 +1025,12 @@ func (a *AST) buildSpineSelect(alias string, spine *Spine, tr *TimeRange) (*Sele
 		}
 
 		n := &SelectNode{
-			Alias:     alias,
-			DimFields: a.dimFields,
-			Unnests:   a.unnests,
-			Group:     true,
-			FromTable: a.underlyingTable,
+			Alias:         alias,
+			DimFields:     a.dimFields,
+			Unnests:       a.unnests,
+			Group:         true,
+			FromTable:     a.underlyingTable,
+			FromTableArgs: a.underlyingTableArgs,
 		}
 		n.Where = n.Where.and(expr, args)
 		a.addTimeRange(n, tr)
@@ -992,6 +1342,7 @@ func (a *AST) wrapSelect(s *SelectNode, innerAlias string) {
 	}
 
 	s.FromTable = nil
+	s.FromTableArgs = nil
 	s.FromSelect = &cpy
 	s.SpineSelect = nil
 	s.LeftJoinSelects = nil
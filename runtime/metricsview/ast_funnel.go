@@ -0,0 +1,183 @@
+package metricsview
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// FunnelAST is the abstract syntax tree for a funnel query.
+// It is compiled to SQL that:
+//  1. Selects the events relevant to the funnel from the underlying table (with a flag for each step).
+//  2. Computes the time each entity completed each step in a CTE per step, where each step joins onto the previous step's CTE.
+//  3. Counts the entities that completed each step per combination of breakdown dimensions.
+//  4. Unpivots the counts to one row per step with conversion and drop-off computed relative to the previous step.
+type FunnelAST struct {
+	Events           *FunnelEventsNode
+	Steps            []*FunnelStepNode
+	DimFields        []FieldNode   // Breakdown dimensions. They are evaluated against the events of the first step.
+	ConversionWindow time.Duration // Max time between completing the first and last step (if zero, there is no conversion window)
+	CountsAlias      string        // Alias for the CTE that counts entities per step
+
+	// Underlying AST used for resolving dimensions and expressions against the metrics view
+	ast *AST
+}
+
+// FunnelEventsNode represents the query that selects the events relevant to a funnel from the underlying table.
+type FunnelEventsNode struct {
+	Alias      string
+	EntityExpr string    // Expression that identifies the entity progressing through the funnel
+	TimeExpr   string    // Expression for the time of the event
+	FromTable  string    // Underlying table expression to select from
+	Where      *ExprNode // Expression for the WHERE clause (includes the security policy's row filters)
+	TimeWhere  *ExprNode // Expression for the time range to add to the WHERE clause
+}
+
+// FunnelStepNode represents a step in a funnel.
+type FunnelStepNode struct {
+	Name  string    // Name of the step as specified in the query
+	Alias string    // Alias of the CTE containing the entities that completed the step
+	Expr  *ExprNode // Expression that identifies events matching the step
+}
+
+// Names of internal columns used in the funnel SQL.
+// The step CTEs output the step time under a different name than the event time, since dialects like ClickHouse resolve a column reference to a select alias of the same name.
+const (
+	funnelEntityColumn   = "__rill_entity"
+	funnelTimeColumn     = "__rill_time"
+	funnelStartColumn    = "__rill_start"
+	funnelStepTimeColumn = "__rill_step_time"
+)
+
+// NewFunnelAST builds a new SQL AST based on a funnel query.
+//
+// Dynamic time ranges in the qry must be resolved to static start/end timestamps before calling this function.
+func NewFunnelAST(mv *runtimev1.MetricsViewSpec, sec *runtime.ResolvedSecurity, qry *FunnelQuery, dialect drivers.Dialect) (*FunnelAST, error) {
+	// Validation
+	if err := qry.Validate(); err != nil {
+		return nil, err
+	}
+	if dialect != drivers.DialectDuckDB && dialect != drivers.DialectClickHouse {
+		return nil, fmt.Errorf("funnels are not supported for the %q dialect", dialect.String())
+	}
+	if mv.TimeDimension == "" {
+		return nil, errors.New("funnels require the metrics view to have a time dimension")
+	}
+
+	window, err := qry.conversionWindow()
+	if err != nil {
+		return nil, err
+	}
+
+	// Init an AST for the breakdown dimensions, which we use to resolve dimensions and compile expressions against the underlying table.
+	ast := &AST{
+		metricsView: mv,
+		security:    sec,
+		query: &Query{
+			MetricsView: qry.MetricsView,
+			Dimensions:  qry.Dimensions,
+			TimeRange:   qry.TimeRange,
+			Where:       qry.Where,
+			TimeZone:    qry.TimeZone,
+		},
+		dialect: dialect,
+	}
+
+	f := &FunnelAST{
+		ConversionWindow: window,
+		ast:              ast,
+	}
+
+	// Resolve the breakdown dimensions
+	for _, qd := range qry.Dimensions {
+		if qd.Compute != nil && (qd.Compute.Cohort != nil || qd.Compute.CohortOffset != nil) {
+			return nil, fmt.Errorf("invalid dimension %q: cohorts are not supported in funnels", qd.Name)
+		}
+
+		dim, err := ast.resolveDimension(qd, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dimension %q: %w", qd.Name, err)
+		}
+		if dim.Unnest {
+			return nil, fmt.Errorf("invalid dimension %q: unnested dimensions are not supported in funnels", qd.Name)
+		}
+
+		f.DimFields = append(f.DimFields, FieldNode{
+			Name:  dim.Name,
+			Label: dim.Label,
+			Expr:  ast.dialect.MetricsViewDimensionExpression(dim),
+		})
+	}
+	ast.dimFields = f.DimFields
+
+	// Resolve the entity and time dimensions
+	entity, err := ast.lookupDimension(qry.Entity, true)
+	if err != nil {
+		return nil, fmt.Errorf("invalid entity %q: %w", qry.Entity, err)
+	}
+	if entity.Unnest {
+		return nil, fmt.Errorf("invalid entity %q: unnested dimensions can't be used as funnel entities", qry.Entity)
+	}
+	td, err := ast.lookupDimension(mv.TimeDimension, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the events node
+	where, err := ast.buildUnderlyingWhere()
+	if err != nil {
+		return nil, err
+	}
+	f.Events = &FunnelEventsNode{
+		Alias:      ast.generateIdentifier(),
+		EntityExpr: ast.dialect.MetricsViewDimensionExpression(entity),
+		TimeExpr:   ast.dialect.MetricsViewDimensionExpression(td),
+		FromTable:  ast.dialect.EscapeTable(mv.Database, mv.DatabaseSchema, mv.Table),
+		Where:      where,
+	}
+	if tr := qry.TimeRange; tr != nil && !tr.IsZero() {
+		if tr.Start.IsZero() && tr.End.IsZero() {
+			panic("funnel ast received a non-empty, unresolved time range")
+		}
+		expr, args := ast.sqlForTimeRange(mv.TimeDimension, tr.Start, tr.End)
+		f.Events.TimeWhere = &ExprNode{
+			Expr: expr,
+			Args: args,
+		}
+	}
+
+	// Build the step nodes
+	for _, s := range qry.Steps {
+		expr, args, err := ast.sqlForExpression(s.Where, nil, false, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile 'where' for step %q: %w", s.Name, err)
+		}
+
+		f.Steps = append(f.Steps, &FunnelStepNode{
+			Name:  s.Name,
+			Alias: ast.generateIdentifier(),
+			Expr: &ExprNode{
+				Expr: expr,
+				Args: args,
+			},
+		})
+	}
+
+	f.CountsAlias = ast.generateIdentifier()
+
+	return f, nil
+}
+
+// stepColumn returns the name of the events column that flags whether an event matches the i'th step.
+func (f *FunnelAST) stepColumn(i int) string {
+	return fmt.Sprintf("__rill_step_%d", i+1)
+}
+
+// countColumn returns the name of the column that contains the number of entities that completed the i'th step.
+func (f *FunnelAST) countColumn(i int) string {
+	return fmt.Sprintf("__rill_count_%d", i+1)
+}
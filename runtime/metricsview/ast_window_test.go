@@ -0,0 +1,119 @@
+package metricsview
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveWindowMeasure(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		TimeDimension: "ts",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "country", Column: "country"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "revenue", Label: "Revenue", Expression: "SUM(revenue)"},
+		},
+	}
+	floor := func(name string, grain TimeGrain) Dimension {
+		return Dimension{Name: name, Compute: &DimensionCompute{TimeFloor: &DimensionComputeTimeFloor{Dimension: "ts", Grain: grain}}}
+	}
+
+	tests := []struct {
+		name    string
+		dims    []Dimension
+		compute *MeasureCompute
+		dialect drivers.Dialect
+		expr    string
+		label   string
+		err     string
+	}{
+		{
+			name:    "running total",
+			dims:    []Dimension{floor("ts_day", TimeGrainDay)},
+			compute: &MeasureCompute{RunningTotal: &MeasureComputeRunningTotal{Measure: "revenue"}},
+			expr:    `SUM("revenue") OVER (ORDER BY "ts_day" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`,
+			label:   "Revenue (running total)",
+		},
+		{
+			name:    "moving average partitioned by other dimensions",
+			dims:    []Dimension{{Name: "country"}, floor("ts_day", TimeGrainDay)},
+			compute: &MeasureCompute{MovingAverage: &MeasureComputeMovingAverage{Measure: "revenue", Periods: 7}},
+			expr:    `AVG("revenue") OVER (PARTITION BY "country" ORDER BY "ts_day" ROWS BETWEEN 6 PRECEDING AND CURRENT ROW)`,
+			label:   "Revenue (7 period moving avg)",
+		},
+		{
+			name:    "moving average with too few periods",
+			dims:    []Dimension{floor("ts_day", TimeGrainDay)},
+			compute: &MeasureCompute{MovingAverage: &MeasureComputeMovingAverage{Measure: "revenue", Periods: 0}},
+			err:     `"periods" must be at least 1`,
+		},
+		{
+			name:    "period to date",
+			dims:    []Dimension{floor("ts_day", TimeGrainDay)},
+			compute: &MeasureCompute{PeriodToDate: &MeasureComputePeriodToDate{Measure: "revenue", Grain: TimeGrainMonth}},
+			expr:    `SUM("revenue") OVER (PARTITION BY date_trunc('MONTH', ("ts_day")::TIMESTAMP)::TIMESTAMP ORDER BY "ts_day" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`,
+			label:   "Revenue (month to date)",
+		},
+		{
+			name:    "period to date finer than the query grain",
+			dims:    []Dimension{floor("ts_month", TimeGrainMonth)},
+			compute: &MeasureCompute{PeriodToDate: &MeasureComputePeriodToDate{Measure: "revenue", Grain: TimeGrainDay}},
+			err:     "must be coarser than or equal to",
+		},
+		{
+			name:    "mixed grains order by the finest grain",
+			dims:    []Dimension{floor("ts_month", TimeGrainMonth), floor("ts_day", TimeGrainDay), floor("ts_year", TimeGrainYear)},
+			compute: &MeasureCompute{RunningTotal: &MeasureComputeRunningTotal{Measure: "revenue"}},
+			expr:    `SUM("revenue") OVER (PARTITION BY "ts_month", "ts_year" ORDER BY "ts_day" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`,
+			label:   "Revenue (running total)",
+		},
+		{
+			name:    "raw time dimension is finer than any grain",
+			dims:    []Dimension{floor("ts_day", TimeGrainDay), {Name: "TS"}},
+			compute: &MeasureCompute{RunningTotal: &MeasureComputeRunningTotal{Measure: "revenue"}},
+			expr:    `SUM("revenue") OVER (PARTITION BY "ts_day" ORDER BY "TS" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)`,
+			label:   "Revenue (running total)",
+		},
+		{
+			name:    "missing time dimension",
+			dims:    []Dimension{{Name: "country"}},
+			compute: &MeasureCompute{RunningTotal: &MeasureComputeRunningTotal{Measure: "revenue"}},
+			err:     `requires the time dimension "ts"`,
+		},
+		{
+			name:    "unsupported dialect",
+			dims:    []Dimension{floor("ts_day", TimeGrainDay)},
+			compute: &MeasureCompute{RunningTotal: &MeasureComputeRunningTotal{Measure: "revenue"}},
+			dialect: drivers.DialectDruid,
+			err:     "not supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dialect := tt.dialect
+			if dialect == drivers.DialectUnspecified {
+				dialect = drivers.DialectDuckDB
+			}
+			a := &AST{
+				metricsView: mv,
+				query:       &Query{Dimensions: tt.dims},
+				dialect:     dialect,
+			}
+
+			m, err := a.resolveWindowMeasure(Measure{Name: "computed", Compute: tt.compute}, false)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expr, m.Expression)
+			require.Equal(t, tt.label, m.Label)
+			require.Equal(t, []string{"revenue"}, m.ReferencedMeasures)
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package metricsview
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 )
 
 // SQL builds a SQL query from the AST.
@@ -117,6 +121,7 @@ func (b *sqlBuilder) writeSelect(n *SelectNode) error {
 	b.out.WriteString(" FROM ")
 	if n.FromTable != nil {
 		b.out.WriteString(*n.FromTable)
+		b.args = append(b.args, n.FromTableArgs...)
 
 		// Add unnest joins. We only and always apply these against FromTable (ensuring they are already unnested when referenced in outer SELECTs).
 		for _, u := range n.Unnests {
@@ -250,3 +255,291 @@ func (b *sqlBuilder) writeJoin(joinType JoinType, baseSelect, joinSelect *Select
 	}
 	return nil
 }
+
+// SQL builds a SQL query from the funnel AST.
+// It returns the query and query arguments to be passed to the database driver.
+func (f *FunnelAST) SQL() (string, []any, error) {
+	b := &sqlBuilder{
+		ast: f.ast,
+		out: &strings.Builder{},
+	}
+
+	b.out.WriteString("WITH ")
+	b.writeFunnelEvents(f)
+	for i := range f.Steps {
+		b.out.WriteString(", ")
+		err := b.writeFunnelStep(f, i)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	b.out.WriteString(", ")
+	b.writeFunnelCounts(f)
+	b.out.WriteByte(' ')
+	b.writeFunnelOutput(f)
+
+	return b.out.String(), b.args, nil
+}
+
+// writeFunnelEvents writes a CTE that selects the entity, time, breakdown dimensions and step flags for each event in the underlying table.
+func (b *sqlBuilder) writeFunnelEvents(f *FunnelAST) {
+	n := f.Events
+	b.out.WriteString(n.Alias)
+	b.out.WriteString(" AS (SELECT (")
+	b.out.WriteString(n.EntityExpr)
+	b.out.WriteString(") AS ")
+	b.out.WriteString(b.ast.dialect.EscapeIdentifier(funnelEntityColumn))
+	b.out.WriteString(", (")
+	b.out.WriteString(n.TimeExpr)
+	b.out.WriteString(") AS ")
+	b.out.WriteString(b.ast.dialect.EscapeIdentifier(funnelTimeColumn))
+
+	for _, d := range f.DimFields {
+		b.out.WriteString(", (")
+		b.out.WriteString(d.Expr)
+		b.out.WriteString(") AS ")
+		b.out.WriteString(b.ast.dialect.EscapeIdentifier(d.Name))
+	}
+
+	for i, s := range f.Steps {
+		b.out.WriteString(", ")
+		b.out.WriteString(s.Expr.Expr)
+		b.out.WriteString(" AS ")
+		b.out.WriteString(b.ast.dialect.EscapeIdentifier(f.stepColumn(i)))
+		b.args = append(b.args, s.Expr.Args...)
+	}
+
+	b.out.WriteString(" FROM ")
+	b.out.WriteString(n.FromTable)
+
+	var wroteWhere bool
+	for _, w := range []*ExprNode{n.TimeWhere, n.Where} {
+		if w == nil || w.Expr == "" {
+			continue
+		}
+		if wroteWhere {
+			b.out.WriteString(" AND ")
+		} else {
+			b.out.WriteString(" WHERE ")
+			wroteWhere = true
+		}
+		b.out.WriteByte('(')
+		b.out.WriteString(w.Expr)
+		b.out.WriteByte(')')
+		b.args = append(b.args, w.Args...)
+	}
+
+	b.out.WriteByte(')')
+}
+
+// writeFunnelStep writes a CTE that contains the entities that completed the i'th step of the funnel.
+// The first step selects the first matching event per entity. Subsequent steps join the previous step onto the events.
+// The non-equi join conditions are applied in the WHERE clause (which is equivalent for inner joins) since not all dialects support them in the ON clause.
+func (b *sqlBuilder) writeFunnelStep(f *FunnelAST, i int) error {
+	d := b.ast.dialect
+	s := f.Steps[i]
+	entityCol := d.EscapeIdentifier(funnelEntityColumn)
+	timeCol := d.EscapeIdentifier(funnelTimeColumn)
+	startCol := d.EscapeIdentifier(funnelStartColumn)
+	stepTimeCol := d.EscapeIdentifier(funnelStepTimeColumn)
+
+	b.out.WriteString(s.Alias)
+	b.out.WriteString(" AS (SELECT ")
+
+	if i == 0 {
+		b.out.WriteString(entityCol)
+		for _, df := range f.DimFields {
+			b.out.WriteString(", ")
+			b.out.WriteString(d.EscapeIdentifier(df.Name))
+		}
+		b.out.WriteString(", MIN(")
+		b.out.WriteString(timeCol)
+		b.out.WriteString(") AS ")
+		b.out.WriteString(startCol)
+		b.out.WriteString(", MIN(")
+		b.out.WriteString(timeCol)
+		b.out.WriteString(") AS ")
+		b.out.WriteString(stepTimeCol)
+		b.out.WriteString(" FROM ")
+		b.out.WriteString(f.Events.Alias)
+		b.out.WriteString(" WHERE ")
+		b.out.WriteString(d.EscapeIdentifier(f.stepColumn(i)))
+	} else {
+		prev := f.Steps[i-1].Alias
+		events := f.Events.Alias
+
+		b.out.WriteString(b.ast.sqlForMember(prev, funnelEntityColumn))
+		b.out.WriteString(" AS ")
+		b.out.WriteString(entityCol)
+		for _, df := range f.DimFields {
+			b.out.WriteString(", ")
+			b.out.WriteString(b.ast.sqlForMember(prev, df.Name))
+			b.out.WriteString(" AS ")
+			b.out.WriteString(d.EscapeIdentifier(df.Name))
+		}
+		b.out.WriteString(", ")
+		b.out.WriteString(b.ast.sqlForMember(prev, funnelStartColumn))
+		b.out.WriteString(" AS ")
+		b.out.WriteString(startCol)
+		b.out.WriteString(", MIN(")
+		b.out.WriteString(b.ast.sqlForMember(events, funnelTimeColumn))
+		b.out.WriteString(") AS ")
+		b.out.WriteString(stepTimeCol)
+
+		b.out.WriteString(" FROM ")
+		b.out.WriteString(prev)
+		b.out.WriteString(" INNER JOIN ")
+		b.out.WriteString(events)
+		b.out.WriteString(" ON ")
+		b.out.WriteString(b.ast.sqlForMember(prev, funnelEntityColumn))
+		b.out.WriteString(" = ")
+		b.out.WriteString(b.ast.sqlForMember(events, funnelEntityColumn))
+
+		b.out.WriteString(" WHERE ")
+		b.out.WriteString(b.ast.sqlForMember(events, f.stepColumn(i)))
+		b.out.WriteString(" AND ")
+		b.out.WriteString(b.ast.sqlForMember(events, funnelTimeColumn))
+		b.out.WriteString(" >= ")
+		b.out.WriteString(b.ast.sqlForMember(prev, funnelStepTimeColumn))
+
+		if f.ConversionWindow > 0 {
+			diff, err := d.DateDiffExpr(runtimev1.TimeGrain_TIME_GRAIN_SECOND, b.ast.sqlForMember(prev, funnelStartColumn), b.ast.sqlForMember(events, funnelTimeColumn))
+			if err != nil {
+				return fmt.Errorf("failed to compile conversion window: %w", err)
+			}
+			b.out.WriteString(" AND ")
+			b.out.WriteString(diff)
+			b.out.WriteString(" <= ")
+			b.out.WriteString(strconv.FormatInt(int64(f.ConversionWindow/time.Second), 10))
+		}
+	}
+
+	// Group by the entity, the breakdown dimensions and (except for the first step) the start time
+	n := 1 + len(f.DimFields)
+	if i > 0 {
+		n++
+	}
+	b.out.WriteString(" GROUP BY ")
+	for j := 0; j < n; j++ {
+		if j > 0 {
+			b.out.WriteString(", ")
+		}
+		b.out.WriteString(strconv.Itoa(j + 1))
+	}
+
+	b.out.WriteByte(')')
+	return nil
+}
+
+// writeFunnelCounts writes a CTE that counts the entities that completed each step per combination of breakdown dimensions.
+// Since the entities in each step are a subset of the entities in the previous step, it left joins each step onto the first step.
+func (b *sqlBuilder) writeFunnelCounts(f *FunnelAST) {
+	d := b.ast.dialect
+	first := f.Steps[0].Alias
+
+	b.out.WriteString(f.CountsAlias)
+	b.out.WriteString(" AS (SELECT ")
+	for _, df := range f.DimFields {
+		b.out.WriteString(b.ast.sqlForMember(first, df.Name))
+		b.out.WriteString(" AS ")
+		b.out.WriteString(d.EscapeIdentifier(df.Name))
+		b.out.WriteString(", ")
+	}
+	for i, s := range f.Steps {
+		if i > 0 {
+			b.out.WriteString(", ")
+		}
+		b.out.WriteString("COUNT(")
+		b.out.WriteString(b.ast.sqlForMember(s.Alias, funnelStepTimeColumn))
+		b.out.WriteString(") AS ")
+		b.out.WriteString(d.EscapeIdentifier(f.countColumn(i)))
+	}
+
+	b.out.WriteString(" FROM ")
+	b.out.WriteString(first)
+	for _, s := range f.Steps[1:] {
+		b.out.WriteString(" LEFT JOIN ")
+		b.out.WriteString(s.Alias)
+		b.out.WriteString(" ON (")
+		b.out.WriteString(d.JoinOnExpression(b.ast.sqlForMember(first, funnelEntityColumn), b.ast.sqlForMember(s.Alias, funnelEntityColumn)))
+		b.out.WriteByte(')')
+		for _, df := range f.DimFields {
+			b.out.WriteString(" AND (")
+			b.out.WriteString(d.JoinOnExpression(b.ast.sqlForMember(first, df.Name), b.ast.sqlForMember(s.Alias, df.Name)))
+			b.out.WriteByte(')')
+		}
+	}
+
+	if len(f.DimFields) > 0 {
+		b.out.WriteString(" GROUP BY ")
+		for i := range f.DimFields {
+			if i > 0 {
+				b.out.WriteString(", ")
+			}
+			b.out.WriteString(strconv.Itoa(i + 1))
+		}
+	}
+
+	b.out.WriteByte(')')
+}
+
+// writeFunnelOutput writes the final SELECT of the funnel, which unpivots the counts to one row per step and breakdown.
+func (b *sqlBuilder) writeFunnelOutput(f *FunnelAST) {
+	d := b.ast.dialect
+	first := d.EscapeIdentifier(f.countColumn(0))
+
+	b.out.WriteString("SELECT * FROM (")
+	for i, s := range f.Steps {
+		if i > 0 {
+			b.out.WriteString(" UNION ALL ")
+		}
+
+		curr := d.EscapeIdentifier(f.countColumn(i))
+		prev := curr
+		if i > 0 {
+			prev = d.EscapeIdentifier(f.countColumn(i - 1))
+		}
+
+		b.out.WriteString("SELECT ")
+		b.out.WriteString(strconv.Itoa(i + 1))
+		b.out.WriteString(" AS ")
+		b.out.WriteString(d.EscapeIdentifier(FunnelFieldStep))
+		b.out.WriteString(", ")
+		b.out.WriteString(d.EscapeStringValue(s.Name))
+		b.out.WriteString(" AS ")
+		b.out.WriteString(d.EscapeIdentifier(FunnelFieldStepName))
+		for _, df := range f.DimFields {
+			b.out.WriteString(", ")
+			b.out.WriteString(d.EscapeIdentifier(df.Name))
+		}
+		b.out.WriteString(", ")
+		b.out.WriteString(curr)
+		b.out.WriteString(" AS ")
+		b.out.WriteString(d.EscapeIdentifier(FunnelFieldCount))
+		b.out.WriteString(", ")
+		b.out.WriteString(d.SafeDivideExpression(curr, prev))
+		b.out.WriteString(" AS ")
+		b.out.WriteString(d.EscapeIdentifier(FunnelFieldConversionRate))
+		b.out.WriteString(", ")
+		b.out.WriteString(prev)
+		b.out.WriteString(" - ")
+		b.out.WriteString(curr)
+		b.out.WriteString(" AS ")
+		b.out.WriteString(d.EscapeIdentifier(FunnelFieldDropOff))
+		b.out.WriteString(", ")
+		b.out.WriteString(d.SafeDivideExpression(curr, first))
+		b.out.WriteString(" AS ")
+		b.out.WriteString(d.EscapeIdentifier(FunnelFieldOverallConversionRate))
+		b.out.WriteString(" FROM ")
+		b.out.WriteString(f.CountsAlias)
+	}
+	b.out.WriteString(") ")
+	b.out.WriteString(b.ast.generateIdentifier())
+
+	b.out.WriteString(" ORDER BY ")
+	for _, df := range f.DimFields {
+		b.out.WriteString(d.OrderByExpression(df.Name, false))
+		b.out.WriteString(", ")
+	}
+	b.out.WriteString(d.OrderByExpression(FunnelFieldStep, false))
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -19,6 +18,11 @@ const (
 	defaultInteractiveTimeout = time.Minute * 3
 	defaultExportTimeout      = time.Minute * 5
 	defaultPivotExportTimeout = time.Minute * 5
+
+	// maxPivotColumns is the maximum number of columns a pivot query can produce.
+	maxPivotColumns = 10000
+	// maxInMemoryPivotRows is the maximum number of underlying rows to pivot in memory for OLAPs that don't support pivoting natively.
+	maxInMemoryPivotRows = 100000
 )
 
 // Executor is capable of executing queries and other operations against a metrics view.
@@ -68,7 +72,33 @@ func (e *Executor) Close() {
 // Cacheable returns whether the result of running the given query is cacheable.
 func (e *Executor) Cacheable(qry *Query) bool {
 	// TODO: Get from OLAP instead of hardcoding
-	return e.olap.Dialect() == drivers.DialectDuckDB
+	if e.olap.Dialect() == drivers.DialectDuckDB {
+		return true
+	}
+
+	// For other OLAPs, the data may change outside of Rill, so we can only cache if we can use the watermark to detect changes (see CacheKey).
+	return e.metricsView.WatermarkExpression != "" || e.metricsView.TimeDimension != ""
+}
+
+// CacheKey returns a key that identifies the current state of the metrics view's data.
+// Cached results should be invalidated when it changes.
+//
+// For DuckDB, the data only changes when the underlying model is refreshed, which is tracked by the resource state, so it returns an empty key.
+// For other OLAPs, it returns the metrics view's current watermark.
+func (e *Executor) CacheKey(ctx context.Context) (string, error) {
+	if e.olap.Dialect() == drivers.DialectDuckDB {
+		return "", nil
+	}
+
+	if e.metricsView.WatermarkExpression == "" && e.metricsView.TimeDimension == "" {
+		return "", errors.New("cannot determine a cache key for a metrics view without a watermark")
+	}
+
+	w, err := e.Watermark(ctx)
+	if err != nil {
+		return "", err
+	}
+	return w.Format(time.RFC3339Nano), nil
 }
 
 // ValidateMetricsView validates the dimensions and measures in the executor's metrics view.
@@ -192,7 +222,9 @@ func (e *Executor) Query(ctx context.Context, qry *Query, executionTime *time.Ti
 		return nil, err
 	}
 
-	ast, err := NewAST(e.metricsView, e.security, qry, e.olap.Dialect())
+	mv := e.rewriteQueryRollups(qry)
+
+	ast, err := NewAST(mv, e.security, qry, e.olap.Dialect())
 	if err != nil {
 		return nil, err
 	}
@@ -223,53 +255,44 @@ func (e *Executor) Query(ctx context.Context, qry *Query, executionTime *time.Ti
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		// Since pivots are mainly used for exports, we just do an inefficient shim that runs a pivoted export to a temporary Parquet file, and then reads the file into a *drivers.Result using DuckDB.
-		// (An efficient interactive pivot implementation would look quite different from the export-based implementation, so is not worth it at this point.)
-
-		// If e.olap is a DuckDB, use it directly. Else open a "duckdb" handle (which is always available, even for instances where DuckDB is not the main OLAP connector).
-		var duck drivers.OLAPStore
-		var releaseDuck func()
-		if e.olap.Dialect() == drivers.DialectDuckDB {
-			duck = e.olap
-		} else {
-			handle, release, err := e.rt.AcquireHandle(ctx, e.instanceID, "duckdb")
-			if err != nil {
-				return nil, fmt.Errorf("failed to acquire DuckDB for serving pivot: %w", err)
-			}
-
-			var ok bool
-			duck, ok = handle.AsOLAP(e.instanceID)
-			if !ok {
-				release()
-				return nil, fmt.Errorf(`connector "duckdb" is not an OLAP store`)
-			}
-			releaseDuck = release
+	} else if e.olap.Dialect() == drivers.DialectClickHouse {
+		// ClickHouse pivots natively using conditional aggregation, so we can execute the pivot query directly.
+		underlyingSQL, args, err := ast.SQL()
+		if err != nil {
+			return nil, err
 		}
 
-		// Execute the pivot export
-		path, err := e.executePivotExport(ctx, ast, pivotAST, "parquet")
+		sql, args, err := e.clickHousePivotSQL(ctx, ast, pivotAST, underlyingSQL, args)
 		if err != nil {
 			return nil, err
 		}
 
-		// Use DuckDB to read the Parquet file into a *drivers.Result
-		res, err = duck.Execute(ctx, &drivers.Statement{
-			Query:            fmt.Sprintf("SELECT * FROM '%s'", path),
+		res, err = e.olap.Execute(ctx, &drivers.Statement{
+			Query:            sql,
+			Args:             args,
 			Priority:         e.priority,
 			ExecutionTimeout: defaultInteractiveTimeout,
 		})
 		if err != nil {
-			_ = os.Remove(path)
 			return nil, err
 		}
-		res.SetCleanupFunc(func() error {
-			if releaseDuck != nil {
-				releaseDuck()
+	} else {
+		// For Druid, Pinot, Postgres and StarRocks, we try to pivot the underlying data in memory.
+		var ok bool
+		if e.olap.Dialect() == drivers.DialectDruid || e.olap.Dialect() == drivers.DialectPinot || e.olap.Dialect() == drivers.DialectPostgres || e.olap.Dialect() == drivers.DialectStarRocks {
+			res, ok, err = e.executePivotInMemory(ctx, ast, pivotAST)
+			if err != nil {
+				return nil, err
 			}
-			_ = os.Remove(path)
-			return nil
-		})
+		}
+
+		// Otherwise (or if the data is too large to pivot in memory), we pivot using DuckDB.
+		if !ok {
+			res, err = e.executePivotWithDuckDB(ctx, ast, pivotAST)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if rowsCap > 0 {
@@ -303,7 +326,9 @@ func (e *Executor) Export(ctx context.Context, qry *Query, executionTime *time.T
 		return "", err
 	}
 
-	ast, err := NewAST(e.metricsView, e.security, qry, e.olap.Dialect())
+	mv := e.rewriteQueryRollups(qry)
+
+	ast, err := NewAST(mv, e.security, qry, e.olap.Dialect())
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,436 @@
+package metricsview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+)
+
+// Anomalies executes the provided anomaly query against the metrics view.
+// It returns one row per anomalous point with the query's dimensions, the time dimension, the measure and the fields listed in the AnomalyField* constants.
+// The rows are ordered by how far they deviate from the baseline, starting with the largest deviation.
+func (e *Executor) Anomalies(ctx context.Context, qry *AnomalyQuery, executionTime *time.Time) ([]map[string]any, *runtimev1.StructType, error) {
+	if !e.security.CanAccess() {
+		return nil, nil, runtime.ErrForbidden
+	}
+
+	if err := qry.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	timeDim := e.metricsView.TimeDimension
+	if timeDim == "" {
+		return nil, nil, fmt.Errorf("metrics view %q does not have a time dimension", qry.MetricsView)
+	}
+	for _, d := range qry.Dimensions {
+		if d.Name == timeDim {
+			return nil, nil, fmt.Errorf("dimension name %q collides with the time dimension", d.Name)
+		}
+	}
+
+	tz := time.UTC
+	if qry.TimeZone != "" {
+		var err error
+		tz, err = time.LoadLocation(qry.TimeZone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid time zone %q: %w", qry.TimeZone, err)
+		}
+	}
+
+	// Resolve the range of points to check. Only complete periods are checked.
+	start, end, err := e.resolveAnomalyTimeRange(ctx, qry, tz, executionTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !start.Before(end) {
+		return nil, anomalySchema(qry, timeDim, nil), nil
+	}
+
+	// Load the points to check together with the history needed to compute their baselines
+	var historyStart time.Time
+	if qry.Method == AnomalyMethodSeasonal {
+		historyStart = start.In(tz).AddDate(0, 0, -7*qry.Window)
+	} else {
+		historyStart = addTimeGrain(start, qry.TimeGrain, -qry.Window, tz)
+	}
+
+	dims := slices.Clone(qry.Dimensions)
+	dims = append(dims, Dimension{
+		Name:    timeDim,
+		Compute: &DimensionCompute{TimeFloor: &DimensionComputeTimeFloor{Dimension: timeDim, Grain: qry.TimeGrain}},
+	})
+	res, err := e.Query(ctx, &Query{
+		MetricsView: qry.MetricsView,
+		Dimensions:  dims,
+		Measures:    []Measure{{Name: qry.Measure}},
+		TimeRange:   &TimeRange{Start: historyStart, End: end},
+		Where:       qry.Where,
+		TimeZone:    qry.TimeZone,
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Close()
+
+	series := make(map[string]*anomalySeries)
+	var order []*anomalySeries
+	for res.Next() {
+		row := make(map[string]any)
+		if err := res.MapScan(row); err != nil {
+			return nil, nil, err
+		}
+
+		t, ok := anomalyTime(row[timeDim])
+		if !ok {
+			return nil, nil, fmt.Errorf("unexpected value %v of type %T for time dimension %q", row[timeDim], row[timeDim], timeDim)
+		}
+		v, ok := anomalyValue(row[qry.Measure])
+		if !ok {
+			// Null or non-numeric values are treated as missing points
+			continue
+		}
+
+		dimVals := make([]any, len(qry.Dimensions))
+		for i, d := range qry.Dimensions {
+			dimVals[i] = row[d.Name]
+		}
+		key, err := json.Marshal(dimVals)
+		if err != nil {
+			return nil, nil, err
+		}
+		s, ok := series[string(key)]
+		if !ok {
+			s = &anomalySeries{dimensions: dimVals, points: make(map[int64]float64)}
+			series[string(key)] = s
+			order = append(order, s)
+		}
+		s.points[t.UnixNano()] = v
+	}
+	if err := res.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var anomalies []*anomaly
+	for _, s := range order {
+		anomalies = append(anomalies, detectAnomalies(qry, s, start, end, tz)...)
+	}
+	sortAnomalies(anomalies)
+	if qry.Limit != nil && *qry.Limit > 0 && int64(len(anomalies)) > *qry.Limit {
+		anomalies = anomalies[:*qry.Limit]
+	}
+
+	rows := make([]map[string]any, len(anomalies))
+	for i, a := range anomalies {
+		row := make(map[string]any, len(qry.Dimensions)+6)
+		for j, d := range qry.Dimensions {
+			row[d.Name] = a.series.dimensions[j]
+		}
+		row[timeDim] = a.time
+		row[qry.Measure] = a.value
+		row[AnomalyFieldBaseline] = a.baseline
+		row[AnomalyFieldLowerBound] = a.lower
+		row[AnomalyFieldUpperBound] = a.upper
+		if !math.IsInf(a.score, 0) {
+			row[AnomalyFieldScore] = a.score
+		} else {
+			row[AnomalyFieldScore] = nil
+		}
+		rows[i] = row
+	}
+
+	return rows, anomalySchema(qry, timeDim, res.Schema), nil
+}
+
+// resolveAnomalyTimeRange resolves the range of points to check, truncated to the query's time grain.
+// If the query doesn't have a time range, it checks the last complete period before the execution time (or the watermark).
+func (e *Executor) resolveAnomalyTimeRange(ctx context.Context, qry *AnomalyQuery, tz *time.Location, executionTime *time.Time) (time.Time, time.Time, error) {
+	var tr TimeRange
+	if qry.TimeRange != nil {
+		tr = *qry.TimeRange
+	}
+
+	if !tr.IsZero() {
+		err := e.resolveTimeRange(ctx, &tr, tz, executionTime)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("failed to resolve time range: %w", err)
+		}
+	}
+
+	if tr.End.IsZero() {
+		t, err := e.loadWatermark(ctx, executionTime)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		tr.End = t
+	}
+	end := e.truncateTime(tr.End, qry.TimeGrain, tz)
+
+	if qry.TimeRange == nil || qry.TimeRange.IsZero() {
+		return addTimeGrain(end, qry.TimeGrain, -1, tz), end, nil
+	}
+	if tr.Start.IsZero() {
+		return time.Time{}, time.Time{}, errors.New(`"time_range" must have a start (for example, set "iso_duration")`)
+	}
+	return e.truncateTime(tr.Start, qry.TimeGrain, tz), end, nil
+}
+
+// truncateTime truncates a time to the start of its period of the given grain, using the metrics view's first day of week and month of year.
+func (e *Executor) truncateTime(t time.Time, g TimeGrain, tz *time.Location) time.Time {
+	fdow := int(e.metricsView.FirstDayOfWeek)
+	if fdow > 7 || fdow <= 0 {
+		fdow = 1
+	}
+	fmoy := int(e.metricsView.FirstMonthOfYear)
+	if fmoy > 12 || fmoy <= 0 {
+		fmoy = 1
+	}
+	return timeutil.TruncateTime(t, g.ToTimeutil(), tz, fdow, fmoy)
+}
+
+// anomalySeries is the measure's values over time for one combination of dimension values.
+type anomalySeries struct {
+	dimensions []any
+	points     map[int64]float64 // Keyed by Unix nanoseconds
+}
+
+type anomaly struct {
+	series   *anomalySeries
+	time     time.Time
+	value    float64
+	baseline float64
+	lower    float64
+	upper    float64
+	score    float64 // Number of standard deviations (or scaled MADs) from the baseline. Infinite if the baseline has no variation.
+}
+
+// detectAnomalies checks each period in [start, end) of a series against its baseline.
+// Periods without a value and periods with too little history are skipped.
+func detectAnomalies(qry *AnomalyQuery, s *anomalySeries, start, end time.Time, tz *time.Location) []*anomaly {
+	var res []*anomaly
+	for t := start; t.Before(end); t = addTimeGrain(t, qry.TimeGrain, 1, tz) {
+		v, ok := s.points[t.UnixNano()]
+		if !ok {
+			continue
+		}
+
+		history := make([]float64, 0, qry.Window)
+		for i := 1; i <= qry.Window; i++ {
+			var prev time.Time
+			if qry.Method == AnomalyMethodSeasonal {
+				prev = t.In(tz).AddDate(0, 0, -7*i)
+			} else {
+				prev = addTimeGrain(t, qry.TimeGrain, -i, tz)
+			}
+			if pv, ok := s.points[prev.UnixNano()]; ok {
+				history = append(history, pv)
+			}
+		}
+		if len(history) < anomalyMinBaselinePoints {
+			continue
+		}
+
+		var center, scale float64
+		if qry.Method == AnomalyMethodSeasonal {
+			center, scale = medianAndMAD(history)
+		} else {
+			center, scale = meanAndStddev(history)
+		}
+		lower := center - qry.Threshold*scale
+		upper := center + qry.Threshold*scale
+
+		isAnomaly := false
+		switch qry.Direction {
+		case AnomalyDirectionUp:
+			isAnomaly = v > upper
+		case AnomalyDirectionDown:
+			isAnomaly = v < lower
+		default:
+			isAnomaly = v > upper || v < lower
+		}
+		if !isAnomaly {
+			continue
+		}
+
+		score := math.Inf(1)
+		if v < center {
+			score = math.Inf(-1)
+		}
+		if scale > 0 {
+			score = (v - center) / scale
+		}
+
+		res = append(res, &anomaly{
+			series:   s,
+			time:     t,
+			value:    v,
+			baseline: center,
+			lower:    lower,
+			upper:    upper,
+			score:    score,
+		})
+	}
+	return res
+}
+
+// sortAnomalies orders anomalies by the absolute value of their score (descending), then by time (descending).
+func sortAnomalies(as []*anomaly) {
+	sort.SliceStable(as, func(i, j int) bool {
+		si, sj := math.Abs(as[i].score), math.Abs(as[j].score)
+		if si != sj {
+			return si > sj
+		}
+		return as[i].time.After(as[j].time)
+	})
+}
+
+// meanAndStddev returns the mean and sample standard deviation of the values.
+func meanAndStddev(vals []float64) (float64, float64) {
+	var sum float64
+	for _, v := range vals {
+		sum += v
+	}
+	mean := sum / float64(len(vals))
+
+	var ss float64
+	for _, v := range vals {
+		ss += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(ss / float64(len(vals)-1))
+}
+
+// medianAndMAD returns the median and the median absolute deviation of the values.
+// The MAD is scaled by 1.4826, which makes it an estimate of the standard deviation for normally distributed values.
+func medianAndMAD(vals []float64) (float64, float64) {
+	m := median(vals)
+	devs := make([]float64, len(vals))
+	for i, v := range vals {
+		devs[i] = math.Abs(v - m)
+	}
+	return m, 1.4826 * median(devs)
+}
+
+func median(vals []float64) float64 {
+	sorted := slices.Clone(vals)
+	slices.Sort(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// addTimeGrain adds n periods of the given grain to t. Calendar grains are added in the given time zone.
+func addTimeGrain(t time.Time, g TimeGrain, n int, tz *time.Location) time.Time {
+	switch g {
+	case TimeGrainMillisecond:
+		return t.Add(time.Duration(n) * time.Millisecond)
+	case TimeGrainSecond:
+		return t.Add(time.Duration(n) * time.Second)
+	case TimeGrainMinute:
+		return t.Add(time.Duration(n) * time.Minute)
+	case TimeGrainHour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case TimeGrainDay:
+		return t.In(tz).AddDate(0, 0, n)
+	case TimeGrainWeek:
+		return t.In(tz).AddDate(0, 0, 7*n)
+	case TimeGrainMonth:
+		return t.In(tz).AddDate(0, n, 0)
+	case TimeGrainQuarter:
+		return t.In(tz).AddDate(0, 3*n, 0)
+	case TimeGrainYear:
+		return t.In(tz).AddDate(n, 0, 0)
+	default:
+		panic(fmt.Errorf("invalid time grain %q", g))
+	}
+}
+
+// anomalySchema returns the schema of the rows returned by Anomalies.
+// The types of the dimensions are taken from the schema of the underlying query if available.
+func anomalySchema(qry *AnomalyQuery, timeDim string, querySchema *runtimev1.StructType) *runtimev1.StructType {
+	types := make(map[string]*runtimev1.Type)
+	if querySchema != nil {
+		for _, f := range querySchema.Fields {
+			types[f.Name] = f.Type
+		}
+	}
+
+	var fields []*runtimev1.StructType_Field
+	for _, d := range qry.Dimensions {
+		t, ok := types[d.Name]
+		if !ok {
+			t = &runtimev1.Type{Code: runtimev1.Type_CODE_STRING, Nullable: true}
+		}
+		fields = append(fields, &runtimev1.StructType_Field{Name: d.Name, Type: t})
+	}
+	fields = append(fields, &runtimev1.StructType_Field{Name: timeDim, Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}})
+	for _, name := range []string{qry.Measure, AnomalyFieldBaseline, AnomalyFieldLowerBound, AnomalyFieldUpperBound, AnomalyFieldScore} {
+		fields = append(fields, &runtimev1.StructType_Field{Name: name, Type: &runtimev1.Type{Code: runtimev1.Type_CODE_FLOAT64, Nullable: name == AnomalyFieldScore}})
+	}
+	return &runtimev1.StructType{Fields: fields}
+}
+
+// anomalyTime converts a value of the time dimension returned by an OLAP driver to a time.
+func anomalyTime(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		return t, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+// anomalyValue converts a measure value returned by an OLAP driver to a float64.
+func anomalyValue(v any) (float64, bool) {
+	var f float64
+	switch v := v.(type) {
+	case float64:
+		f = v
+	case float32:
+		f = float64(v)
+	case int:
+		f = float64(v)
+	case int8:
+		f = float64(v)
+	case int16:
+		f = float64(v)
+	case int32:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case uint8:
+		f = float64(v)
+	case uint16:
+		f = float64(v)
+	case uint32:
+		f = float64(v)
+	case uint64:
+		f = float64(v)
+	case string:
+		// Some drivers return decimals as strings
+		var err error
+		f, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
@@ -0,0 +1,49 @@
+package metricsview
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// Funnel executes the provided funnel query against the metrics view.
+// It returns one row per step (and combination of breakdown dimensions) with the fields listed in the FunnelField* constants.
+func (e *Executor) Funnel(ctx context.Context, qry *FunnelQuery, executionTime *time.Time) (*drivers.Result, error) {
+	if !e.security.CanAccess() {
+		return nil, runtime.ErrForbidden
+	}
+
+	tz := time.UTC
+	if qry.TimeZone != "" {
+		var err error
+		tz, err = time.LoadLocation(qry.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", qry.TimeZone, err)
+		}
+	}
+
+	err := e.resolveTimeRange(ctx, qry.TimeRange, tz, executionTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve time range: %w", err)
+	}
+
+	ast, err := NewFunnelAST(e.metricsView, e.security, qry, e.olap.Dialect())
+	if err != nil {
+		return nil, err
+	}
+
+	sql, args, err := ast.SQL()
+	if err != nil {
+		return nil, err
+	}
+
+	return e.olap.Execute(ctx, &drivers.Statement{
+		Query:            sql,
+		Args:             args,
+		Priority:         e.priority,
+		ExecutionTimeout: defaultInteractiveTimeout,
+	})
+}
@@ -0,0 +1,121 @@
+package metricsview_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+const funnelEventsSQL = `
+SELECT * FROM (VALUES
+	('a', TIMESTAMP '2024-01-01 00:00:00', 'view'),
+	('a', TIMESTAMP '2024-01-02 00:00:00', 'cart'),
+	('a', TIMESTAMP '2024-01-03 00:00:00', 'buy'),
+	('b', TIMESTAMP '2024-01-01 00:00:00', 'view'),
+	('b', TIMESTAMP '2024-01-02 00:00:00', 'buy'),
+	('c', TIMESTAMP '2024-01-01 00:00:00', 'cart'),
+	('c', TIMESTAMP '2024-01-02 00:00:00', 'view'),
+	('d', TIMESTAMP '2024-01-01 00:00:00', 'view'),
+	('d', TIMESTAMP '2024-01-20 00:00:00', 'cart'),
+	('d', TIMESTAMP '2024-01-21 00:00:00', 'buy')
+) t(user_id, ts, event)
+`
+
+var funnelMetricsView = &runtimev1.MetricsViewSpec{
+	Connector:     "duckdb",
+	Table:         "events",
+	TimeDimension: "ts",
+	Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+		{Name: "user_id", Column: "user_id"},
+		{Name: "event", Column: "event"},
+		{Name: "ts", Column: "ts"},
+	},
+	Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+		{Name: "count", Expression: "COUNT(*)"},
+	},
+}
+
+func funnelQuery() *metricsview.FunnelQuery {
+	step := func(name string) metricsview.FunnelStep {
+		return metricsview.FunnelStep{
+			Name: name,
+			Where: &metricsview.Expression{Condition: &metricsview.Condition{
+				Operator:    metricsview.OperatorEq,
+				Expressions: []*metricsview.Expression{{Name: "event"}, {Value: name}},
+			}},
+		}
+	}
+	return &metricsview.FunnelQuery{
+		MetricsView:      "events_mv",
+		Entity:           "user_id",
+		Steps:            []metricsview.FunnelStep{step("view"), step("cart"), step("buy")},
+		ConversionWindow: "P7D",
+	}
+}
+
+func TestFunnelSQL(t *testing.T) {
+	rt, instanceID := testruntime.NewInstance(t)
+	sec, err := rt.ResolveSecurity(instanceID, &runtime.SecurityClaims{SkipChecks: true}, nil)
+	require.NoError(t, err)
+
+	for _, dialect := range []drivers.Dialect{drivers.DialectDuckDB, drivers.DialectClickHouse} {
+		t.Run(dialect.String(), func(t *testing.T) {
+			ast, err := metricsview.NewFunnelAST(funnelMetricsView, sec, funnelQuery(), dialect)
+			require.NoError(t, err)
+
+			sql, _, err := ast.SQL()
+			require.NoError(t, err)
+
+			// The event time must only be aliased in the events CTE.
+			// If a step aliases an aggregate to it, ClickHouse resolves references to the event time in the same SELECT to the aggregate.
+			require.Equal(t, 1, strings.Count(sql, "AS "+dialect.EscapeIdentifier("__rill_time")), sql)
+			require.Equal(t, 3, strings.Count(sql, "AS "+dialect.EscapeIdentifier("__rill_step_time")), sql)
+			require.Contains(t, sql, "MIN("+dialect.EscapeIdentifier("__rill_time")+") AS "+dialect.EscapeIdentifier("__rill_start"))
+		})
+	}
+}
+
+func TestFunnelDuckDB(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithModel(t, "events", funnelEventsSQL)
+	sec, err := rt.ResolveSecurity(instanceID, &runtime.SecurityClaims{SkipChecks: true}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	e, err := metricsview.NewExecutor(ctx, rt, instanceID, funnelMetricsView, sec, 0)
+	require.NoError(t, err)
+	defer e.Close()
+
+	res, err := e.Funnel(ctx, funnelQuery(), nil)
+	require.NoError(t, err)
+	defer res.Close()
+
+	type funnelRow struct {
+		step    int
+		name    string
+		count   int64
+		conv    float64
+		dropOff int64
+		overall float64
+	}
+	var rows []funnelRow
+	for res.Next() {
+		var r funnelRow
+		require.NoError(t, res.Scan(&r.step, &r.name, &r.count, &r.conv, &r.dropOff, &r.overall))
+		rows = append(rows, r)
+	}
+	require.NoError(t, res.Err())
+
+	// "b" never adds to cart, "c" only adds to cart before viewing and "d" adds to cart after the conversion window.
+	require.Equal(t, []funnelRow{
+		{step: 1, name: "view", count: 4, conv: 1, dropOff: 0, overall: 1},
+		{step: 2, name: "cart", count: 1, conv: 0.25, dropOff: 3, overall: 0.25},
+		{step: 3, name: "buy", count: 1, conv: 1, dropOff: 0, overall: 0.25},
+	}, rows)
+}
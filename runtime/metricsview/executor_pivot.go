@@ -57,7 +57,7 @@ func (e *Executor) rewriteQueryForPivot(qry *Query) (*pivotAST, bool, error) {
 		}
 	}
 
-	// Determine dialect for the PIVOT (in practice, this currently always becomes DuckDB because it's the only OLAP that supports pivoting)
+	// Determine dialect for the PIVOT (for OLAPs that don't support pivoting natively, the pivot is executed in memory or in DuckDB)
 	dialect := e.olap.Dialect()
 	if !dialect.CanPivot() {
 		dialect = drivers.DialectDuckDB
@@ -114,6 +114,23 @@ func (e *Executor) executePivotExport(ctx context.Context, ast *AST, pivot *pivo
 		return "", err
 	}
 
+	// ClickHouse pivots using conditional aggregation, so we can export the pivot query directly without staging the underlying data.
+	if pivot.dialect == drivers.DialectClickHouse {
+		pivotSQL, pivotArgs, err := e.clickHousePivotSQL(ctx, ast, pivot, underlyingSQL, args)
+		if err != nil {
+			return "", err
+		}
+
+		path, err := e.executeExport(ctx, format, e.metricsView.Connector, map[string]any{
+			"sql":  pivotSQL,
+			"args": pivotArgs,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to execute pivot export: %w", err)
+		}
+		return path, nil
+	}
+
 	// If the metrics view's connector doesn't support pivoting, we export the underlying (non-pivoted) data to a Parquet file, and handover to DuckDB to do the pivot.
 	pivotConnector := e.metricsView.Connector
 	if !e.olap.Dialect().CanPivot() {
@@ -195,6 +212,56 @@ func (e *Executor) executePivotExport(ctx context.Context, ast *AST, pivot *pivo
 	return path, nil
 }
 
+// executePivotWithDuckDB executes a pivot query prepared using rewriteQueryForPivot and returns the result.
+// Since pivots are mainly used for exports, it uses an inefficient shim that runs a pivoted export to a temporary Parquet file, and then reads the file into a *drivers.Result using DuckDB.
+// (An efficient interactive pivot implementation would look quite different from the export-based implementation, so is not worth it at this point.)
+func (e *Executor) executePivotWithDuckDB(ctx context.Context, ast *AST, pivot *pivotAST) (*drivers.Result, error) {
+	// If e.olap is a DuckDB, use it directly. Else open a "duckdb" handle (which is always available, even for instances where DuckDB is not the main OLAP connector).
+	var duck drivers.OLAPStore
+	var releaseDuck func()
+	if e.olap.Dialect() == drivers.DialectDuckDB {
+		duck = e.olap
+	} else {
+		handle, release, err := e.rt.AcquireHandle(ctx, e.instanceID, "duckdb")
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire DuckDB for serving pivot: %w", err)
+		}
+
+		var ok bool
+		duck, ok = handle.AsOLAP(e.instanceID)
+		if !ok {
+			release()
+			return nil, fmt.Errorf(`connector "duckdb" is not an OLAP store`)
+		}
+		releaseDuck = release
+	}
+
+	// Execute the pivot export
+	path, err := e.executePivotExport(ctx, ast, pivot, "parquet")
+	if err != nil {
+		return nil, err
+	}
+
+	// Use DuckDB to read the Parquet file into a *drivers.Result
+	res, err := duck.Execute(ctx, &drivers.Statement{
+		Query:            fmt.Sprintf("SELECT * FROM '%s'", path),
+		Priority:         e.priority,
+		ExecutionTimeout: defaultInteractiveTimeout,
+	})
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, err
+	}
+	res.SetCleanupFunc(func() error {
+		if releaseDuck != nil {
+			releaseDuck()
+		}
+		_ = os.Remove(path)
+		return nil
+	})
+	return res, nil
+}
+
 // pivotAST represents config for generating a PIVOT query.
 type pivotAST struct {
 	keep    []string
@@ -208,10 +275,11 @@ type pivotAST struct {
 	dialect drivers.Dialect
 }
 
-// SQL generates a query that outputs a pivoted table based on the pivot config and data in the underlying query.
+// SQL generates a DuckDB PIVOT query that outputs a pivoted table based on the pivot config and data in the underlying query.
 // The underlyingAlias must be an alias for a table that holds the data produced by underlyingAST.SQL().
+// Other dialects that support pivoting build their pivot queries separately (see clickHousePivotSQL).
 func (a *pivotAST) SQL(underlyingAST *AST, underlyingAlias string) (string, error) {
-	if !a.dialect.CanPivot() {
+	if a.dialect != drivers.DialectDuckDB {
 		return "", fmt.Errorf("pivot queries not supported for dialect %q", a.dialect.String())
 	}
 
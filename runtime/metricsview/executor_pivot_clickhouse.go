@@ -0,0 +1,232 @@
+package metricsview
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// clickHousePivotSQL builds a query that pivots the results of the underlying query using conditional aggregation in ClickHouse.
+// ClickHouse doesn't have a PIVOT statement, so it first queries the distinct values of the pivot dimensions,
+// and then builds a query that emits a column per combination of pivot values and measure, like:
+//
+//	SELECT d1, anyIf(m1, toString(p1) = 'v1') AS "v1_m1", anyIf(m1, toString(p1) = 'v2') AS "v2_m1" FROM (<underlyingSQL>) GROUP BY d1
+//
+// The column names match those produced by DuckDB's PIVOT statement.
+func (e *Executor) clickHousePivotSQL(ctx context.Context, underlyingAST *AST, pivot *pivotAST, underlyingSQL string, underlyingArgs []any) (string, []any, error) {
+	if pivot.dialect != drivers.DialectClickHouse {
+		return "", nil, fmt.Errorf("cannot build ClickHouse pivot for dialect %q", pivot.dialect.String())
+	}
+
+	values, err := e.clickHousePivotValues(ctx, pivot, underlyingSQL, underlyingArgs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	d := pivot.dialect
+	b := &strings.Builder{}
+	var args []any
+
+	// If we need to label the non-pivoted dims, we wrap the query like: SELECT d1 AS "L1", * EXCEPT (d1) FROM (...)
+	wrapWithLabels := pivot.label && len(pivot.keep) > 0
+	if wrapWithLabels {
+		b.WriteString("SELECT ")
+		for _, fn := range pivot.keep {
+			f, ok := findField(fn, underlyingAST.Root.DimFields)
+			if !ok {
+				return "", nil, fmt.Errorf("pivot keep dimension %q not found in underlying query", fn)
+			}
+
+			b.WriteString(d.EscapeIdentifier(f.Name))
+			if f.Label != "" {
+				b.WriteString(" AS ")
+				b.WriteString(d.EscapeIdentifier(f.Label))
+			}
+			b.WriteString(", ")
+		}
+
+		b.WriteString("* EXCEPT (")
+		for i, fn := range pivot.keep {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(d.EscapeIdentifier(fn))
+		}
+		b.WriteString(") FROM (")
+	}
+
+	b.WriteString("SELECT ")
+	var n int
+	for _, fn := range pivot.keep {
+		if n > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(d.EscapeIdentifier(fn))
+		n++
+	}
+	for _, vals := range values {
+		// Build the condition that matches rows for this combination of pivot values
+		cond := &strings.Builder{}
+		var condArgs []any
+		for i, fn := range pivot.on {
+			if i > 0 {
+				cond.WriteString(" AND ")
+			}
+			if !vals[i].Valid {
+				fmt.Fprintf(cond, "isNull(%s)", d.EscapeIdentifier(fn))
+				continue
+			}
+			fmt.Fprintf(cond, "toString(%s) = ?", d.EscapeIdentifier(fn))
+			condArgs = append(condArgs, vals[i].String)
+		}
+
+		prefix := pivotColumnPrefix(vals)
+		if len(pivot.using) == 0 {
+			if n > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(b, "countIf(ifNull(%s, 0)) AS %s", cond.String(), d.EscapeIdentifier(prefix))
+			args = append(args, condArgs...)
+			n++
+			continue
+		}
+
+		for _, fn := range pivot.using {
+			f, ok := findField(fn, underlyingAST.Root.MeasureFields)
+			if !ok {
+				return "", nil, fmt.Errorf("pivot using measure %q not found in underlying query", fn)
+			}
+			name := f.Name
+			if pivot.label && f.Label != "" {
+				name = f.Label
+			}
+
+			if n > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(b, "anyIf(%s, ifNull(%s, 0)) AS %s", d.EscapeIdentifier(fn), cond.String(), d.EscapeIdentifier(prefix+"_"+name))
+			args = append(args, condArgs...)
+			n++
+		}
+	}
+	if n == 0 {
+		// There are no non-pivoted dims and the underlying query returned no values to pivot on.
+		b.WriteString("NULL")
+	}
+
+	b.WriteString(" FROM (")
+	b.WriteString(underlyingSQL)
+	b.WriteString(")")
+	args = append(args, underlyingArgs...)
+
+	if len(pivot.keep) > 0 {
+		b.WriteString(" GROUP BY ")
+		for i, fn := range pivot.keep {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(d.EscapeIdentifier(fn))
+		}
+	} else {
+		// Without a GROUP BY, ClickHouse returns a row even when the underlying query is empty.
+		b.WriteString(" HAVING count(*) > 0")
+	}
+
+	if len(pivot.orderBy) > 0 {
+		b.WriteString(" ORDER BY ")
+		for i, f := range pivot.orderBy {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(d.OrderByExpression(f.Name, f.Desc))
+		}
+	}
+
+	if pivot.limit != nil {
+		b.WriteString(" LIMIT ")
+		b.WriteString(strconv.FormatInt(*pivot.limit, 10))
+	}
+
+	if pivot.offset != nil {
+		b.WriteString(" OFFSET ")
+		b.WriteString(strconv.FormatInt(*pivot.offset, 10))
+	}
+
+	if wrapWithLabels {
+		b.WriteString(")")
+	}
+
+	return b.String(), args, nil
+}
+
+// clickHousePivotValues returns the distinct combinations of values of the pivot dimensions in the underlying query.
+// The values are cast to strings, which are used both for matching rows and for naming the pivoted columns.
+func (e *Executor) clickHousePivotValues(ctx context.Context, pivot *pivotAST, underlyingSQL string, underlyingArgs []any) ([][]sql.NullString, error) {
+	d := pivot.dialect
+	maxValues := maxPivotColumns / max(len(pivot.using), 1)
+
+	var selectCols, groupCols []string
+	for _, fn := range pivot.on {
+		selectCols = append(selectCols, fmt.Sprintf("toString(%s)", d.EscapeIdentifier(fn)))
+		groupCols = append(groupCols, d.EscapeIdentifier(fn))
+	}
+	q := fmt.Sprintf(
+		"SELECT %s FROM (%s) GROUP BY %s ORDER BY %s LIMIT %d",
+		strings.Join(selectCols, ", "),
+		underlyingSQL,
+		strings.Join(groupCols, ", "),
+		strings.Join(groupCols, ", "),
+		maxValues+1,
+	)
+
+	res, err := e.olap.Execute(ctx, &drivers.Statement{
+		Query:            q,
+		Args:             underlyingArgs,
+		Priority:         e.priority,
+		ExecutionTimeout: defaultInteractiveTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pivot values: %w", err)
+	}
+	defer res.Close()
+
+	var values [][]sql.NullString
+	for res.Next() {
+		vals := make([]sql.NullString, len(pivot.on))
+		ptrs := make([]any, len(vals))
+		for i := range vals {
+			ptrs[i] = &vals[i]
+		}
+		if err := res.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		values = append(values, vals)
+
+		if len(values) > maxValues {
+			return nil, fmt.Errorf("pivot would produce more than %d columns", maxPivotColumns)
+		}
+	}
+	if err := res.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// pivotColumnPrefix returns the prefix for the name of a pivoted column for the given combination of pivot values.
+// It follows DuckDB's naming convention of joining the values with underscores (and formatting NULLs as "NULL").
+func pivotColumnPrefix(vals []sql.NullString) string {
+	strs := make([]string, len(vals))
+	for i, v := range vals {
+		if v.Valid {
+			strs[i] = v.String
+		} else {
+			strs[i] = "NULL"
+		}
+	}
+	return strings.Join(strs, "_")
+}
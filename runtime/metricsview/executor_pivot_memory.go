@@ -0,0 +1,396 @@
+package metricsview
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// executePivotInMemory executes a pivot query by running the underlying query and pivoting its results in memory.
+// It is used to serve interactive pivot queries for OLAPs that don't support pivoting natively (i.e. Druid and Pinot).
+// To bound memory usage, it returns false if the underlying query returns more than maxInMemoryPivotRows rows.
+// In that case, the caller should fall back to pivoting in DuckDB.
+func (e *Executor) executePivotInMemory(ctx context.Context, ast *AST, pivot *pivotAST) (*drivers.Result, bool, error) {
+	// Limit the underlying query to one more row than we're willing to pivot in memory.
+	// We restore the original limit afterwards since the AST may be re-used by the caller if we return false.
+	prevLimit := ast.Root.Limit
+	tmp := int64(maxInMemoryPivotRows + 1)
+	ast.Root.Limit = &tmp
+	underlyingSQL, args, err := ast.SQL()
+	ast.Root.Limit = prevLimit
+	if err != nil {
+		return nil, false, err
+	}
+
+	res, err := e.olap.Execute(ctx, &drivers.Statement{
+		Query:            underlyingSQL,
+		Args:             args,
+		Priority:         e.priority,
+		ExecutionTimeout: defaultInteractiveTimeout,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	defer res.Close()
+
+	p, err := newMemoryPivot(ast, pivot, res.Schema)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var n int
+	for res.Next() {
+		n++
+		if n > maxInMemoryPivotRows {
+			return nil, false, nil
+		}
+
+		row, err := res.SliceScan()
+		if err != nil {
+			return nil, false, err
+		}
+		p.add(row)
+	}
+	if err := res.Err(); err != nil {
+		return nil, false, err
+	}
+
+	schema, data, err := p.result()
+	if err != nil {
+		return nil, false, err
+	}
+
+	out, err := drivers.NewInMemoryResult(schema, data)
+	if err != nil {
+		return nil, false, err
+	}
+	return out, true, nil
+}
+
+// memoryPivot incrementally pivots rows of an underlying query in memory.
+type memoryPivot struct {
+	ast    *AST
+	pivot  *pivotAST
+	schema *runtimev1.StructType
+
+	keepIdx  []int
+	onIdx    []int
+	usingIdx []int
+
+	columns    []*memoryPivotColumn
+	columnsIdx map[string]int
+	rows       []*memoryPivotRow
+	rowsIdx    map[string]int
+}
+
+// memoryPivotColumn represents a distinct combination of values of the pivot dimensions.
+type memoryPivotColumn struct {
+	values []any
+	prefix string
+}
+
+// memoryPivotRow represents a distinct combination of values of the non-pivoted dimensions.
+type memoryPivotRow struct {
+	keep  []any
+	cells map[int][]any // Maps column index to measure values
+}
+
+func newMemoryPivot(ast *AST, pivot *pivotAST, schema *runtimev1.StructType) (*memoryPivot, error) {
+	p := &memoryPivot{
+		ast:        ast,
+		pivot:      pivot,
+		schema:     schema,
+		columnsIdx: make(map[string]int),
+		rowsIdx:    make(map[string]int),
+	}
+
+	lookup := func(name string) (int, error) {
+		for i, f := range schema.Fields {
+			if f.Name == name {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("pivot field %q not found in underlying query", name)
+	}
+
+	for _, fn := range pivot.keep {
+		i, err := lookup(fn)
+		if err != nil {
+			return nil, err
+		}
+		p.keepIdx = append(p.keepIdx, i)
+	}
+	for _, fn := range pivot.on {
+		i, err := lookup(fn)
+		if err != nil {
+			return nil, err
+		}
+		p.onIdx = append(p.onIdx, i)
+	}
+	for _, fn := range pivot.using {
+		i, err := lookup(fn)
+		if err != nil {
+			return nil, err
+		}
+		p.usingIdx = append(p.usingIdx, i)
+	}
+
+	return p, nil
+}
+
+// add adds a row of the underlying query to the pivot.
+func (p *memoryPivot) add(row []any) {
+	// Find or create the pivoted column
+	onVals := make([]any, len(p.onIdx))
+	onStrs := make([]string, len(p.onIdx))
+	for i, idx := range p.onIdx {
+		onVals[i] = row[idx]
+		onStrs[i] = pivotValueString(row[idx])
+	}
+	colKey := strings.Join(onStrs, "\x00")
+	colIdx, ok := p.columnsIdx[colKey]
+	if !ok {
+		colIdx = len(p.columns)
+		p.columns = append(p.columns, &memoryPivotColumn{values: onVals, prefix: strings.Join(onStrs, "_")})
+		p.columnsIdx[colKey] = colIdx
+	}
+
+	// Find or create the output row
+	keepVals := make([]any, len(p.keepIdx))
+	keepStrs := make([]string, len(p.keepIdx))
+	for i, idx := range p.keepIdx {
+		keepVals[i] = row[idx]
+		keepStrs[i] = fmt.Sprintf("%T:%v", row[idx], row[idx])
+	}
+	rowKey := strings.Join(keepStrs, "\x00")
+	rowIdx, ok := p.rowsIdx[rowKey]
+	if !ok {
+		rowIdx = len(p.rows)
+		p.rows = append(p.rows, &memoryPivotRow{keep: keepVals, cells: make(map[int][]any)})
+		p.rowsIdx[rowKey] = rowIdx
+	}
+
+	// Set the measure values (like ANY_VALUE, the first value wins).
+	r := p.rows[rowIdx]
+	if _, ok := r.cells[colIdx]; ok {
+		if len(p.usingIdx) == 0 {
+			r.cells[colIdx][0] = r.cells[colIdx][0].(int64) + 1
+		}
+		return
+	}
+	if len(p.usingIdx) == 0 {
+		r.cells[colIdx] = []any{int64(1)}
+		return
+	}
+	vals := make([]any, len(p.usingIdx))
+	for i, idx := range p.usingIdx {
+		vals[i] = row[idx]
+	}
+	r.cells[colIdx] = vals
+}
+
+// result returns the schema and data of the pivoted table.
+func (p *memoryPivot) result() (*runtimev1.StructType, [][]any, error) {
+	if len(p.columns)*max(len(p.usingIdx), 1) > maxPivotColumns {
+		return nil, nil, fmt.Errorf("pivot would produce more than %d columns", maxPivotColumns)
+	}
+
+	// Sort the pivoted columns by their values (nulls last)
+	slices.SortStableFunc(p.columns, func(a, b *memoryPivotColumn) int {
+		for i := range a.values {
+			if c := comparePivotValues(a.values[i], b.values[i], false); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+
+	// Build the schema
+	schema := &runtimev1.StructType{}
+	for i, fn := range p.pivot.keep {
+		name := fn
+		if p.pivot.label {
+			if f, ok := findField(fn, p.ast.Root.DimFields); ok && f.Label != "" {
+				name = f.Label
+			}
+		}
+		schema.Fields = append(schema.Fields, &runtimev1.StructType_Field{
+			Name: name,
+			Type: p.schema.Fields[p.keepIdx[i]].Type,
+		})
+	}
+	for _, c := range p.columns {
+		if len(p.usingIdx) == 0 {
+			schema.Fields = append(schema.Fields, &runtimev1.StructType_Field{
+				Name: c.prefix,
+				Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64},
+			})
+			continue
+		}
+		for i, fn := range p.pivot.using {
+			name := fn
+			if p.pivot.label {
+				if f, ok := findField(fn, p.ast.Root.MeasureFields); ok && f.Label != "" {
+					name = f.Label
+				}
+			}
+			schema.Fields = append(schema.Fields, &runtimev1.StructType_Field{
+				Name: c.prefix + "_" + name,
+				Type: p.schema.Fields[p.usingIdx[i]].Type,
+			})
+		}
+	}
+
+	// Sort the rows
+	if len(p.pivot.orderBy) > 0 {
+		sortIdx := make([]int, len(p.pivot.orderBy))
+		for i, f := range p.pivot.orderBy {
+			idx := slices.Index(p.pivot.keep, f.Name)
+			if idx < 0 {
+				return nil, nil, fmt.Errorf("pivot sort field %q is not a non-pivoted dimension", f.Name)
+			}
+			sortIdx[i] = idx
+		}
+		slices.SortStableFunc(p.rows, func(a, b *memoryPivotRow) int {
+			for i, f := range p.pivot.orderBy {
+				if c := comparePivotValues(a.keep[sortIdx[i]], b.keep[sortIdx[i]], f.Desc); c != 0 {
+					return c
+				}
+			}
+			return 0
+		})
+	}
+
+	// Apply offset and limit
+	rows := p.rows
+	if p.pivot.offset != nil {
+		rows = rows[min(int(*p.pivot.offset), len(rows)):]
+	}
+	if p.pivot.limit != nil {
+		rows = rows[:min(int(*p.pivot.limit), len(rows))]
+	}
+
+	// Build the data
+	cellsPerColumn := max(len(p.usingIdx), 1)
+	data := make([][]any, len(rows))
+	for i, r := range rows {
+		row := make([]any, 0, len(schema.Fields))
+		row = append(row, r.keep...)
+		for j := range p.columns {
+			vals, ok := r.cells[p.columnsIdx[p.columnKey(j)]]
+			if !ok {
+				if len(p.usingIdx) == 0 {
+					row = append(row, int64(0))
+				} else {
+					row = append(row, make([]any, cellsPerColumn)...)
+				}
+				continue
+			}
+			row = append(row, vals...)
+		}
+		data[i] = row
+	}
+
+	return schema, data, nil
+}
+
+// columnKey returns the key of the j'th pivoted column in p.columnsIdx.
+func (p *memoryPivot) columnKey(j int) string {
+	strs := make([]string, len(p.columns[j].values))
+	for i, v := range p.columns[j].values {
+		strs[i] = pivotValueString(v)
+	}
+	return strings.Join(strs, "\x00")
+}
+
+// pivotValueString formats a pivot value for use in a column name.
+// It follows DuckDB's formatting of values cast to VARCHAR for common types.
+func pivotValueString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case time.Time:
+		return v.UTC().Format("2006-01-02 15:04:05.999999")
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// comparePivotValues compares two values returned by an OLAP query. Nulls are sorted last regardless of the sort direction.
+func comparePivotValues(a, b any, desc bool) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return 1
+		default:
+			return -1
+		}
+	}
+
+	var c int
+	af, aok := pivotValueFloat(a)
+	bf, bok := pivotValueFloat(b)
+	switch {
+	case aok && bok:
+		switch {
+		case af < bf:
+			c = -1
+		case af > bf:
+			c = 1
+		}
+	default:
+		at, aok := a.(time.Time)
+		bt, bok := b.(time.Time)
+		if aok && bok {
+			c = at.Compare(bt)
+		} else {
+			c = strings.Compare(pivotValueString(a), pivotValueString(b))
+		}
+	}
+
+	if desc {
+		return -c
+	}
+	return c
+}
+
+// pivotValueFloat converts a numeric value to a float64 for comparisons.
+func pivotValueFloat(v any) (float64, bool) {
+	switch v := v.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,70 @@
+package metricsview
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryPivot(t *testing.T) {
+	ast := &AST{
+		Root: &SelectNode{
+			DimFields:     []FieldNode{{Name: "pub", Label: "Publisher"}, {Name: "day"}},
+			MeasureFields: []FieldNode{{Name: "cnt", Label: "Count"}},
+		},
+	}
+	limit := int64(2)
+	pivot := &pivotAST{
+		keep:    []string{"pub"},
+		on:      []string{"day"},
+		using:   []string{"cnt"},
+		orderBy: []OrderFieldNode{{Name: "pub", Desc: true}},
+		limit:   &limit,
+		label:   true,
+	}
+	schema := &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+		{Name: "pub", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}},
+		{Name: "day", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}},
+		{Name: "cnt", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}},
+	}}
+
+	d1 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	p, err := newMemoryPivot(ast, pivot, schema)
+	require.NoError(t, err)
+	p.add([]any{"a", d2, int64(1)})
+	p.add([]any{"b", d1, int64(2)})
+	p.add([]any{"a", d1, int64(3)})
+	p.add([]any{nil, d1, int64(4)})
+	p.add([]any{"c", nil, int64(5)})
+
+	resSchema, data, err := p.result()
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range resSchema.Fields {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"Publisher", "2022-01-01 00:00:00_Count", "2022-01-02 00:00:00_Count", "NULL_Count"}, names)
+	require.Equal(t, [][]any{
+		{"c", nil, nil, int64(5)},
+		{"b", int64(2), nil, nil},
+	}, data)
+
+	// Check the data can be served as a result
+	res, err := drivers.NewInMemoryResult(resSchema, data)
+	require.NoError(t, err)
+	defer res.Close()
+	var rows [][]any
+	for res.Next() {
+		row, err := res.SliceScan()
+		require.NoError(t, err)
+		rows = append(rows, row)
+	}
+	require.NoError(t, res.Err())
+	require.Equal(t, data, rows)
+}
@@ -9,6 +9,13 @@ func (e *Executor) rewriteLimitsIntoSubqueries(ast *AST) error {
 		return nil
 	}
 
+	// Some computed measures are computed with a window over the grouped rows, so limiting the rows before the window is applied would change the result.
+	for _, qm := range ast.query.Measures {
+		if qm.Compute != nil && qm.Compute.usesWindow() {
+			return nil
+		}
+	}
+
 	return e.rewriteLimitsIntoSubqueriesWalk(ast, ast.Root)
 }
 
@@ -0,0 +1,365 @@
+package metricsview
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/timeutil"
+	"google.golang.org/protobuf/proto"
+)
+
+// rewriteQueryRollups routes a query to the smallest rollup of the metrics view that can answer it exactly.
+// It returns a metrics view spec that should be used to build the query's AST.
+// If the query can be answered from a rollup, the returned spec is a copy of the metrics view spec where the table, dimensions and measures are rewritten to reference the rollup's table.
+// Otherwise, it returns the executor's metrics view spec.
+//
+// The time ranges in the query must be resolved before calling this function.
+func (e *Executor) rewriteQueryRollups(qry *Query) *runtimev1.MetricsViewSpec {
+	// Rollups are only created for DuckDB and ClickHouse (see the parser)
+	dialect := e.olap.Dialect()
+	if dialect != drivers.DialectDuckDB && dialect != drivers.DialectClickHouse {
+		return e.metricsView
+	}
+
+	var best *runtimev1.MetricsViewSpec_Rollup
+	var bestExact bool
+	for _, r := range e.metricsView.Rollups {
+		ok, exact := e.rollupCanAnswer(r, qry)
+		if !ok {
+			continue
+		}
+
+		// Prefer coarser rollups, then rollups with fewer dimensions, since they are expected to have fewer rows.
+		if best == nil || r.TimeGrain > best.TimeGrain || (r.TimeGrain == best.TimeGrain && len(r.Dimensions) < len(best.Dimensions)) {
+			best = r
+			bestExact = exact
+		}
+	}
+	if best == nil {
+		return e.metricsView
+	}
+
+	return e.rollupMetricsViewSpec(best, bestExact)
+}
+
+// rollupCanAnswer checks whether the rollup can answer the query exactly.
+// If so, it also returns whether the query's grouping exactly matches the rollup's grouping (in which case the rollup's measures can be returned without re-aggregation).
+func (e *Executor) rollupCanAnswer(r *runtimev1.MetricsViewSpec_Rollup, qry *Query) (bool, bool) {
+	mv := e.metricsView
+	if r.Table == "" || mv.TimeDimension == "" {
+		return false, false
+	}
+
+	// Rollups are truncated in UTC
+	switch qry.TimeZone {
+	case "", "UTC", "Etc/UTC":
+	default:
+		return false, false
+	}
+
+	// Row filters are arbitrary SQL against the underlying table, so they can't be applied to a rollup
+	if e.security.RowFilter() != "" {
+		return false, false
+	}
+
+	// Check the dimensions
+	queryDims := make(map[string]bool)
+	exactGrain := true
+	hasTimeFloor := false
+	for _, d := range qry.Dimensions {
+		if d.Compute == nil {
+			if !slices.Contains(r.Dimensions, d.Name) {
+				return false, false
+			}
+			queryDims[d.Name] = true
+			continue
+		}
+
+		tf := d.Compute.TimeFloor
+		if tf == nil || tf.Dimension != mv.TimeDimension {
+			return false, false
+		}
+		grain := tf.Grain.ToProto()
+		if !rollupCanDeriveGrain(r.TimeGrain, grain, int(mv.FirstDayOfWeek), int(mv.FirstMonthOfYear)) {
+			return false, false
+		}
+		hasTimeFloor = true
+		if grain != r.TimeGrain {
+			exactGrain = false
+		}
+	}
+	exact := hasTimeFloor && exactGrain && len(queryDims) == len(r.Dimensions)
+
+	// Check the time ranges are aligned with the rollup's grain
+	if !rollupTimeRangeAligned(r.TimeGrain, qry.TimeRange) || !rollupTimeRangeAligned(r.TimeGrain, qry.ComparisonTimeRange) {
+		return false, false
+	}
+	if qry.Spine != nil && qry.Spine.TimeRange != nil {
+		if !rollupCanDeriveGrain(r.TimeGrain, qry.Spine.TimeRange.Grain.ToProto(), int(mv.FirstDayOfWeek), int(mv.FirstMonthOfYear)) {
+			return false, false
+		}
+	}
+
+	// Check filters only reference dimensions in the rollup
+	var filters []*Expression
+	filters = append(filters, qry.Where)
+	if qry.Spine != nil && qry.Spine.Where != nil {
+		filters = append(filters, qry.Spine.Where.Expression)
+	}
+	if qf := e.security.QueryFilter(); qf != nil {
+		filters = append(filters, NewExpressionFromProto(qf))
+	}
+	for _, f := range filters {
+		names, ok := rollupExpressionNames(f, nil)
+		if !ok {
+			return false, false
+		}
+		for _, n := range names {
+			if !slices.Contains(r.Dimensions, n) {
+				return false, false
+			}
+		}
+	}
+	if _, ok := rollupExpressionNames(qry.Having, nil); !ok {
+		return false, false
+	}
+
+	// Check the measures
+	for _, m := range qry.Measures {
+		if m.Compute == nil {
+			if !e.rollupCanAnswerMeasure(r, m.Name, exact, 0) {
+				return false, false
+			}
+			continue
+		}
+
+		c := m.Compute
+		switch {
+		case c.Count, c.Retention != nil:
+			// These need the underlying rows
+			return false, false
+		case c.CountDistinct != nil:
+			if !slices.Contains(r.Dimensions, c.CountDistinct.Dimension) {
+				return false, false
+			}
+		case c.URI != nil:
+			if !slices.Contains(r.Dimensions, c.URI.Dimension) {
+				return false, false
+			}
+		default:
+			// Other computes are based on other measures in the query, which are checked separately
+		}
+	}
+
+	return true, exact
+}
+
+// rollupCanAnswerMeasure checks whether a measure in the metrics view can be computed from the rollup.
+func (e *Executor) rollupCanAnswerMeasure(r *runtimev1.MetricsViewSpec_Rollup, name string, exact bool, depth int) bool {
+	if depth > 10 {
+		return false
+	}
+
+	var m *runtimev1.MetricsViewSpec_MeasureV2
+	for _, mm := range e.metricsView.Measures {
+		if mm.Name == name {
+			m = mm
+			break
+		}
+	}
+	if m == nil || m.Window != nil || len(m.PerDimensions) > 0 || len(m.RequiredDimensions) > 0 {
+		return false
+	}
+
+	switch m.Type {
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE:
+		if !slices.Contains(r.Measures, m.Name) {
+			return false
+		}
+		// Measures that can't be re-aggregated can only be served if the query is grouped exactly like the rollup
+		_, ok := rollupReaggregation(m.Expression)
+		return ok || exact
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED:
+		for _, ref := range m.ReferencedMeasures {
+			if !e.rollupCanAnswerMeasure(r, ref, exact, depth+1) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// rollupMetricsViewSpec returns a copy of the metrics view spec that reads from the rollup's table.
+func (e *Executor) rollupMetricsViewSpec(r *runtimev1.MetricsViewSpec_Rollup, exact bool) *runtimev1.MetricsViewSpec {
+	dialect := e.olap.Dialect()
+
+	spec := proto.Clone(e.metricsView).(*runtimev1.MetricsViewSpec)
+	spec.Database = r.Database
+	spec.DatabaseSchema = r.DatabaseSchema
+	spec.Table = r.Table
+	spec.Model = ""
+	spec.Rollups = nil
+
+	// In the rollup, dimensions (including the time dimension) are stored in columns named after them
+	spec.Dimensions = nil
+	for _, d := range e.metricsView.Dimensions {
+		if d.Name != e.metricsView.TimeDimension && !slices.Contains(r.Dimensions, d.Name) {
+			continue
+		}
+		d = proto.Clone(d).(*runtimev1.MetricsViewSpec_DimensionV2)
+		d.Column = d.Name
+		d.Expression = ""
+		spec.Dimensions = append(spec.Dimensions, d)
+	}
+
+	// Simple measures are re-aggregated from the pre-aggregated columns in the rollup.
+	// Derived measures are kept as is since they only reference other measures.
+	spec.Measures = nil
+	for _, m := range e.metricsView.Measures {
+		switch m.Type {
+		case runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE:
+			if !slices.Contains(r.Measures, m.Name) {
+				continue
+			}
+			agg, ok := rollupReaggregation(m.Expression)
+			if !ok {
+				if !exact {
+					continue
+				}
+				// Each group maps to exactly one row in the rollup, so any aggregate returns the pre-aggregated value
+				agg = "MIN"
+			}
+			expr := fmt.Sprintf("%s(%s)", agg, dialect.EscapeIdentifier(m.Name))
+			if dialect == drivers.DialectDuckDB && strings.HasPrefix(strings.ToUpper(strings.TrimSpace(m.Expression)), "COUNT") {
+				// DuckDB sums integers to a HUGEINT, so we cast it back to match the type of the original count
+				expr = fmt.Sprintf("CAST(%s AS BIGINT)", expr)
+			}
+			m = proto.Clone(m).(*runtimev1.MetricsViewSpec_MeasureV2)
+			m.Expression = expr
+			spec.Measures = append(spec.Measures, m)
+		case runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED:
+			spec.Measures = append(spec.Measures, m)
+		}
+	}
+
+	return spec
+}
+
+// rollupReaggregationRegexp matches simple aggregations that can be re-aggregated from pre-aggregated values.
+var rollupReaggregationRegexp = regexp.MustCompile(`(?i)^\s*(sum|count|min|max)\s*\(`)
+
+// rollupReaggregation returns the aggregation function to use to re-aggregate pre-aggregated values of a measure expression.
+// It returns false if the expression isn't a single aggregation that can be re-aggregated (e.g. AVG or COUNT(DISTINCT ...)).
+func rollupReaggregation(expr string) (string, bool) {
+	m := rollupReaggregationRegexp.FindStringSubmatch(expr)
+	if m == nil {
+		return "", false
+	}
+
+	// Check the aggregation's parentheses span the rest of the expression
+	rest := expr[len(m[0]):]
+	depth := 1
+	end := -1
+	for i, c := range rest {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth == 0 {
+			end = i
+			break
+		}
+	}
+	if end < 0 || strings.TrimSpace(rest[end+1:]) != "" {
+		return "", false
+	}
+	if strings.HasPrefix(strings.ToLower(strings.TrimSpace(rest[:end])), "distinct") {
+		return "", false
+	}
+
+	switch strings.ToLower(m[1]) {
+	case "sum", "count":
+		return "SUM", true
+	case "min":
+		return "MIN", true
+	case "max":
+		return "MAX", true
+	default:
+		return "", false
+	}
+}
+
+// rollupCanDeriveGrain returns true if values truncated to the rollup grain can be truncated to the query grain with the same result as truncating the raw values.
+func rollupCanDeriveGrain(rollup, query runtimev1.TimeGrain, firstDayOfWeek, firstMonthOfYear int) bool {
+	if query < rollup {
+		return false
+	}
+
+	// Rollups are truncated using standard weeks (starting on Monday) and years (starting in January)
+	customWeek := firstDayOfWeek > 1
+	customYear := firstMonthOfYear > 1
+
+	switch rollup {
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND, runtimev1.TimeGrain_TIME_GRAIN_MINUTE, runtimev1.TimeGrain_TIME_GRAIN_HOUR, runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return true
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		return query == runtimev1.TimeGrain_TIME_GRAIN_WEEK && !customWeek
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return query != runtimev1.TimeGrain_TIME_GRAIN_WEEK
+	case runtimev1.TimeGrain_TIME_GRAIN_QUARTER, runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return !customYear
+	default:
+		return false
+	}
+}
+
+// rollupTimeRangeAligned returns true if the resolved time range's bounds are aligned with the rollup's grain.
+func rollupTimeRangeAligned(grain runtimev1.TimeGrain, tr *TimeRange) bool {
+	if tr == nil {
+		return true
+	}
+
+	tg := TimeGrainFromProto(grain).ToTimeutil()
+	for _, t := range []time.Time{tr.Start, tr.End} {
+		if t.IsZero() {
+			continue
+		}
+		if !timeutil.TruncateTime(t, tg, time.UTC, 1, 1).Equal(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// rollupExpressionNames returns the names of the fields referenced in the expression.
+// It returns false if the expression contains a subquery, which is not supported for rollups.
+func rollupExpressionNames(e *Expression, names []string) ([]string, bool) {
+	if e == nil {
+		return names, true
+	}
+	if e.Subquery != nil {
+		return nil, false
+	}
+	if e.Name != "" {
+		names = append(names, e.Name)
+	}
+	if e.Condition != nil {
+		for _, ce := range e.Condition.Expressions {
+			var ok bool
+			names, ok = rollupExpressionNames(ce, names)
+			if !ok {
+				return nil, false
+			}
+		}
+	}
+	return names, true
+}
@@ -0,0 +1,255 @@
+package metricsview
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupReaggregation(t *testing.T) {
+	tests := []struct {
+		expr string
+		agg  string
+		ok   bool
+	}{
+		{"SUM(x)", "SUM", true},
+		{" count(*) ", "SUM", true},
+		{"MIN(a + b)", "MIN", true},
+		{"max(coalesce(x, 0))", "MAX", true},
+		{"COUNT(DISTINCT x)", "", false},
+		{"AVG(x)", "", false},
+		{"SUM(x) / COUNT(*)", "", false},
+		{"SUM(x) + 1", "", false},
+		{"sumIf(x, y)", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			agg, ok := rollupReaggregation(tt.expr)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.agg, agg)
+		})
+	}
+}
+
+func TestRollupCanDeriveGrain(t *testing.T) {
+	require.True(t, rollupCanDeriveGrain(runtimev1.TimeGrain_TIME_GRAIN_HOUR, runtimev1.TimeGrain_TIME_GRAIN_HOUR, 1, 1))
+	require.True(t, rollupCanDeriveGrain(runtimev1.TimeGrain_TIME_GRAIN_DAY, runtimev1.TimeGrain_TIME_GRAIN_WEEK, 3, 2))
+	require.False(t, rollupCanDeriveGrain(runtimev1.TimeGrain_TIME_GRAIN_DAY, runtimev1.TimeGrain_TIME_GRAIN_HOUR, 1, 1))
+	require.False(t, rollupCanDeriveGrain(runtimev1.TimeGrain_TIME_GRAIN_WEEK, runtimev1.TimeGrain_TIME_GRAIN_MONTH, 1, 1))
+	require.False(t, rollupCanDeriveGrain(runtimev1.TimeGrain_TIME_GRAIN_WEEK, runtimev1.TimeGrain_TIME_GRAIN_WEEK, 7, 1))
+	require.True(t, rollupCanDeriveGrain(runtimev1.TimeGrain_TIME_GRAIN_MONTH, runtimev1.TimeGrain_TIME_GRAIN_YEAR, 1, 4))
+	require.False(t, rollupCanDeriveGrain(runtimev1.TimeGrain_TIME_GRAIN_MONTH, runtimev1.TimeGrain_TIME_GRAIN_WEEK, 1, 1))
+	require.False(t, rollupCanDeriveGrain(runtimev1.TimeGrain_TIME_GRAIN_QUARTER, runtimev1.TimeGrain_TIME_GRAIN_YEAR, 1, 4))
+}
+
+func TestRewriteQueryRollups(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Table:         "events",
+		TimeDimension: "ts",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "ts", Column: "ts"},
+			{Name: "country", Column: "country"},
+			{Name: "device", Expression: "lower(device_type)"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "events", Expression: "COUNT(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{Name: "revenue", Expression: "SUM(revenue)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{Name: "avg_revenue", Expression: "AVG(revenue)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{Name: "revenue_per_event", Expression: "revenue / events", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, ReferencedMeasures: []string{"revenue", "events"}},
+		},
+		Rollups: []*runtimev1.MetricsViewSpec_Rollup{
+			{
+				Table:      "events_hourly",
+				TimeGrain:  runtimev1.TimeGrain_TIME_GRAIN_HOUR,
+				Dimensions: []string{"country", "device"},
+				Measures:   []string{"events", "revenue", "avg_revenue"},
+			},
+			{
+				Table:      "events_daily",
+				TimeGrain:  runtimev1.TimeGrain_TIME_GRAIN_DAY,
+				Dimensions: []string{"country"},
+				Measures:   []string{"events", "revenue", "avg_revenue"},
+			},
+		},
+	}
+
+	day := func(name string, grain TimeGrain) Dimension {
+		return Dimension{Name: name, Compute: &DimensionCompute{TimeFloor: &DimensionComputeTimeFloor{Dimension: "ts", Grain: grain}}}
+	}
+	aligned := &TimeRange{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name    string
+		dialect drivers.Dialect
+		query   *Query
+		table   string
+	}{
+		{
+			name:    "coarsest rollup",
+			dialect: drivers.DialectDuckDB,
+			query:   &Query{Dimensions: []Dimension{day("day", TimeGrainDay), {Name: "country"}}, Measures: []Measure{{Name: "revenue_per_event"}}, TimeRange: aligned},
+			table:   "events_daily",
+		},
+		{
+			name:    "finer rollup for dimension",
+			dialect: drivers.DialectClickHouse,
+			query:   &Query{Dimensions: []Dimension{day("month", TimeGrainMonth), {Name: "device"}}, Measures: []Measure{{Name: "events"}}},
+			table:   "events_hourly",
+		},
+		{
+			name:    "finer rollup for grain",
+			dialect: drivers.DialectDuckDB,
+			query:   &Query{Dimensions: []Dimension{day("hour", TimeGrainHour)}, Measures: []Measure{{Name: "events"}}},
+			table:   "events_hourly",
+		},
+		{
+			name:    "finer rollup for time range",
+			dialect: drivers.DialectDuckDB,
+			query:   &Query{Measures: []Measure{{Name: "events"}}, TimeRange: &TimeRange{Start: time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC)}},
+			table:   "events_hourly",
+		},
+		{
+			name:    "exact grouping for non-reaggregatable measure",
+			dialect: drivers.DialectDuckDB,
+			query:   &Query{Dimensions: []Dimension{day("day", TimeGrainDay), {Name: "country"}}, Measures: []Measure{{Name: "avg_revenue"}}},
+			table:   "events_daily",
+		},
+		{
+			name:    "dimension mismatch",
+			dialect: drivers.DialectDuckDB,
+			query:   &Query{Dimensions: []Dimension{{Name: "ts"}}, Measures: []Measure{{Name: "events"}}},
+			table:   "events",
+		},
+		{
+			name:    "filter dimension mismatch",
+			dialect: drivers.DialectDuckDB,
+			query: &Query{Measures: []Measure{{Name: "events"}}, Where: &Expression{Condition: &Condition{
+				Operator:    OperatorEq,
+				Expressions: []*Expression{{Name: "ts"}, {Value: "2024-01-01"}},
+			}}},
+			table: "events",
+		},
+		{
+			name:    "grain mismatch",
+			dialect: drivers.DialectDuckDB,
+			query:   &Query{Dimensions: []Dimension{day("minute", TimeGrainMinute)}, Measures: []Measure{{Name: "events"}}},
+			table:   "events",
+		},
+		{
+			name:    "time range mismatch",
+			dialect: drivers.DialectDuckDB,
+			query:   &Query{Measures: []Measure{{Name: "events"}}, TimeRange: &TimeRange{Start: time.Date(2024, 1, 1, 6, 30, 0, 0, time.UTC)}},
+			table:   "events",
+		},
+		{
+			name:    "timezone mismatch",
+			dialect: drivers.DialectDuckDB,
+			query:   &Query{Dimensions: []Dimension{day("day", TimeGrainDay)}, Measures: []Measure{{Name: "events"}}, TimeZone: "America/New_York"},
+			table:   "events",
+		},
+		{
+			name:    "non-reaggregatable measure",
+			dialect: drivers.DialectDuckDB,
+			query:   &Query{Dimensions: []Dimension{day("month", TimeGrainMonth)}, Measures: []Measure{{Name: "avg_revenue"}}},
+			table:   "events",
+		},
+		{
+			name:    "count compute",
+			dialect: drivers.DialectDuckDB,
+			query:   &Query{Measures: []Measure{{Name: "n", Compute: &MeasureCompute{Count: true}}}},
+			table:   "events",
+		},
+		{
+			name:    "unsupported dialect",
+			dialect: drivers.DialectDruid,
+			query:   &Query{Measures: []Measure{{Name: "events"}}},
+			table:   "events",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Executor{
+				metricsView: mv,
+				security:    &runtime.ResolvedSecurity{},
+				olap:        dialectOLAP{dialect: tt.dialect},
+			}
+			spec := e.rewriteQueryRollups(tt.query)
+			require.Equal(t, tt.table, spec.Table)
+			if tt.table == mv.Table {
+				require.Same(t, mv, spec)
+			}
+		})
+	}
+}
+
+func TestRollupMetricsViewSpec(t *testing.T) {
+	mv := &runtimev1.MetricsViewSpec{
+		Model:         "events",
+		Table:         "events",
+		TimeDimension: "ts",
+		Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+			{Name: "ts", Column: "event_time"},
+			{Name: "country", Column: "country_code"},
+			{Name: "device", Expression: "lower(device_type)"},
+		},
+		Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+			{Name: "events", Expression: "COUNT(*)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{Name: "max_revenue", Expression: "max(revenue)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{Name: "avg_revenue", Expression: "AVG(revenue)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{Name: "users", Expression: "COUNT(DISTINCT user_id)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE},
+			{Name: "events_per_user", Expression: "events / users", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, ReferencedMeasures: []string{"events", "users"}},
+		},
+	}
+	r := &runtimev1.MetricsViewSpec_Rollup{
+		DatabaseSchema: "rollups",
+		Table:          "events_daily",
+		TimeGrain:      runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		Dimensions:     []string{"country"},
+		Measures:       []string{"events", "max_revenue", "avg_revenue"},
+	}
+	e := &Executor{metricsView: mv, security: &runtime.ResolvedSecurity{}, olap: dialectOLAP{dialect: drivers.DialectDuckDB}}
+
+	// Dimensions read the rollup's columns and simple measures are re-aggregated
+	spec := e.rollupMetricsViewSpec(r, false)
+	require.Equal(t, "rollups", spec.DatabaseSchema)
+	require.Equal(t, "events_daily", spec.Table)
+	require.Empty(t, spec.Model)
+	require.Len(t, spec.Dimensions, 2)
+	require.Equal(t, "ts", spec.Dimensions[0].Column)
+	require.Equal(t, "country", spec.Dimensions[1].Column)
+	measures := make(map[string]string)
+	for _, m := range spec.Measures {
+		measures[m.Name] = m.Expression
+	}
+	require.Equal(t, map[string]string{
+		"events":          `CAST(SUM("events") AS BIGINT)`,
+		"max_revenue":     `MAX("max_revenue")`,
+		"events_per_user": "events / users",
+	}, measures)
+
+	// Measures that can't be re-aggregated are served as is for exact groupings
+	spec = e.rollupMetricsViewSpec(r, true)
+	measures = make(map[string]string)
+	for _, m := range spec.Measures {
+		measures[m.Name] = m.Expression
+	}
+	require.Equal(t, `MIN("avg_revenue")`, measures["avg_revenue"])
+
+	// The original spec is not modified
+	require.Equal(t, "event_time", mv.Dimensions[0].Column)
+	require.Equal(t, "COUNT(*)", mv.Measures[0].Expression)
+}
+
+// dialectOLAP is a drivers.OLAPStore that only implements Dialect.
+type dialectOLAP struct {
+	drivers.OLAPStore
+	dialect drivers.Dialect
+}
+
+func (o dialectOLAP) Dialect() drivers.Dialect {
+	return o.dialect
+}
@@ -31,7 +31,29 @@ type Dimension struct {
 }
 
 type DimensionCompute struct {
-	TimeFloor *DimensionComputeTimeFloor `mapstructure:"time_floor"`
+	TimeFloor    *DimensionComputeTimeFloor    `mapstructure:"time_floor"`
+	Cohort       *DimensionComputeCohort       `mapstructure:"cohort"`
+	CohortOffset *DimensionComputeCohortOffset `mapstructure:"cohort_offset"`
+}
+
+func (d *DimensionCompute) Validate() error {
+	n := 0
+	if d.TimeFloor != nil {
+		n++
+	}
+	if d.Cohort != nil {
+		n++
+	}
+	if d.CohortOffset != nil {
+		n++
+	}
+	if n == 0 {
+		return fmt.Errorf(`must specify a compute operation`)
+	}
+	if n > 1 {
+		return fmt.Errorf("must specify only one compute operation")
+	}
+	return nil
 }
 
 type DimensionComputeTimeFloor struct {
@@ -39,6 +61,20 @@ type DimensionComputeTimeFloor struct {
 	Grain     TimeGrain `mapstructure:"grain"`
 }
 
+// DimensionComputeCohort resolves to the start of the period (at the given grain) in which each entity was first seen.
+// Entities are identified by the values of Dimension. The first time an entity was seen is based on the metrics view's time dimension.
+type DimensionComputeCohort struct {
+	Dimension string    `mapstructure:"dimension"`
+	Grain     TimeGrain `mapstructure:"grain"`
+}
+
+// DimensionComputeCohortOffset resolves to the number of periods (at the given grain) between an entity's cohort and the current row.
+// It is 0 for rows in the cohort's own period, 1 for the next period, and so on.
+type DimensionComputeCohortOffset struct {
+	Dimension string    `mapstructure:"dimension"`
+	Grain     TimeGrain `mapstructure:"grain"`
+}
+
 type Measure struct {
 	Name    string          `mapstructure:"name"`
 	Compute *MeasureCompute `mapstructure:"compute"`
@@ -52,6 +88,10 @@ type MeasureCompute struct {
 	ComparisonRatio *MeasureComputeComparisonRatio `mapstructure:"comparison_ratio"`
 	PercentOfTotal  *MeasureComputePercentOfTotal  `mapstructure:"percent_of_total"`
 	URI             *MeasureComputeURI             `mapstructure:"uri"`
+	Retention       *MeasureComputeRetention       `mapstructure:"retention"`
+	RunningTotal    *MeasureComputeRunningTotal    `mapstructure:"running_total"`
+	MovingAverage   *MeasureComputeMovingAverage   `mapstructure:"moving_average"`
+	PeriodToDate    *MeasureComputePeriodToDate    `mapstructure:"period_to_date"`
 }
 
 func (m *MeasureCompute) Validate() error {
@@ -77,6 +117,18 @@ func (m *MeasureCompute) Validate() error {
 	if m.URI != nil {
 		n++
 	}
+	if m.Retention != nil {
+		n++
+	}
+	if m.RunningTotal != nil {
+		n++
+	}
+	if m.MovingAverage != nil {
+		n++
+	}
+	if m.PeriodToDate != nil {
+		n++
+	}
 	if n == 0 {
 		return fmt.Errorf(`must specify a compute operation`)
 	}
@@ -86,6 +138,12 @@ func (m *MeasureCompute) Validate() error {
 	return nil
 }
 
+// usesWindow returns true if the computed measure is evaluated with a window function over the query's result rows.
+// Such measures depend on the other rows in the result, so the result rows must not be limited before the measure is computed.
+func (m *MeasureCompute) usesWindow() bool {
+	return m.Retention != nil || m.RunningTotal != nil || m.MovingAverage != nil || m.PeriodToDate != nil
+}
+
 type MeasureComputeCountDistinct struct {
 	Dimension string `mapstructure:"dimension"`
 }
@@ -111,6 +169,33 @@ type MeasureComputeURI struct {
 	Dimension string `mapstructure:"dimension"`
 }
 
+// MeasureComputeRetention computes a measure as a fraction of its value in the cohort's first period (i.e. at cohort offset 0).
+// It requires the query to include a dimension with a cohort offset compute.
+type MeasureComputeRetention struct {
+	Measure string `mapstructure:"measure"`
+}
+
+// MeasureComputeRunningTotal computes the cumulative sum of a measure over time.
+// It requires the query to include the metrics view's time dimension. The sum restarts for each combination of the query's other dimensions.
+type MeasureComputeRunningTotal struct {
+	Measure string `mapstructure:"measure"`
+}
+
+// MeasureComputeMovingAverage computes the average of a measure over the current and the N-1 preceding time periods.
+// It requires the query to include the metrics view's time dimension. The periods are the rows returned for each combination of the query's other dimensions,
+// so periods without data are not counted unless the query uses a spine.
+type MeasureComputeMovingAverage struct {
+	Measure string `mapstructure:"measure"`
+	Periods int    `mapstructure:"periods"`
+}
+
+// MeasureComputePeriodToDate computes the cumulative sum of a measure since the start of the current period of the given grain (e.g. month-to-date).
+// It requires the query to include the metrics view's time dimension at a grain that is finer than or equal to the period's grain.
+type MeasureComputePeriodToDate struct {
+	Measure string    `mapstructure:"measure"`
+	Grain   TimeGrain `mapstructure:"grain"`
+}
+
 type Spine struct {
 	Where     *WhereSpine `mapstructure:"where"`
 	TimeRange *TimeSpine  `mapstructure:"time"`
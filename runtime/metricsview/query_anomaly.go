@@ -0,0 +1,143 @@
+package metricsview
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AnomalyQuery detects anomalies in a measure of a metrics view without hand-written thresholds.
+// It aggregates the measure by the query's dimensions and the metrics view's time dimension at the given grain,
+// and flags each point in the time range that falls outside a baseline band computed from the points that precede it.
+//
+// The "rolling" method uses the mean ± threshold·stddev of the preceding Window points.
+// The "seasonal" method uses the median ± threshold·MAD (median absolute deviation, scaled to be comparable to a stddev)
+// of the points at the same time of the week in the preceding Window weeks, which accounts for weekday seasonality.
+type AnomalyQuery struct {
+	MetricsView string           `mapstructure:"metrics_view"`
+	Measure     string           `mapstructure:"measure"`
+	Dimensions  []Dimension      `mapstructure:"dimensions"`
+	TimeGrain   TimeGrain        `mapstructure:"time_grain"`
+	TimeRange   *TimeRange       `mapstructure:"time_range"`
+	Where       *Expression      `mapstructure:"where"`
+	TimeZone    string           `mapstructure:"time_zone"`
+	Method      AnomalyMethod    `mapstructure:"method"`
+	Window      int              `mapstructure:"window"`
+	Threshold   float64          `mapstructure:"threshold"`
+	Direction   AnomalyDirection `mapstructure:"direction"`
+	Limit       *int64           `mapstructure:"limit"`
+}
+
+// AnomalyMethod is the method used to compute the baseline of an anomaly query.
+type AnomalyMethod string
+
+const (
+	AnomalyMethodUnspecified AnomalyMethod = ""
+	AnomalyMethodRolling     AnomalyMethod = "rolling"
+	AnomalyMethodSeasonal    AnomalyMethod = "seasonal"
+)
+
+// AnomalyDirection restricts the anomalies returned by an anomaly query to points above or below the baseline band.
+type AnomalyDirection string
+
+const (
+	AnomalyDirectionUnspecified AnomalyDirection = ""
+	AnomalyDirectionBoth        AnomalyDirection = "both"
+	AnomalyDirectionUp          AnomalyDirection = "up"
+	AnomalyDirectionDown        AnomalyDirection = "down"
+)
+
+// Names of the fields returned by an anomaly query (in addition to the query's dimensions, the time dimension and the measure).
+const (
+	AnomalyFieldBaseline   = "baseline"
+	AnomalyFieldLowerBound = "lower_bound"
+	AnomalyFieldUpperBound = "upper_bound"
+	AnomalyFieldScore      = "score"
+)
+
+var anomalyFields = []string{AnomalyFieldBaseline, AnomalyFieldLowerBound, AnomalyFieldUpperBound, AnomalyFieldScore}
+
+const (
+	anomalyDefaultRollingWindow  = 28
+	anomalyDefaultSeasonalWindow = 4
+	anomalyDefaultThreshold      = 3
+	// anomalyMinBaselinePoints is the minimum number of points required to compute a baseline. Points with less history are not checked.
+	anomalyMinBaselinePoints = 3
+)
+
+// Validate checks the anomaly query for structural errors and applies defaults.
+// It does not validate the query against the metrics view.
+func (q *AnomalyQuery) Validate() error {
+	if q.MetricsView == "" {
+		return errors.New(`"metrics_view" must be specified`)
+	}
+	if q.Measure == "" {
+		return errors.New(`"measure" must be specified`)
+	}
+	if q.TimeGrain == TimeGrainUnspecified {
+		return errors.New(`"time_grain" must be specified`)
+	}
+	if !q.TimeGrain.Valid() {
+		return fmt.Errorf("invalid time grain %q", q.TimeGrain)
+	}
+
+	switch q.Method {
+	case AnomalyMethodUnspecified:
+		q.Method = AnomalyMethodRolling
+	case AnomalyMethodRolling:
+	case AnomalyMethodSeasonal:
+		switch q.TimeGrain {
+		case TimeGrainWeek, TimeGrainMonth, TimeGrainQuarter, TimeGrainYear:
+			return fmt.Errorf(`the "seasonal" method requires a time grain of a day or less, got %q`, q.TimeGrain)
+		}
+	default:
+		return fmt.Errorf(`invalid method %q: must be "rolling" or "seasonal"`, q.Method)
+	}
+
+	if q.Window == 0 {
+		if q.Method == AnomalyMethodSeasonal {
+			q.Window = anomalyDefaultSeasonalWindow
+		} else {
+			q.Window = anomalyDefaultRollingWindow
+		}
+	}
+	if q.Window < anomalyMinBaselinePoints {
+		return fmt.Errorf(`"window" must be at least %d`, anomalyMinBaselinePoints)
+	}
+
+	if q.Threshold == 0 {
+		q.Threshold = anomalyDefaultThreshold
+	}
+	if q.Threshold < 0 {
+		return errors.New(`"threshold" must be positive`)
+	}
+
+	switch q.Direction {
+	case AnomalyDirectionUnspecified:
+		q.Direction = AnomalyDirectionBoth
+	case AnomalyDirectionBoth, AnomalyDirectionUp, AnomalyDirectionDown:
+	default:
+		return fmt.Errorf(`invalid direction %q: must be "both", "up" or "down"`, q.Direction)
+	}
+
+	if q.Limit != nil && *q.Limit < 0 {
+		return errors.New(`"limit" must be positive`)
+	}
+
+	for _, d := range q.Dimensions {
+		if d.Name == q.Measure {
+			return fmt.Errorf("dimension name %q collides with the measure", d.Name)
+		}
+		for _, f := range anomalyFields {
+			if d.Name == f {
+				return fmt.Errorf("dimension name %q is reserved for the anomaly output", d.Name)
+			}
+		}
+	}
+	for _, f := range anomalyFields {
+		if q.Measure == f {
+			return fmt.Errorf("measure name %q is reserved for the anomaly output", q.Measure)
+		}
+	}
+
+	return nil
+}
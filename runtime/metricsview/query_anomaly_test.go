@@ -0,0 +1,159 @@
+package metricsview
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnomalyQueryValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		q       *AnomalyQuery
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			q:    &AnomalyQuery{MetricsView: "mv", Measure: "m", TimeGrain: TimeGrainDay},
+		},
+		{
+			name:    "missing measure",
+			q:       &AnomalyQuery{MetricsView: "mv", TimeGrain: TimeGrainDay},
+			wantErr: true,
+		},
+		{
+			name:    "missing time grain",
+			q:       &AnomalyQuery{MetricsView: "mv", Measure: "m"},
+			wantErr: true,
+		},
+		{
+			name:    "seasonal with coarse grain",
+			q:       &AnomalyQuery{MetricsView: "mv", Measure: "m", TimeGrain: TimeGrainWeek, Method: AnomalyMethodSeasonal},
+			wantErr: true,
+		},
+		{
+			name:    "short window",
+			q:       &AnomalyQuery{MetricsView: "mv", Measure: "m", TimeGrain: TimeGrainDay, Window: 2},
+			wantErr: true,
+		},
+		{
+			name:    "invalid direction",
+			q:       &AnomalyQuery{MetricsView: "mv", Measure: "m", TimeGrain: TimeGrainDay, Direction: "sideways"},
+			wantErr: true,
+		},
+		{
+			name:    "reserved dimension name",
+			q:       &AnomalyQuery{MetricsView: "mv", Measure: "m", TimeGrain: TimeGrainDay, Dimensions: []Dimension{{Name: "score"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.q.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// Defaults depend on the method
+	q := &AnomalyQuery{MetricsView: "mv", Measure: "m", TimeGrain: TimeGrainHour, Method: AnomalyMethodSeasonal}
+	require.NoError(t, q.Validate())
+	require.Equal(t, anomalyDefaultSeasonalWindow, q.Window)
+	require.Equal(t, float64(anomalyDefaultThreshold), q.Threshold)
+	require.Equal(t, AnomalyDirectionBoth, q.Direction)
+}
+
+func TestDetectAnomaliesRolling(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := func(i int) time.Time { return start.AddDate(0, 0, i) }
+
+	s := &anomalySeries{points: map[int64]float64{}}
+	for i, v := range []float64{10, 12, 11, 9, 10, 11, 30, 10, 11, 10, 12, 11, 0} {
+		s.points[day(i).UnixNano()] = v
+	}
+
+	q := &AnomalyQuery{MetricsView: "mv", Measure: "m", TimeGrain: TimeGrainDay, Window: 5}
+	require.NoError(t, q.Validate())
+
+	// Only points in the range are checked, using the preceding points (including other checked points) as the baseline.
+	// The days after the spike on day 6 have a baseline that includes the spike, so their bands are wide.
+	as := detectAnomalies(q, s, day(5), day(13), time.UTC)
+	require.Len(t, as, 2)
+	require.Equal(t, day(6), as[0].time)
+	require.Equal(t, 30.0, as[0].value)
+	require.InDelta(t, 10.6, as[0].baseline, 1e-9)
+	require.Greater(t, as[0].score, 3.0)
+	require.Equal(t, day(12), as[1].time)
+	require.Less(t, as[1].score, -3.0)
+
+	// Only spikes
+	q.Direction = AnomalyDirectionUp
+	as = detectAnomalies(q, s, day(5), day(13), time.UTC)
+	require.Len(t, as, 1)
+	require.Equal(t, day(6), as[0].time)
+
+	// Points without enough history are skipped
+	as = detectAnomalies(q, s, day(0), day(3), time.UTC)
+	require.Empty(t, as)
+}
+
+func TestDetectAnomaliesSeasonal(t *testing.T) {
+	// Mondays have ten times the traffic of the other days
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) // A Monday
+	s := &anomalySeries{points: map[int64]float64{}}
+	for i := 0; i < 35; i++ {
+		t := start.AddDate(0, 0, i)
+		v := 10.0 + float64(i%3)
+		if t.Weekday() == time.Monday {
+			v = 100 + float64(i%3)
+		}
+		s.points[t.UnixNano()] = v
+	}
+	// A quiet Monday and a busy Tuesday in the last week
+	lastMonday := start.AddDate(0, 0, 28)
+	s.points[lastMonday.UnixNano()] = 11
+	s.points[lastMonday.AddDate(0, 0, 1).UnixNano()] = 101
+
+	q := &AnomalyQuery{MetricsView: "mv", Measure: "m", TimeGrain: TimeGrainDay, Method: AnomalyMethodSeasonal}
+	require.NoError(t, q.Validate())
+	as := detectAnomalies(q, s, lastMonday, lastMonday.AddDate(0, 0, 7), time.UTC)
+	require.Len(t, as, 2)
+	for _, a := range as {
+		require.True(t, a.value < a.lower || a.value > a.upper)
+	}
+
+	sortAnomalies(as)
+	require.Equal(t, lastMonday.AddDate(0, 0, 1), as[0].time)
+	require.Equal(t, lastMonday, as[1].time)
+}
+
+func TestDetectAnomaliesConstantBaseline(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := &anomalySeries{points: map[int64]float64{}}
+	for i, v := range []float64{5, 5, 5, 5, 6} {
+		s.points[start.Add(time.Duration(i)*time.Hour).UnixNano()] = v
+	}
+
+	q := &AnomalyQuery{MetricsView: "mv", Measure: "m", TimeGrain: TimeGrainHour}
+	require.NoError(t, q.Validate())
+	as := detectAnomalies(q, s, start.Add(3*time.Hour), start.Add(5*time.Hour), time.UTC)
+	require.Len(t, as, 1)
+	require.Equal(t, 6.0, as[0].value)
+	require.True(t, math.IsInf(as[0].score, 1))
+}
+
+func TestAddTimeGrain(t *testing.T) {
+	tz, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// Days are calendar days in the time zone, so they are 23 hours long across the DST change
+	t1 := time.Date(2024, 3, 10, 0, 0, 0, 0, tz)
+	require.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, tz), addTimeGrain(t1, TimeGrainDay, 1, tz))
+	require.Equal(t, time.Date(2024, 1, 30, 0, 0, 0, 0, tz), addTimeGrain(time.Date(2024, 4, 30, 0, 0, 0, 0, tz), TimeGrainQuarter, -1, tz))
+	require.Equal(t, t1.Add(-3*time.Hour), addTimeGrain(t1, TimeGrainHour, -3, tz))
+}
@@ -0,0 +1,103 @@
+package metricsview
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/duration"
+)
+
+// FunnelQuery represents an ordered multi-step funnel analysis against a metrics view.
+// It counts the number of distinct entities (identified by the values of the Entity dimension) that completed each step in order.
+//
+// An entity completes the first step at the first time it has an event that matches the first step's filter.
+// It completes each subsequent step at the first time it has a matching event at or after the time it completed the previous step.
+// If a conversion window is set, all steps must be completed within that duration of completing the first step.
+type FunnelQuery struct {
+	MetricsView      string       `mapstructure:"metrics_view"`
+	Entity           string       `mapstructure:"entity"`
+	Steps            []FunnelStep `mapstructure:"steps"`
+	Dimensions       []Dimension  `mapstructure:"dimensions"`
+	ConversionWindow string       `mapstructure:"conversion_window"`
+	TimeRange        *TimeRange   `mapstructure:"time_range"`
+	Where            *Expression  `mapstructure:"where"`
+	TimeZone         string       `mapstructure:"time_zone"`
+}
+
+// FunnelStep represents a step in a funnel query.
+// The step's filter can reference any dimension in the metrics view.
+type FunnelStep struct {
+	Name  string      `mapstructure:"name"`
+	Where *Expression `mapstructure:"where"`
+}
+
+// Names of the fields returned by a funnel query (in addition to the query's breakdown dimensions).
+const (
+	FunnelFieldStep                  = "step"
+	FunnelFieldStepName              = "step_name"
+	FunnelFieldCount                 = "count"
+	FunnelFieldConversionRate        = "conversion_rate"
+	FunnelFieldDropOff               = "drop_off"
+	FunnelFieldOverallConversionRate = "overall_conversion_rate"
+)
+
+var funnelFields = []string{FunnelFieldStep, FunnelFieldStepName, FunnelFieldCount, FunnelFieldConversionRate, FunnelFieldDropOff, FunnelFieldOverallConversionRate}
+
+// Validate checks the funnel query for structural errors.
+// It does not validate the query against the metrics view.
+func (q *FunnelQuery) Validate() error {
+	if q.MetricsView == "" {
+		return errors.New(`"metrics_view" must be specified`)
+	}
+	if q.Entity == "" {
+		return errors.New(`"entity" must be specified`)
+	}
+	if len(q.Steps) < 2 {
+		return errors.New("a funnel must have at least two steps")
+	}
+
+	names := make(map[string]bool, len(q.Steps))
+	for i, s := range q.Steps {
+		if s.Name == "" {
+			return fmt.Errorf("step %d: must specify a name", i+1)
+		}
+		if names[s.Name] {
+			return fmt.Errorf("step %d: duplicate step name %q", i+1, s.Name)
+		}
+		names[s.Name] = true
+		if s.Where == nil {
+			return fmt.Errorf("step %q: must specify a filter", s.Name)
+		}
+	}
+
+	for _, d := range q.Dimensions {
+		for _, f := range funnelFields {
+			if d.Name == f {
+				return fmt.Errorf("dimension name %q is reserved for the funnel output", d.Name)
+			}
+		}
+	}
+
+	_, err := q.conversionWindow()
+	return err
+}
+
+// conversionWindow parses the query's conversion window. It returns 0 if no conversion window is set.
+func (q *FunnelQuery) conversionWindow() (time.Duration, error) {
+	if q.ConversionWindow == "" {
+		return 0, nil
+	}
+
+	d, err := duration.ParseISO8601(q.ConversionWindow)
+	if err != nil {
+		return 0, fmt.Errorf("invalid conversion_window %q: %w", q.ConversionWindow, err)
+	}
+
+	w, ok := d.EstimateNative()
+	if !ok || w <= 0 {
+		return 0, fmt.Errorf("invalid conversion_window %q: must be a fixed, positive duration", q.ConversionWindow)
+	}
+
+	return w, nil
+}
@@ -0,0 +1,59 @@
+package metricsview
+
+import "testing"
+
+func TestFunnelQueryValidate(t *testing.T) {
+	step := func(name string) FunnelStep {
+		return FunnelStep{Name: name, Where: &Expression{Name: "foo"}}
+	}
+
+	tests := []struct {
+		name    string
+		q       *FunnelQuery
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			q:    &FunnelQuery{MetricsView: "mv", Entity: "user", Steps: []FunnelStep{step("a"), step("b")}, ConversionWindow: "P7D"},
+		},
+		{
+			name:    "missing entity",
+			q:       &FunnelQuery{MetricsView: "mv", Steps: []FunnelStep{step("a"), step("b")}},
+			wantErr: true,
+		},
+		{
+			name:    "single step",
+			q:       &FunnelQuery{MetricsView: "mv", Entity: "user", Steps: []FunnelStep{step("a")}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate step names",
+			q:       &FunnelQuery{MetricsView: "mv", Entity: "user", Steps: []FunnelStep{step("a"), step("a")}},
+			wantErr: true,
+		},
+		{
+			name:    "step without filter",
+			q:       &FunnelQuery{MetricsView: "mv", Entity: "user", Steps: []FunnelStep{step("a"), {Name: "b"}}},
+			wantErr: true,
+		},
+		{
+			name:    "reserved dimension name",
+			q:       &FunnelQuery{MetricsView: "mv", Entity: "user", Steps: []FunnelStep{step("a"), step("b")}, Dimensions: []Dimension{{Name: "count"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid conversion window",
+			q:       &FunnelQuery{MetricsView: "mv", Entity: "user", Steps: []FunnelStep{step("a"), step("b")}, ConversionWindow: "7 days"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.q.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
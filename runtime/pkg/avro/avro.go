@@ -0,0 +1,386 @@
+// Package avro implements decoding of data encoded with Apache Avro.
+// It decodes values to the types produced by encoding/json (maps, slices, strings, etc.), which makes it easy to serialize the values to JSON.
+// Encoding is not supported.
+package avro
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Schema is a parsed Avro schema.
+// It implements decoding of the Avro binary encoding into values that can be serialized to JSON.
+// See: https://avro.apache.org/docs/1.11.1/specification/
+type Schema struct {
+	typ         string
+	logicalType string
+	name        string
+	fields      []*field
+	symbols     []string
+	items       *Schema
+	values      *Schema
+	branches    []*Schema
+	size        int
+}
+
+type field struct {
+	name   string
+	schema *Schema
+}
+
+// ParseSchema parses an Avro schema in JSON format.
+func ParseSchema(schema string) (*Schema, error) {
+	var v any
+	if err := json.Unmarshal([]byte(schema), &v); err != nil {
+		return nil, fmt.Errorf("invalid avro schema: %w", err)
+	}
+	p := &schemaParser{named: make(map[string]*Schema)}
+	return p.parse(v, "")
+}
+
+type schemaParser struct {
+	named map[string]*Schema
+}
+
+func (p *schemaParser) parse(v any, namespace string) (*Schema, error) {
+	switch v := v.(type) {
+	case string:
+		return p.parseName(v, namespace)
+	case []any:
+		s := &Schema{typ: "union"}
+		for _, b := range v {
+			bs, err := p.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.branches = append(s.branches, bs)
+		}
+		return s, nil
+	case map[string]any:
+		return p.parseComplex(v, namespace)
+	default:
+		return nil, fmt.Errorf("invalid avro schema: unexpected %T", v)
+	}
+}
+
+func (p *schemaParser) parseName(name, namespace string) (*Schema, error) {
+	switch name {
+	case "null", "boolean", "int", "long", "float", "double", "bytes", "string":
+		return &Schema{typ: name}, nil
+	}
+	if s, ok := p.named[fullName(name, namespace)]; ok {
+		return s, nil
+	}
+	if s, ok := p.named[name]; ok {
+		return s, nil
+	}
+	return nil, fmt.Errorf("invalid avro schema: unknown type %q", name)
+}
+
+func (p *schemaParser) parseComplex(v map[string]any, namespace string) (*Schema, error) {
+	typ, ok := v["type"]
+	if !ok {
+		return nil, errors.New(`invalid avro schema: missing "type"`)
+	}
+	typName, ok := typ.(string)
+	if !ok {
+		// The type is itself a schema, e.g. {"type": {"type": "array", ...}}
+		return p.parse(typ, namespace)
+	}
+	logicalType, _ := v["logicalType"].(string)
+
+	s := &Schema{typ: typName, logicalType: logicalType}
+	switch typName {
+	case "record", "error", "enum", "fixed":
+		// Handled below
+	case "array":
+		items, err := p.parse(v["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.items = items
+		return s, nil
+	case "map":
+		values, err := p.parse(v["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.values = values
+		return s, nil
+	default:
+		// A primitive or a reference to a named type, optionally annotated with a logical type
+		ref, err := p.parseName(typName, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if logicalType == "" {
+			return ref, nil
+		}
+		clone := *ref
+		clone.logicalType = logicalType
+		return &clone, nil
+	}
+
+	// Named types
+	name, _ := v["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("invalid avro schema: %s must have a name", typName)
+	}
+	if ns, ok := v["namespace"].(string); ok {
+		namespace = ns
+	}
+	s.name = fullName(name, namespace)
+	if idx := strings.LastIndex(s.name, "."); idx >= 0 {
+		namespace = s.name[:idx]
+	}
+	p.named[s.name] = s
+
+	switch typName {
+	case "enum":
+		symbols, _ := v["symbols"].([]any)
+		for _, sym := range symbols {
+			str, ok := sym.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid avro schema: enum %q has an invalid symbol", s.name)
+			}
+			s.symbols = append(s.symbols, str)
+		}
+	case "fixed":
+		size, ok := v["size"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("invalid avro schema: fixed %q must have a size", s.name)
+		}
+		s.size = int(size)
+	default: // record or error
+		s.typ = "record"
+		fields, _ := v["fields"].([]any)
+		for _, f := range fields {
+			fm, ok := f.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid avro schema: record %q has an invalid field", s.name)
+			}
+			fname, _ := fm["name"].(string)
+			if fname == "" {
+				return nil, fmt.Errorf("invalid avro schema: record %q has a field without a name", s.name)
+			}
+			fs, err := p.parse(fm["type"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.fields = append(s.fields, &field{name: fname, schema: fs})
+		}
+	}
+
+	return s, nil
+}
+
+func fullName(name, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// Decode decodes a value encoded with the Avro binary encoding.
+// It returns an error if the data is not fully consumed.
+func (s *Schema) Decode(data []byte) (any, error) {
+	d := &decoder{data: data}
+	v, err := d.decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if d.pos != len(d.data) {
+		return nil, fmt.Errorf("avro: %d trailing bytes after decoding value", len(d.data)-d.pos)
+	}
+	return v, nil
+}
+
+type decoder struct {
+	data []byte
+	pos  int
+}
+
+var errShortBuffer = errors.New("avro: unexpected end of data")
+
+func (d *decoder) decode(s *Schema) (any, error) {
+	switch s.typ {
+	case "null":
+		return nil, nil
+	case "boolean":
+		if d.pos >= len(d.data) {
+			return nil, errShortBuffer
+		}
+		b := d.data[d.pos] != 0
+		d.pos++
+		return b, nil
+	case "int", "long":
+		n, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		return logicalLong(s.logicalType, n), nil
+	case "float":
+		if d.pos+4 > len(d.data) {
+			return nil, errShortBuffer
+		}
+		f := math.Float32frombits(binary.LittleEndian.Uint32(d.data[d.pos:]))
+		d.pos += 4
+		return float64(f), nil
+	case "double":
+		if d.pos+8 > len(d.data) {
+			return nil, errShortBuffer
+		}
+		f := math.Float64frombits(binary.LittleEndian.Uint64(d.data[d.pos:]))
+		d.pos += 8
+		return f, nil
+	case "bytes":
+		b, err := d.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	case "string":
+		b, err := d.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case "fixed":
+		if d.pos+s.size > len(d.data) {
+			return nil, errShortBuffer
+		}
+		b := d.data[d.pos : d.pos+s.size]
+		d.pos += s.size
+		return b, nil
+	case "enum":
+		n, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 || int(n) >= len(s.symbols) {
+			return nil, fmt.Errorf("avro: invalid index %d for enum %q", n, s.name)
+		}
+		return s.symbols[n], nil
+	case "union":
+		n, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 || int(n) >= len(s.branches) {
+			return nil, fmt.Errorf("avro: invalid union branch %d", n)
+		}
+		return d.decode(s.branches[n])
+	case "array":
+		var res []any
+		err := d.readBlocks(func() error {
+			v, err := d.decode(s.items)
+			if err != nil {
+				return err
+			}
+			res = append(res, v)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	case "map":
+		res := make(map[string]any)
+		err := d.readBlocks(func() error {
+			k, err := d.readBytes()
+			if err != nil {
+				return err
+			}
+			v, err := d.decode(s.values)
+			if err != nil {
+				return err
+			}
+			res[string(k)] = v
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	case "record":
+		res := make(map[string]any, len(s.fields))
+		for _, f := range s.fields {
+			v, err := d.decode(f.schema)
+			if err != nil {
+				return nil, err
+			}
+			res[f.name] = v
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("avro: unsupported type %q", s.typ)
+	}
+}
+
+// readLong reads a zig-zag encoded variable-length integer.
+func (d *decoder) readLong() (int64, error) {
+	u, n := binary.Uvarint(d.data[d.pos:])
+	if n <= 0 {
+		return 0, errShortBuffer
+	}
+	d.pos += n
+	return int64(u>>1) ^ -int64(u&1), nil
+}
+
+func (d *decoder) readBytes() ([]byte, error) {
+	n, err := d.readLong()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || d.pos+int(n) > len(d.data) {
+		return nil, errShortBuffer
+	}
+	b := d.data[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return b, nil
+}
+
+// readBlocks reads the blocks of an array or map, calling fn for each item.
+func (d *decoder) readBlocks(fn func() error) error {
+	for {
+		n, err := d.readLong()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+		if n < 0 {
+			// A negative count is followed by the block's size in bytes, which we don't need
+			n = -n
+			if _, err := d.readLong(); err != nil {
+				return err
+			}
+		}
+		for i := int64(0); i < n; i++ {
+			if err := fn(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// logicalLong converts an int or long with a time-related logical type to a time.
+// Other values are returned as-is.
+func logicalLong(logicalType string, n int64) any {
+	switch logicalType {
+	case "date":
+		return time.Unix(n*86400, 0).UTC().Format(time.DateOnly)
+	case "timestamp-millis":
+		return time.UnixMilli(n).UTC()
+	case "timestamp-micros":
+		return time.UnixMicro(n).UTC()
+	default:
+		return n
+	}
+}
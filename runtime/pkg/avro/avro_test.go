@@ -0,0 +1,104 @@
+package avro
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	schema, err := ParseSchema(`{
+		"type": "record",
+		"name": "Event",
+		"namespace": "com.example",
+		"fields": [
+			{"name": "id", "type": "long"},
+			{"name": "name", "type": "string"},
+			{"name": "score", "type": "double"},
+			{"name": "ratio", "type": "float"},
+			{"name": "active", "type": "boolean"},
+			{"name": "comment", "type": ["null", "string"]},
+			{"name": "tags", "type": {"type": "array", "items": "string"}},
+			{"name": "attrs", "type": {"type": "map", "values": "int"}},
+			{"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["A", "B"]}},
+			{"name": "ts", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+			{"name": "day", "type": {"type": "int", "logicalType": "date"}},
+			{"name": "hash", "type": {"type": "fixed", "name": "Hash", "size": 2}},
+			{"name": "parent", "type": ["null", {"type": "record", "name": "Parent", "fields": [{"name": "kind", "type": "Kind"}]}]}
+		]
+	}`)
+	require.NoError(t, err)
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var b []byte
+	b = avroLong(b, -42)                                            // id
+	b = avroString(b, "hello")                                      // name
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(1.5))  // score
+	b = binary.LittleEndian.AppendUint32(b, math.Float32bits(0.25)) // ratio
+	b = append(b, 1)                                                // active
+	b = avroLong(b, 1)                                              // comment: union branch "string"
+	b = avroString(b, "note")                                       // comment
+	b = avroLong(b, 2)                                              // tags: block of 2 items
+	b = avroString(b, "x")                                          // tags[0]
+	b = avroString(b, "y")                                          // tags[1]
+	b = avroLong(b, 0)                                              // tags: end of blocks
+	b = avroLong(b, -1)                                             // attrs: block of 1 item with size
+	b = avroLong(b, 3)                                              // attrs: block size in bytes
+	b = avroString(b, "k")                                          // attrs key
+	b = avroLong(b, 7)                                              // attrs value
+	b = avroLong(b, 0)                                              // attrs: end of blocks
+	b = avroLong(b, 1)                                              // kind
+	b = avroLong(b, ts.UnixMilli())                                 // ts
+	b = avroLong(b, 19724)                                          // day
+	b = append(b, 0xAB, 0xCD)                                       // hash
+	b = avroLong(b, 1)                                              // parent: union branch "Parent"
+	b = avroLong(b, 0)                                              // parent.kind
+
+	v, err := schema.Decode(b)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"id":      int64(-42),
+		"name":    "hello",
+		"score":   1.5,
+		"ratio":   0.25,
+		"active":  true,
+		"comment": "note",
+		"tags":    []any{"x", "y"},
+		"attrs":   map[string]any{"k": int64(7)},
+		"kind":    "B",
+		"ts":      ts,
+		"day":     "2024-01-02",
+		"hash":    []byte{0xAB, 0xCD},
+		"parent":  map[string]any{"kind": "A"},
+	}, v)
+
+	// Truncated and oversized data
+	_, err = schema.Decode(b[:len(b)-1])
+	require.Error(t, err)
+	_, err = schema.Decode(append(b, 0))
+	require.Error(t, err)
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	_, err := ParseSchema(`{"type": "record", "fields": []}`)
+	require.ErrorContains(t, err, "must have a name")
+
+	_, err = ParseSchema(`{"type": "record", "name": "R", "fields": [{"name": "a", "type": "Unknown"}]}`)
+	require.ErrorContains(t, err, "unknown type")
+
+	_, err = ParseSchema(`not json`)
+	require.Error(t, err)
+}
+
+func avroLong(b []byte, n int64) []byte {
+	return binary.AppendUvarint(b, uint64((n<<1)^(n>>63)))
+}
+
+func avroString(b []byte, s string) []byte {
+	b = avroLong(b, int64(len(s)))
+	return append(b, s...)
+}
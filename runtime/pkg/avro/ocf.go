@@ -0,0 +1,127 @@
+package avro
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// ocfMagic is the magic bytes at the start of an Avro object container file.
+var ocfMagic = []byte{'O', 'b', 'j', 1}
+
+const ocfSyncSize = 16
+
+// DecodeOCF decodes all values in an Avro object container file.
+// The values are decoded using the writer schema embedded in the file.
+// See: https://avro.apache.org/docs/1.11.1/specification/#object-container-files
+func DecodeOCF(data []byte) ([]any, error) {
+	if !bytes.HasPrefix(data, ocfMagic) {
+		return nil, errors.New("avro: not an object container file")
+	}
+	d := &decoder{data: data, pos: len(ocfMagic)}
+
+	// Read the file metadata
+	meta := make(map[string][]byte)
+	err := d.readBlocks(func() error {
+		k, err := d.readBytes()
+		if err != nil {
+			return err
+		}
+		v, err := d.readBytes()
+		if err != nil {
+			return err
+		}
+		meta[string(k)] = v
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("avro: invalid file metadata: %w", err)
+	}
+
+	schema, err := ParseSchema(string(meta["avro.schema"]))
+	if err != nil {
+		return nil, err
+	}
+	codec := string(meta["avro.codec"])
+
+	if d.pos+ocfSyncSize > len(d.data) {
+		return nil, errShortBuffer
+	}
+	sync := d.data[d.pos : d.pos+ocfSyncSize]
+	d.pos += ocfSyncSize
+
+	// Read the data blocks
+	var res []any
+	for d.pos < len(d.data) {
+		count, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		block, err := d.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		if d.pos+ocfSyncSize > len(d.data) {
+			return nil, errShortBuffer
+		}
+		if !bytes.Equal(d.data[d.pos:d.pos+ocfSyncSize], sync) {
+			return nil, errors.New("avro: invalid sync marker")
+		}
+		d.pos += ocfSyncSize
+
+		block, err = decompressBlock(codec, block)
+		if err != nil {
+			return nil, err
+		}
+
+		bd := &decoder{data: block}
+		for i := int64(0); i < count; i++ {
+			v, err := bd.decode(schema)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, v)
+		}
+	}
+
+	return res, nil
+}
+
+// decompressBlock decompresses a data block in an object container file.
+func decompressBlock(codec string, block []byte) ([]byte, error) {
+	switch codec {
+	case "", "null":
+		return block, nil
+	case "deflate":
+		return io.ReadAll(flate.NewReader(bytes.NewReader(block)))
+	case "snappy":
+		// The compressed data is followed by the CRC32 checksum of the uncompressed data
+		if len(block) < 4 {
+			return nil, errShortBuffer
+		}
+		res, err := snappy.Decode(nil, block[:len(block)-4])
+		if err != nil {
+			return nil, err
+		}
+		if crc32.ChecksumIEEE(res) != binary.BigEndian.Uint32(block[len(block)-4:]) {
+			return nil, errors.New("avro: invalid snappy checksum")
+		}
+		return res, nil
+	case "zstandard":
+		dec, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer dec.Close()
+		return dec.DecodeAll(block, nil)
+	default:
+		return nil, fmt.Errorf("avro: unsupported codec %q", codec)
+	}
+}
@@ -0,0 +1,73 @@
+package avro
+
+import (
+	"bytes"
+	"compress/flate"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeOCF(t *testing.T) {
+	schema := `{"type": "record", "name": "R", "fields": [{"name": "id", "type": "long"}, {"name": "name", "type": "string"}]}`
+	sync := []byte("0123456789abcdef")
+
+	// Write a file with two blocks, the first with two records and the second with one record
+	for _, codec := range []string{"null", "deflate"} {
+		t.Run(codec, func(t *testing.T) {
+			var b []byte
+			b = append(b, ocfMagic...)
+			b = avroLong(b, 2)
+			b = avroString(b, "avro.schema")
+			b = avroString(b, schema)
+			b = avroString(b, "avro.codec")
+			b = avroString(b, codec)
+			b = avroLong(b, 0)
+			b = append(b, sync...)
+
+			var block []byte
+			block = avroLong(block, 1)
+			block = avroString(block, "a")
+			block = avroLong(block, 2)
+			block = avroString(block, "b")
+			b = appendBlock(t, b, codec, 2, block, sync)
+
+			block = nil
+			block = avroLong(block, 3)
+			block = avroString(block, "c")
+			b = appendBlock(t, b, codec, 1, block, sync)
+
+			vals, err := DecodeOCF(b)
+			require.NoError(t, err)
+			require.Equal(t, []any{
+				map[string]any{"id": int64(1), "name": "a"},
+				map[string]any{"id": int64(2), "name": "b"},
+				map[string]any{"id": int64(3), "name": "c"},
+			}, vals)
+
+			// Corrupt the last sync marker
+			b[len(b)-1] = 'x'
+			_, err = DecodeOCF(b)
+			require.ErrorContains(t, err, "sync marker")
+		})
+	}
+
+	_, err := DecodeOCF([]byte("not avro"))
+	require.Error(t, err)
+}
+
+func appendBlock(t *testing.T, b []byte, codec string, count int64, block, sync []byte) []byte {
+	if codec == "deflate" {
+		var buf bytes.Buffer
+		w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+		require.NoError(t, err)
+		_, err = w.Write(block)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		block = buf.Bytes()
+	}
+	b = avroLong(b, count)
+	b = avroLong(b, int64(len(block)))
+	b = append(b, block...)
+	return append(b, sync...)
+}
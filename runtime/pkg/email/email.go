@@ -35,6 +35,8 @@ type ScheduledReport struct {
 	OpenLink       string
 	DownloadLink   string
 	EditLink       string
+	Attachment     *drivers.ReportAttachment
+	Preview        *drivers.ReportPreview
 }
 
 type scheduledReportData struct {
@@ -44,6 +46,8 @@ type scheduledReportData struct {
 	OpenLink         template.URL
 	DownloadLink     template.URL
 	EditLink         template.URL
+	HasAttachment    bool
+	Preview          *drivers.ReportPreview
 }
 
 func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
@@ -55,6 +59,8 @@ func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
 		OpenLink:         template.URL(opts.OpenLink),
 		DownloadLink:     template.URL(opts.DownloadLink),
 		EditLink:         template.URL(opts.EditLink),
+		HasAttachment:    opts.Attachment != nil,
+		Preview:          opts.Preview,
 	}
 
 	// Build subject
@@ -68,6 +74,14 @@ func (c *Client) SendScheduledReport(opts *ScheduledReport) error {
 	}
 	html := buf.String()
 
+	if opts.Attachment != nil {
+		return c.Sender.SendWithAttachments(opts.ToEmail, opts.ToName, subject, html, []*Attachment{{
+			Filename:    opts.Attachment.Filename,
+			ContentType: opts.Attachment.ContentType,
+			Data:        opts.Attachment.Data,
+		}})
+	}
+
 	return c.Sender.Send(opts.ToEmail, opts.ToName, subject, html)
 }
 
@@ -1,7 +1,14 @@
 package email
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,13 +25,19 @@ type mockSender struct {
 	toName    string
 	subject   string
 	body      string
+	attached  []*Attachment
 }
 
 func (m *mockSender) Send(toEmail, toName, subject, body string) error {
+	return m.SendWithAttachments(toEmail, toName, subject, body, nil)
+}
+
+func (m *mockSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
 	m.toEmail = toEmail
 	m.toName = toName
 	m.subject = subject
 	m.body = body
+	m.attached = attachments
 	return nil
 }
 
@@ -128,3 +141,97 @@ func TestAlertError(t *testing.T) {
 	require.Contains(t, mock.body, opts.ExecutionTime.Format(time.RFC1123))
 	require.Contains(t, mock.body, "hello error")
 }
+
+func TestScheduledReport(t *testing.T) {
+	mock := &mockSender{}
+	client := New(mock)
+
+	opts := &ScheduledReport{
+		ToEmail:        uuid.New().String(),
+		Title:          "Weekly <revenue>",
+		ReportTime:     time.Date(2024, 01, 27, 0, 0, 0, 0, time.UTC),
+		DownloadFormat: "CSV",
+		OpenLink:       "https://example.com/open",
+		DownloadLink:   "https://example.com/download",
+		EditLink:       "https://example.com/edit",
+	}
+	err := client.SendScheduledReport(opts)
+	require.NoError(t, err)
+	require.Contains(t, mock.body, "Weekly &lt;revenue&gt;")
+	require.NotContains(t, mock.body, "attached")
+	require.NotContains(t, mock.body, "<th")
+	require.Nil(t, mock.attached)
+
+	opts.Attachment = &drivers.ReportAttachment{Filename: "weekly.csv", ContentType: "text/csv", Data: []byte("a,b\n1,2\n")}
+	opts.Preview = &drivers.ReportPreview{
+		Columns:   []string{"Country", "Revenue"},
+		Rows:      [][]string{{"DK", "$1.2k"}, {"<script>", "$3"}},
+		Truncated: true,
+	}
+	err = client.SendScheduledReport(opts)
+	require.NoError(t, err)
+	require.Contains(t, mock.body, "The CSV file is attached to this email.")
+	require.Contains(t, mock.body, "<th style=\"padding:4px 8px;\">Revenue</th>")
+	require.Contains(t, mock.body, "<td style=\"padding:4px 8px;\">$1.2k</td>")
+	require.Contains(t, mock.body, "&lt;script&gt;")
+	require.Contains(t, mock.body, "Showing the first 2 rows.")
+	require.Len(t, mock.attached, 1)
+	require.Equal(t, "weekly.csv", mock.attached[0].Filename)
+	require.Equal(t, opts.Attachment.Data, mock.attached[0].Data)
+	require.Contains(t, mock.body, "https://example.com/download")
+
+	// The download button is left out without a download link
+	opts.DownloadLink = ""
+	err = client.SendScheduledReport(opts)
+	require.NoError(t, err)
+	require.NotContains(t, mock.body, "Download CSV file")
+	require.Contains(t, mock.body, "https://example.com/open")
+}
+
+func TestComposeMessage(t *testing.T) {
+	from := mail.Address{Name: "Rill", Address: "noreply@example.com"}
+	to := mail.Address{Address: "user@example.com"}
+
+	// Without attachments, the message is a plain HTML message
+	msg, err := composeMessage(from, to, "Hello", "<p>Hi</p>", nil)
+	require.NoError(t, err)
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	require.NoError(t, err)
+	require.Equal(t, "text/html; charset=utf-8", m.Header.Get("Content-Type"))
+
+	// With attachments, the body and the attachments are separate parts
+	data := bytes.Repeat([]byte("0123456789"), 100)
+	msg, err = composeMessage(from, to, "Hello", "<p>Hi</p>", []*Attachment{{Filename: "report.csv", ContentType: "text/csv", Data: data}})
+	require.NoError(t, err)
+	m, err = mail.ReadMessage(bytes.NewReader(msg))
+	require.NoError(t, err)
+	require.Equal(t, "Hello", m.Header.Get("Subject"))
+
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	r := multipart.NewReader(m.Body, params["boundary"])
+	p, err := r.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "text/html; charset=utf-8", p.Header.Get("Content-Type"))
+	body, err := io.ReadAll(p)
+	require.NoError(t, err)
+	require.Equal(t, "<p>Hi</p>\r\n", string(body))
+
+	p, err = r.NextPart()
+	require.NoError(t, err)
+	require.Equal(t, "report.csv", p.FileName())
+	require.Equal(t, "base64", p.Header.Get("Content-Transfer-Encoding"))
+	enc, err := io.ReadAll(p)
+	require.NoError(t, err)
+	for _, line := range strings.Split(strings.TrimSpace(string(enc)), "\r\n") {
+		require.LessOrEqual(t, len(line), 76)
+	}
+	dec, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(enc), "\r\n", ""))
+	require.NoError(t, err)
+	require.Equal(t, data, dec)
+
+	_, err = r.NextPart()
+	require.ErrorIs(t, err, io.EOF)
+}
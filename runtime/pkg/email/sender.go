@@ -1,9 +1,15 @@
 package email
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/mail"
 	"net/smtp"
+	"net/textproto"
 	"strconv"
 
 	"go.uber.org/zap"
@@ -11,6 +17,14 @@ import (
 
 type Sender interface {
 	Send(toEmail, toName, subject, body string) error
+	SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error
+}
+
+// Attachment is a file attached to an email.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
 }
 
 type SMTPOptions struct {
@@ -48,16 +62,17 @@ func NewSMTPSender(opts *SMTPOptions) (Sender, error) {
 }
 
 func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
+	return s.SendWithAttachments(toEmail, toName, subject, body, nil)
+}
+
+func (s *smtpSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
 	// Compose the email message
 	from := mail.Address{Name: s.opts.FromName, Address: s.opts.FromEmail}
 	to := mail.Address{Name: toName, Address: toEmail}
-	message := []byte("From: " + from.String() + "\r\n" +
-		"To: " + to.String() + "\r\n" +
-		"Subject: " + subject + "\r\n" +
-		"Content-Type: text/html; charset=utf-8\r\n" +
-		"\r\n" +
-		body + "\r\n",
-	)
+	message, err := composeMessage(from, to, subject, body, attachments)
+	if err != nil {
+		return err
+	}
 
 	// Build recipients list
 	recipients := []string{toEmail}
@@ -67,7 +82,7 @@ func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
 
 	// Connect to the SMTP server
 	auth := smtp.PlainAuth("", s.opts.SMTPUsername, s.opts.SMTPPassword, s.opts.SMTPHost)
-	err := smtp.SendMail(s.opts.SMTPHost+":"+strconv.Itoa(s.opts.SMTPPort), auth, from.Address, recipients, message)
+	err = smtp.SendMail(s.opts.SMTPHost+":"+strconv.Itoa(s.opts.SMTPPort), auth, from.Address, recipients, message)
 	if err != nil {
 		return err
 	}
@@ -75,6 +90,82 @@ func (s *smtpSender) Send(toEmail, toName, subject, body string) error {
 	return nil
 }
 
+// composeMessage composes a MIME message with an HTML body.
+// If there are attachments, the message is a multipart/mixed message with the body as the first part.
+func composeMessage(from, to mail.Address, subject, body string, attachments []*Attachment) ([]byte, error) {
+	if len(attachments) == 0 {
+		return []byte("From: " + from.String() + "\r\n" +
+			"To: " + to.String() + "\r\n" +
+			"Subject: " + subject + "\r\n" +
+			"Content-Type: text/html; charset=utf-8\r\n" +
+			"\r\n" +
+			body + "\r\n",
+		), nil
+	}
+
+	parts := new(bytes.Buffer)
+	w := multipart.NewWriter(parts)
+
+	p, err := w.CreatePart(textproto.MIMEHeader{"Content-Type": {"text/html; charset=utf-8"}})
+	if err != nil {
+		return nil, err
+	}
+	_, err = p.Write([]byte(body + "\r\n"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range attachments {
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		p, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {mime.FormatMediaType(contentType, map[string]string{"name": a.Filename})},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		err = writeBase64Lines(p, a.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := new(bytes.Buffer)
+	msg.WriteString("From: " + from.String() + "\r\n" +
+		"To: " + to.String() + "\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: multipart/mixed; boundary=" + w.Boundary() + "\r\n" +
+		"\r\n",
+	)
+	msg.Write(parts.Bytes())
+	return msg.Bytes(), nil
+}
+
+// writeBase64Lines writes base64 encoded data split into lines of 76 characters as required by RFC 2045.
+func writeBase64Lines(w io.Writer, data []byte) error {
+	const lineLen = 76
+	enc := base64.StdEncoding.EncodeToString(data)
+	for len(enc) > 0 {
+		n := min(lineLen, len(enc))
+		_, err := w.Write([]byte(enc[:n] + "\r\n"))
+		if err != nil {
+			return err
+		}
+		enc = enc[n:]
+	}
+	return nil
+}
+
 type consoleSender struct {
 	logger    *zap.Logger
 	fromEmail string
@@ -97,6 +188,23 @@ func (s *consoleSender) Send(toEmail, toName, subject, body string) error {
 	return nil
 }
 
+func (s *consoleSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
+	names := make([]string, len(attachments))
+	for i, a := range attachments {
+		names[i] = a.Filename
+	}
+	s.logger.Info("email sent",
+		zap.String("from_email", s.fromEmail),
+		zap.String("from_name", s.fromName),
+		zap.String("to_email", toEmail),
+		zap.String("to_name", toName),
+		zap.String("subject", subject),
+		zap.String("body", body),
+		zap.Strings("attachments", names),
+	)
+	return nil
+}
+
 type noopSender struct{}
 
 func NewNoopSender() Sender {
@@ -107,12 +215,17 @@ func (s *noopSender) Send(toEmail, toName, subject, body string) error {
 	return nil
 }
 
+func (s *noopSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
+	return nil
+}
+
 type TestSender struct {
 	Emails []struct {
-		ToEmail string
-		ToName  string
-		Subject string
-		Body    string
+		ToEmail     string
+		ToName      string
+		Subject     string
+		Body        string
+		Attachments []*Attachment
 	}
 }
 
@@ -121,16 +234,22 @@ func NewTestSender() Sender {
 }
 
 func (s *TestSender) Send(toEmail, toName, subject, body string) error {
+	return s.SendWithAttachments(toEmail, toName, subject, body, nil)
+}
+
+func (s *TestSender) SendWithAttachments(toEmail, toName, subject, body string, attachments []*Attachment) error {
 	s.Emails = append(s.Emails, struct {
-		ToEmail string
-		ToName  string
-		Subject string
-		Body    string
+		ToEmail     string
+		ToName      string
+		Subject     string
+		Body        string
+		Attachments []*Attachment
 	}{
-		ToEmail: toEmail,
-		ToName:  toName,
-		Subject: subject,
-		Body:    body,
+		ToEmail:     toEmail,
+		ToName:      toName,
+		Subject:     subject,
+		Body:        body,
+		Attachments: attachments,
 	})
 	return nil
 }
@@ -82,6 +82,18 @@ func (q *ColumnDescriptiveStatistics) Resolve(ctx context.Context, rt *runtime.R
 			FROM %[2]s WHERE `+isNonNullFinite(olap.Dialect(), sanitizedColumnName)+``,
 			sanitizedColumnName,
 			olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName))
+	case drivers.DialectPostgres:
+		descriptiveStatisticsSQL = fmt.Sprintf(`SELECT
+			min(%[1]s)::DOUBLE PRECISION as min,
+			percentile_cont(0.25) WITHIN GROUP (ORDER BY %[1]s::DOUBLE PRECISION) as q25,
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY %[1]s::DOUBLE PRECISION) as q50,
+			percentile_cont(0.75) WITHIN GROUP (ORDER BY %[1]s::DOUBLE PRECISION) as q75,
+			max(%[1]s)::DOUBLE PRECISION as max,
+			avg(%[1]s)::DOUBLE PRECISION as mean,
+			stddev_samp(%[1]s)::DOUBLE PRECISION as sd
+			FROM %[2]s WHERE `+isNonNullFinite(olap.Dialect(), sanitizedColumnName)+``,
+			sanitizedColumnName,
+			olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName))
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
@@ -56,13 +56,13 @@ func (q *ColumnNullCount) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectStarRocks && olap.Dialect() != drivers.DialectPostgres {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
 	nullCountSQL := fmt.Sprintf("SELECT count(*) AS count FROM %s WHERE %s IS NULL",
 		olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName),
-		safeName(q.ColumnName),
+		olap.Dialect().EscapeIdentifier(q.ColumnName),
 	)
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -85,6 +85,8 @@ func (q *ColumnNumericHistogram) calculateBucketSize(ctx context.Context, olap d
 		qryString = "SELECT (approx_quantile(%s, 0.75)-approx_quantile(%s, 0.25))::DOUBLE AS iqr, approx_count_distinct(%s) AS count, (max(%s) - min(%s))::DOUBLE AS range FROM %s"
 	case drivers.DialectClickHouse:
 		qryString = "SELECT (quantileTDigest(0.75)(%s)-quantileTDigest(0.25)(%s)) AS iqr, uniq(%s) AS count, (max(%s) - min(%s)) AS range FROM %s"
+	case drivers.DialectPostgres:
+		qryString = "SELECT (percentile_cont(0.75) WITHIN GROUP (ORDER BY %s::DOUBLE PRECISION) - percentile_cont(0.25) WITHIN GROUP (ORDER BY %s::DOUBLE PRECISION)) AS iqr, count(DISTINCT %s) AS count, (max(%s) - min(%s))::DOUBLE PRECISION AS range FROM %s"
 	default:
 		return 0, fmt.Errorf("unsupported dialect %v", olap.Dialect())
 	}
@@ -145,7 +147,7 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPostgres {
 		return fmt.Errorf("not available for dialect %q", olap.Dialect())
 	}
 
@@ -167,9 +169,16 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 		return nil
 	}
 
-	selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
-	histogramSQL := fmt.Sprintf(
-		`
+	var histogramSQL string
+	if olap.Dialect() == drivers.DialectPostgres {
+		histogramSQL = fmt.Sprintf(
+			"SELECT bucket, low, high, count FROM (%s) AS histogram ORDER BY bucket",
+			histogramPostgresSQL(sanitizedColumnName, olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName), int(bucketSize), *minVal, *rng/bucketSize, *rng, *maxVal),
+		)
+	} else {
+		selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
+		histogramSQL = fmt.Sprintf(
+			`
           WITH data_table AS (
             SELECT %[1]s as %[2]s 
             FROM %[3]s
@@ -216,14 +225,15 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
             FROM histogram_stage
             ORDER BY bucket
 	      `,
-		selectColumn,
-		sanitizedColumnName,
-		olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName),
-		bucketSize,
-		*minVal,
-		*maxVal,
-		*rng,
-	)
+			selectColumn,
+			sanitizedColumnName,
+			olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName),
+			bucketSize,
+			*minVal,
+			*maxVal,
+			*rng,
+		)
+	}
 
 	histogramRows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            histogramSQL,
@@ -263,7 +273,7 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPostgres {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -287,9 +297,16 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 	}
 
 	sanitizedColumnName := safeName(q.ColumnName)
-	selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
-	histogramSQL := fmt.Sprintf(
-		`
+	var histogramSQL string
+	if olap.Dialect() == drivers.DialectPostgres {
+		histogramSQL = fmt.Sprintf(
+			"SELECT bucket, low, high, midpoint, count FROM (%s) AS histogram ORDER BY bucket",
+			histogramPostgresSQL(sanitizedColumnName, olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName), bucketCount, startTick, gap, endTick-startTick, endTick),
+		)
+	} else {
+		selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
+		histogramSQL = fmt.Sprintf(
+			`
 		WITH data_table AS (
 			SELECT %[1]s as %[2]s
 			FROM %[3]s
@@ -345,15 +362,16 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 		FROM histogram_stage
     ORDER BY bucket
 		`,
-		selectColumn,
-		sanitizedColumnName,
-		olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName),
-		bucketCount,
-		startTick,
-		endTick,
-		gap,
-		endTick-startTick,
-	)
+			selectColumn,
+			sanitizedColumnName,
+			olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName),
+			bucketCount,
+			startTick,
+			endTick,
+			gap,
+			endTick-startTick,
+		)
+	}
 
 	histogramRows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            histogramSQL,
@@ -390,6 +408,9 @@ func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context,
 func getMinMaxRange(ctx context.Context, olap drivers.OLAPStore, columnName, database, databaseSchema, tableName string, priority int) (*float64, *float64, *float64, error) {
 	sanitizedColumnName := safeName(columnName)
 	selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
+	if olap.Dialect() == drivers.DialectPostgres {
+		selectColumn = fmt.Sprintf("%s::DOUBLE PRECISION", sanitizedColumnName)
+	}
 
 	minMaxSQL := fmt.Sprintf(
 		`
@@ -429,12 +450,46 @@ func getMinMaxRange(ctx context.Context, olap drivers.OLAPStore, columnName, dat
 	return minVal, maxVal, rng, nil
 }
 
+// histogramPostgresSQL returns a Postgres query for a histogram of the finite values of col with bucketCount buckets of the given width starting at low.
+// Like the right_edge CTE in the queries for other dialects, values equal to rightEdge (which fall just outside the last bucket) are counted in the last bucket.
+// It returns the bucket, low, high, midpoint and count of each bucket.
+func histogramPostgresSQL(col, table string, bucketCount int, low, width, span, rightEdge float64) string {
+	return fmt.Sprintf(`
+		WITH vals AS (
+			SELECT %[1]s::DOUBLE PRECISION AS value FROM %[2]s WHERE `+isNonNullFinite(drivers.DialectPostgres, col)+`
+		), buckets AS (
+			SELECT
+				b::DOUBLE PRECISION AS bucket,
+				%[4]v::DOUBLE PRECISION + b * %[5]v::DOUBLE PRECISION AS low,
+				%[4]v::DOUBLE PRECISION + b * %[5]v::DOUBLE PRECISION + %[5]v::DOUBLE PRECISION / 2 AS midpoint,
+				%[4]v::DOUBLE PRECISION + (b + 1) * %[5]v::DOUBLE PRECISION AS high
+			FROM generate_series(0, %[3]d - 1) AS b
+		), binned_data AS (
+			SELECT FLOOR(%[3]d * (value - %[4]v::DOUBLE PRECISION) / %[6]v::DOUBLE PRECISION) AS bucket, count(*) AS count
+			FROM vals
+			GROUP BY 1
+		), right_edge AS (
+			SELECT count(*) AS c FROM vals WHERE value = %[7]v::DOUBLE PRECISION
+		)
+		SELECT
+			buckets.bucket,
+			low,
+			high,
+			midpoint,
+			COALESCE(binned_data.count, 0) + CASE WHEN buckets.bucket = %[3]d - 1 THEN (SELECT c FROM right_edge) ELSE 0 END AS count
+		FROM buckets
+		LEFT JOIN binned_data ON binned_data.bucket = buckets.bucket
+	`, col, table, bucketCount, low, width, span, rightEdge)
+}
+
 func isNonNullFinite(d drivers.Dialect, floatCol string) string {
 	switch d {
 	case drivers.DialectClickHouse:
 		return fmt.Sprintf("%s IS NOT NULL AND isFinite(%s)", floatCol, floatCol)
 	case drivers.DialectDuckDB:
 		return fmt.Sprintf("%s IS NOT NULL AND NOT isinf(%s)", floatCol, floatCol)
+	case drivers.DialectPostgres:
+		return fmt.Sprintf("%s IS NOT NULL AND %s::DOUBLE PRECISION NOT IN ('Infinity', '-Infinity')", floatCol, floatCol)
 	default:
 		return "1=1"
 	}
@@ -0,0 +1,84 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnProfilingAgainstPostgres(t *testing.T) {
+	if testing.Short() {
+		t.Skip("postgres: skipping test in short mode")
+	}
+	rt, instanceID := testruntime.NewInstanceWithPostgresProject(t)
+	ctx := context.Background()
+
+	tc := &queries.TableCardinality{Connector: "postgres", TableName: "ad_bids"}
+	require.NoError(t, tc.Resolve(ctx, rt, instanceID, 0))
+	require.Equal(t, int64(100000), tc.Result)
+
+	cc := &queries.ColumnCardinality{Connector: "postgres", TableName: "ad_bids", ColumnName: "publisher"}
+	require.NoError(t, cc.Resolve(ctx, rt, instanceID, 0))
+	require.Equal(t, 4.0, cc.Result)
+
+	nc := &queries.ColumnNullCount{Connector: "postgres", TableName: "ad_bids", ColumnName: "publisher"}
+	require.NoError(t, nc.Resolve(ctx, rt, instanceID, 0))
+	require.Equal(t, 32897.0, nc.Result)
+
+	topK := &queries.ColumnTopK{Connector: "postgres", TableName: "ad_bids", ColumnName: "domain", Agg: "count(*)", K: 2}
+	require.NoError(t, topK.Resolve(ctx, rt, instanceID, 0))
+	require.Len(t, topK.Result.Entries, 2)
+	require.Equal(t, "facebook.com", topK.Result.Entries[0].Value.GetStringValue())
+	require.Equal(t, 15609.0, topK.Result.Entries[0].Count)
+
+	ds := &queries.ColumnDescriptiveStatistics{Connector: "postgres", TableName: "ad_bids", ColumnName: "bid_price"}
+	require.NoError(t, ds.Resolve(ctx, rt, instanceID, 0))
+	require.Equal(t, 1.0, ds.Result.Min)
+	require.Equal(t, 6.0, ds.Result.Max)
+	require.Less(t, ds.Result.Q25, ds.Result.Q75)
+
+	tg := &queries.ColumnTimeGrain{Connector: "postgres", TableName: "ad_bids", ColumnName: "timestamp"}
+	require.NoError(t, tg.Resolve(ctx, rt, instanceID, 0))
+	require.Equal(t, runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND, tg.Result)
+
+	for _, method := range []runtimev1.HistogramMethod{runtimev1.HistogramMethod_HISTOGRAM_METHOD_FD, runtimev1.HistogramMethod_HISTOGRAM_METHOD_DIAGNOSTIC} {
+		h := &queries.ColumnNumericHistogram{Connector: "postgres", TableName: "ad_bids", ColumnName: "bid_price", Method: method}
+		require.NoError(t, h.Resolve(ctx, rt, instanceID, 0))
+		require.NotEmpty(t, h.Result)
+		var total float64
+		for _, bin := range h.Result {
+			total += bin.Count
+		}
+		require.Equal(t, 100000.0, total, method.String())
+	}
+
+	rug := &queries.ColumnRugHistogram{Connector: "postgres", TableName: "ad_bids", ColumnName: "bid_price"}
+	require.NoError(t, rug.Resolve(ctx, rt, instanceID, 0))
+	var total int32
+	for _, o := range rug.Result {
+		require.True(t, o.Present)
+		total += o.Count
+	}
+	require.Equal(t, int32(100000), total)
+
+	ts := &queries.ColumnTimeseries{
+		Connector:           "postgres",
+		TableName:           "ad_bids",
+		TimestampColumnName: "timestamp",
+		TimeRange:           &runtimev1.TimeSeriesTimeRange{Interval: runtimev1.TimeGrain_TIME_GRAIN_DAY},
+		Pixels:              10,
+	}
+	require.NoError(t, ts.Resolve(ctx, rt, instanceID, 0))
+	require.Len(t, ts.Result.Results, 89)
+	require.Equal(t, parseTime(t, "2022-01-01T00:00:00Z"), ts.Result.Results[0].Ts)
+	var count float64
+	for _, v := range ts.Result.Results {
+		count += v.Records.Fields["count"].GetNumberValue()
+	}
+	require.Equal(t, 100000.0, count)
+	require.NotEmpty(t, ts.Result.Spark)
+}
@@ -60,7 +60,7 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPostgres {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -74,10 +74,17 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 
 	sanitizedColumnName := safeName(q.ColumnName)
 	outlierPseudoBucketCount := 500
-	selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
-
-	rugSQL := fmt.Sprintf(
-		`
+	var rugSQL string
+	if olap.Dialect() == drivers.DialectPostgres {
+		rugSQL = fmt.Sprintf(
+			"SELECT bucket, low, high, true AS present, count FROM (%s) AS histogram WHERE count > 0 ORDER BY bucket",
+			histogramPostgresSQL(sanitizedColumnName, olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName), outlierPseudoBucketCount, *minVal, *rng/float64(outlierPseudoBucketCount), *rng, *maxVal),
+		)
+	} else {
+		selectColumn := fmt.Sprintf("%s::DOUBLE", sanitizedColumnName)
+
+		rugSQL = fmt.Sprintf(
+			`
   WITH data_table AS (
 		SELECT %[1]s as %[2]s
 		FROM %[3]s
@@ -133,14 +140,15 @@ func (q *ColumnRugHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	  WHERE present=true
 	  ORDER BY bucket
 `,
-		selectColumn,        // 1
-		sanitizedColumnName, // 2
-		olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName), // 3
-		outlierPseudoBucketCount, // 4
-		*minVal,                  // 5
-		*maxVal,                  // 6
-		*rng,                     // 7
-	)
+			selectColumn,        // 1
+			sanitizedColumnName, // 2
+			olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName), // 3
+			outlierPseudoBucketCount, // 4
+			*minVal,                  // 5
+			*maxVal,                  // 6
+			*rng,                     // 7
+		)
+	}
 
 	outlierResults, err := olap.Execute(ctx, &drivers.Statement{
 		Query:            rugSQL,
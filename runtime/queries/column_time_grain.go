@@ -162,6 +162,53 @@ func (q *ColumnTimeGrain) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 			olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName),
 			useSample,
 		)
+	case drivers.DialectPostgres:
+		if sampleSize <= cq.Result {
+			useSample = fmt.Sprintf("ORDER BY random() LIMIT %d", sampleSize)
+		}
+		// The column is cast to TIMESTAMP since hours, minutes and seconds can't be extracted from a DATE.
+		// Postgres has no approximate distinct count.
+		estimateSQL = fmt.Sprintf(`
+		WITH cleaned_column AS (
+			SELECT %s::TIMESTAMP as cd
+			from %s
+			%s
+		),
+		time_grains as (
+		SELECT
+			count(DISTINCT extract(year from cd)) as year,
+			count(DISTINCT extract(month from cd)) as month,
+			count(DISTINCT extract(doy from cd)) as dayofyear,
+			count(DISTINCT extract(day from cd)) as dayofmonth,
+			bool_and(cd::DATE = (date_trunc('month', cd) + INTERVAL '1 month - 1 day')::DATE) as lastdayofmonth,
+			count(DISTINCT extract(week from cd)) as weekofyear,
+			count(DISTINCT extract(isodow from cd)) as dayofweek,
+			count(DISTINCT extract(hour from cd)) as hour,
+			count(DISTINCT extract(minute from cd)) as minute,
+			count(DISTINCT floor(extract(second from cd))) as second,
+			count(DISTINCT floor(extract(milliseconds from cd)) - floor(extract(second from cd)) * 1000) as ms
+		FROM cleaned_column
+		)
+		SELECT
+		  COALESCE(
+			  case WHEN ms > 1 THEN 'MILLISECOND' else NULL END,
+			  CASE WHEN second > 1 THEN 'SECOND' else NULL END,
+			  CASE WHEN minute > 1 THEN 'MINUTE' else null END,
+			  CASE WHEN hour > 1 THEN 'HOUR' else null END,
+			  -- cases above, if equal to 1, then we have some candidates for
+			  -- bigger time grains. We need to reverse from here
+			  -- years, months, weeks, days.
+			  CASE WHEN dayofyear = 1 and year > 1 THEN 'YEAR' else null END,
+			  CASE WHEN (dayofmonth = 1 OR lastdayofmonth) and month > 1 THEN 'MONTH' else null END,
+			  CASE WHEN dayofweek = 1 and weekofyear > 1 THEN 'WEEK' else null END,
+			  CASE WHEN hour = 1 THEN 'DAY' else null END
+		  ) as estimatedSmallestTimeGrain
+		FROM time_grains
+		`,
+			safeName(q.ColumnName),
+			olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName),
+			useSample,
+		)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
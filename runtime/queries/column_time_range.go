@@ -69,7 +69,7 @@ func (q *ColumnTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 		return q.resolveDuckDB(ctx, olap, priority)
 	case drivers.DialectDruid:
 		return q.resolveDruid(ctx, olap, priority)
-	case drivers.DialectClickHouse:
+	case drivers.DialectClickHouse, drivers.DialectPostgres, drivers.DialectStarRocks:
 		return q.resolveClickHouse(ctx, olap, priority)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
@@ -230,8 +230,8 @@ func (q *ColumnTimeRange) resolveDruid(ctx context.Context, olap drivers.OLAPSto
 func (q *ColumnTimeRange) resolveClickHouse(ctx context.Context, olap drivers.OLAPStore, priority int) error {
 	sql := fmt.Sprintf(
 		"SELECT min(%[1]s) as \"min\", max(%[1]s) as \"max\" FROM %[2]s",
-		safeName(q.ColumnName),
-		drivers.DialectClickHouse.EscapeTable(q.Database, q.DatabaseSchema, q.TableName),
+		olap.Dialect().EscapeIdentifier(q.ColumnName),
+		olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName),
 	)
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -94,7 +94,7 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPostgres {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -113,18 +113,23 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 		timezone = q.TimeZone
 	}
 
+	if q.FirstDayOfWeek > 7 || q.FirstDayOfWeek <= 0 {
+		q.FirstDayOfWeek = 1
+	}
+
+	if q.FirstMonthOfYear > 12 || q.FirstMonthOfYear <= 0 {
+		q.FirstMonthOfYear = 1
+	}
+
+	if olap.Dialect() == drivers.DialectPostgres {
+		// The Postgres connector doesn't support WithConnection and may be connected to a read replica that can't create temporary tables.
+		return q.resolvePostgres(ctx, olap, timeRange, timezone, priority)
+	}
+
 	return olap.WithConnection(ctx, priority, false, false, func(ctx context.Context, ensuredCtx context.Context, _ *sql.Conn) error {
 		tsAlias := tempName("_ts_")
 		temporaryTableName := tempName("_timeseries_")
 
-		if q.FirstDayOfWeek > 7 || q.FirstDayOfWeek <= 0 {
-			q.FirstDayOfWeek = 1
-		}
-
-		if q.FirstMonthOfYear > 12 || q.FirstMonthOfYear <= 0 {
-			q.FirstMonthOfYear = 1
-		}
-
 		var querySQL string
 		var args []any
 		switch olap.Dialect() {
@@ -163,60 +168,11 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 			return err
 		}
 
-		// Omit the time value from the result schema
-		schema := rows.Schema
-		if schema != nil {
-			for i, f := range schema.Fields {
-				if f.Name == tsAlias {
-					schema.Fields = slices.Delete(schema.Fields, i, i+1)
-					break
-				}
-			}
-		}
-
-		var data []*runtimev1.TimeSeriesValue
-		rowMap := make(map[string]any)
-		for rows.Next() {
-			err := rows.MapScan(rowMap)
-			if err != nil {
-				rows.Close()
-				return err
-			}
-
-			var t time.Time
-			switch v := rowMap[tsAlias].(type) {
-			case time.Time:
-				t = v
-			default:
-				rows.Close()
-				panic(fmt.Sprintf("unexpected type for timestamp column: %T", v))
-			}
-			delete(rowMap, tsAlias)
-
-			records, err := pbutil.ToStruct(rowMap, schema)
-			if err != nil {
-				rows.Close()
-				return err
-			}
-
-			tpb := timestamppb.New(t)
-			if err := tpb.CheckValid(); err != nil {
-				rows.Close()
-				return err
-			}
-
-			data = append(data, &runtimev1.TimeSeriesValue{
-				Ts:      tpb,
-				Records: records,
-			})
-		}
-		if err := rows.Err(); err != nil {
+		data, meta, err := scanTimeseries(rows, tsAlias)
+		if err != nil {
 			return err
 		}
 
-		meta := structTypeToMetricsViewColumn(rows.Schema)
-		rows.Close()
-
 		var sparkValues []*runtimev1.TimeSeriesValue
 		if q.Pixels != 0 {
 			sparkValues, err = q.CreateTimestampRollupReduction(ctx, rt, olap, instanceID, priority, temporaryTableName, tsAlias, "count")
@@ -234,6 +190,94 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 	})
 }
 
+// resolvePostgres resolves the time series with a single query instead of a temporary table.
+// The spark values are computed in memory from the time series.
+func (q *ColumnTimeseries) resolvePostgres(ctx context.Context, olap drivers.OLAPStore, timeRange *runtimev1.TimeSeriesTimeRange, timezone string, priority int) error {
+	tsAlias := tempName("_ts_")
+	querySQL, args := timeSeriesPostgresSQL(timeRange, q, tsAlias, timezone, olap.Dialect())
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:            querySQL,
+		Args:             args,
+		Priority:         priority,
+		ExecutionTimeout: defaultExecutionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, meta, err := scanTimeseries(rows, tsAlias)
+	if err != nil {
+		return err
+	}
+
+	var sparkValues []*runtimev1.TimeSeriesValue
+	if q.Pixels != 0 {
+		sparkValues = timestampRollupReduction(data, q.Pixels, "count")
+	}
+
+	q.Result = &ColumnTimeseriesResult{
+		Meta:    meta,
+		Results: data,
+		Spark:   sparkValues,
+	}
+	return nil
+}
+
+// scanTimeseries scans the rows of a time series query and closes them.
+// The tsAlias column holds the timestamp of each value and is omitted from the records.
+func scanTimeseries(rows *drivers.Result, tsAlias string) ([]*runtimev1.TimeSeriesValue, []*runtimev1.MetricsViewColumn, error) {
+	defer rows.Close()
+
+	// Omit the time value from the result schema
+	schema := rows.Schema
+	if schema != nil {
+		for i, f := range schema.Fields {
+			if f.Name == tsAlias {
+				schema.Fields = slices.Delete(schema.Fields, i, i+1)
+				break
+			}
+		}
+	}
+
+	var data []*runtimev1.TimeSeriesValue
+	rowMap := make(map[string]any)
+	for rows.Next() {
+		err := rows.MapScan(rowMap)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var t time.Time
+		switch v := rowMap[tsAlias].(type) {
+		case time.Time:
+			t = v
+		default:
+			panic(fmt.Sprintf("unexpected type for timestamp column: %T", v))
+		}
+		delete(rowMap, tsAlias)
+
+		records, err := pbutil.ToStruct(rowMap, schema)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		tpb := timestamppb.New(t)
+		if err := tpb.CheckValid(); err != nil {
+			return nil, nil, err
+		}
+
+		data = append(data, &runtimev1.TimeSeriesValue{
+			Ts:      tpb,
+			Records: records,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return data, structTypeToMetricsViewColumn(rows.Schema), nil
+}
+
 func timeSeriesClickHouseSQL(timeRange *runtimev1.TimeSeriesTimeRange, q *ColumnTimeseries, temporaryTableName, tsAlias, timezone string, dialect drivers.Dialect) (string, []any) {
 	dateTruncSpecifier := dialect.ConvertToDateTruncSpecifier(timeRange.Interval)
 	measures := normaliseMeasures(q.Measures, q.Pixels != 0)
@@ -362,6 +406,60 @@ func timeSeriesDuckDBSQL(timeRange *runtimev1.TimeSeriesTimeRange, q *ColumnTime
 		}
 }
 
+func timeSeriesPostgresSQL(timeRange *runtimev1.TimeSeriesTimeRange, q *ColumnTimeseries, tsAlias, timezone string, dialect drivers.Dialect) (string, []any) {
+	dateTruncSpecifier := drivers.DialectDuckDB.ConvertToDateTruncSpecifier(timeRange.Interval)
+	measures := normaliseMeasures(q.Measures, q.Pixels != 0)
+	safeTsAlias := safeName(tsAlias)
+
+	timeOffsetClause1 := ""
+	timeOffsetClause2 := ""
+	if timeRange.Interval == runtimev1.TimeGrain_TIME_GRAIN_WEEK && q.FirstDayOfWeek > 1 {
+		dayOffset := 8 - q.FirstDayOfWeek
+		timeOffsetClause1 = fmt.Sprintf(" + INTERVAL '%d DAY'", dayOffset)
+		timeOffsetClause2 = fmt.Sprintf(" - INTERVAL '%d DAY'", dayOffset)
+	} else if timeRange.Interval == runtimev1.TimeGrain_TIME_GRAIN_YEAR && q.FirstMonthOfYear > 1 {
+		monthOffset := 13 - q.FirstMonthOfYear
+		timeOffsetClause1 = fmt.Sprintf(" + INTERVAL '%d MONTH'", monthOffset)
+		timeOffsetClause2 = fmt.Sprintf(" - INTERVAL '%d MONTH'", monthOffset)
+	}
+	dateTrunc := func(expr string) string {
+		return `date_trunc('` + dateTruncSpecifier + `', timezone(?, ` + expr + `::TIMESTAMPTZ)` + timeOffsetClause1 + `)` + timeOffsetClause2
+	}
+
+	return `
+		-- generate a time series column that has the intended range (with at least the first value in case a range is empty)
+		WITH template AS (
+			SELECT generate_series(
+				` + dateTrunc("?") + `,
+				GREATEST(` + dateTrunc("?") + `, ` + dateTrunc("?") + ` - INTERVAL '1 microsecond'),
+				INTERVAL '1 ` + dateTruncSpecifier + `'
+			) AS ` + safeTsAlias + `
+		),
+		series AS (
+			SELECT
+				` + dateTrunc(safeName(q.TimestampColumnName)) + ` AS ` + safeTsAlias + `,` + getExpressionColumnsFromMeasures(measures) + `
+			FROM ` + dialect.EscapeTable(q.Database, q.DatabaseSchema, q.TableName) + `
+			GROUP BY 1
+		)
+		-- an additional grouping is required for time zone DST (see unit tests for examples)
+		SELECT ` + safeTsAlias + `,` + getCoalesceStatementsMeasuresLast(dialect, measures) + ` FROM (
+			SELECT
+			` + getCoalesceStatementsMeasures(measures) + `,
+			timezone(?, template.` + safeTsAlias + `) AS ` + safeTsAlias + ` FROM template
+			LEFT OUTER JOIN series ON template.` + safeTsAlias + ` = series.` + safeTsAlias + `
+		) AS joined GROUP BY 1 ORDER BY 1
+	`, []any{
+		timezone,
+		timeRange.Start.AsTime(),
+		timezone,
+		timeRange.Start.AsTime(),
+		timezone,
+		timeRange.End.AsTime(),
+		timezone,
+		timezone,
+	}
+}
+
 func (q *ColumnTimeseries) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
 	return ErrExportNotSupported
 }
@@ -547,25 +645,6 @@ func (q *ColumnTimeseries) CreateTimestampRollupReduction(
 
 	defer rows.Close()
 
-	toTSV := func(ts int64, value *float64, bin *float64) *runtimev1.TimeSeriesValue {
-		tsv := &runtimev1.TimeSeriesValue{
-			Records: &structpb.Struct{
-				Fields: make(map[string]*structpb.Value),
-			},
-		}
-		tsv.Ts = timestamppb.New(time.UnixMilli(ts))
-		tsv.Bin = math.NaN()
-		if bin != nil {
-			tsv.Bin = *bin
-		}
-		if value != nil {
-			tsv.Records.Fields["count"] = structpb.NewNumberValue(*value)
-		} else {
-			tsv.Records.Fields["count"] = structpb.NewNullValue()
-		}
-		return tsv
-	}
-
 	results := make([]*runtimev1.TimeSeriesValue, 0, (q.Pixels+1)*4)
 	for rows.Next() {
 		var minT, maxT int64
@@ -585,7 +664,7 @@ func (q *ColumnTimeseries) CreateTimestampRollupReduction(
 		if argmaxVT != nil {
 			argmaxVTSafe = *argmaxVT
 		}
-		results = append(results, toTSV(minT, argminTV, bin), toTSV(argminVTSafe, minV, bin), toTSV(argmaxVTSafe, maxV, bin), toTSV(maxT, argmaxTV, bin))
+		results = append(results, rollupTSV(minT, argminTV, bin), rollupTSV(argminVTSafe, minV, bin), rollupTSV(argmaxVTSafe, maxV, bin), rollupTSV(maxT, argmaxTV, bin))
 
 		if argminVT != nil && argmaxVT != nil && *argminVT > *argmaxVT {
 			i := len(results)
@@ -596,6 +675,99 @@ func (q *ColumnTimeseries) CreateTimestampRollupReduction(
 	return results, nil
 }
 
+// timestampRollupReduction is an in-memory implementation of the reduction in CreateTimestampRollupReduction.
+// It is used for OLAP connectors where the time series isn't stored in a temporary table.
+// The values must be ordered by timestamp.
+func timestampRollupReduction(values []*runtimev1.TimeSeriesValue, pixels int32, valueColumn string) []*runtimev1.TimeSeriesValue {
+	valueOf := func(tsv *runtimev1.TimeSeriesValue) *float64 {
+		if v, ok := tsv.Records.Fields[valueColumn].GetKind().(*structpb.Value_NumberValue); ok {
+			return &v.NumberValue
+		}
+		return nil
+	}
+
+	if len(values) < int(pixels*4) {
+		results := make([]*runtimev1.TimeSeriesValue, 0, len(values))
+		for _, tsv := range values {
+			res := rollupTSV(tsv.Ts.AsTime().UnixMilli(), valueOf(tsv), nil)
+			res.Bin = 0
+			results = append(results, res)
+		}
+		return results
+	}
+
+	t1 := values[0].Ts.AsTime().Unix()
+	diff := values[len(values)-1].Ts.AsTime().Unix() - t1
+
+	results := make([]*runtimev1.TimeSeriesValue, 0, (pixels+1)*4)
+	for i := 0; i < len(values); {
+		// Find the values in the same bin
+		var bin *float64
+		if diff != 0 {
+			b := math.Round(float64(pixels) * float64(values[i].Ts.AsTime().Unix()-t1) / float64(diff))
+			bin = &b
+		}
+		j := i + 1
+		for ; j < len(values) && bin != nil; j++ {
+			if math.Round(float64(pixels)*float64(values[j].Ts.AsTime().Unix()-t1)/float64(diff)) != *bin {
+				break
+			}
+		}
+		if bin == nil {
+			j = len(values)
+		}
+
+		// The boundary points and the smallest and largest points within the bin
+		minT := values[i].Ts.AsTime().Unix() * 1000
+		maxT := values[j-1].Ts.AsTime().Unix() * 1000
+		argminTV, argmaxTV := valueOf(values[i]), valueOf(values[j-1])
+		var minV, maxV *float64
+		argminVT, argmaxVT := minT, maxT
+		for _, tsv := range values[i:j] {
+			v := valueOf(tsv)
+			if v == nil {
+				continue
+			}
+			if minV == nil || *v < *minV {
+				minV, argminVT = v, tsv.Ts.AsTime().Unix()*1000
+			}
+			if maxV == nil || *v > *maxV {
+				maxV, argmaxVT = v, tsv.Ts.AsTime().Unix()*1000
+			}
+		}
+
+		results = append(results, rollupTSV(minT, argminTV, bin), rollupTSV(argminVT, minV, bin), rollupTSV(argmaxVT, maxV, bin), rollupTSV(maxT, argmaxTV, bin))
+		if minV != nil && maxV != nil && argminVT > argmaxVT {
+			k := len(results)
+			results[k-3], results[k-2] = results[k-2], results[k-3]
+		}
+
+		i = j
+	}
+
+	return results
+}
+
+// rollupTSV returns a point of a reduced time series with the timestamp in milliseconds.
+func rollupTSV(ts int64, value, bin *float64) *runtimev1.TimeSeriesValue {
+	tsv := &runtimev1.TimeSeriesValue{
+		Records: &structpb.Struct{
+			Fields: make(map[string]*structpb.Value),
+		},
+	}
+	tsv.Ts = timestamppb.New(time.UnixMilli(ts))
+	tsv.Bin = math.NaN()
+	if bin != nil {
+		tsv.Bin = *bin
+	}
+	if value != nil {
+		tsv.Records.Fields["count"] = structpb.NewNumberValue(*value)
+	} else {
+		tsv.Records.Fields["count"] = structpb.NewNullValue()
+	}
+	return tsv
+}
+
 func (q *ColumnTimeseries) resolveRowCount(ctx context.Context, olap drivers.OLAPStore, priority int) (int64, error) {
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:    fmt.Sprintf("SELECT count(*) AS count FROM %s", olap.Dialect().EscapeTable(q.Database, q.DatabaseSchema, q.TableName)),
@@ -717,6 +889,9 @@ func lastValue(dialect drivers.Dialect) string {
 	switch dialect {
 	case drivers.DialectClickHouse:
 		return "last_value"
+	case drivers.DialectPostgres:
+		// Postgres has no last aggregate. Like DuckDB's last ordered by value (see getCoalesceStatementsMeasuresLast), it picks the largest value.
+		return "max"
 	default:
 		return "last"
 	}
@@ -167,7 +167,7 @@ func (builder *ExpressionBuilder) columnIdentifierExpression(name string) (strin
 	for _, mes := range builder.mv.Measures {
 		if mes.Name == name {
 			if !builder.having {
-				return safeName(mes.Name), true
+				return builder.dialect.EscapeIdentifier(mes.Name), true
 			}
 
 			return mes.Expression, true
@@ -176,7 +176,7 @@ func (builder *ExpressionBuilder) columnIdentifierExpression(name string) (strin
 
 	for _, m := range builder.measures {
 		if m.Name == name {
-			return safeName(name), true
+			return builder.dialect.EscapeIdentifier(name), true
 		}
 	}
 
@@ -278,11 +278,15 @@ func (builder *ExpressionBuilder) buildLikeExpression(cond *runtimev1.Condition)
 
 	var clause string
 	// Build [NOT] len(list_filter("dim", x -> x ILIKE ?)) > 0
-	if unnest && builder.dialect != drivers.DialectDruid && builder.dialect != drivers.DialectPinot {
+	if unnest && builder.dialect == drivers.DialectPostgres {
+		clause = fmt.Sprintf("%s EXISTS (SELECT 1 FROM unnest(%s) x WHERE x ILIKE %s)", notKeyword, leftExpr, rightExpr)
+	} else if unnest && builder.dialect == drivers.DialectStarRocks {
+		clause = fmt.Sprintf("%s any_match(x -> LOWER(x) LIKE LOWER(%s), %s)", notKeyword, rightExpr, leftExpr)
+	} else if unnest && builder.dialect != drivers.DialectDruid && builder.dialect != drivers.DialectPinot {
 		clause = fmt.Sprintf("%s len(list_filter((%s), x -> x ILIKE %s)) > 0", notKeyword, leftExpr, rightExpr)
 	} else {
-		if builder.dialect == drivers.DialectDruid || builder.dialect == drivers.DialectPinot {
-			// Druid and Pinot does not support ILIKE
+		if builder.dialect == drivers.DialectDruid || builder.dialect == drivers.DialectPinot || builder.dialect == drivers.DialectStarRocks {
+			// Druid, Pinot and StarRocks do not support ILIKE
 			clause = fmt.Sprintf("LOWER(%s) %s LIKE LOWER(CAST(%s AS VARCHAR))", leftExpr, notKeyword, rightExpr)
 		} else {
 			clause = fmt.Sprintf("(%s) %s ILIKE %s", leftExpr, notKeyword, rightExpr)
@@ -342,7 +346,11 @@ func (builder *ExpressionBuilder) buildInExpression(cond *runtimev1.Condition) (
 		questionMarks := strings.Join(valClauses, ",")
 		var clause string
 		// Build [NOT] list_has_any("dim", ARRAY[?, ?, ...])
-		if unnest && builder.dialect != drivers.DialectDruid {
+		if unnest && builder.dialect == drivers.DialectPostgres {
+			clause = fmt.Sprintf("%s ((%s) && ARRAY[%s])", notKeyword, leftExpr, questionMarks)
+		} else if unnest && builder.dialect == drivers.DialectStarRocks {
+			clause = fmt.Sprintf("%s arrays_overlap((%s), [%s])", notKeyword, leftExpr, questionMarks)
+		} else if unnest && builder.dialect != drivers.DialectDruid {
 			clause = fmt.Sprintf("%s list_has_any((%s), ARRAY[%s])", notKeyword, leftExpr, questionMarks)
 		} else {
 			clause = fmt.Sprintf("(%s) %s IN (%s)", leftExpr, notKeyword, questionMarks)
@@ -177,13 +177,30 @@ func (q *MetricsViewAggregation) rewriteToMetricsViewQuery(export bool) (*metric
 		if d.TimeZone != "" {
 			qry.TimeZone = d.TimeZone
 		}
-		if d.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+		switch c := d.Compute.(type) {
+		case *runtimev1.MetricsViewAggregationDimension_Cohort:
 			res.Compute = &metricsview.DimensionCompute{
-				TimeFloor: &metricsview.DimensionComputeTimeFloor{
-					Dimension: d.Name,
-					Grain:     metricsview.TimeGrainFromProto(d.TimeGrain),
+				Cohort: &metricsview.DimensionComputeCohort{
+					Dimension: c.Cohort.Dimension,
+					Grain:     metricsview.TimeGrainFromProto(c.Cohort.TimeGrain),
 				},
 			}
+		case *runtimev1.MetricsViewAggregationDimension_CohortOffset:
+			res.Compute = &metricsview.DimensionCompute{
+				CohortOffset: &metricsview.DimensionComputeCohortOffset{
+					Dimension: c.CohortOffset.Dimension,
+					Grain:     metricsview.TimeGrainFromProto(c.CohortOffset.TimeGrain),
+				},
+			}
+		default:
+			if d.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+				res.Compute = &metricsview.DimensionCompute{
+					TimeFloor: &metricsview.DimensionComputeTimeFloor{
+						Dimension: d.Name,
+						Grain:     metricsview.TimeGrainFromProto(d.TimeGrain),
+					},
+				}
+			}
 		}
 		qry.Dimensions = append(qry.Dimensions, res)
 	}
@@ -230,6 +247,24 @@ func (q *MetricsViewAggregation) rewriteToMetricsViewQuery(export bool) (*metric
 				res.Compute = &metricsview.MeasureCompute{URI: &metricsview.MeasureComputeURI{
 					Dimension: c.Uri.Dimension,
 				}}
+			case *runtimev1.MetricsViewAggregationMeasure_Retention:
+				res.Compute = &metricsview.MeasureCompute{Retention: &metricsview.MeasureComputeRetention{
+					Measure: c.Retention.Measure,
+				}}
+			case *runtimev1.MetricsViewAggregationMeasure_RunningTotal:
+				res.Compute = &metricsview.MeasureCompute{RunningTotal: &metricsview.MeasureComputeRunningTotal{
+					Measure: c.RunningTotal.Measure,
+				}}
+			case *runtimev1.MetricsViewAggregationMeasure_MovingAverage:
+				res.Compute = &metricsview.MeasureCompute{MovingAverage: &metricsview.MeasureComputeMovingAverage{
+					Measure: c.MovingAverage.Measure,
+					Periods: int(c.MovingAverage.Periods),
+				}}
+			case *runtimev1.MetricsViewAggregationMeasure_PeriodToDate:
+				res.Compute = &metricsview.MeasureCompute{PeriodToDate: &metricsview.MeasureComputePeriodToDate{
+					Measure: c.PeriodToDate.Measure,
+					Grain:   metricsview.TimeGrainFromProto(c.PeriodToDate.TimeGrain),
+				}}
 			}
 		}
 
@@ -13,6 +13,8 @@ import (
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -63,6 +65,95 @@ func TestMetricViewAggregationAgainstClickHouse(t *testing.T) {
 	t.Run("testMetricsViewsAggregation_comparison_with_offset_and_limit_and_delta", func(t *testing.T) {
 		testMetricsViewsAggregation_comparison_with_offset_and_limit_and_delta(t, rt, instanceID)
 	})
+	t.Run("testMetricsViewsAggregation_pivot_values", func(t *testing.T) { testMetricsViewsAggregation_pivot_values(t, rt, instanceID) })
+}
+
+func TestMetricViewAggregationAgainstStarRocks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starrocks: skipping test in short mode")
+	}
+	rt, instanceID := testruntime.NewInstanceWithStarRocksProject(t)
+	t.Run("testMetricsViewsAggregation", func(t *testing.T) { testMetricsViewsAggregation(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregationURI", func(t *testing.T) { testMetricsViewsAggregationURI(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_export_day", func(t *testing.T) { testMetricsViewsAggregation_export_day(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_export_hour", func(t *testing.T) { testMetricsViewsAggregation_export_hour(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_no_limit", func(t *testing.T) { testMetricsViewsAggregation_no_limit(t, rt, instanceID) })
+	t.Run("testMetricsViewAggregation_measure_filters", func(t *testing.T) { testMetricsViewAggregation_measure_filters(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_timezone", func(t *testing.T) { testMetricsViewsAggregation_timezone(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_filter", func(t *testing.T) { testMetricsViewsAggregation_filter(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_filter_with_timestamp", func(t *testing.T) { testMetricsViewsAggregation_filter_with_timestamp(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_filter_2dims", func(t *testing.T) { testMetricsViewsAggregation_filter_2dims(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_having_gt", func(t *testing.T) { testMetricsViewsAggregation_having_gt(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_having_same_name", func(t *testing.T) { testMetricsViewsAggregation_having_same_name(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_having", func(t *testing.T) { testMetricsViewsAggregation_having(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_where", func(t *testing.T) { testMetricsViewsAggregation_where(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_whereAndSQLBoth", func(t *testing.T) { testMetricsViewsAggregation_whereAndSQLBoth(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_filter_having_measure", func(t *testing.T) { testMetricsViewsAggregation_filter_having_measure(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_filter_with_where_and_having_measure", func(t *testing.T) {
+		testMetricsViewsAggregation_filter_with_where_and_having_measure(t, rt, instanceID)
+	})
+	t.Run("testMetricsViewsAggregation_2time_aggregations", func(t *testing.T) { testMetricsViewsAggregation_2time_aggregations(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_comparison_no_time_dim", func(t *testing.T) { testMetricsViewsAggregation_comparison_no_time_dim(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_comparison_no_dims", func(t *testing.T) { testMetricsViewsAggregation_comparison_no_dims(t, rt, instanceID) })
+	t.Run("TestMetricsViewsAggregation_comparison_measure_filter_with_a_single_derivative_measure", func(t *testing.T) {
+		testMetricsViewsAggregation_comparison_measure_filter_with_a_single_derivative_measure(t, rt, instanceID)
+	})
+	t.Run("testMetricsViewsAggregation_comparison_measure_filter_no_duplicates", func(t *testing.T) {
+		testMetricsViewsAggregation_comparison_measure_filter_no_duplicates(t, rt, instanceID)
+	})
+	t.Run("testMetricsViewsAggregation_comparison_measure_filter_with_totals", func(t *testing.T) {
+		testMetricsViewsAggregation_comparison_measure_filter_with_totals(t, rt, instanceID)
+	})
+	t.Run("testMetricsViewsAggregation_comparison_with_offset", func(t *testing.T) { testMetricsViewsAggregation_comparison_with_offset(t, rt, instanceID) })
+	t.Run("testMetricsViewAggregation_percent_of_totals", func(t *testing.T) { testMetricsViewAggregation_percent_of_totals(t, rt, instanceID) })
+	t.Run("testMetricsViewAggregation_percent_of_totals_with_limit", func(t *testing.T) { testMetricsViewAggregation_percent_of_totals_with_limit(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_comparison_with_offset_and_limit_and_delta", func(t *testing.T) {
+		testMetricsViewsAggregation_comparison_with_offset_and_limit_and_delta(t, rt, instanceID)
+	})
+}
+
+func TestMetricViewAggregationAgainstPostgres(t *testing.T) {
+	if testing.Short() {
+		t.Skip("postgres: skipping test in short mode")
+	}
+	rt, instanceID := testruntime.NewInstanceWithPostgresProject(t)
+	t.Run("testMetricsViewsAggregation", func(t *testing.T) { testMetricsViewsAggregation(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregationURI", func(t *testing.T) { testMetricsViewsAggregationURI(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_export_day", func(t *testing.T) { testMetricsViewsAggregation_export_day(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_export_hour", func(t *testing.T) { testMetricsViewsAggregation_export_hour(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_no_limit", func(t *testing.T) { testMetricsViewsAggregation_no_limit(t, rt, instanceID) })
+	t.Run("testMetricsViewAggregation_measure_filters", func(t *testing.T) { testMetricsViewAggregation_measure_filters(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_timezone", func(t *testing.T) { testMetricsViewsAggregation_timezone(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_filter", func(t *testing.T) { testMetricsViewsAggregation_filter(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_filter_with_timestamp", func(t *testing.T) { testMetricsViewsAggregation_filter_with_timestamp(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_filter_2dims", func(t *testing.T) { testMetricsViewsAggregation_filter_2dims(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_having_gt", func(t *testing.T) { testMetricsViewsAggregation_having_gt(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_having_same_name", func(t *testing.T) { testMetricsViewsAggregation_having_same_name(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_having", func(t *testing.T) { testMetricsViewsAggregation_having(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_where", func(t *testing.T) { testMetricsViewsAggregation_where(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_whereAndSQLBoth", func(t *testing.T) { testMetricsViewsAggregation_whereAndSQLBoth(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_filter_having_measure", func(t *testing.T) { testMetricsViewsAggregation_filter_having_measure(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_filter_with_where_and_having_measure", func(t *testing.T) {
+		testMetricsViewsAggregation_filter_with_where_and_having_measure(t, rt, instanceID)
+	})
+	t.Run("testMetricsViewsAggregation_2time_aggregations", func(t *testing.T) { testMetricsViewsAggregation_2time_aggregations(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_comparison_no_time_dim", func(t *testing.T) { testMetricsViewsAggregation_comparison_no_time_dim(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_comparison_no_dims", func(t *testing.T) { testMetricsViewsAggregation_comparison_no_dims(t, rt, instanceID) })
+	t.Run("TestMetricsViewsAggregation_comparison_measure_filter_with_a_single_derivative_measure", func(t *testing.T) {
+		testMetricsViewsAggregation_comparison_measure_filter_with_a_single_derivative_measure(t, rt, instanceID)
+	})
+	t.Run("testMetricsViewsAggregation_comparison_measure_filter_no_duplicates", func(t *testing.T) {
+		testMetricsViewsAggregation_comparison_measure_filter_no_duplicates(t, rt, instanceID)
+	})
+	t.Run("testMetricsViewsAggregation_comparison_measure_filter_with_totals", func(t *testing.T) {
+		testMetricsViewsAggregation_comparison_measure_filter_with_totals(t, rt, instanceID)
+	})
+	t.Run("testMetricsViewsAggregation_comparison_with_offset", func(t *testing.T) { testMetricsViewsAggregation_comparison_with_offset(t, rt, instanceID) })
+	t.Run("testMetricsViewAggregation_percent_of_totals", func(t *testing.T) { testMetricsViewAggregation_percent_of_totals(t, rt, instanceID) })
+	t.Run("testMetricsViewAggregation_percent_of_totals_with_limit", func(t *testing.T) { testMetricsViewAggregation_percent_of_totals_with_limit(t, rt, instanceID) })
+	t.Run("testMetricsViewsAggregation_comparison_with_offset_and_limit_and_delta", func(t *testing.T) {
+		testMetricsViewsAggregation_comparison_with_offset_and_limit_and_delta(t, rt, instanceID)
+	})
 }
 
 func TestMetricViewAggregationAgainstDuckDB(t *testing.T) {
@@ -496,6 +587,358 @@ func TestMetricsViewsAggregation_pivot(t *testing.T) {
 	require.Equal(t, "Yahoo", fieldsToString(rows[i], "pub"))
 }
 
+func TestMetricsViewsAggregation_pivot_values(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+	testMetricsViewsAggregation_pivot_values(t, rt, instanceID)
+}
+
+// testMetricsViewsAggregation_pivot_values checks that each pivoted cell matches the value of the unpivoted aggregation.
+func testMetricsViewsAggregation_pivot_values(t *testing.T, rt *runtime.Runtime, instanceID string) {
+	dims := []*runtimev1.MetricsViewAggregationDimension{
+		{
+			Name: "pub",
+		},
+		{
+			Name:      "timestamp",
+			TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+		},
+	}
+	measures := []*runtimev1.MetricsViewAggregationMeasure{
+		{
+			Name: "measure_1",
+		},
+	}
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions:      dims,
+		Measures:        measures,
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "pub",
+			},
+			{
+				Name: "timestamp",
+			},
+		},
+		SecurityClaims: testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result.Data)
+
+	expected := make(map[string]map[string]float64)
+	months := make(map[string]bool)
+	for _, row := range q.Result.Data {
+		pub := fieldsToString(row, "pub")
+		month := row.Fields["timestamp"].GetStringValue()
+		if expected[pub] == nil {
+			expected[pub] = make(map[string]float64)
+		}
+		expected[pub][month] = row.Fields["measure_1"].GetNumberValue()
+		months[month] = true
+	}
+	sortedMonths := maps.Keys(months)
+	slices.Sort(sortedMonths)
+
+	pq := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions:      dims,
+		Measures:        measures,
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "pub",
+			},
+		},
+		PivotOn: []string{
+			"timestamp",
+		},
+		SecurityClaims: testClaims(),
+	}
+	err = pq.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Len(t, pq.Result.Data, len(expected))
+
+	// The pivoted columns are ordered by the pivot values
+	fields := pq.Result.Schema.Fields
+	require.Len(t, fields, 1+len(sortedMonths))
+	require.Equal(t, "pub", fields[0].Name)
+	for _, row := range pq.Result.Data {
+		pub := fieldsToString(row, "pub")
+		require.Contains(t, expected, pub)
+		for i, f := range fields[1:] {
+			require.True(t, strings.HasSuffix(f.Name, "_measure_1"), f.Name)
+			want, ok := expected[pub][sortedMonths[i]]
+			if !ok {
+				continue
+			}
+			require.InDelta(t, want, row.Fields[f.Name].GetNumberValue(), 1e-6, "pub %q, column %q", pub, f.Name)
+		}
+	}
+}
+
+func TestMetricsViewsAggregation_cohort_retention(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name: "cohort",
+				Compute: &runtimev1.MetricsViewAggregationDimension_Cohort{
+					Cohort: &runtimev1.MetricsViewAggregationDimensionComputeCohort{
+						Dimension: "dom",
+						TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+					},
+				},
+			},
+			{
+				Name: "offset",
+				Compute: &runtimev1.MetricsViewAggregationDimension_CohortOffset{
+					CohortOffset: &runtimev1.MetricsViewAggregationDimensionComputeCohortOffset{
+						Dimension: "dom",
+						TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+					},
+				},
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "measure_0",
+			},
+			{
+				Name: "measure_0__retention",
+				Compute: &runtimev1.MetricsViewAggregationMeasure_Retention{
+					Retention: &runtimev1.MetricsViewAggregationMeasureComputeRetention{
+						Measure: "measure_0",
+					},
+				},
+			},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "cohort",
+			},
+			{
+				Name: "offset",
+			},
+		},
+		SecurityClaims: testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result.Data)
+
+	require.Equal(t, 4, len(q.Result.Schema.Fields))
+	require.Equal(t, "cohort", q.Result.Schema.Fields[0].Name)
+	require.Equal(t, "offset", q.Result.Schema.Fields[1].Name)
+	require.Equal(t, "measure_0", q.Result.Schema.Fields[2].Name)
+	require.Equal(t, "measure_0__retention", q.Result.Schema.Fields[3].Name)
+
+	for _, row := range q.Result.Data {
+		offset := row.Fields["offset"].GetNumberValue()
+		require.GreaterOrEqual(t, offset, float64(0))
+		if offset == 0 {
+			require.Equal(t, "1", fieldsToString(row, "measure_0__retention"))
+		}
+	}
+}
+
+func TestMetricsViewsAggregation_running_total(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name:      "timestamp",
+				TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "measure_0",
+			},
+			{
+				Name: "measure_0__running_total",
+				Compute: &runtimev1.MetricsViewAggregationMeasure_RunningTotal{
+					RunningTotal: &runtimev1.MetricsViewAggregationMeasureComputeRunningTotal{
+						Measure: "measure_0",
+					},
+				},
+			},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "timestamp",
+			},
+		},
+		SecurityClaims: testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result.Data)
+
+	var total float64
+	for _, row := range q.Result.Data {
+		total += row.Fields["measure_0"].GetNumberValue()
+		require.Equal(t, total, row.Fields["measure_0__running_total"].GetNumberValue())
+	}
+}
+
+func TestMetricsViewsAggregation_moving_average(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name:      "timestamp",
+				TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "measure_0",
+			},
+			{
+				Name: "measure_0__moving_average",
+				Compute: &runtimev1.MetricsViewAggregationMeasure_MovingAverage{
+					MovingAverage: &runtimev1.MetricsViewAggregationMeasureComputeMovingAverage{
+						Measure: "measure_0",
+						Periods: 3,
+					},
+				},
+			},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "timestamp",
+			},
+		},
+		SecurityClaims: testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result.Data)
+
+	var vals []float64
+	for _, row := range q.Result.Data {
+		vals = append(vals, row.Fields["measure_0"].GetNumberValue())
+		window := vals[max(0, len(vals)-3):]
+		var sum float64
+		for _, v := range window {
+			sum += v
+		}
+		require.InDelta(t, sum/float64(len(window)), row.Fields["measure_0__moving_average"].GetNumberValue(), 1e-9)
+	}
+}
+
+func TestMetricsViewsAggregation_period_to_date(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name:      "timestamp",
+				TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "measure_0",
+			},
+			{
+				Name: "measure_0__month_to_date",
+				Compute: &runtimev1.MetricsViewAggregationMeasure_PeriodToDate{
+					PeriodToDate: &runtimev1.MetricsViewAggregationMeasureComputePeriodToDate{
+						Measure:   "measure_0",
+						TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+					},
+				},
+			},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "timestamp",
+			},
+		},
+		SecurityClaims: testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result.Data)
+
+	// The total resets at the start of each month
+	var total float64
+	var month string
+	for _, row := range q.Result.Data {
+		ts := row.Fields["timestamp"].GetStringValue()
+		if ts[:7] != month {
+			month = ts[:7]
+			total = 0
+		}
+		total += row.Fields["measure_0"].GetNumberValue()
+		require.InDelta(t, total, row.Fields["measure_0__month_to_date"].GetNumberValue(), 1e-9)
+	}
+}
+
+func TestMetricsViewsAggregation_running_total_mixed_grains(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "ad_bids_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{
+				Name:      "timestamp",
+				TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH,
+				Alias:     "timestamp_month",
+			},
+			{
+				Name:      "timestamp",
+				TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+				Alias:     "timestamp_day",
+			},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{
+				Name: "measure_0",
+			},
+			{
+				Name: "measure_0__running_total",
+				Compute: &runtimev1.MetricsViewAggregationMeasure_RunningTotal{
+					RunningTotal: &runtimev1.MetricsViewAggregationMeasureComputeRunningTotal{
+						Measure: "measure_0",
+					},
+				},
+			},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{
+				Name: "timestamp_day",
+			},
+		},
+		SecurityClaims: testClaims(),
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, q.Result.Data)
+
+	// The window is ordered by the finest grain (day) and partitioned by the coarser one (month)
+	var total float64
+	var month string
+	for _, row := range q.Result.Data {
+		m := row.Fields["timestamp_month"].GetStringValue()
+		if m != month {
+			month = m
+			total = 0
+		}
+		total += row.Fields["measure_0"].GetNumberValue()
+		require.InDelta(t, total, row.Fields["measure_0__running_total"].GetNumberValue(), 1e-9)
+	}
+}
+
 func TestMetricsViewsAggregation_pivot_export_labels_2_time_columns_limit_exceeded_error(t *testing.T) {
 	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
 
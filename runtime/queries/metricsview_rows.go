@@ -72,7 +72,7 @@ func (q *MetricsViewRows) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot && olap.Dialect() != drivers.DialectPostgres && olap.Dialect() != drivers.DialectStarRocks {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -152,7 +152,7 @@ func (q *MetricsViewRows) Export(ctx context.Context, rt *runtime.Runtime, insta
 				return err
 			}
 		}
-	case drivers.DialectDruid, drivers.DialectClickHouse, drivers.DialectPinot:
+	case drivers.DialectDruid, drivers.DialectClickHouse, drivers.DialectPinot, drivers.DialectPostgres, drivers.DialectStarRocks:
 		if err := q.generalExport(ctx, rt, instanceID, w, opts, q.MetricsView); err != nil {
 			return err
 		}
@@ -237,7 +237,7 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsViewSpec, dia
 	whereClause := "1=1"
 	args := []any{}
 	if mv.TimeDimension != "" {
-		td := safeName(mv.TimeDimension)
+		td := dialect.EscapeIdentifier(mv.TimeDimension)
 		if dialect == drivers.DialectDuckDB {
 			td = fmt.Sprintf("%s::TIMESTAMP", td)
 		}
@@ -273,7 +273,7 @@ func (q *MetricsViewRows) buildMetricsRowsSQL(mv *runtimev1.MetricsViewSpec, dia
 
 	sortingCriteria := make([]string, 0, len(q.Sort))
 	for _, s := range q.Sort {
-		sortCriterion := safeName(s.Name)
+		sortCriterion := dialect.EscapeIdentifier(s.Name)
 		if !s.Ascending {
 			sortCriterion += " DESC"
 		}
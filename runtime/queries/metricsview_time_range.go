@@ -80,6 +80,10 @@ func (q *MetricsViewTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime,
 		return q.resolveClickHouseAndPinot(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectClickHouse, q.MetricsView), policyFilter, priority)
 	case drivers.DialectPinot:
 		return q.resolveClickHouseAndPinot(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectPinot, q.MetricsView), policyFilter, priority)
+	case drivers.DialectPostgres:
+		return q.resolveClickHouseAndPinot(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectPostgres, q.MetricsView), policyFilter, priority)
+	case drivers.DialectStarRocks:
+		return q.resolveClickHouseAndPinot(ctx, olap, q.MetricsView.TimeDimension, escapeMetricsViewTable(drivers.DialectStarRocks, q.MetricsView), policyFilter, priority)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
@@ -241,7 +245,7 @@ func (q *MetricsViewTimeRange) resolveClickHouseAndPinot(ctx context.Context, ol
 
 	rangeSQL := fmt.Sprintf(
 		"SELECT min(%[1]s) AS \"min\", max(%[1]s) AS \"max\" FROM %[2]s %[3]s",
-		safeName(timeDim),
+		olap.Dialect().EscapeIdentifier(timeDim),
 		escapedTableName,
 		filter,
 	)
@@ -64,7 +64,7 @@ func (q *TableHead) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectPinot {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectPinot && olap.Dialect() != drivers.DialectPostgres && olap.Dialect() != drivers.DialectStarRocks {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -121,7 +121,7 @@ func (q *TableHead) Export(ctx context.Context, rt *runtime.Runtime, instanceID
 		if err := q.generalExport(ctx, rt, instanceID, w, opts); err != nil {
 			return err
 		}
-	case drivers.DialectClickHouse:
+	case drivers.DialectClickHouse, drivers.DialectPostgres, drivers.DialectStarRocks:
 		if err := q.generalExport(ctx, rt, instanceID, w, opts); err != nil {
 			return err
 		}
@@ -188,7 +188,7 @@ func supportedColumns(ctx context.Context, olap drivers.OLAPStore, db, schema, t
 	}
 	var columns []string
 	for _, field := range tbl.Schema.Fields {
-		columns = append(columns, safeName(field.Name))
+		columns = append(columns, olap.Dialect().EscapeIdentifier(field.Name))
 	}
 	return columns, nil
 }
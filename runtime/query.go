@@ -7,25 +7,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/dgraph-io/ristretto"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
-	"github.com/rilldata/rill/runtime/pkg/singleflight"
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
 
-var (
-	meter                        = otel.Meter("github.com/rilldata/rill/runtime")
-	queryCacheHitsCounter        = observability.Must(meter.Int64ObservableCounter("query_cache.hits"))
-	queryCacheMissesCounter      = observability.Must(meter.Int64ObservableCounter("query_cache.misses"))
-	queryCacheItemCountGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.items"))
-	queryCacheSizeBytesGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.size", metric.WithUnit("bytes")))
-	queryCacheEntrySizeHistogram = observability.Must(meter.Int64Histogram("query_cache.entry_size", metric.WithUnit("bytes")))
-)
-
 type QueryResult struct {
 	Value any
 	Bytes int64
@@ -122,17 +110,19 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	}.String()
 
 	// Try to get from cache
-	if val, ok := r.queryCache.cache.Get(key); ok {
+	if val, ok := r.queryCache.objects.Get(key); ok {
 		observability.AddRequestAttributes(ctx, attribute.Bool("query.cache_hit", true))
+		r.queryCache.recordLookup(ctx, instanceID, "query", true)
 		return query.UnmarshalResult(val)
 	}
 	observability.AddRequestAttributes(ctx, attribute.Bool("query.cache_hit", false))
+	r.queryCache.recordLookup(ctx, instanceID, "query", false)
 
 	// Load with singleflight
 	owner := false
 	val, err := r.queryCache.singleflight.Do(ctx, key, func(ctx context.Context) (any, error) {
 		// Try cache again
-		if val, ok := r.queryCache.cache.Get(key); ok {
+		if val, ok := r.queryCache.objects.Get(key); ok {
 			return val, nil
 		}
 
@@ -144,7 +134,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 
 		owner = true
 		res := query.MarshalResult()
-		r.queryCache.cache.Set(key, res.Value, res.Bytes)
+		r.queryCache.objects.Set(key, res.Value, res.Bytes)
 		queryCacheEntrySizeHistogram.Record(ctx, res.Bytes, metric.WithAttributes(attribute.String("query", queryName(query))))
 		return res.Value, nil
 	})
@@ -158,59 +148,6 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	return nil
 }
 
-type queryCacheKey struct {
-	instanceID    string
-	queryKey      string
-	dependencyKey string
-}
-
-func (k queryCacheKey) String() string {
-	return fmt.Sprintf("inst:%s deps:%s qry:%s", k.instanceID, k.dependencyKey, k.queryKey)
-}
-
-type queryCache struct {
-	cache        *ristretto.Cache
-	singleflight *singleflight.Group[string, any]
-	metrics      metric.Registration
-}
-
-func newQueryCache(sizeInBytes int64) *queryCache {
-	if sizeInBytes <= 100 {
-		panic(fmt.Sprintf("invalid cache size should be greater than 100: %v", sizeInBytes))
-	}
-	cache, err := ristretto.NewCache(&ristretto.Config{
-		// Use 5% of cache memory for storing counters. Each counter takes roughly 3 bytes.
-		// Recommended value is 10x the number of items in cache when full.
-		// Tune this again based on metrics.
-		NumCounters: int64(float64(sizeInBytes) * 0.05 / 3),
-		MaxCost:     int64(float64(sizeInBytes) * 0.95),
-		BufferItems: 64,
-		Metrics:     true,
-	})
-	if err != nil {
-		panic(err)
-	}
-
-	metrics := observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
-		observer.ObserveInt64(queryCacheHitsCounter, int64(cache.Metrics.Hits()))
-		observer.ObserveInt64(queryCacheMissesCounter, int64(cache.Metrics.Misses()))
-		observer.ObserveInt64(queryCacheItemCountGauge, int64(cache.Metrics.KeysAdded()-cache.Metrics.KeysEvicted()))
-		observer.ObserveInt64(queryCacheSizeBytesGauge, int64(cache.Metrics.CostAdded()-cache.Metrics.CostEvicted()))
-		return nil
-	}, queryCacheHitsCounter, queryCacheMissesCounter, queryCacheItemCountGauge, queryCacheSizeBytesGauge))
-
-	return &queryCache{
-		cache:        cache,
-		singleflight: &singleflight.Group[string, any]{},
-		metrics:      metrics,
-	}
-}
-
-func (c *queryCache) close() error {
-	c.cache.Close()
-	return c.metrics.Unregister()
-}
-
 func queryName(q Query) string {
 	nameWithPkg := fmt.Sprintf("%T", q)
 	_, after, _ := strings.Cut(nameWithPkg, ".")
@@ -0,0 +1,127 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/singleflight"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	meter                        = otel.Meter("github.com/rilldata/rill/runtime")
+	queryCacheHitsCounter        = observability.Must(meter.Int64Counter("query_cache.hits"))
+	queryCacheMissesCounter      = observability.Must(meter.Int64Counter("query_cache.misses"))
+	queryCacheItemCountGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.items"))
+	queryCacheSizeBytesGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.size", metric.WithUnit("bytes")))
+	queryCacheEntrySizeHistogram = observability.Must(meter.Int64Histogram("query_cache.entry_size", metric.WithUnit("bytes")))
+)
+
+// QueryCacheBackend is a pluggable storage backend for cached resolver results.
+// It stores serialized results, which enables implementations that persist results outside of the process (for example on disk),
+// such that the cache survives restarts and hibernation.
+//
+// Keys include the state of the data the result was computed from (see Runtime.Resolve), so backends don't need to handle invalidation.
+// They should however bound their size by evicting entries, and must not return entries after their TTL has passed.
+// Implementations must be safe for concurrent use.
+type QueryCacheBackend interface {
+	// Get returns the value stored for the key. It returns false if the key is not found or has expired.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores a value for the key. A ttl of zero means the value doesn't expire.
+	// It may silently drop the value (for example if it exceeds the backend's capacity).
+	Set(ctx context.Context, key string, val []byte, ttl time.Duration) error
+	// Close releases the backend's resources.
+	Close() error
+}
+
+// defaultQueryCacheTTL is the default TTL for cached results of resolvers that read data managed outside of Rill (see ResolverWithCacheKey).
+// Their cache keys may not capture all changes to the data (e.g. restatements that don't move the watermark), so their results must expire.
+const defaultQueryCacheTTL = 5 * time.Minute
+
+// queryCacheDataKeyTTL is how long the data key of a resolver (see ResolverWithCacheKey) is reused before it is computed again.
+// It avoids running a query (e.g. for the watermark) before every cache lookup.
+const queryCacheDataKeyTTL = 30 * time.Second
+
+type queryCacheKey struct {
+	instanceID    string
+	queryKey      string
+	dependencyKey string
+}
+
+func (k queryCacheKey) String() string {
+	return fmt.Sprintf("inst:%s deps:%s qry:%s", k.instanceID, k.dependencyKey, k.queryKey)
+}
+
+// queryCache caches query and resolver results.
+// Results are cached as in-process values in memory, unless a backend is configured, in which case resolver results are serialized and stored in the backend.
+// Results of legacy queries (see Query) are always cached in memory.
+type queryCache struct {
+	objects      *ristretto.Cache
+	backend      QueryCacheBackend // Optional
+	ttl          time.Duration
+	singleflight *singleflight.Group[string, any]
+	metrics      metric.Registration
+}
+
+func newQueryCache(sizeInBytes int64, backend QueryCacheBackend, ttl time.Duration) *queryCache {
+	if sizeInBytes <= 100 {
+		panic(fmt.Sprintf("invalid cache size should be greater than 100: %v", sizeInBytes))
+	}
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		// Use 5% of cache memory for storing counters. Each counter takes roughly 3 bytes.
+		// Recommended value is 10x the number of items in cache when full.
+		// Tune this again based on metrics.
+		NumCounters: int64(float64(sizeInBytes) * 0.05 / 3),
+		MaxCost:     int64(float64(sizeInBytes) * 0.95),
+		BufferItems: 64,
+		Metrics:     true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if ttl <= 0 {
+		ttl = defaultQueryCacheTTL
+	}
+
+	metrics := observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		observer.ObserveInt64(queryCacheItemCountGauge, int64(cache.Metrics.KeysAdded()-cache.Metrics.KeysEvicted()))
+		observer.ObserveInt64(queryCacheSizeBytesGauge, int64(cache.Metrics.CostAdded()-cache.Metrics.CostEvicted()))
+		return nil
+	}, queryCacheItemCountGauge, queryCacheSizeBytesGauge))
+
+	return &queryCache{
+		objects:      cache,
+		backend:      backend,
+		ttl:          ttl,
+		singleflight: &singleflight.Group[string, any]{},
+		metrics:      metrics,
+	}
+}
+
+func (c *queryCache) close() error {
+	var err error
+	if c.backend != nil {
+		err = c.backend.Close()
+	}
+	c.objects.Close()
+	if err2 := c.metrics.Unregister(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+// recordLookup records a cache hit or miss for the instance.
+func (c *queryCache) recordLookup(ctx context.Context, instanceID, kind string, hit bool) {
+	attrs := metric.WithAttributes(attribute.String("instance_id", instanceID), attribute.String("kind", kind))
+	if hit {
+		queryCacheHitsCounter.Add(ctx, 1, attrs)
+	} else {
+		queryCacheMissesCounter.Add(ctx, 1, attrs)
+	}
+}
@@ -0,0 +1,246 @@
+package runtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	diskQueryCacheItemCountGauge = observability.Must(meter.Int64ObservableGauge("query_cache.disk.items"))
+	diskQueryCacheSizeBytesGauge = observability.Must(meter.Int64ObservableGauge("query_cache.disk.size", metric.WithUnit("bytes")))
+)
+
+// diskQueryCacheEvictionRatio is the fraction of the max size that the disk cache is trimmed to when it exceeds its max size.
+// Trimming below the max size amortizes the cost of evictions.
+const diskQueryCacheEvictionRatio = 0.9
+
+// diskQueryCacheHeaderSize is the size of the header that precedes the value in each file.
+// The header contains the entry's expiration time as Unix nanoseconds (zero if it doesn't expire).
+const diskQueryCacheHeaderSize = 8
+
+// diskQueryCacheBackend is a QueryCacheBackend that persists values as files in a directory.
+// It bounds the total size of the files by evicting the least recently used entries, and removes expired entries when they are accessed.
+type diskQueryCacheBackend struct {
+	dir      string
+	maxBytes int64
+	metrics  metric.Registration
+
+	mu      sync.Mutex
+	entries map[string]*diskQueryCacheEntry // Maps file names to entries
+	size    int64
+}
+
+type diskQueryCacheEntry struct {
+	size       int64
+	lastAccess time.Time
+}
+
+var _ QueryCacheBackend = (*diskQueryCacheBackend)(nil)
+
+// NewDiskQueryCacheBackend creates a QueryCacheBackend that persists cached results in the given directory.
+// Entries already in the directory (e.g. from before a restart) are retained, subject to the max size.
+func NewDiskQueryCacheBackend(dir string, maxBytes int64) (QueryCacheBackend, error) {
+	if maxBytes <= 0 {
+		return nil, fmt.Errorf("invalid disk query cache size: %d", maxBytes)
+	}
+
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &diskQueryCacheBackend{
+		dir:      dir,
+		maxBytes: maxBytes,
+		entries:  make(map[string]*diskQueryCacheEntry),
+	}
+
+	// Load existing entries
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(f.Name(), ".tmp") {
+			// Incomplete write from a previous process
+			_ = os.Remove(filepath.Join(dir, f.Name()))
+			continue
+		}
+		info, err := f.Info()
+		if err != nil {
+			continue
+		}
+		b.entries[f.Name()] = &diskQueryCacheEntry{size: info.Size(), lastAccess: info.ModTime()}
+		b.size += info.Size()
+	}
+	b.mu.Lock()
+	b.evictLocked()
+	b.mu.Unlock()
+
+	b.metrics = observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		observer.ObserveInt64(diskQueryCacheItemCountGauge, int64(len(b.entries)))
+		observer.ObserveInt64(diskQueryCacheSizeBytesGauge, b.size)
+		return nil
+	}, diskQueryCacheItemCountGauge, diskQueryCacheSizeBytesGauge))
+
+	return b, nil
+}
+
+func (b *diskQueryCacheBackend) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	name := b.fileName(key)
+
+	b.mu.Lock()
+	e, ok := b.entries[name]
+	if ok {
+		e.lastAccess = time.Now()
+	}
+	b.mu.Unlock()
+	if !ok {
+		return nil, false, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(b.dir, name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// Evicted concurrently
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if len(data) < diskQueryCacheHeaderSize {
+		// Corrupt or written by an older version
+		b.mu.Lock()
+		err = b.removeLocked(name)
+		b.mu.Unlock()
+		return nil, false, err
+	}
+	expiresOn := int64(binary.BigEndian.Uint64(data[:diskQueryCacheHeaderSize]))
+	if expiresOn != 0 && time.Now().UnixNano() >= expiresOn {
+		b.mu.Lock()
+		err = b.removeLocked(name)
+		b.mu.Unlock()
+		return nil, false, err
+	}
+
+	return data[diskQueryCacheHeaderSize:], true, nil
+}
+
+func (b *diskQueryCacheBackend) Set(ctx context.Context, key string, val []byte, ttl time.Duration) error {
+	size := int64(diskQueryCacheHeaderSize + len(val))
+	if size > b.maxBytes {
+		return nil
+	}
+
+	var header [diskQueryCacheHeaderSize]byte
+	if ttl > 0 {
+		binary.BigEndian.PutUint64(header[:], uint64(time.Now().Add(ttl).UnixNano()))
+	}
+
+	// Write to a temporary file and rename it to atomically replace any existing entry
+	name := b.fileName(key)
+	path := filepath.Join(b.dir, name)
+	tmp, err := os.CreateTemp(b.dir, name+"-*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(header[:])
+	if err == nil {
+		_, err = tmp.Write(val)
+	}
+	if err2 := tmp.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	if e, ok := b.entries[name]; ok {
+		b.size -= e.size
+	}
+	b.entries[name] = &diskQueryCacheEntry{size: size, lastAccess: time.Now()}
+	b.size += size
+	b.evictLocked()
+
+	return nil
+}
+
+func (b *diskQueryCacheBackend) Close() error {
+	if b.metrics != nil {
+		return b.metrics.Unregister()
+	}
+	return nil
+}
+
+// evictLocked removes the least recently used entries until the cache is below its max size.
+// It must be called while holding b.mu.
+func (b *diskQueryCacheBackend) evictLocked() {
+	if b.size <= b.maxBytes {
+		return
+	}
+
+	names := make([]string, 0, len(b.entries))
+	for name := range b.entries {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, c string) int {
+		return b.entries[a].lastAccess.Compare(b.entries[c].lastAccess)
+	})
+
+	target := int64(float64(b.maxBytes) * diskQueryCacheEvictionRatio)
+	for _, name := range names {
+		if b.size <= target {
+			break
+		}
+		_ = b.removeLocked(name)
+	}
+}
+
+// removeLocked deletes an entry's file and removes it from the index.
+// It must be called while holding b.mu.
+func (b *diskQueryCacheBackend) removeLocked(name string) error {
+	err := os.Remove(filepath.Join(b.dir, name))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if e, ok := b.entries[name]; ok {
+		b.size -= e.size
+		delete(b.entries, name)
+	}
+	return nil
+}
+
+// fileName returns the name of the file that stores the value for a key.
+func (b *diskQueryCacheBackend) fileName(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
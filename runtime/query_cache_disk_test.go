@@ -0,0 +1,94 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskQueryCacheBackend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	b, err := NewDiskQueryCacheBackend(dir, 100)
+	require.NoError(t, err)
+
+	// Miss
+	_, ok, err := b.Get(ctx, "a")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Set and get
+	require.NoError(t, b.Set(ctx, "a", []byte("hello"), 0))
+	val, ok, err := b.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "hello", string(val))
+
+	// Overwrite
+	require.NoError(t, b.Set(ctx, "a", []byte("world"), 0))
+	val, ok, err = b.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "world", string(val))
+
+	// Values larger than the max size are dropped
+	require.NoError(t, b.Set(ctx, "big", make([]byte, 101), 0))
+	_, ok, err = b.Get(ctx, "big")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Exceeding the max size evicts the least recently used entries
+	require.NoError(t, b.Set(ctx, "b", make([]byte, 40), 0))
+	_, _, err = b.Get(ctx, "a")
+	require.NoError(t, err)
+	require.NoError(t, b.Set(ctx, "c", make([]byte, 60), 0))
+	_, ok, err = b.Get(ctx, "b")
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, ok, err = b.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, b.Close())
+
+	// Entries persist across restarts
+	b, err = NewDiskQueryCacheBackend(dir, 100)
+	require.NoError(t, err)
+	val, ok, err = b.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "world", string(val))
+	_, ok, err = b.Get(ctx, "c")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, b.Close())
+
+	// Expired entries are removed
+	b, err = NewDiskQueryCacheBackend(dir, 100)
+	require.NoError(t, err)
+	require.NoError(t, b.Set(ctx, "d", []byte("expiring"), time.Millisecond))
+	time.Sleep(10 * time.Millisecond)
+	_, ok, err = b.Get(ctx, "d")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NoError(t, b.Close())
+}
+
+func TestCachedResolverResultEncoding(t *testing.T) {
+	res := &mapsResolverResult{
+		rows: []map[string]any{{"a": 1.0}, {"a": 2.0}},
+	}
+	cRes, err := newCachedResolverResult(res)
+	require.NoError(t, err)
+
+	data, err := cRes.encode()
+	require.NoError(t, err)
+	decoded, err := decodeCachedResolverResult(data)
+	require.NoError(t, err)
+
+	row, err := decoded.Next()
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"a": 1.0}, row)
+}
@@ -0,0 +1,86 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestResolverResultCache(t *testing.T) {
+	ctx := context.Background()
+	disk, err := NewDiskQueryCacheBackend(t.TempDir(), 1000)
+	require.NoError(t, err)
+
+	for name, backend := range map[string]QueryCacheBackend{"memory": nil, "disk": disk} {
+		t.Run(name, func(t *testing.T) {
+			rt := &Runtime{Logger: zap.NewNop(), queryCache: newQueryCache(10000, backend, 0)}
+			defer rt.queryCache.close()
+
+			res, err := newCachedResolverResult(&mapsResolverResult{rows: []map[string]any{{"a": 1.0}}})
+			require.NoError(t, err)
+
+			// Results without a TTL don't expire
+			require.NoError(t, rt.setCachedResolverResult(ctx, "a", res, 0))
+			// Results with a TTL expire
+			require.NoError(t, rt.setCachedResolverResult(ctx, "b", res, 50*time.Millisecond))
+			rt.queryCache.objects.Wait()
+
+			cached, ok, err := rt.getCachedResolverResult(ctx, "b")
+			require.NoError(t, err)
+			require.True(t, ok)
+			row, err := cached.copy().Next()
+			require.NoError(t, err)
+			require.Equal(t, map[string]any{"a": 1.0}, row)
+			if backend == nil {
+				// The memory backend caches the result itself
+				require.Same(t, res, cached)
+			}
+
+			time.Sleep(100 * time.Millisecond)
+			_, ok, err = rt.getCachedResolverResult(ctx, "a")
+			require.NoError(t, err)
+			require.True(t, ok)
+			_, ok, err = rt.getCachedResolverResult(ctx, "b")
+			require.NoError(t, err)
+			require.False(t, ok)
+		})
+	}
+}
+
+func TestResolverDataKey(t *testing.T) {
+	ctx := context.Background()
+	rt := &Runtime{Logger: zap.NewNop(), queryCache: newQueryCache(10000, nil, 0)}
+	defer rt.queryCache.close()
+
+	ck := &countingCacheKeyResolver{key: "2024-01-01T00:00:00Z"}
+
+	// The key is reused for the same data hash
+	key, err := rt.resolverDataKey(ctx, "default", "foo", ck)
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-01T00:00:00Z", key)
+	rt.queryCache.objects.Wait()
+	ck.key = "2024-01-02T00:00:00Z"
+	key, err = rt.resolverDataKey(ctx, "default", "foo", ck)
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-01T00:00:00Z", key)
+	require.Equal(t, 1, ck.calls)
+
+	// It's computed again for a different data hash (e.g. after the refs' state changed)
+	key, err = rt.resolverDataKey(ctx, "default", "bar", ck)
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-02T00:00:00Z", key)
+	require.Equal(t, 2, ck.calls)
+}
+
+type countingCacheKeyResolver struct {
+	key   string
+	calls int
+}
+
+func (r *countingCacheKeyResolver) CacheKey(ctx context.Context) (string, error) {
+	r.calls++
+	return r.key, nil
+}
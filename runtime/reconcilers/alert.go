@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -99,6 +100,14 @@ func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		}
 	}
 
+	// Apply acknowledgements and snoozes submitted through the API
+	if a.Spec.StatusChange != nil {
+		err := r.applyStatusChange(ctx, self, a)
+		if err != nil {
+			return runtime.ReconcileResult{Err: fmt.Errorf("failed to apply status change: %w", err)}
+		}
+	}
+
 	// Exit early if disabled
 	if a.Spec.RefreshSchedule != nil && a.Spec.RefreshSchedule.Disable {
 		return runtime.ReconcileResult{}
@@ -158,6 +167,10 @@ func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		a.State.CurrentExecution = nil
 		a.State.ExecutionHistory = nil
 		a.State.ExecutionCount = 0
+		a.State.ConsecutiveFailures = 0
+		a.State.EscalationLevel = 0
+		a.State.AcknowledgedOn = nil
+		a.State.AcknowledgedBy = ""
 		err = r.C.UpdateState(ctx, self.Meta.Name, self)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -374,6 +387,52 @@ func (r *AlertReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.Reso
 	return r.C.UpdateSpec(ctx, self.Meta.Name, self)
 }
 
+// applyStatusChange applies the pending acknowledgement or snooze in a.Spec.StatusChange to the alert's state and clears it from the spec.
+func (r *AlertReconciler) applyStatusChange(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert) error {
+	change := a.Spec.StatusChange
+
+	if change.Acknowledge {
+		a.State.AcknowledgedOn = timestamppb.Now()
+		a.State.AcknowledgedBy = change.Actor
+		r.C.Logger.Info("Alert acknowledged", zap.String("name", self.Meta.Name.Name), zap.String("actor", change.Actor))
+	}
+	if change.Unsnooze {
+		a.State.SnoozedUntil = nil
+		a.State.SnoozedBy = ""
+		r.C.Logger.Info("Alert unsnoozed", zap.String("name", self.Meta.Name.Name), zap.String("actor", change.Actor))
+	} else if change.SnoozeUntil != nil {
+		a.State.SnoozedUntil = change.SnoozeUntil
+		a.State.SnoozedBy = change.Actor
+		r.C.Logger.Info("Alert snoozed", zap.String("name", self.Meta.Name.Name), zap.String("actor", change.Actor), zap.Time("until", change.SnoozeUntil.AsTime()))
+	}
+
+	err := r.C.UpdateState(ctx, self.Meta.Name, self)
+	if err != nil {
+		return err
+	}
+
+	// Clear the status change from the spec.
+	// Unlike the State, the Spec may be edited concurrently with a Reconcile call, so we need to read and edit it under a lock.
+	r.C.Lock(ctx)
+	defer r.C.Unlock(ctx)
+
+	latest, err := r.C.Get(ctx, self.Meta.Name, false)
+	if err != nil {
+		return err
+	}
+	la := latest.GetAlert()
+	if la == nil {
+		return fmt.Errorf("not an alert")
+	}
+
+	// Don't clear the status change if a new one was submitted in the meantime (it will be applied on the next reconcile)
+	if !proto.Equal(la.Spec.StatusChange, change) {
+		return nil
+	}
+	la.Spec.StatusChange = nil
+	return r.C.UpdateSpec(ctx, latest.Meta.Name, latest)
+}
+
 // executeAll runs queries and (maybe) sends notifications for the alert. It also adds entries to a.State.ExecutionHistory.
 // By default, an alert is checked once for the current watermark, but if a.Spec.IntervalsIsoDuration is set, it will be checked *for each* interval that has elapsed since the previous execution watermark.
 func (r *AlertReconciler) executeAll(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert, triggerTime time.Time, adhocTrigger bool) error {
@@ -586,8 +645,9 @@ func (r *AlertReconciler) executeSingleWrapped(ctx context.Context, self *runtim
 
 	r.C.Logger.Info("Alert failed", zap.String("name", self.Meta.Name.Name), zap.Time("execution_time", executionTime))
 
-	// Return fail row
-	failRow, err := structpb.NewStruct(row)
+	// Return fail row.
+	// Using pbutil.ToStruct because rows that are not served from the cache may contain time.Time values (e.g. anomalies).
+	failRow, err := pbutil.ToStruct(row, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert fail row to proto: %w", err)
 	}
@@ -603,6 +663,24 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 
 	current := a.State.CurrentExecution
 
+	// Track consecutive failures. Acknowledgements only last until the alert recovers.
+	if current.Result.Status == runtimev1.AssertionStatus_ASSERTION_STATUS_PASS {
+		a.State.ConsecutiveFailures = 0
+		a.State.AcknowledgedOn = nil
+		a.State.AcknowledgedBy = ""
+	} else {
+		a.State.ConsecutiveFailures++
+	}
+
+	// Clear expired snoozes
+	if a.State.SnoozedUntil != nil && !time.Now().Before(a.State.SnoozedUntil.AsTime()) {
+		a.State.SnoozedUntil = nil
+		a.State.SnoozedBy = ""
+	}
+
+	// Notifications are silenced while the alert is snoozed or its current failure is acknowledged
+	current.Silenced = a.State.SnoozedUntil != nil || a.State.AcknowledgedOn != nil
+
 	// td represents the amount of time since we last sent a notification for the current status AND where all intervening executions have returned the same status.
 	var td *time.Duration
 	var lastNotifyTime time.Time
@@ -618,6 +696,10 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 			if prev.Result.Status != current.Result.Status {
 				break
 			}
+			if prev.Silenced {
+				// Notify once the alert is no longer acknowledged or snoozed
+				break
+			}
 			if !prev.SentNotifications {
 				// If notifications were not sent we store since when we are suppressing
 				if prev.SuppressedSince != nil {
@@ -662,6 +744,21 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 		}
 	}
 
+	// Determine the escalation tiers that have been reached
+	escalationLevel := alertEscalationLevel(a, current.Result.Status)
+	escalate := escalationLevel > a.State.EscalationLevel
+
+	// Don't notify or escalate while silenced.
+	// Recoveries are handled below, since they are still sent to PagerDuty.
+	if current.Silenced && (notify || escalate) && current.Result.Status != runtimev1.AssertionStatus_ASSERTION_STATUS_PASS {
+		r.C.Logger.Info("Alert notifications silenced", zap.String("name", self.Meta.Name.Name), zap.Bool("acknowledged", a.State.AcknowledgedOn != nil), zap.Bool("snoozed", a.State.SnoozedUntil != nil))
+		notify = false
+		escalate = false
+	}
+
+	// Determine the notifiers to notify
+	notifiers := alertExecutionNotifiers(a, escalationLevel, notify, escalate)
+
 	// Get execution time
 	var executionTime time.Time
 	if current.ExecutionTime != nil {
@@ -670,13 +767,9 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 
 	// Generate the notification message to send (if any)
 	var msg *drivers.AlertStatus
-	if notify {
+	if notify || escalate {
 		switch current.Result.Status {
 		case runtimev1.AssertionStatus_ASSERTION_STATUS_PASS:
-			if !a.Spec.NotifyOnRecover {
-				break
-			}
-
 			// Check this is a recovery, i.e. that the previous status was something other than a PASS
 			if len(a.State.ExecutionHistory) == 0 {
 				break
@@ -686,6 +779,19 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 				break
 			}
 
+			// PagerDuty incidents stay open until they are resolved, so recoveries are always sent to PagerDuty (even while silenced)
+			if !a.Spec.NotifyOnRecover || current.Silenced {
+				notifiers = slices.DeleteFunc(notifiers, func(n *runtimev1.Notifier) bool {
+					return n.Connector != "pagerduty"
+				})
+				if len(notifiers) == 0 {
+					if current.Silenced {
+						r.C.Logger.Info("Alert notifications silenced", zap.String("name", self.Meta.Name.Name), zap.Bool("acknowledged", a.State.AcknowledgedOn != nil), zap.Bool("snoozed", a.State.SnoozedUntil != nil))
+					}
+					break
+				}
+			}
+
 			msg = &drivers.AlertStatus{
 				Title:         a.Spec.Title,
 				ExecutionTime: executionTime,
@@ -723,6 +829,7 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 	var notificationErr error
 	var sentNotifications bool
 	if msg != nil {
+		msg.Name = self.Meta.Name.Name
 		if adminMeta != nil {
 			// Note: adminMeta may not always be available (if outside of cloud). In those cases, we leave the links blank (no clickthrough available).
 			openLink, err := addExecutionTime(adminMeta.OpenURL, executionTime)
@@ -733,7 +840,7 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 			msg.EditLink = adminMeta.EditURL
 		}
 
-		for _, notifier := range a.Spec.Notifiers {
+		for _, notifier := range notifiers {
 			switch notifier.Connector {
 			// TODO: transform email client to notifier
 			case "email":
@@ -781,6 +888,12 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 			}
 		}
 		sentNotifications = true
+		a.State.EscalationLevel = escalationLevel
+	}
+
+	// Reset escalations when the alert recovers
+	if current.Result.Status == runtimev1.AssertionStatus_ASSERTION_STATUS_PASS {
+		a.State.EscalationLevel = 0
 	}
 
 	// If sending notifications failed, add the error as an execution error.
@@ -804,6 +917,37 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 	return r.C.UpdateState(ctx, self.Meta.Name, self)
 }
 
+// alertEscalationLevel returns the number of escalation tiers that have been reached after an execution with the given status.
+// It expects a.State.ConsecutiveFailures to have been updated for the execution.
+func alertEscalationLevel(a *runtimev1.Alert, status runtimev1.AssertionStatus) uint32 {
+	// The escalations may have been edited since the level was reached
+	level := min(a.State.EscalationLevel, uint32(len(a.Spec.Escalations)))
+	if status == runtimev1.AssertionStatus_ASSERTION_STATUS_PASS {
+		return level
+	}
+	for int(level) < len(a.Spec.Escalations) && a.Spec.Escalations[level].AfterExecutions <= a.State.ConsecutiveFailures {
+		level++
+	}
+	return level
+}
+
+// alertExecutionNotifiers returns the notifiers to notify for an execution.
+// Regular notifications also go to the escalation tiers that have been reached. Escalations without a regular notification only go to the new tiers.
+func alertExecutionNotifiers(a *runtimev1.Alert, level uint32, notify, escalate bool) []*runtimev1.Notifier {
+	var notifiers []*runtimev1.Notifier
+	if notify {
+		notifiers = append(notifiers, a.Spec.Notifiers...)
+		for _, e := range a.Spec.Escalations[:level] {
+			notifiers = append(notifiers, e.Notifiers...)
+		}
+	} else if escalate {
+		for _, e := range a.Spec.Escalations[min(a.State.EscalationLevel, level):level] {
+			notifiers = append(notifiers, e.Notifiers...)
+		}
+	}
+	return notifiers
+}
+
 // computeInheritedWatermark computes the inherited watermark for the alert.
 // It returns false if the watermark could not be computed.
 func (r *AlertReconciler) computeInheritedWatermark(ctx context.Context, refs []*runtimev1.ResourceName) (time.Time, bool, error) {
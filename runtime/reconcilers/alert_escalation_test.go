@@ -0,0 +1,64 @@
+package reconcilers
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertEscalation(t *testing.T) {
+	base := &runtimev1.Notifier{Connector: "email"}
+	tier1 := &runtimev1.Notifier{Connector: "slack"}
+	tier2 := &runtimev1.Notifier{Connector: "pagerduty"}
+	a := &runtimev1.Alert{
+		Spec: &runtimev1.AlertSpec{
+			Notifiers: []*runtimev1.Notifier{base},
+			Escalations: []*runtimev1.AlertEscalation{
+				{AfterExecutions: 3, Notifiers: []*runtimev1.Notifier{tier1}},
+				{AfterExecutions: 5, Notifiers: []*runtimev1.Notifier{tier2}},
+			},
+		},
+		State: &runtimev1.AlertState{},
+	}
+	fail := runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL
+	pass := runtimev1.AssertionStatus_ASSERTION_STATUS_PASS
+
+	// The first failure only notifies the base notifiers
+	a.State.ConsecutiveFailures = 1
+	level := alertEscalationLevel(a, fail)
+	require.Equal(t, uint32(0), level)
+	require.Equal(t, []*runtimev1.Notifier{base}, alertExecutionNotifiers(a, level, true, false))
+
+	// Still failing without renotify, so only the first tier is notified when it's reached
+	a.State.ConsecutiveFailures = 3
+	level = alertEscalationLevel(a, fail)
+	require.Equal(t, uint32(1), level)
+	require.Equal(t, []*runtimev1.Notifier{tier1}, alertExecutionNotifiers(a, level, false, level > a.State.EscalationLevel))
+	a.State.EscalationLevel = level
+
+	// Renotifications go to the base notifiers and the tiers that have been reached
+	a.State.ConsecutiveFailures = 4
+	level = alertEscalationLevel(a, fail)
+	require.Equal(t, uint32(1), level)
+	require.Equal(t, []*runtimev1.Notifier{base, tier1}, alertExecutionNotifiers(a, level, true, false))
+	require.Empty(t, alertExecutionNotifiers(a, level, false, false))
+
+	// Multiple tiers can be reached at once (e.g. after a snooze)
+	a.State.EscalationLevel = 0
+	a.State.ConsecutiveFailures = 7
+	level = alertEscalationLevel(a, fail)
+	require.Equal(t, uint32(2), level)
+	require.Equal(t, []*runtimev1.Notifier{tier1, tier2}, alertExecutionNotifiers(a, level, false, true))
+	a.State.EscalationLevel = level
+
+	// Recoveries are sent to the tiers that were reached
+	a.State.ConsecutiveFailures = 0
+	level = alertEscalationLevel(a, pass)
+	require.Equal(t, uint32(2), level)
+	require.Equal(t, []*runtimev1.Notifier{base, tier1, tier2}, alertExecutionNotifiers(a, level, true, false))
+
+	// The level is capped if escalations are removed
+	a.Spec.Escalations = a.Spec.Escalations[:1]
+	require.Equal(t, uint32(1), alertEscalationLevel(a, pass))
+}
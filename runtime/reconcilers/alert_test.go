@@ -1,8 +1,13 @@
 package reconcilers_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -14,6 +19,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	_ "github.com/rilldata/rill/runtime/drivers/pagerduty"
 	_ "github.com/rilldata/rill/runtime/resolvers"
 )
 
@@ -575,6 +581,337 @@ SELECT '2024-01-02T00:00:00Z'::TIMESTAMP as __time, 'Sweden' as country
 	require.Contains(t, emails[0].Body, "measure_0")
 }
 
+func TestAlertAnomaly(t *testing.T) {
+	rt, id := testruntime.NewInstanceForProject(t, "ad_bids")
+
+	// The number of bids on 2022-03-18 is more than three standard deviations above the preceding week
+	a := testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "ad_bids_anomaly")
+	require.Empty(t, a.Meta.ReconcileError)
+	require.Equal(t, []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: "ad_bids_metrics_view"}}, a.Meta.Refs)
+	require.Equal(t, "metrics_anomaly", a.GetAlert().Spec.Resolver)
+
+	history := a.GetAlert().State.ExecutionHistory
+	require.Len(t, history, 1)
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, history[0].Result.Status)
+	require.True(t, history[0].SentNotifications)
+
+	row := history[0].Result.FailRow.AsMap()
+	require.Equal(t, float64(1206), row["total_records"])
+	require.InDelta(t, 1087, row["baseline"], 0.001)
+	require.InDelta(t, 3.689, row["score"], 0.001)
+	require.Contains(t, row["timestamp"], "2022-03-18")
+
+	// Check that the alert was sent
+	emails := rt.Email.Sender.(*email.TestSender).Emails
+	require.Len(t, emails, 1)
+	require.Equal(t, "somebody@example.com", emails[0].ToEmail)
+	require.Contains(t, emails[0].Body, "total_records")
+}
+
+func TestAlertPagerDutyRecover(t *testing.T) {
+	// Record the actions of the events sent to PagerDuty
+	var mu sync.Mutex
+	var actions []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e struct {
+			EventAction string `json:"event_action"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+		mu.Lock()
+		actions = append(actions, e.EventAction)
+		mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/connectors/pagerduty.yaml": fmt.Sprintf(`
+type: connector
+driver: pagerduty
+routing_key: key
+events_url: %s
+`, srv.URL),
+		"/models/bar.sql": `
+-- @materialize: true
+SELECT 'Denmark' as country
+`,
+		"/alerts/a1.yaml": `
+type: alert
+title: Test Alert
+refs:
+- type: Model
+  name: bar
+data:
+  sql: select * from bar where country <> 'Denmark'
+notify:
+  pagerduty:
+    severity: critical
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+
+	// The alert fails, which triggers an incident
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.sql": `
+-- @materialize: true
+SELECT 'Sweden' as country
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	require.Equal(t, []string{"trigger"}, actions)
+
+	// The alert recovers, which resolves the incident even though on_recover is not set
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.sql": `
+-- @materialize: true
+SELECT 'Denmark' as country
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	require.Equal(t, []string{"trigger", "resolve"}, actions)
+}
+
+func TestAlertSilenced(t *testing.T) {
+	rt, id := newAlertTestInstance(t, `
+type: alert
+title: Test Alert
+refs:
+- type: MetricsView
+  name: mv1
+watermark: inherit
+intervals:
+  duration: P1D
+data:
+  metrics_sql: select measure_0 from mv1 where country <> 'Denmark' having measure_0 > 0
+renotify: true
+notify:
+  email:
+    recipients:
+      - somebody@example.com
+`)
+	sender := rt.Email.Sender.(*email.TestSender)
+
+	// The alert fails and notifies
+	putAlertTestDays(t, rt, id, 2, "Sweden")
+	require.Len(t, sender.Emails, 1)
+
+	// Acknowledged failures don't renotify
+	changeAlertStatus(t, rt, id, "a1", &runtimev1.AlertStatusChange{Acknowledge: true, Actor: "somebody@example.com"})
+	a := testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+	require.NotNil(t, a.State.AcknowledgedOn)
+	require.Equal(t, "somebody@example.com", a.State.AcknowledgedBy)
+	putAlertTestDays(t, rt, id, 3, "Sweden")
+	require.Len(t, sender.Emails, 1)
+	a = testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, a.State.ExecutionHistory[0].Result.Status)
+	require.True(t, a.State.ExecutionHistory[0].Silenced)
+	require.False(t, a.State.ExecutionHistory[0].SentNotifications)
+
+	// The acknowledgement ends when the alert recovers
+	putAlertTestDays(t, rt, id, 4, "Denmark")
+	a = testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+	require.Nil(t, a.State.AcknowledgedOn)
+	require.Len(t, sender.Emails, 1)
+
+	// Snoozed failures don't notify
+	changeAlertStatus(t, rt, id, "a1", &runtimev1.AlertStatusChange{SnoozeUntil: timestamppb.New(time.Now().Add(time.Hour))})
+	putAlertTestDays(t, rt, id, 5, "Sweden")
+	require.Len(t, sender.Emails, 1)
+	a = testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+	require.NotNil(t, a.State.SnoozedUntil)
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, a.State.ExecutionHistory[0].Result.Status)
+	require.True(t, a.State.ExecutionHistory[0].Silenced)
+
+	// Notifications resume when the snooze is cleared
+	changeAlertStatus(t, rt, id, "a1", &runtimev1.AlertStatusChange{Unsnooze: true})
+	putAlertTestDays(t, rt, id, 6, "Sweden")
+	require.Len(t, sender.Emails, 2)
+}
+
+func TestAlertSilencedRecover(t *testing.T) {
+	// Record the actions of the events sent to PagerDuty
+	var mu sync.Mutex
+	var actions []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e struct {
+			EventAction string `json:"event_action"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+		mu.Lock()
+		actions = append(actions, e.EventAction)
+		mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	rt, id := newAlertTestInstance(t, `
+type: alert
+title: Test Alert
+refs:
+- type: MetricsView
+  name: mv1
+watermark: inherit
+intervals:
+  duration: P1D
+data:
+  metrics_sql: select measure_0 from mv1 where country <> 'Denmark' having measure_0 > 0
+on_recover: true
+notify:
+  email:
+    recipients:
+      - somebody@example.com
+  pagerduty:
+    severity: critical
+`)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/connectors/pagerduty.yaml": fmt.Sprintf(`
+type: connector
+driver: pagerduty
+routing_key: key
+events_url: %s
+`, srv.URL),
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	sender := rt.Email.Sender.(*email.TestSender)
+
+	// The alert fails, which notifies and triggers an incident
+	putAlertTestDays(t, rt, id, 2, "Sweden")
+	require.Len(t, sender.Emails, 1)
+	require.Equal(t, []string{"trigger"}, actions)
+
+	// The alert recovers while snoozed, which still resolves the incident, but doesn't send the recovery email
+	changeAlertStatus(t, rt, id, "a1", &runtimev1.AlertStatusChange{SnoozeUntil: timestamppb.New(time.Now().Add(time.Hour))})
+	putAlertTestDays(t, rt, id, 3, "Denmark")
+	require.Len(t, sender.Emails, 1)
+	require.Equal(t, []string{"trigger", "resolve"}, actions)
+	a := testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_PASS, a.State.ExecutionHistory[0].Result.Status)
+	require.True(t, a.State.ExecutionHistory[0].Silenced)
+	require.True(t, a.State.ExecutionHistory[0].SentNotifications)
+}
+
+func TestAlertEscalations(t *testing.T) {
+	rt, id := newAlertTestInstance(t, `
+type: alert
+title: Test Alert
+refs:
+- type: MetricsView
+  name: mv1
+watermark: inherit
+intervals:
+  duration: P1D
+data:
+  metrics_sql: select measure_0 from mv1 where country <> 'Denmark' having measure_0 > 0
+on_recover: true
+notify:
+  email:
+    recipients:
+      - first@example.com
+escalations:
+- after_executions: 2
+  notify:
+    email:
+      recipients:
+        - second@example.com
+- after_executions: 3
+  notify:
+    email:
+      recipients:
+        - third@example.com
+`)
+	sender := rt.Email.Sender.(*email.TestSender)
+	sent := func() []string {
+		var res []string
+		for _, e := range sender.Emails {
+			res = append(res, e.ToEmail)
+		}
+		return res
+	}
+
+	// The first failure only notifies the base recipients
+	putAlertTestDays(t, rt, id, 2, "Sweden")
+	require.Equal(t, []string{"first@example.com"}, sent())
+
+	// Each tier is only notified when the alert has kept failing for its number of executions
+	putAlertTestDays(t, rt, id, 3, "Sweden")
+	require.Equal(t, []string{"first@example.com", "second@example.com"}, sent())
+	require.Equal(t, uint32(1), testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert().State.EscalationLevel)
+
+	putAlertTestDays(t, rt, id, 4, "Sweden")
+	require.Equal(t, []string{"first@example.com", "second@example.com", "third@example.com"}, sent())
+	require.Equal(t, uint32(2), testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert().State.EscalationLevel)
+
+	// No new tier is reached, so nothing is sent without renotify
+	putAlertTestDays(t, rt, id, 5, "Sweden")
+	require.Len(t, sender.Emails, 3)
+
+	// The recovery is sent to all tiers that were reached and resets the escalation
+	putAlertTestDays(t, rt, id, 6, "Denmark")
+	require.Equal(t, []string{"first@example.com", "second@example.com", "third@example.com"}, sent()[3:])
+	a := testruntime.GetResource(t, rt, id, runtime.ResourceKindAlert, "a1").GetAlert()
+	require.Equal(t, uint32(0), a.State.EscalationLevel)
+	require.Equal(t, uint32(0), a.State.ConsecutiveFailures)
+}
+
+// newAlertTestInstance creates an instance with the given alert on a metrics view "mv1" that has a row for Denmark on 2024-01-01.
+func newAlertTestInstance(t *testing.T, alertYAML string) (*runtime.Runtime, string) {
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.sql": `
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+`,
+		"/metrics/mv1.yaml": `
+version: 1
+type: metrics_view
+title: mv1
+model: bar
+timeseries: __time
+dimensions:
+- column: country
+measures:
+- expression: count(*)
+`,
+		"/alerts/a1.yaml": alertYAML,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+	return rt, id
+}
+
+// putAlertTestDays replaces the data of the "bar" model with a row for the given country on each of the first days of 2024.
+// Since the alert runs once for each day, adding one day at a time runs it once.
+func putAlertTestDays(t *testing.T, rt *runtime.Runtime, id string, days int, country string) {
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.sql": fmt.Sprintf(`
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP + to_days(i::INTEGER) as __time, '%s' as country FROM range(%d) t(i)
+`, country, days),
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+}
+
+// changeAlertStatus acknowledges or snoozes an alert in the same way as the AcknowledgeAlert and SnoozeAlert APIs.
+func changeAlertStatus(t *testing.T, rt *runtime.Runtime, id, alert string, change *runtimev1.AlertStatusChange) {
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+
+	n := &runtimev1.ResourceName{Kind: runtime.ResourceKindRefreshTrigger, Name: fmt.Sprintf("trigger_%s", time.Now().Format(time.RFC3339Nano))}
+	err = ctrl.Create(context.Background(), n, nil, nil, nil, true, &runtimev1.Resource{
+		Resource: &runtimev1.Resource_RefreshTrigger{
+			RefreshTrigger: &runtimev1.RefreshTrigger{
+				Spec: &runtimev1.RefreshTriggerSpec{
+					Alerts: []*runtimev1.RefreshAlertTrigger{{Alert: alert, StatusChange: change}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = ctrl.WaitUntilIdle(context.Background(), false)
+	require.NoError(t, err)
+}
+
 func newMetricsView(name, model, timeDim string, measures, dimensions []string) (*runtimev1.MetricsViewV2, *runtimev1.Resource) {
 	metrics := &runtimev1.MetricsViewV2{
 		Spec: &runtimev1.MetricsViewSpec{
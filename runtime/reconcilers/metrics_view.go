@@ -78,6 +78,24 @@ func (r *MetricsViewReconciler) Reconcile(ctx context.Context, n *runtimev1.Reso
 		}
 	}
 
+	// Resolve the tables of the models that materialize the metrics view's rollups.
+	// Rollups that have not been materialized yet (or that are in a different connector) are left without a table, which means they are not used for queries.
+	for _, rollup := range mv.Spec.Rollups {
+		rollup.Database = ""
+		rollup.DatabaseSchema = ""
+		rollup.Table = ""
+
+		res, err := r.C.Get(ctx, &runtimev1.ResourceName{Name: rollup.Model, Kind: runtime.ResourceKindModel}, false)
+		if err != nil {
+			continue
+		}
+		state := res.GetModel().State
+		if state.ResultTable == "" || state.ResultConnector != mv.Spec.Connector {
+			continue
+		}
+		rollup.Table = state.ResultTable
+	}
+
 	// NOTE: In other reconcilers, state like spec_hash and refreshed_on is used to avoid redundant reconciles.
 	// We don't do that here because none of the operations below are particularly expensive.
 	// So it doesn't really matter if they run a bit more often than necessary ¯\_(ツ)_/¯.
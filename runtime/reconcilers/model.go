@@ -22,6 +22,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -235,14 +236,30 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		}
 	}
 
+	// If the model has blocking tests, we run them against the new result before it replaces the previous result.
+	// Only executors that stage changes call prePromote, and only on full runs, so on incremental runs (and for other executors) the tests are run after the result has been promoted (see below).
+	var testResults []*runtimev1.ModelTestResult
+	var prePromote func(ctx context.Context, staged *drivers.ModelResult) error
+	if hasBlockingModelTests(model.Spec) {
+		prePromote = func(ctx context.Context, staged *drivers.ModelResult) error {
+			testResults = r.runModelTests(ctx, self, staged, true, nil)
+			err := blockingModelTestsError(model.Spec, testResults)
+			if err != nil {
+				// The state keeps the test results of the previous result, which is still served, so the rejected result's test results are only reported in the error
+				return fmt.Errorf("the new result was not promoted: %w", err)
+			}
+			return nil
+		}
+	}
+
 	// Build the model
-	executorConnector, execRes, execErr := r.executeAll(ctx, self, model, modelEnv, triggerReset, prevResult)
+	executorConnector, execRes, execErr := r.executeAll(ctx, self, model, modelEnv, triggerReset, prevResult, prePromote)
 
 	// After the model has executed successfully, we re-evaluate the model's incremental state (not to be confused with the resource state)
 	var newIncrementalState *structpb.Struct
 	var newIncrementalStateSchema *runtimev1.StructType
 	if execErr == nil {
-		newIncrementalState, newIncrementalStateSchema, execErr = r.resolveIncrementalState(ctx, model)
+		newIncrementalState, newIncrementalStateSchema, execErr = r.resolveIncrementalState(ctx, model, execRes)
 	}
 
 	// If the model is split, track if any of the splits have errors
@@ -261,6 +278,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	}
 
 	// If the build succeeded, update the model's state accodingly
+	var testsErr error
 	if execErr == nil {
 		model.State.ExecutorConnector = executorConnector
 		model.State.SpecHash = specHash
@@ -269,6 +287,8 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		model.State.IncrementalState = newIncrementalState
 		model.State.IncrementalStateSchema = newIncrementalStateSchema
 		model.State.SplitsHaveErrors = splitsHaveErrors
+		model.State.TestResults = r.runModelTests(ctx, self, execRes, false, testResults)
+		testsErr = blockingModelTestsError(model.Spec, model.State.TestResults)
 		err := r.updateStateWithResult(ctx, self, execRes)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
@@ -319,6 +339,11 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Err: errSplitsHaveErrors, Retrigger: refreshOn}
 	}
 
+	// Show if any blocking tests failed (but could not block the result because the executor doesn't stage changes)
+	if testsErr != nil {
+		return runtime.ReconcileResult{Err: testsErr, Retrigger: refreshOn}
+	}
+
 	// Return the next refresh time
 	return runtime.ReconcileResult{Retrigger: refreshOn}
 }
@@ -462,6 +487,17 @@ func (r *ModelReconciler) executionSpecHash(ctx context.Context, refs []*runtime
 		}
 	}
 
+	if spec.Snapshot != nil {
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(spec.Snapshot)
+		if err != nil {
+			return "", err
+		}
+		_, err = hash.Write(b)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
@@ -540,6 +576,7 @@ func (r *ModelReconciler) updateStateClear(ctx context.Context, self *runtimev1.
 	mdl.State.IncrementalStateSchema = nil
 	mdl.State.SplitsModelId = ""
 	mdl.State.SplitsHaveErrors = false
+	mdl.State.TestResults = nil
 
 	return r.C.UpdateState(ctx, self.Meta.Name, self)
 }
@@ -568,14 +605,22 @@ func (r *ModelReconciler) updateTriggerFalse(ctx context.Context, n *runtimev1.R
 // resolveIncrementalState resolves the incremental state of a model using its configured incremental state resolver.
 // Note the ambiguity around "state" in models – all resources have a "spec" and a "state",
 // but models also have a resolver for "incremental state" that enables incremental/stateful computation by persisting data from the previous execution.
-// It returns nil results if an incremental state resolver is not configured or does not return any data.
-func (r *ModelReconciler) resolveIncrementalState(ctx context.Context, mdl *runtimev1.ModelV2) (*structpb.Struct, *runtimev1.StructType, error) {
+// If an incremental state resolver is not configured, it falls back to the incremental state reported by the executor in the execution result (if any).
+// It returns nil results if neither is available or the resolver does not return any data.
+func (r *ModelReconciler) resolveIncrementalState(ctx context.Context, mdl *runtimev1.ModelV2, execRes *drivers.ModelResult) (*structpb.Struct, *runtimev1.StructType, error) {
 	if !mdl.Spec.Incremental {
 		return nil, nil, nil
 	}
 
 	if mdl.Spec.IncrementalStateResolver == "" {
-		return nil, nil, nil
+		if execRes == nil || execRes.IncrementalState == nil {
+			return nil, nil, nil
+		}
+		state, err := structpb.NewStruct(execRes.IncrementalState)
+		if err != nil {
+			return nil, nil, fmt.Errorf("model executor produced invalid incremental state: %w", err)
+		}
+		return state, nil, nil
 	}
 
 	res, err := r.C.Runtime.Resolve(ctx, &runtime.ResolveOptions{
@@ -794,7 +839,8 @@ func (r *ModelReconciler) clearSplits(ctx context.Context, mdl *runtimev1.ModelV
 
 // executeAll executes all splits (if any) of a model with the given execution options.
 // Note that triggerReset only denotes if a reset is required. Even if it is false, the model will still be reset if it's not an incremental model.
-func (r *ModelReconciler) executeAll(ctx context.Context, self *runtimev1.Resource, model *runtimev1.ModelV2, env *drivers.ModelEnv, triggerReset bool, prevResult *drivers.ModelResult) (string, *drivers.ModelResult, error) {
+// The prePromote callback is optional and only used for models without splits (see drivers.ModelExecuteOptions.PrePromote).
+func (r *ModelReconciler) executeAll(ctx context.Context, self *runtimev1.Resource, model *runtimev1.ModelV2, env *drivers.ModelEnv, triggerReset bool, prevResult *drivers.ModelResult, prePromote func(ctx context.Context, staged *drivers.ModelResult) error) (string, *drivers.ModelResult, error) {
 	// Prepare the incremental state to pass to the executor
 	useSplits := model.Spec.SplitsResolver != ""
 	incrementalRun := false
@@ -858,7 +904,7 @@ func (r *ModelReconciler) executeAll(ctx context.Context, self *runtimev1.Resour
 
 	// If we're not splitting execution, run the executor directly and return
 	if !useSplits {
-		res, err := r.executeSingle(ctx, executor, self, model, prevResult, incrementalRun, incrementalState, nil)
+		res, err := r.executeSingle(ctx, executor, self, model, prevResult, incrementalRun, incrementalState, nil, prePromote)
 		if err != nil {
 			return "", nil, err
 		}
@@ -1050,7 +1096,7 @@ func (r *ModelReconciler) executeSplit(ctx context.Context, catalog drivers.Cata
 	// Execute the split.
 	start := time.Now()
 	errStr := ""
-	res, err := r.executeSingle(ctx, executor, self, mdl, prevResult, incrementalRun, incrementalState, data)
+	res, err := r.executeSingle(ctx, executor, self, mdl, prevResult, incrementalRun, incrementalState, data, nil)
 	if err != nil {
 		// Unless cancelled or explicitly told to return the error, we save the error in the split and continue.
 		if returnErr {
@@ -1077,8 +1123,8 @@ func (r *ModelReconciler) executeSplit(ctx context.Context, catalog drivers.Cata
 	return res, res != nil, nil
 }
 
-// executeSingle executes a single step of a model. Passing a previous result, incremental state, and/or a split is optional.
-func (r *ModelReconciler) executeSingle(ctx context.Context, executor *wrappedModelExecutor, self *runtimev1.Resource, mdl *runtimev1.ModelV2, prevResult *drivers.ModelResult, incrementalRun bool, incrementalState, split map[string]any) (*drivers.ModelResult, error) {
+// executeSingle executes a single step of a model. Passing a previous result, incremental state, a split and/or a prePromote callback is optional.
+func (r *ModelReconciler) executeSingle(ctx context.Context, executor *wrappedModelExecutor, self *runtimev1.Resource, mdl *runtimev1.ModelV2, prevResult *drivers.ModelResult, incrementalRun bool, incrementalState, split map[string]any, prePromote func(ctx context.Context, staged *drivers.ModelResult) error) (*drivers.ModelResult, error) {
 	// Resolve templating in the input and output props
 	inputProps, err := r.resolveTemplatedProps(ctx, self, incrementalState, split, mdl.Spec.InputConnector, mdl.Spec.InputProperties.AsMap())
 	if err != nil {
@@ -1107,6 +1153,7 @@ func (r *ModelReconciler) executeSingle(ctx context.Context, executor *wrappedMo
 			IncrementalRun:       incrementalRun,
 			SplitRun:             split != nil,
 			PreviousResult:       prevResult,
+			IncrementalState:     incrementalState,
 		})
 		if err != nil {
 			return nil, err
@@ -1126,6 +1173,15 @@ func (r *ModelReconciler) executeSingle(ctx context.Context, executor *wrappedMo
 		}()
 	}
 
+	// Snapshot models maintain their history in the output connector, so they can't use a stage connector
+	snapshot, err := r.modelSnapshotOptions(ctx, mdl.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot != nil && executor.stage != nil {
+		return nil, errors.New("snapshot models can't use a stage connector")
+	}
+
 	// Execute the final step
 	finalResult, err := executor.final.Execute(ctx, &drivers.ModelExecuteOptions{
 		ModelExecutorOptions: executor.finalOpts,
@@ -1136,6 +1192,9 @@ func (r *ModelReconciler) executeSingle(ctx context.Context, executor *wrappedMo
 		IncrementalRun:       incrementalRun,
 		SplitRun:             split != nil,
 		PreviousResult:       prevResult,
+		IncrementalState:     incrementalState,
+		Snapshot:             snapshot,
+		PrePromote:           prePromote,
 	})
 	if err != nil {
 		return nil, err
@@ -1143,6 +1202,38 @@ func (r *ModelReconciler) executeSingle(ctx context.Context, executor *wrappedMo
 	return finalResult, nil
 }
 
+// modelSnapshotOptions converts the model's snapshot config (if any) to the options passed to the executor.
+func (r *ModelReconciler) modelSnapshotOptions(ctx context.Context, spec *runtimev1.ModelSpec) (*drivers.ModelSnapshot, error) {
+	if spec.Snapshot == nil {
+		return nil, nil
+	}
+
+	s := &drivers.ModelSnapshot{
+		UniqueKey:             spec.Snapshot.UniqueKey,
+		Strategy:              drivers.ModelSnapshotStrategy(spec.Snapshot.Strategy),
+		UpdatedAt:             spec.Snapshot.UpdatedAt,
+		CheckColumns:          spec.Snapshot.CheckColumns,
+		InvalidateHardDeletes: spec.Snapshot.InvalidateHardDeletes,
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	if spec.InputConnector != spec.OutputConnector {
+		return nil, errors.New("snapshot models must have the same input and output connector")
+	}
+
+	olap, release, err := r.C.AcquireOLAP(ctx, spec.OutputConnector)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot models must output to an OLAP connector: %w", err)
+	}
+	defer release()
+	if d := olap.Dialect(); d != drivers.DialectDuckDB && d != drivers.DialectClickHouse {
+		return nil, fmt.Errorf("snapshot models are not supported for dialect %q", d.String())
+	}
+
+	return s, nil
+}
+
 // wrappedModelExecutor is a ModelExecutor wraps one or two ModelExecutors. It is used to execute a model with a staging connector.
 // If the model does not require a staging connector, the wrappedModelExecutor will only wrap the final executor.
 type wrappedModelExecutor struct {
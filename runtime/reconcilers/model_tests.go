@@ -0,0 +1,235 @@
+package reconcilers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+// errModelTestsFailed is returned when one or more blocking tests of a model fail.
+var errModelTestsFailed = errors.New("model tests failed")
+
+// runModelTests runs the model's tests against the given result and returns the results.
+// If blockingOnly is true, only tests with block set are run.
+// Tests that have a result in prevResults are not run again; their previous result is returned instead.
+func (r *ModelReconciler) runModelTests(ctx context.Context, self *runtimev1.Resource, res *drivers.ModelResult, blockingOnly bool, prevResults []*runtimev1.ModelTestResult) []*runtimev1.ModelTestResult {
+	mdl := self.GetModel()
+	if len(mdl.Spec.Tests) == 0 {
+		return nil
+	}
+
+	olap, release, err := r.C.AcquireOLAP(ctx, res.Connector)
+	if err != nil {
+		err = fmt.Errorf("tests can only be run for models that output to an OLAP connector: %w", err)
+	} else {
+		defer release()
+	}
+
+	var results []*runtimev1.ModelTestResult
+	for _, t := range mdl.Spec.Tests {
+		if blockingOnly && !t.Block {
+			continue
+		}
+
+		var prev *runtimev1.ModelTestResult
+		for _, pr := range prevResults {
+			if pr.Name == t.Name {
+				prev = pr
+				break
+			}
+		}
+		if prev != nil {
+			results = append(results, prev)
+			continue
+		}
+
+		if err != nil {
+			results = append(results, &runtimev1.ModelTestResult{Name: t.Name, Error: err.Error()})
+			continue
+		}
+
+		tr := r.runModelTest(ctx, self, olap, res, t)
+		if tr.Error != "" {
+			r.C.Logger.Warn("Model test failed", zap.String("model", self.Meta.Name.Name), zap.String("test", t.Name), zap.String("error", tr.Error))
+		}
+		results = append(results, tr)
+	}
+
+	return results
+}
+
+// runModelTest runs a single test against the given result.
+func (r *ModelReconciler) runModelTest(ctx context.Context, self *runtimev1.Resource, olap drivers.OLAPStore, mr *drivers.ModelResult, t *runtimev1.ModelTest) *runtimev1.ModelTestResult {
+	res := &runtimev1.ModelTestResult{Name: t.Name}
+
+	sql, args, err := r.modelTestSQL(ctx, self, olap.Dialect(), mr, t)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT COUNT(*) FROM (%s\n) AS failures", sql),
+		Args:     args,
+		Priority: 1,
+	})
+	if err != nil {
+		res.Error = fmt.Sprintf("failed to run test: %s", err.Error())
+		return res
+	}
+	defer rows.Close()
+
+	var n int64
+	if rows.Next() {
+		if err := rows.Scan(&n); err != nil {
+			res.Error = fmt.Sprintf("failed to scan test result: %s", err.Error())
+			return res
+		}
+	}
+	if err := rows.Err(); err != nil {
+		res.Error = fmt.Sprintf("failed to run test: %s", err.Error())
+		return res
+	}
+
+	if n > 0 {
+		res.FailedRows = n
+		if t.RowCount != nil {
+			res.Error = "row count is outside the expected bounds"
+		} else {
+			res.Error = fmt.Sprintf("found %d failing rows", n)
+		}
+	}
+	return res
+}
+
+// modelTestSQL builds a query that returns the rows in the result that fail the test.
+func (r *ModelReconciler) modelTestSQL(ctx context.Context, self *runtimev1.Resource, dialect drivers.Dialect, mr *drivers.ModelResult, t *runtimev1.ModelTest) (string, []any, error) {
+	tbl := dialect.EscapeIdentifier(mr.Table)
+
+	switch {
+	case len(t.NotNull) > 0:
+		var where []string
+		for _, c := range t.NotNull {
+			where = append(where, fmt.Sprintf("%s IS NULL", dialect.EscapeIdentifier(c)))
+		}
+		return fmt.Sprintf("SELECT * FROM %s WHERE %s", tbl, strings.Join(where, " OR ")), nil, nil
+	case len(t.Unique) > 0:
+		var cols []string
+		for _, c := range t.Unique {
+			cols = append(cols, dialect.EscapeIdentifier(c))
+		}
+		colsSQL := strings.Join(cols, ", ")
+		return fmt.Sprintf("SELECT %s, COUNT(*) AS count FROM %s GROUP BY %s HAVING COUNT(*) > 1", colsSQL, tbl, colsSQL), nil, nil
+	case t.AcceptedValues != nil:
+		col := dialect.EscapeIdentifier(t.AcceptedValues.Column)
+		var placeholders []string
+		var args []any
+		for _, v := range t.AcceptedValues.Values {
+			placeholders = append(placeholders, "?")
+			args = append(args, v.AsInterface())
+		}
+		return fmt.Sprintf("SELECT * FROM %s WHERE %s IS NOT NULL AND %s NOT IN (%s)", tbl, col, col, strings.Join(placeholders, ", ")), args, nil
+	case t.RowCount != nil:
+		var where []string
+		var args []any
+		if t.RowCount.Min != nil {
+			where = append(where, "n < ?")
+			args = append(args, *t.RowCount.Min)
+		}
+		if t.RowCount.Max != nil {
+			where = append(where, "n > ?")
+			args = append(args, *t.RowCount.Max)
+		}
+		if len(where) == 0 {
+			return "", nil, errors.New(`"row_count" must specify "min" and/or "max"`)
+		}
+		return fmt.Sprintf("SELECT * FROM (SELECT COUNT(*) AS n FROM %s) AS row_count WHERE %s", tbl, strings.Join(where, " OR ")), args, nil
+	case t.Sql != "":
+		inst, err := r.C.Runtime.Instance(ctx, r.C.InstanceID)
+		if err != nil {
+			return "", nil, err
+		}
+
+		// Refs to the model itself resolve to the table being tested (which may be a staged result).
+		// Other refs resolve to the referenced model's result table.
+		sql, err := compilerv1.ResolveTemplate(t.Sql, compilerv1.TemplateData{
+			Environment: inst.Environment,
+			User:        map[string]any{},
+			Variables:   inst.ResolveVariables(false),
+			Self: compilerv1.TemplateResource{
+				Meta:  self.Meta,
+				Spec:  self.GetModel().Spec,
+				State: self.GetModel().State,
+			},
+			Resolve: func(ref compilerv1.ResourceName) (string, error) {
+				if strings.EqualFold(ref.Name, self.Meta.Name.Name) {
+					return tbl, nil
+				}
+				return r.resolveModelTestRef(ctx, dialect, mr.Connector, ref)
+			},
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to resolve template: %w", err)
+		}
+		return sql, nil, nil
+	default:
+		return "", nil, errors.New("test does not specify an assertion")
+	}
+}
+
+// resolveModelTestRef resolves a ref in a test's SQL to the referenced model's result table.
+// For backwards compatibility, the ref may actually be a source or external table.
+// So if a model is not found, we use the ref's name as the table.
+func (r *ModelReconciler) resolveModelTestRef(ctx context.Context, dialect drivers.Dialect, connector string, ref compilerv1.ResourceName) (string, error) {
+	if ref.Kind != compilerv1.ResourceKindUnspecified && ref.Kind != compilerv1.ResourceKindModel {
+		return dialect.EscapeIdentifier(ref.Name), nil
+	}
+
+	res, err := r.C.Get(ctx, &runtimev1.ResourceName{Name: ref.Name, Kind: runtime.ResourceKindModel}, false)
+	if err != nil || res.GetModel().State.ResultTable == "" {
+		return dialect.EscapeIdentifier(ref.Name), nil
+	}
+
+	state := res.GetModel().State
+	if state.ResultConnector != connector {
+		return "", fmt.Errorf("model %q is in connector %q, but the tested model is in connector %q", ref.Name, state.ResultConnector, connector)
+	}
+	return dialect.EscapeIdentifier(state.ResultTable), nil
+}
+
+// blockingModelTestsError returns an error if any of the results belong to a failed test with block set.
+func blockingModelTestsError(spec *runtimev1.ModelSpec, results []*runtimev1.ModelTestResult) error {
+	var failed []string
+	for _, res := range results {
+		if res.Error == "" {
+			continue
+		}
+		for _, t := range spec.Tests {
+			if t.Name == res.Name && t.Block {
+				failed = append(failed, fmt.Sprintf("%s (%s)", res.Name, res.Error))
+				break
+			}
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", errModelTestsFailed, strings.Join(failed, ", "))
+}
+
+// hasBlockingModelTests returns true if the model has tests that block promotion of a new result if they fail.
+func hasBlockingModelTests(spec *runtimev1.ModelSpec) bool {
+	for _, t := range spec.Tests {
+		if t.Block {
+			return true
+		}
+	}
+	return false
+}
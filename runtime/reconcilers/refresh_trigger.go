@@ -10,8 +10,12 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// _backfillSplitsPageSize is the number of splits to scan at a time when backfilling a range of splits.
+const _backfillSplitsPageSize = 1000
+
 func init() {
 	runtime.RegisterReconcilerInitializer(runtime.ResourceKindRefreshTrigger, newRefreshTriggerReconciler)
 }
@@ -110,7 +114,8 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 			continue
 		}
 
-		if len(mt.Splits) > 0 || mt.AllErroredSplits {
+		backfill := mt.SplitsWatermarkStart != nil || mt.SplitsWatermarkEnd != nil
+		if len(mt.Splits) > 0 || mt.AllErroredSplits || backfill {
 			mdl := mr.GetModel()
 			modelID := mdl.State.SplitsModelId
 			if !mdl.Spec.Incremental {
@@ -135,6 +140,14 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 					return runtime.ReconcileResult{Err: fmt.Errorf("failed to mark split %q pending for model %q: %w", split, mt.Model, err)}
 				}
 			}
+
+			if backfill {
+				n, err := r.markSplitsPendingInWatermarkRange(ctx, catalog, modelID, mt.SplitsWatermarkStart, mt.SplitsWatermarkEnd)
+				if err != nil {
+					return runtime.ReconcileResult{Err: fmt.Errorf("failed to mark splits pending for model %q: %w", mt.Model, err)}
+				}
+				r.C.Logger.Info("Backfilling model splits", zap.String("model", mt.Model), zap.Int("splits", n))
+			}
 		}
 
 		err = r.UpdateTriggerTrue(ctx, mr, mt.Full)
@@ -163,6 +176,27 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 		}
 	}
 
+	// Handle alert status changes
+	for _, at := range trigger.Spec.Alerts {
+		ar, err := r.C.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: at.Alert}, true)
+		if err != nil {
+			// Skip status changes for non-existent alerts
+			if !errors.Is(err, drivers.ErrResourceNotFound) {
+				return runtime.ReconcileResult{Err: err}
+			}
+			r.C.Logger.Warn("Skipped status change for non-existent alert", zap.String("alert", at.Alert))
+			continue
+		}
+
+		alert := ar.GetAlert()
+		alert.Spec.StatusChange = mergeAlertStatusChange(alert.Spec.StatusChange, at.StatusChange)
+		err = r.C.UpdateSpec(ctx, ar.Meta.Name, ar)
+		if err != nil {
+			// Not handling deletion race conditions because we hold a lock.
+			return runtime.ReconcileResult{Err: fmt.Errorf("failed to update status for alert %q: %w", at.Alert, err)}
+		}
+	}
+
 	// Delete self now that all triggers have been set
 	err = r.C.Delete(ctx, n)
 	if err != nil {
@@ -213,3 +247,65 @@ func (r *RefreshTriggerReconciler) UpdateTriggerTrue(ctx context.Context, res *r
 
 	return r.C.UpdateSpec(ctx, res.Meta.Name, res)
 }
+
+// mergeAlertStatusChange combines a new alert status change with one that has not yet been applied by the alert reconciler.
+func mergeAlertStatusChange(pending, next *runtimev1.AlertStatusChange) *runtimev1.AlertStatusChange {
+	if pending == nil {
+		return next
+	}
+	res := &runtimev1.AlertStatusChange{
+		Acknowledge: pending.Acknowledge || next.Acknowledge,
+		SnoozeUntil: pending.SnoozeUntil,
+		Unsnooze:    pending.Unsnooze,
+		Actor:       next.Actor,
+	}
+	// The latest snooze or unsnooze wins
+	if next.SnoozeUntil != nil || next.Unsnooze {
+		res.SnoozeUntil = next.SnoozeUntil
+		res.Unsnooze = next.Unsnooze
+	}
+	return res
+}
+
+// markSplitsPendingInWatermarkRange marks all splits of a model with a watermark in the range [start, end) as pending.
+// Either bound may be nil to leave the range open on that side. Splits without a watermark are never matched.
+// It returns the number of splits that were marked pending.
+func (r *RefreshTriggerReconciler) markSplitsPendingInWatermarkRange(ctx context.Context, catalog drivers.CatalogStore, modelID string, start, end *timestamppb.Timestamp) (int, error) {
+	var n int
+	opts := &drivers.FindModelSplitsOptions{
+		ModelID: modelID,
+		Limit:   _backfillSplitsPageSize,
+	}
+	for {
+		splits, err := catalog.FindModelSplits(ctx, opts)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, split := range splits {
+			if split.Watermark == nil {
+				continue
+			}
+			if start != nil && split.Watermark.Before(start.AsTime()) {
+				continue
+			}
+			if end != nil && !split.Watermark.Before(end.AsTime()) {
+				continue
+			}
+
+			err := catalog.UpdateModelSplitPending(ctx, modelID, split.Key)
+			if err != nil {
+				return 0, err
+			}
+			n++
+		}
+
+		if len(splits) < opts.Limit {
+			break
+		}
+		last := splits[len(splits)-1]
+		opts.AfterIndex = last.Index
+		opts.AfterKey = last.Key
+	}
+	return n, nil
+}
@@ -0,0 +1,83 @@
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
+)
+
+func TestMarkSplitsPendingInWatermarkRange(t *testing.T) {
+	ctx := context.Background()
+	conn, err := drivers.Open("sqlite", "", map[string]any{"dsn": ":memory:"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.Migrate(ctx))
+	catalog, ok := conn.AsCatalogStore("")
+	require.True(t, ok)
+
+	// Insert executed splits with a watermark for each day in January, plus a split without a watermark
+	modelID := "model"
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 31; i++ {
+		watermark := start.AddDate(0, 0, i)
+		err := catalog.InsertModelSplit(ctx, modelID, drivers.ModelSplit{
+			Key:        fmt.Sprintf("split%d", i),
+			DataJSON:   []byte("{}"),
+			Index:      i,
+			Watermark:  &watermark,
+			ExecutedOn: &start,
+		})
+		require.NoError(t, err)
+	}
+	err = catalog.InsertModelSplit(ctx, modelID, drivers.ModelSplit{Key: "no_watermark", DataJSON: []byte("{}"), Index: 31, ExecutedOn: &start})
+	require.NoError(t, err)
+
+	countPending := func() int {
+		splits, err := catalog.FindModelSplits(ctx, &drivers.FindModelSplitsOptions{ModelID: modelID, WherePending: true})
+		require.NoError(t, err)
+		return len(splits)
+	}
+
+	r := &RefreshTriggerReconciler{}
+
+	// Bounded range
+	n, err := r.markSplitsPendingInWatermarkRange(ctx, catalog, modelID, timestamppb.New(start.AddDate(0, 0, 10)), timestamppb.New(start.AddDate(0, 0, 20)))
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+	require.Equal(t, 10, countPending())
+
+	// Open-ended range
+	n, err = r.markSplitsPendingInWatermarkRange(ctx, catalog, modelID, timestamppb.New(start.AddDate(0, 0, 25)), nil)
+	require.NoError(t, err)
+	require.Equal(t, 6, n)
+	require.Equal(t, 16, countPending())
+}
+
+func TestMergeAlertStatusChange(t *testing.T) {
+	until := timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// No pending change
+	next := &runtimev1.AlertStatusChange{Acknowledge: true, Actor: "a"}
+	require.Equal(t, next, mergeAlertStatusChange(nil, next))
+
+	// Acknowledgements are kept when snoozing
+	res := mergeAlertStatusChange(next, &runtimev1.AlertStatusChange{SnoozeUntil: until, Actor: "b"})
+	require.True(t, proto.Equal(&runtimev1.AlertStatusChange{Acknowledge: true, SnoozeUntil: until, Actor: "b"}, res))
+
+	// The latest snooze or unsnooze wins
+	res = mergeAlertStatusChange(res, &runtimev1.AlertStatusChange{Unsnooze: true, Actor: "c"})
+	require.True(t, proto.Equal(&runtimev1.AlertStatusChange{Acknowledge: true, Unsnooze: true, Actor: "c"}, res))
+	res = mergeAlertStatusChange(res, &runtimev1.AlertStatusChange{Acknowledge: true, Actor: "d"})
+	require.True(t, proto.Equal(&runtimev1.AlertStatusChange{Acknowledge: true, Unsnooze: true, Actor: "d"}, res))
+}
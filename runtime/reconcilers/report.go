@@ -1,11 +1,15 @@
 package reconcilers
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/mail"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -18,17 +22,23 @@ import (
 	"github.com/rilldata/rill/runtime/server"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
-	reportExecutionHistoryLimit = 10
-	reportCheckDefaultTimeout   = 5 * time.Minute
-	reportDefaultIntervalsLimit = 25
-	reportQueryPriority         = 1
+	reportExecutionHistoryLimit     = 10
+	reportCheckDefaultTimeout       = 5 * time.Minute
+	reportDefaultIntervalsLimit     = 25
+	reportQueryPriority             = 1
+	reportAttachmentDefaultMaxBytes = 10 * 1024 * 1024
+	reportBurstMaxRecipients        = 1000
 )
 
+// errReportAttachmentTooLarge is returned by attachmentBuffer when the export exceeds the attachment size limit.
+var errReportAttachmentTooLarge = errors.New("report export exceeds the attachment size limit")
+
 func init() {
 	runtime.RegisterReconcilerInitializer(runtime.ResourceKindReport, newReportReconciler)
 }
@@ -394,33 +404,20 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 	}
 	defer release()
 
-	meta, err := admin.GetReportMetadata(ctx, self.Meta.Name.Name, rep.Spec.Annotations, t)
-	if err != nil {
-		return false, fmt.Errorf("failed to get report metadata: %w", err)
-	}
-
-	qry, err := queries.ProtoFromJSON(rep.Spec.QueryName, rep.Spec.QueryArgsJson, &t)
-	if err != nil {
-		return false, fmt.Errorf("failed to build export request: %w", err)
+	if rep.Spec.BurstResolver != "" {
+		return r.sendBurstReport(ctx, self, rep, admin, t)
 	}
 
-	bakedQry, err := server.BakeQuery(qry)
+	meta, err := admin.GetReportMetadata(ctx, self.Meta.Name.Name, rep.Spec.Annotations, t, "")
 	if err != nil {
-		return false, fmt.Errorf("failed to bake query of type %T: %w", qry, err)
+		return false, fmt.Errorf("failed to get report metadata: %w", err)
 	}
 
-	exportURL, err := url.Parse(meta.ExportURL)
+	// Query the data with the security attributes of the report's owner (like for alerts)
+	content, err := r.renderReport(ctx, self, rep, meta, t, &runtime.SecurityClaims{UserAttributes: meta.QueryForAttributes})
 	if err != nil {
-		return false, fmt.Errorf("failed to parse export URL %q: %w", meta.ExportURL, err)
-	}
-
-	exportURLQry := exportURL.Query()
-	exportURLQry.Set("format", rep.Spec.ExportFormat.String())
-	exportURLQry.Set("query", bakedQry)
-	if rep.Spec.ExportLimit > 0 {
-		exportURLQry.Set("limit", strconv.Itoa(int(rep.Spec.ExportLimit)))
+		return false, err
 	}
-	exportURL.RawQuery = exportURLQry.Encode()
 
 	sent := false
 	for _, notifier := range rep.Spec.Notifiers {
@@ -435,8 +432,10 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 					ReportTime:     t,
 					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
 					OpenLink:       meta.OpenURL,
-					DownloadLink:   exportURL.String(),
+					DownloadLink:   content.downloadLink,
 					EditLink:       meta.EditURL,
+					Attachment:     content.attachment,
+					Preview:        content.preview,
 				})
 				sent = true
 				if err != nil {
@@ -455,12 +454,15 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 					return err
 				}
 				msg := &drivers.ScheduledReport{
+					Name:           self.Meta.Name.Name,
 					Title:          rep.Spec.Title,
 					ReportTime:     t,
 					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
 					OpenLink:       meta.OpenURL,
-					DownloadLink:   exportURL.String(),
+					DownloadLink:   content.downloadLink,
 					EditLink:       meta.EditURL,
+					Attachment:     content.attachment,
+					Preview:        content.preview,
 				}
 				start := time.Now()
 				defer func() {
@@ -490,6 +492,370 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 	return false, nil
 }
 
+// sendBurstReport sends the report to each recipient returned by the report's burst resolver.
+// The report is rendered separately for each recipient using their security context, so security policies (such as row filters) apply to the data they receive.
+// It returns true if an error occurred after some or all notifications were sent.
+func (r *ReportReconciler) sendBurstReport(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, admin drivers.AdminService, t time.Time) (bool, error) {
+	// Resolve all recipients before sending anything, so an invalid row doesn't result in a partial send.
+	recipients, err := r.resolveBurstRecipients(ctx, rep, t)
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve burst recipients: %w", err)
+	}
+
+	// A failure for one recipient doesn't prevent sending the report to the others
+	var errs []error
+	var sent int
+	for _, recipient := range recipients {
+		err := r.sendBurstReportTo(ctx, self, rep, admin, t, recipient)
+		if err != nil {
+			r.C.Logger.Warn("Failed to send burst report", zap.String("report", self.Meta.Name.Name), zap.String("recipient", recipient.email), zap.Error(err))
+			errs = append(errs, err)
+			continue
+		}
+		sent++
+	}
+
+	r.C.Logger.Info("Sent burst report", zap.String("report", self.Meta.Name.Name), zap.Int("recipients", len(recipients)), zap.Int("sent", sent))
+	if len(errs) > 0 {
+		return sent > 0, fmt.Errorf("failed to send the report to %d of %d recipients: %w", len(errs), len(recipients), errors.Join(errs...))
+	}
+	return false, nil
+}
+
+// sendBurstReportTo renders and sends a burst report to a single recipient.
+func (r *ReportReconciler) sendBurstReportTo(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, admin drivers.AdminService, t time.Time, recipient *reportBurstRecipient) error {
+	// Get the recipient's links and security attributes (like for alerts with "for.user_email")
+	meta, err := admin.GetReportMetadata(ctx, self.Meta.Name.Name, rep.Spec.Annotations, t, recipient.email)
+	if err != nil {
+		return fmt.Errorf("failed to get report metadata for %q: %w", recipient.email, err)
+	}
+
+	content, err := r.renderReport(ctx, self, rep, meta, t, burstRecipientClaims(meta, recipient))
+	if err != nil {
+		return fmt.Errorf("failed to render report for %q: %w", recipient.email, err)
+	}
+
+	// The download link is left out, since the export it links to applies the security policies of the user who opens it, not the recipient's burst attributes.
+	err = r.C.Runtime.Email.SendScheduledReport(&email.ScheduledReport{
+		ToEmail:        recipient.email,
+		ToName:         "",
+		Title:          rep.Spec.Title,
+		ReportTime:     t,
+		DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
+		OpenLink:       meta.OpenURL,
+		EditLink:       meta.EditURL,
+		Attachment:     content.attachment,
+		Preview:        content.preview,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate report for %q: %w", recipient.email, err)
+	}
+	return nil
+}
+
+// burstRecipientClaims returns the security claims to render a burst report for a recipient.
+// Attributes returned by the burst resolver take precedence over the recipient's own attributes, except for the email, which always identifies the recipient.
+func burstRecipientClaims(meta *drivers.ReportMetadata, recipient *reportBurstRecipient) *runtime.SecurityClaims {
+	attrs := make(map[string]any, len(meta.QueryForAttributes)+len(recipient.attributes)+1)
+	maps.Copy(attrs, meta.QueryForAttributes)
+	maps.Copy(attrs, recipient.attributes)
+	attrs["email"] = recipient.email
+	return &runtime.SecurityClaims{UserAttributes: attrs}
+}
+
+// reportContent is the rendered content of a report notification.
+type reportContent struct {
+	downloadLink string
+	attachment   *drivers.ReportAttachment
+	preview      *drivers.ReportPreview
+}
+
+// renderReport builds the download link and, if configured, the attachment and preview of a report.
+// The attachment and preview are queried with the provided claims.
+func (r *ReportReconciler) renderReport(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, meta *drivers.ReportMetadata, t time.Time, claims *runtime.SecurityClaims) (*reportContent, error) {
+	qry, err := queries.ProtoFromJSON(rep.Spec.QueryName, rep.Spec.QueryArgsJson, &t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build export request: %w", err)
+	}
+
+	bakedQry, err := server.BakeQuery(qry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bake query of type %T: %w", qry, err)
+	}
+
+	exportURL, err := url.Parse(meta.ExportURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse export URL %q: %w", meta.ExportURL, err)
+	}
+
+	exportURLQry := exportURL.Query()
+	exportURLQry.Set("format", rep.Spec.ExportFormat.String())
+	exportURLQry.Set("query", bakedQry)
+	if rep.Spec.ExportLimit > 0 {
+		exportURLQry.Set("limit", strconv.Itoa(int(rep.Spec.ExportLimit)))
+	}
+	exportURL.RawQuery = exportURLQry.Encode()
+
+	content := &reportContent{downloadLink: exportURL.String()}
+
+	// Render the data to include in the notifications
+	if rep.Spec.ExportAttach {
+		content.attachment, err = r.exportAttachment(ctx, self, rep, qry, t, claims)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export report attachment: %w", err)
+		}
+	}
+	if rep.Spec.ExportPreviewRows > 0 {
+		content.preview, err = r.buildPreview(ctx, rep, t, claims)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build report preview: %w", err)
+		}
+	}
+
+	return content, nil
+}
+
+// reportBurstRecipient is a recipient returned by a report's burst resolver.
+type reportBurstRecipient struct {
+	email      string
+	attributes map[string]any
+}
+
+// resolveBurstRecipients runs the report's burst resolver and returns the recipients it returns.
+// The resolver runs without user attributes.
+func (r *ReportReconciler) resolveBurstRecipients(ctx context.Context, rep *runtimev1.Report, t time.Time) ([]*reportBurstRecipient, error) {
+	res, err := r.C.Runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         r.C.InstanceID,
+		Resolver:           rep.Spec.BurstResolver,
+		ResolverProperties: rep.Spec.BurstResolverProperties.AsMap(),
+		Args: map[string]any{
+			"priority":       reportQueryPriority,
+			"execution_time": t,
+			"limit":          reportBurstMaxRecipients + 1,
+		},
+		Claims: &runtime.SecurityClaims{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var recipients []*reportBurstRecipient
+	seen := make(map[string]bool)
+	for {
+		row, err := res.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(recipients) == reportBurstMaxRecipients {
+			return nil, fmt.Errorf("the burst resolver returned more than %d recipients", reportBurstMaxRecipients)
+		}
+
+		recipient, err := parseBurstRecipient(row, rep.Spec.BurstRecipientField)
+		if err != nil {
+			return nil, err
+		}
+		if seen[recipient.email] {
+			return nil, fmt.Errorf("the burst resolver returned recipient %q more than once", recipient.email)
+		}
+		seen[recipient.email] = true
+		recipients = append(recipients, recipient)
+	}
+
+	return recipients, nil
+}
+
+// parseBurstRecipient extracts a recipient from a row returned by a burst resolver.
+// The field with the recipient's email is removed; the remaining fields are used as user attributes.
+// Since the "email" attribute identifies the recipient to security policies, rows where it differs from the recipient are rejected.
+func parseBurstRecipient(row map[string]any, field string) (*reportBurstRecipient, error) {
+	v, ok := row[field]
+	if !ok {
+		return nil, fmt.Errorf("the burst resolver did not return the recipient field %q", field)
+	}
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil, fmt.Errorf("the burst resolver returned an invalid value %v for the recipient field %q", v, field)
+	}
+	addr, err := mail.ParseAddress(s)
+	if err != nil {
+		return nil, fmt.Errorf("the burst resolver returned an invalid recipient email address %q", s)
+	}
+
+	attrs := make(map[string]any, len(row)-1)
+	for k, v := range row {
+		if k != field {
+			attrs[k] = v
+		}
+	}
+	if v, ok := attrs["email"]; ok {
+		str, _ := v.(string)
+		other, err := mail.ParseAddress(str)
+		if err != nil || !strings.EqualFold(other.Address, addr.Address) {
+			return nil, fmt.Errorf("the burst resolver returned an \"email\" value %v that doesn't match the recipient %q", v, addr.Address)
+		}
+		delete(attrs, "email")
+	}
+
+	return &reportBurstRecipient{
+		email:      addr.Address,
+		attributes: attrs,
+	}, nil
+}
+
+// exportAttachment exports the report's query to a file that can be attached to notifications.
+// It returns nil if the export exceeds the report's attachment size limit.
+// NOTE: Unless the report uses bursting, the claims don't have user attributes (like alerts that don't query for a specific user), so security policies that depend on them will restrict the data.
+func (r *ReportReconciler) exportAttachment(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, qpb *runtimev1.Query, t time.Time, claims *runtime.SecurityClaims) (*drivers.ReportAttachment, error) {
+	q, err := queries.ProtoToQuery(qpb, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the export limit in the same way as for downloads
+	switch q := q.(type) {
+	case *queries.MetricsViewAggregation:
+		limit := reportExportLimit(int64(rep.Spec.ExportLimit), qpb.GetMetricsViewAggregationRequest().Limit)
+		if limit != 0 {
+			q.Limit = &limit
+		}
+	case *queries.MetricsViewComparison:
+		q.Limit = reportExportLimit(int64(rep.Spec.ExportLimit), q.Limit)
+	}
+
+	maxBytes := int(rep.Spec.ExportAttachMaxBytes)
+	if maxBytes <= 0 {
+		maxBytes = reportAttachmentDefaultMaxBytes
+	}
+
+	buf := &attachmentBuffer{maxBytes: maxBytes}
+	var filename string
+	err = q.Export(ctx, r.C.Runtime, r.C.InstanceID, buf, &runtime.ExportOptions{
+		Format:   rep.Spec.ExportFormat,
+		Priority: reportQueryPriority,
+		PreWriteHook: func(name string) error {
+			filename = name
+			return nil
+		},
+	})
+	if err != nil {
+		if errors.Is(err, errReportAttachmentTooLarge) {
+			r.C.Logger.Warn("Skipped report attachment because the export is too large", zap.String("report", self.Meta.Name.Name), zap.Int("max_bytes", maxBytes))
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Name the file like downloads
+	filename += "_" + t.Format("20060102150405")
+	var contentType string
+	switch rep.Spec.ExportFormat {
+	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
+		filename += ".csv"
+		contentType = "text/csv"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
+		filename += ".xlsx"
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		filename += ".parquet"
+		contentType = "application/octet-stream"
+	default:
+		return nil, fmt.Errorf("unsupported format %q", rep.Spec.ExportFormat.String())
+	}
+
+	return &drivers.ReportAttachment{
+		Filename:    filename,
+		ContentType: contentType,
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+// buildPreview resolves the first rows of the report's query with measures formatted using their format presets.
+func (r *ReportReconciler) buildPreview(ctx context.Context, rep *runtimev1.Report, t time.Time, claims *runtime.SecurityClaims) (*drivers.ReportPreview, error) {
+	n := int(rep.Spec.ExportPreviewRows)
+	res, err := r.C.Runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID: r.C.InstanceID,
+		Resolver:   "legacy_metrics",
+		ResolverProperties: map[string]any{
+			"query_name":      rep.Spec.QueryName,
+			"query_args_json": rep.Spec.QueryArgsJson,
+		},
+		Args: map[string]any{
+			"priority":       reportQueryPriority,
+			"execution_time": t,
+			"format":         true,
+			"format_schema":  true,
+			"limit":          n + 1, // Fetch one extra row to detect truncation
+		},
+		Claims: claims,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	preview := &drivers.ReportPreview{}
+	if schema := res.Schema(); schema != nil {
+		for _, f := range schema.Fields {
+			preview.Columns = append(preview.Columns, f.Name)
+		}
+	}
+
+	for {
+		row, err := res.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(preview.Rows) == n {
+			preview.Truncated = true
+			break
+		}
+
+		// Fall back to sorted keys if the result doesn't have a schema
+		if preview.Columns == nil {
+			preview.Columns = maps.Keys(row)
+			slices.Sort(preview.Columns)
+		}
+
+		vals := make([]string, len(preview.Columns))
+		for i, c := range preview.Columns {
+			if v := row[c]; v != nil {
+				vals[i] = fmt.Sprintf("%v", v)
+			}
+		}
+		preview.Rows = append(preview.Rows, vals)
+	}
+
+	return preview, nil
+}
+
+// reportExportLimit returns the lower of the report's export limit and the query's own limit, where zero means no limit.
+func reportExportLimit(exportLimit, queryLimit int64) int64 {
+	if exportLimit == 0 || (queryLimit != 0 && queryLimit < exportLimit) {
+		return queryLimit
+	}
+	return exportLimit
+}
+
+// attachmentBuffer is a buffer that returns errReportAttachmentTooLarge if more than maxBytes are written to it.
+type attachmentBuffer struct {
+	bytes.Buffer
+	maxBytes int
+}
+
+func (b *attachmentBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.maxBytes {
+		return 0, errReportAttachmentTooLarge
+	}
+	return b.Buffer.Write(p)
+}
+
 func formatExportFormat(f runtimev1.ExportFormat) string {
 	switch f {
 	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
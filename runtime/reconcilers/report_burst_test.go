@@ -0,0 +1,52 @@
+package reconcilers
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBurstRecipient(t *testing.T) {
+	// The recipient field is removed and the other fields are used as attributes
+	r, err := parseBurstRecipient(map[string]any{"email": "Jane <jane@example.com>", "region": "EMEA", "tier": 2}, "email")
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", r.email)
+	require.Equal(t, map[string]any{"region": "EMEA", "tier": 2}, r.attributes)
+
+	// Custom recipient field
+	r, err = parseBurstRecipient(map[string]any{"manager": "joe@example.com", "region": "APAC"}, "manager")
+	require.NoError(t, err)
+	require.Equal(t, "joe@example.com", r.email)
+	require.Equal(t, map[string]any{"region": "APAC"}, r.attributes)
+
+	// An email field must match the recipient
+	r, err = parseBurstRecipient(map[string]any{"manager": "joe@example.com", "email": "Joe@Example.com"}, "manager")
+	require.NoError(t, err)
+	require.Equal(t, "joe@example.com", r.email)
+	require.Empty(t, r.attributes)
+	_, err = parseBurstRecipient(map[string]any{"manager": "joe@example.com", "email": "other@example.com"}, "manager")
+	require.ErrorContains(t, err, "doesn't match the recipient")
+
+	// Missing, non-string and invalid recipients
+	_, err = parseBurstRecipient(map[string]any{"region": "EMEA"}, "email")
+	require.ErrorContains(t, err, `did not return the recipient field "email"`)
+	_, err = parseBurstRecipient(map[string]any{"email": nil}, "email")
+	require.ErrorContains(t, err, "invalid value")
+	_, err = parseBurstRecipient(map[string]any{"email": "not an email"}, "email")
+	require.ErrorContains(t, err, "invalid recipient email address")
+}
+
+func TestBurstRecipientClaims(t *testing.T) {
+	meta := &drivers.ReportMetadata{QueryForAttributes: map[string]any{"email": "jane@example.com", "admin": true, "region": "APAC"}}
+	recipient := &reportBurstRecipient{email: "jane@example.com", attributes: map[string]any{"region": "EMEA"}}
+
+	// The resolver's attributes take precedence over the user's attributes
+	claims := burstRecipientClaims(meta, recipient)
+	require.Equal(t, map[string]any{"email": "jane@example.com", "admin": true, "region": "EMEA"}, claims.UserAttributes)
+
+	// The email always identifies the recipient
+	meta.QueryForAttributes["email"] = "other@example.com"
+	claims = burstRecipientClaims(meta, recipient)
+	require.Equal(t, "jane@example.com", claims.UserAttributes["email"])
+}
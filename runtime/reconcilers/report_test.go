@@ -0,0 +1,156 @@
+package reconcilers_test
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/rilldata/rill/runtime/drivers/mock"
+)
+
+func TestReportOwnerSecurity(t *testing.T) {
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		AdminDriver: "mock_admin",
+		Files: map[string]string{
+			"rill.yaml": ``,
+			"/models/bar.sql": `
+SELECT * FROM (VALUES ('EMEA', 'Denmark', 1), ('EMEA', 'Sweden', 2), ('APAC', 'Japan', 3)) t(region, country, value)
+`,
+			"/metrics/mv1.yaml": `
+version: 1
+type: metrics_view
+model: bar
+dimensions:
+- column: country
+measures:
+- name: total
+  expression: sum(value)
+security:
+  access: "'{{ .user.id }}' != ''"
+  row_filter: "'{{ .user.id }}' = 'owner_' || lower(region)"
+`,
+			"/reports/r1.yaml": `
+type: report
+title: Owned report
+refresh:
+  cron: 0 0 1 1 *
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+    dimensions:
+    - name: country
+    measures:
+    - name: total
+    sort:
+    - name: country
+export:
+  format: csv
+  attach: true
+notify:
+  email:
+    recipients:
+    - somebody@example.com
+annotations:
+  admin_owner_user_id: owner_emea
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+
+	// Run the report
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindReport, Name: "r1"})
+
+	// The attachment is queried with the security attributes of the report's owner
+	r := testruntime.GetResource(t, rt, id, runtime.ResourceKindReport, "r1").GetReport()
+	require.Len(t, r.State.ExecutionHistory, 1)
+	require.Empty(t, r.State.ExecutionHistory[0].ErrorMessage)
+
+	emails := rt.Email.Sender.(*email.TestSender).Emails
+	require.Len(t, emails, 1)
+	require.Equal(t, "somebody@example.com", emails[0].ToEmail)
+	require.Len(t, emails[0].Attachments, 1)
+	data := string(emails[0].Attachments[0].Data)
+	require.Contains(t, data, "Denmark")
+	require.Contains(t, data, "Sweden")
+	require.NotContains(t, data, "Japan")
+}
+
+func TestBurstReport(t *testing.T) {
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		AdminDriver: "mock_admin",
+		Files: map[string]string{
+			"rill.yaml": ``,
+			"/models/bar.sql": `
+SELECT * FROM (VALUES ('EMEA', 'Denmark', 1), ('EMEA', 'Sweden', 2), ('APAC', 'Japan', 3)) t(region, country, value)
+`,
+			"/metrics/mv1.yaml": `
+version: 1
+type: metrics_view
+model: bar
+dimensions:
+- column: country
+measures:
+- name: total
+  expression: sum(value)
+security:
+  access: "'{{ .user.region }}' == 'EMEA' OR '{{ .user.region }}' == 'APAC'"
+  row_filter: "region = '{{ .user.region }}'"
+`,
+			"/reports/r1.yaml": `
+type: report
+title: Regional report
+refresh:
+  cron: 0 0 1 1 *
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+    dimensions:
+    - name: country
+    measures:
+    - name: total
+    sort:
+    - name: country
+export:
+  format: csv
+  attach: true
+burst:
+  data:
+    sql: SELECT * FROM (VALUES ('latam@example.com', 'LATAM'), ('emea@example.com', 'EMEA'), ('apac@example.com', 'APAC')) t(email, region)
+`,
+		},
+	})
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+
+	// Run the report
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindReport, Name: "r1"})
+
+	// Each recipient only receives the rows for their region
+	emails := rt.Email.Sender.(*email.TestSender).Emails
+	require.Len(t, emails, 2)
+	require.Equal(t, "emea@example.com", emails[0].ToEmail)
+	require.Len(t, emails[0].Attachments, 1)
+	data := string(emails[0].Attachments[0].Data)
+	require.Contains(t, data, "Denmark")
+	require.Contains(t, data, "Sweden")
+	require.NotContains(t, data, "Japan")
+	require.Contains(t, emails[0].Body, "https://example.com/reports/r1/open")
+	require.NotContains(t, emails[0].Body, "https://example.com/reports/r1/export")
+	require.Equal(t, "apac@example.com", emails[1].ToEmail)
+	require.Len(t, emails[1].Attachments, 1)
+	data = string(emails[1].Attachments[0].Data)
+	require.Contains(t, data, "Japan")
+	require.NotContains(t, data, "Denmark")
+	require.NotContains(t, data, "Sweden")
+
+	// The recipient without access doesn't prevent sending the report to the others, but fails the run
+	r := testruntime.GetResource(t, rt, id, runtime.ResourceKindReport, "r1").GetReport()
+	require.Len(t, r.State.ExecutionHistory, 1)
+	require.Contains(t, r.State.ExecutionHistory[0].ErrorMessage, "failed to send the report to 1 of 3 recipients")
+	require.Contains(t, r.State.ExecutionHistory[0].ErrorMessage, "latam@example.com")
+}
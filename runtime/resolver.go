@@ -9,11 +9,14 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/mitchellh/hashstructure/v2"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/jsonval"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // Resolver represents logic, such as a SQL query, that produces output data.
@@ -43,6 +46,18 @@ type Resolver interface {
 	ResolveExport(ctx context.Context, w io.Writer, opts *ResolverExportOptions) error
 }
 
+// ResolverWithCacheKey is an optional interface that a cacheable Resolver can implement when its output depends on data whose changes are not reflected in the state of its refs.
+// For example, the output of a metrics resolver for a metrics view backed by an externally managed table changes when new data is ingested into the table.
+type ResolverWithCacheKey interface {
+	// CacheKey returns a key that identifies the current state of the data accessed by the resolver (such as a watermark).
+	// It is included in the cache key, so cached results are invalidated when it changes.
+	// It should return an empty key if the state of the resolver's refs captures all changes to the data.
+	//
+	// Since the key may not capture all changes to the data (for example restatements of old data), results cached with a non-empty key expire after the query cache's TTL.
+	// The key is reused for resolvers with the same refs for a short time, so it may be computed less often than the resolver is called.
+	CacheKey(ctx context.Context) (string, error)
+}
+
 // ResolverResult is the result of a resolver's execution.
 type ResolverResult interface {
 	// Close should be called to release resources
@@ -132,10 +147,8 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolverRe
 	if err != nil {
 		return nil, err
 	}
+	// Hash the state of the data that the resolver reads, i.e. the user attributes (which may be used in security policies) and the state of its refs
 	hash := md5.New()
-	if _, err := hash.Write([]byte(resolver.Key())); err != nil {
-		return nil, err
-	}
 	if opts.Claims.UserAttributes != nil {
 		h, err := hashstructure.Hash(opts.Claims.UserAttributes, hashstructure.FormatV2, nil)
 		if err != nil {
@@ -165,19 +178,44 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolverRe
 			return nil, err
 		}
 	}
+	var ttl time.Duration
+	if ck, ok := resolver.(ResolverWithCacheKey); ok {
+		dataKey, err := r.resolverDataKey(ctx, opts.InstanceID, hex.EncodeToString(hash.Sum(nil)), ck)
+		if err != nil {
+			return nil, err
+		}
+		if dataKey != "" {
+			ttl = r.queryCache.ttl
+		}
+		if _, err := hash.Write([]byte(dataKey)); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := hash.Write([]byte(resolver.Key())); err != nil {
+		return nil, err
+	}
 	sum := hex.EncodeToString(hash.Sum(nil))
 	key := fmt.Sprintf("inst:%s:resolver:%s:hash:%s", opts.InstanceID, opts.Resolver, sum)
 
 	// Try to get from cache
-	if val, ok := r.queryCache.cache.Get(key); ok {
-		return val.(*cachedResolverResult).copy(), nil
+	cRes, ok, err := r.getCachedResolverResult(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	r.queryCache.recordLookup(ctx, opts.InstanceID, "resolver", ok)
+	if ok {
+		return cRes.copy(), nil
 	}
 
 	// Load with singleflight
 	val, err := r.queryCache.singleflight.Do(ctx, key, func(ctx context.Context) (any, error) {
 		// Try cache again
-		if val, ok := r.queryCache.cache.Get(key); ok {
-			return val.(*cachedResolverResult), nil
+		cRes, ok, err := r.getCachedResolverResult(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return cRes, nil
 		}
 
 		// Resolve
@@ -189,11 +227,14 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolverRe
 		defer res.Close()
 
 		// Cache the result
-		cRes, err := newCachedResolverResult(res)
+		cRes, err = newCachedResolverResult(res)
+		if err != nil {
+			return nil, err
+		}
+		err = r.setCachedResolverResult(ctx, key, cRes, ttl)
 		if err != nil {
 			return nil, err
 		}
-		r.queryCache.cache.Set(key, cRes, int64(len(cRes.data)))
 		return cRes, nil
 	})
 	if err != nil {
@@ -204,6 +245,62 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolverRe
 	return val.(*cachedResolverResult).copy(), nil
 }
 
+// resolverDataKey returns the data key of a resolver (see ResolverWithCacheKey).
+// The key is cached for resolvers with the same data hash (see Resolve) for a short time, so it isn't recomputed before every cache lookup.
+func (r *Runtime) resolverDataKey(ctx context.Context, instanceID, dataHash string, ck ResolverWithCacheKey) (string, error) {
+	key := fmt.Sprintf("inst:%s:datakey:%s", instanceID, dataHash)
+	if val, ok := r.queryCache.objects.Get(key); ok {
+		return val.(string), nil
+	}
+
+	dataKey, err := ck.CacheKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	r.queryCache.objects.SetWithTTL(key, dataKey, int64(len(key)+len(dataKey)), queryCacheDataKeyTTL)
+	return dataKey, nil
+}
+
+// getCachedResolverResult looks up a resolver result in the query cache.
+// The caller must copy the result before consuming it.
+func (r *Runtime) getCachedResolverResult(ctx context.Context, key string) (*cachedResolverResult, bool, error) {
+	if r.queryCache.backend == nil {
+		val, ok := r.queryCache.objects.Get(key)
+		if !ok {
+			return nil, false, nil
+		}
+		return val.(*cachedResolverResult), true, nil
+	}
+
+	data, ok, err := r.queryCache.backend.Get(ctx, key)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	res, err := decodeCachedResolverResult(data)
+	if err != nil {
+		// Treat undecodable entries (e.g. persisted by an older version) as cache misses
+		r.Logger.Warn("failed to decode cached resolver result", zap.String("key", key), zap.Error(err))
+		return nil, false, nil
+	}
+	return res, true, nil
+}
+
+// setCachedResolverResult stores a resolver result in the query cache.
+// A ttl of zero means the result doesn't expire (it may still be evicted).
+func (r *Runtime) setCachedResolverResult(ctx context.Context, key string, res *cachedResolverResult, ttl time.Duration) error {
+	if r.queryCache.backend == nil {
+		r.queryCache.objects.SetWithTTL(key, res, int64(len(res.data)), ttl)
+		return nil
+	}
+
+	data, err := res.encode()
+	if err != nil {
+		return err
+	}
+	return r.queryCache.backend.Set(ctx, key, data, ttl)
+}
+
 // NewDriverResolverResult creates a ResolverResult from a drivers.Result.
 func NewDriverResolverResult(result *drivers.Result) ResolverResult {
 	return &driverResolverResult{
@@ -370,6 +467,42 @@ func (r *cachedResolverResult) MarshalJSON() ([]byte, error) {
 	return r.data, nil
 }
 
+// encode serializes the result for storage in a QueryCacheBackend.
+func (r *cachedResolverResult) encode() ([]byte, error) {
+	schema, err := protojson.Marshal(r.schema)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&encodedResolverResult{
+		Schema: schema,
+		Data:   r.data,
+	})
+}
+
+// decodeCachedResolverResult deserializes a result encoded with encode.
+func decodeCachedResolverResult(data []byte) (*cachedResolverResult, error) {
+	var enc encodedResolverResult
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return nil, err
+	}
+
+	schema := &runtimev1.StructType{}
+	if err := protojson.Unmarshal(enc.Schema, schema); err != nil {
+		return nil, err
+	}
+
+	return &cachedResolverResult{
+		data:   enc.Data,
+		schema: schema,
+	}, nil
+}
+
+// encodedResolverResult is the serialized representation of a cachedResolverResult.
+type encodedResolverResult struct {
+	Schema json.RawMessage `json:"schema"`
+	Data   json.RawMessage `json:"data"`
+}
+
 func (r *cachedResolverResult) copy() *cachedResolverResult {
 	return &cachedResolverResult{
 		data:   r.data,
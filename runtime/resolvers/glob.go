@@ -237,6 +237,7 @@ func (r *globResolver) buildFilesResult(entries []drivers.ObjectStoreEntry) []ma
 }
 
 // hivePartitionRegex is a regex that matches Hive-style partition values in a path.
+// Unlike blob.HivePartitions, which is used for partition_filters, it returns the raw values and skips the first path segment.
 var hivePartitionRegex = regexp.MustCompile(`/([^/\?]+)=([^/\n\?]*)`)
 
 // buildPartitioned builds a result consisting of one row per partition.
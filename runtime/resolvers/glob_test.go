@@ -113,6 +113,73 @@ func TestGlobHivePartitioned(t *testing.T) {
 	}, rows)
 }
 
+func TestGlobHivePartitionedRawValues(t *testing.T) {
+	// Partition values are returned as they appear in the path, and the first path segment is not parsed as a partition
+	rt, instanceID := prepareGlobTest(t, "mock", map[string]string{
+		"year=2024/country=New%20York/region=__HIVE_DEFAULT_PARTITION__/file1.csv": ``,
+	})
+
+	res, err := rt.Resolve(context.Background(), &runtime.ResolveOptions{
+		InstanceID: instanceID,
+		Resolver:   "glob",
+		ResolverProperties: map[string]any{
+			"connector": "mock",
+			"path":      "mock://bucket/**/*.csv",
+			"partition": "hive",
+		},
+		Args:   nil,
+		Claims: &runtime.SecurityClaims{},
+	})
+	require.NoError(t, err)
+	defer res.Close()
+
+	var rows []map[string]interface{}
+	require.NoError(t, json.Unmarshal(must(res.MarshalJSON()), &rows))
+
+	for _, row := range rows {
+		delete(row, "updated_on")
+	}
+
+	require.Equal(t, []map[string]interface{}{
+		{"uri": "mock://bucket/year=2024/country=New%20York/region=__HIVE_DEFAULT_PARTITION__", "path": "year=2024/country=New%20York/region=__HIVE_DEFAULT_PARTITION__", "country": "New%20York", "region": "__HIVE_DEFAULT_PARTITION__"},
+	}, rows)
+}
+
+func TestGlobHivePartitionFilters(t *testing.T) {
+	rt, instanceID := prepareGlobTest(t, "mock", map[string]string{
+		"dir/year=2024/month=02/file1.csv": ``,
+		"dir/year=2024/month=03/file2.csv": ``,
+		"dir/year=2024/month=04/file3.csv": ``,
+	})
+
+	res, err := rt.Resolve(context.Background(), &runtime.ResolveOptions{
+		InstanceID: instanceID,
+		Resolver:   "glob",
+		ResolverProperties: map[string]any{
+			"connector":         "mock",
+			"path":              "mock://bucket/**/*.csv",
+			"partition":         "hive",
+			"partition_filters": map[string]any{"month": []any{"02", "04"}},
+		},
+		Args:   nil,
+		Claims: &runtime.SecurityClaims{},
+	})
+	require.NoError(t, err)
+	defer res.Close()
+
+	var rows []map[string]interface{}
+	require.NoError(t, json.Unmarshal(must(res.MarshalJSON()), &rows))
+
+	for _, row := range rows {
+		delete(row, "updated_on")
+	}
+
+	require.Equal(t, []map[string]interface{}{
+		{"uri": "mock://bucket/dir/year=2024/month=02", "path": "dir/year=2024/month=02", "year": "2024", "month": "02"},
+		{"uri": "mock://bucket/dir/year=2024/month=04", "path": "dir/year=2024/month=04", "year": "2024", "month": "04"},
+	}, rows)
+}
+
 func TestGlobHivePartitionedTransformSQL(t *testing.T) {
 	rt, instanceID := prepareGlobTest(t, "mock", map[string]string{
 		"dir/year=2024/month=02/file1.csv": ``,
@@ -12,6 +12,7 @@ import (
 	"github.com/mitchellh/hashstructure/v2"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
 	"github.com/rilldata/rill/runtime/pkg/formatter"
 	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
 	"github.com/rilldata/rill/runtime/queries"
@@ -41,6 +42,8 @@ type legacyMetricsResolverArgs struct {
 	ExecutionTime *time.Time `mapstructure:"execution_time"`
 	Limit         int        `mapstructure:"limit"`
 	Format        bool       `mapstructure:"format"`
+	// FormatSchema returns a schema matching the formatted rows, which use measure labels as names. It only applies if Format is set.
+	FormatSchema bool `mapstructure:"format_schema"`
 }
 
 func newLegacyMetrics(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolver, error) {
@@ -70,6 +73,21 @@ func newLegacyMetrics(ctx context.Context, opts *runtime.ResolverOptions) (runti
 		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
 
+	// Push the limit down into the query, keeping the query's own limit if it's lower
+	if args.Limit > 0 {
+		limit := int64(args.Limit)
+		switch q := q.(type) {
+		case *queries.MetricsViewAggregation:
+			if q.Limit == nil || *q.Limit == 0 || limit < *q.Limit {
+				q.Limit = &limit
+			}
+		case *queries.MetricsViewComparison:
+			if q.Limit == 0 || limit < q.Limit {
+				q.Limit = limit
+			}
+		}
+	}
+
 	return &legacyMetricsResolver{
 		runtime:         opts.Runtime,
 		instanceID:      opts.InstanceID,
@@ -130,8 +148,11 @@ func (r *legacyMetricsResolver) ResolveInteractive(ctx context.Context) (runtime
 
 	switch q := r.query.(type) {
 	case *queries.MetricsViewAggregation:
-		schema = q.Result.Schema
 		if q.Result != nil {
+			schema = q.Result.Schema
+			if r.args.Format && r.args.FormatSchema {
+				schema = r.formatMetricsViewAggregationSchema(schema, q, spec.Measures)
+			}
 			for i, row := range q.Result.Data {
 				if r.args.Limit > 0 && i >= r.args.Limit {
 					break
@@ -145,6 +166,9 @@ func (r *legacyMetricsResolver) ResolveInteractive(ctx context.Context) (runtime
 		}
 	case *queries.MetricsViewComparison:
 		if q.Result != nil {
+			if r.args.Format && r.args.FormatSchema && len(q.Result.Rows) > 0 {
+				schema = r.metricsViewComparisonSchema(q.Result.Rows[0], q, spec.Measures)
+			}
 			for i, row := range q.Result.Rows {
 				if r.args.Limit > 0 && i >= r.args.Limit {
 					break
@@ -198,6 +222,48 @@ func (r *legacyMetricsResolver) formatMetricsViewAggregationResult(row map[strin
 	return res
 }
 
+// formatMetricsViewAggregationSchema returns a schema matching the rows returned by formatMetricsViewAggregationResult.
+// It preserves the order of the fields, but uses the measure labels as names and changes the type of formatted measures to string.
+func (r *legacyMetricsResolver) formatMetricsViewAggregationSchema(schema *runtimev1.StructType, q *queries.MetricsViewAggregation, measures []*runtimev1.MetricsViewSpec_MeasureV2) *runtimev1.StructType {
+	if schema == nil {
+		return nil
+	}
+	res := &runtimev1.StructType{Fields: make([]*runtimev1.StructType_Field, len(schema.Fields))}
+	for i, f := range schema.Fields {
+		label, fmtr := r.getComparisonMeasureLabelAndFormatter(f.Name, q.Measures, measures)
+		typ := f.Type
+		if fmtr != nil {
+			typ = &runtimev1.Type{Code: runtimev1.Type_CODE_STRING, Nullable: true}
+		}
+		res.Fields[i] = &runtimev1.StructType_Field{Name: label, Type: typ}
+	}
+	return res
+}
+
+// metricsViewComparisonSchema returns a schema with the names of the fields in the rows returned by formatMetricsViewComparisonResult.
+// The comparison fields are inferred from the first row. Since the values are formatted as strings or passed through as-is, the types are left unspecified.
+func (r *legacyMetricsResolver) metricsViewComparisonSchema(row *runtimev1.MetricsViewComparisonRow, q *queries.MetricsViewComparison, measures []*runtimev1.MetricsViewSpec_MeasureV2) *runtimev1.StructType {
+	res := &runtimev1.StructType{}
+	add := func(name string) {
+		res.Fields = append(res.Fields, &runtimev1.StructType_Field{Name: name, Type: &runtimev1.Type{Code: runtimev1.Type_CODE_UNSPECIFIED, Nullable: true}})
+	}
+	add(q.DimensionName)
+	for _, v := range row.MeasureValues {
+		name, _ := r.getMeasureLabelAndFormatter(v.MeasureName, measures)
+		add(name)
+		if v.ComparisonValue != nil {
+			add(name + " (prev)")
+		}
+		if v.DeltaAbs != nil {
+			add(name + " (Δ)")
+		}
+		if v.DeltaRel != nil {
+			add(name + " (Δ%)")
+		}
+	}
+	return res
+}
+
 func (r *legacyMetricsResolver) formatMetricsViewComparisonResult(row *runtimev1.MetricsViewComparisonRow, q *queries.MetricsViewComparison, measures []*runtimev1.MetricsViewSpec_MeasureV2) map[string]any {
 	res := make(map[string]any)
 	res[q.DimensionName] = row.DimensionValue
@@ -243,6 +309,14 @@ func (r *legacyMetricsResolver) getComparisonMeasureLabelAndFormatter(measureNam
 				effectiveMeasure = v.ComparisonRatio.Measure
 			case *runtimev1.MetricsViewAggregationMeasure_PercentOfTotal:
 				effectiveMeasure = v.PercentOfTotal.Measure
+			case *runtimev1.MetricsViewAggregationMeasure_Retention:
+				effectiveMeasure = v.Retention.Measure
+			case *runtimev1.MetricsViewAggregationMeasure_RunningTotal:
+				effectiveMeasure = v.RunningTotal.Measure
+			case *runtimev1.MetricsViewAggregationMeasure_MovingAverage:
+				effectiveMeasure = v.MovingAverage.Measure
+			case *runtimev1.MetricsViewAggregationMeasure_PeriodToDate:
+				effectiveMeasure = v.PeriodToDate.Measure
 			}
 			break
 		}
@@ -270,7 +344,7 @@ func (r *legacyMetricsResolver) getComparisonMeasureLabelAndFormatter(measureNam
 	formatPreset := measure.FormatPreset
 	if effectiveMeasure != measureName {
 		// comparison measure, add a suffix based on type
-		switch reqMeasure.Compute.(type) {
+		switch v := reqMeasure.Compute.(type) {
 		case *runtimev1.MetricsViewAggregationMeasure_ComparisonValue:
 			measureLabel += " (prev)"
 		case *runtimev1.MetricsViewAggregationMeasure_ComparisonDelta:
@@ -281,6 +355,15 @@ func (r *legacyMetricsResolver) getComparisonMeasureLabelAndFormatter(measureNam
 		case *runtimev1.MetricsViewAggregationMeasure_PercentOfTotal:
 			measureLabel += " (Σ%)"
 			formatPreset = "percentage"
+		case *runtimev1.MetricsViewAggregationMeasure_Retention:
+			measureLabel += " (retention)"
+			formatPreset = "percentage"
+		case *runtimev1.MetricsViewAggregationMeasure_RunningTotal:
+			measureLabel += " (running total)"
+		case *runtimev1.MetricsViewAggregationMeasure_MovingAverage:
+			measureLabel += fmt.Sprintf(" (%d period moving avg)", v.MovingAverage.Periods)
+		case *runtimev1.MetricsViewAggregationMeasure_PeriodToDate:
+			measureLabel += fmt.Sprintf(" (%s to date)", metricsview.TimeGrainFromProto(v.PeriodToDate.TimeGrain))
 		}
 	}
 
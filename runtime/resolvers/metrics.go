@@ -23,6 +23,7 @@ type metricsResolver struct {
 	runtime    *runtime.Runtime
 	instanceID string
 	executor   *metricsview.Executor
+	streaming  bool
 	query      *metricsview.Query
 	args       *metricsResolverArgs
 }
@@ -77,6 +78,7 @@ func newMetrics(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Res
 		runtime:    opts.Runtime,
 		instanceID: opts.InstanceID,
 		executor:   executor,
+		streaming:  res.GetMetricsView().State.Streaming,
 		query:      qry,
 		args:       args,
 	}, nil
@@ -88,7 +90,15 @@ func (r *metricsResolver) Close() error {
 }
 
 func (r *metricsResolver) Cacheable() bool {
-	return r.executor.Cacheable(r.query)
+	// Metrics views on models and sources are invalidated by their refresh state, so they can always be cached (see CacheKey).
+	return !r.streaming || r.executor.Cacheable(r.query)
+}
+
+func (r *metricsResolver) CacheKey(ctx context.Context) (string, error) {
+	if !r.streaming {
+		return "", nil
+	}
+	return r.executor.CacheKey(ctx)
 }
 
 func (r *metricsResolver) Key() string {
@@ -0,0 +1,138 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/mitchellh/hashstructure/v2"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
+)
+
+func init() {
+	runtime.RegisterResolverInitializer("metrics_anomaly", newMetricsAnomaly)
+}
+
+// errAnomalyExportUnsupported is returned when a metrics_anomaly resolver is used for an export.
+// Anomalies are detected in memory, so there is no query that can be exported by the OLAP.
+var errAnomalyExportUnsupported = errors.New("anomaly results can't be exported (the metrics_anomaly resolver only supports interactive use, such as APIs and alerts)")
+
+type metricsAnomalyResolver struct {
+	runtime    *runtime.Runtime
+	instanceID string
+	executor   *metricsview.Executor
+	streaming  bool
+	query      *metricsview.AnomalyQuery
+	args       *metricsAnomalyResolverArgs
+}
+
+type metricsAnomalyResolverArgs struct {
+	Priority      int        `mapstructure:"priority"`
+	ExecutionTime *time.Time `mapstructure:"execution_time"`
+}
+
+func newMetricsAnomaly(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolver, error) {
+	if opts.ForExport {
+		return nil, errAnomalyExportUnsupported
+	}
+
+	qry := &metricsview.AnomalyQuery{}
+	if err := mapstructureutil.WeakDecode(opts.Properties, qry); err != nil {
+		return nil, err
+	}
+
+	args := &metricsAnomalyResolverArgs{}
+	if err := mapstructureutil.WeakDecode(opts.Args, args); err != nil {
+		return nil, err
+	}
+
+	ctrl, err := opts.Runtime.Controller(ctx, opts.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: qry.MetricsView}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	mv := res.GetMetricsView().State.ValidSpec
+	if mv == nil {
+		return nil, fmt.Errorf("metrics view %q is invalid", res.Meta.Name.Name)
+	}
+
+	security, err := opts.Runtime.ResolveSecurity(opts.InstanceID, opts.Claims, res)
+	if err != nil {
+		return nil, err
+	}
+
+	if !security.CanAccess() {
+		return nil, runtime.ErrForbidden
+	}
+
+	executor, err := metricsview.NewExecutor(ctx, opts.Runtime, opts.InstanceID, mv, security, args.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metricsAnomalyResolver{
+		runtime:    opts.Runtime,
+		instanceID: opts.InstanceID,
+		executor:   executor,
+		streaming:  res.GetMetricsView().State.Streaming,
+		query:      qry,
+		args:       args,
+	}, nil
+}
+
+func (r *metricsAnomalyResolver) Close() error {
+	r.executor.Close()
+	return nil
+}
+
+func (r *metricsAnomalyResolver) Cacheable() bool {
+	// Metrics views on models and sources are invalidated by their refresh state, so they can always be cached (see CacheKey).
+	return !r.streaming || r.executor.Cacheable(nil)
+}
+
+func (r *metricsAnomalyResolver) CacheKey(ctx context.Context) (string, error) {
+	if !r.streaming {
+		return "", nil
+	}
+	return r.executor.CacheKey(ctx)
+}
+
+func (r *metricsAnomalyResolver) Key() string {
+	// The execution time determines the default time range, so it's part of the key.
+	hash, err := hashstructure.Hash([]any{r.query, r.args.ExecutionTime}, hashstructure.FormatV2, nil)
+	if err != nil {
+		panic(err)
+	}
+	return strconv.FormatUint(hash, 16)
+}
+
+func (r *metricsAnomalyResolver) Refs() []*runtimev1.ResourceName {
+	return []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: r.query.MetricsView}}
+}
+
+func (r *metricsAnomalyResolver) Validate(ctx context.Context) error {
+	return r.query.Validate()
+}
+
+func (r *metricsAnomalyResolver) ResolveInteractive(ctx context.Context) (runtime.ResolverResult, error) {
+	rows, schema, err := r.executor.Anomalies(ctx, r.query, r.args.ExecutionTime)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.NewMapsResolverResult(rows, schema), nil
+}
+
+func (r *metricsAnomalyResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
+	return errAnomalyExportUnsupported
+}
@@ -0,0 +1,129 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/mitchellh/hashstructure/v2"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/metricsview"
+	"github.com/rilldata/rill/runtime/pkg/mapstructureutil"
+)
+
+func init() {
+	runtime.RegisterResolverInitializer("metrics_funnel", newMetricsFunnel)
+}
+
+type metricsFunnelResolver struct {
+	runtime    *runtime.Runtime
+	instanceID string
+	executor   *metricsview.Executor
+	streaming  bool
+	query      *metricsview.FunnelQuery
+	args       *metricsFunnelResolverArgs
+}
+
+type metricsFunnelResolverArgs struct {
+	Priority      int        `mapstructure:"priority"`
+	ExecutionTime *time.Time `mapstructure:"execution_time"`
+}
+
+func newMetricsFunnel(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolver, error) {
+	qry := &metricsview.FunnelQuery{}
+	if err := mapstructureutil.WeakDecode(opts.Properties, qry); err != nil {
+		return nil, err
+	}
+
+	args := &metricsFunnelResolverArgs{}
+	if err := mapstructureutil.WeakDecode(opts.Args, args); err != nil {
+		return nil, err
+	}
+
+	ctrl, err := opts.Runtime.Controller(ctx, opts.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindMetricsView, Name: qry.MetricsView}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	mv := res.GetMetricsView().State.ValidSpec
+	if mv == nil {
+		return nil, fmt.Errorf("metrics view %q is invalid", res.Meta.Name.Name)
+	}
+
+	security, err := opts.Runtime.ResolveSecurity(opts.InstanceID, opts.Claims, res)
+	if err != nil {
+		return nil, err
+	}
+
+	if !security.CanAccess() {
+		return nil, runtime.ErrForbidden
+	}
+
+	executor, err := metricsview.NewExecutor(ctx, opts.Runtime, opts.InstanceID, mv, security, args.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metricsFunnelResolver{
+		runtime:    opts.Runtime,
+		instanceID: opts.InstanceID,
+		executor:   executor,
+		streaming:  res.GetMetricsView().State.Streaming,
+		query:      qry,
+		args:       args,
+	}, nil
+}
+
+func (r *metricsFunnelResolver) Close() error {
+	r.executor.Close()
+	return nil
+}
+
+func (r *metricsFunnelResolver) Cacheable() bool {
+	// Metrics views on models and sources are invalidated by their refresh state, so they can always be cached (see CacheKey).
+	return !r.streaming || r.executor.Cacheable(nil)
+}
+
+func (r *metricsFunnelResolver) CacheKey(ctx context.Context) (string, error) {
+	if !r.streaming {
+		return "", nil
+	}
+	return r.executor.CacheKey(ctx)
+}
+
+func (r *metricsFunnelResolver) Key() string {
+	hash, err := hashstructure.Hash(r.query, hashstructure.FormatV2, nil)
+	if err != nil {
+		panic(err)
+	}
+	return strconv.FormatUint(hash, 16)
+}
+
+func (r *metricsFunnelResolver) Refs() []*runtimev1.ResourceName {
+	return []*runtimev1.ResourceName{{Kind: runtime.ResourceKindMetricsView, Name: r.query.MetricsView}}
+}
+
+func (r *metricsFunnelResolver) Validate(ctx context.Context) error {
+	return r.query.Validate()
+}
+
+func (r *metricsFunnelResolver) ResolveInteractive(ctx context.Context) (runtime.ResolverResult, error) {
+	res, err := r.executor.Funnel(ctx, r.query, r.args.ExecutionTime)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.NewDriverResolverResult(res), nil
+}
+
+func (r *metricsFunnelResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
+	return errors.New("not implemented")
+}
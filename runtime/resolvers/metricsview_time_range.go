@@ -136,6 +136,10 @@ func (r *metricsViewTimeRangeResolver) ResolveInteractive(ctx context.Context) (
 		tr, err = r.resolveClickHouseAndPinot(ctx, olap, r.mv.TimeDimension, escapeMetricsViewTable(drivers.DialectClickHouse, r.mv), r.resolvedMVSecurity.RowFilter(), r.args.Priority)
 	case drivers.DialectPinot:
 		tr, err = r.resolveClickHouseAndPinot(ctx, olap, r.mv.TimeDimension, escapeMetricsViewTable(drivers.DialectPinot, r.mv), r.resolvedMVSecurity.RowFilter(), r.args.Priority)
+	case drivers.DialectPostgres:
+		tr, err = r.resolveClickHouseAndPinot(ctx, olap, r.mv.TimeDimension, escapeMetricsViewTable(drivers.DialectPostgres, r.mv), r.resolvedMVSecurity.RowFilter(), r.args.Priority)
+	case drivers.DialectStarRocks:
+		tr, err = r.resolveClickHouseAndPinot(ctx, olap, r.mv.TimeDimension, escapeMetricsViewTable(drivers.DialectStarRocks, r.mv), r.resolvedMVSecurity.RowFilter(), r.args.Priority)
 	default:
 		return nil, fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
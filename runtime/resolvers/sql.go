@@ -145,7 +145,7 @@ func (r *sqlResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runt
 			return queries.DuckDBCopyExport(ctx, w, exportOpts, r.sql, nil, filename, r.olap, opts.Format)
 		}
 		return r.generalExport(ctx, w, filename, exportOpts)
-	case drivers.DialectDruid, drivers.DialectClickHouse:
+	case drivers.DialectDruid, drivers.DialectClickHouse, drivers.DialectPostgres, drivers.DialectStarRocks:
 		return r.generalExport(ctx, w, filename, exportOpts)
 	default:
 		return fmt.Errorf("export not available for dialect %q", r.olap.Dialect().String())
@@ -24,6 +24,8 @@ type Options struct {
 	SystemConnectors             []*runtimev1.Connector
 	ConnectionCacheSize          int
 	QueryCacheSizeBytes          int64
+	QueryCacheBackend            QueryCacheBackend // Backend for caching resolver results. If nil, results are cached in memory.
+	QueryCacheTTL                time.Duration     // TTL for cached results of resolvers that read data managed outside of Rill. Defaults to 5 minutes.
 	SecurityEngineCacheSize      int
 	ControllerLogBufferCapacity  int
 	ControllerLogBufferSizeBytes int64
@@ -53,7 +55,7 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, ac *activity.Cl
 		opts:           opts,
 		Logger:         logger,
 		activity:       ac,
-		queryCache:     newQueryCache(opts.QueryCacheSizeBytes),
+		queryCache:     newQueryCache(opts.QueryCacheSizeBytes, opts.QueryCacheBackend, opts.QueryCacheTTL),
 		securityEngine: newSecurityEngine(opts.SecurityEngineCacheSize, logger),
 	}
 
@@ -382,15 +382,132 @@ func (s *Server) CreateTrigger(ctx context.Context, req *runtimev1.CreateTrigger
 	}
 
 	// Create the trigger resource
+	err = createRefreshTrigger(ctx, ctrl, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1.CreateTriggerResponse{}, nil
+}
+
+// AcknowledgeAlert implements runtimev1.RuntimeServiceServer
+func (s *Server) AcknowledgeAlert(ctx context.Context, req *runtimev1.AcknowledgeAlertRequest) (*runtimev1.AcknowledgeAlertResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.name", req.Name),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditTrigger) {
+		return nil, ErrForbidden
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Only failing or erroring alerts can be acknowledged
+	r, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: req.Name}, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, status.Error(codes.NotFound, "alert not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	a := r.GetAlert()
+	if len(a.State.ExecutionHistory) == 0 || a.State.ExecutionHistory[0].Result.Status == runtimev1.AssertionStatus_ASSERTION_STATUS_PASS {
+		return nil, status.Error(codes.FailedPrecondition, "alert is not failing")
+	}
+
+	err = createRefreshTrigger(ctx, ctrl, &runtimev1.RefreshTriggerSpec{
+		Alerts: []*runtimev1.RefreshAlertTrigger{{
+			Alert: req.Name,
+			StatusChange: &runtimev1.AlertStatusChange{
+				Acknowledge: true,
+				Actor:       alertActor(ctx, req.Actor),
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1.AcknowledgeAlertResponse{}, nil
+}
+
+// SnoozeAlert implements runtimev1.RuntimeServiceServer
+func (s *Server) SnoozeAlert(ctx context.Context, req *runtimev1.SnoozeAlertRequest) (*runtimev1.SnoozeAlertResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.name", req.Name),
+	)
+	if req.Until != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.until", req.Until.AsTime().String()))
+	}
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditTrigger) {
+		return nil, ErrForbidden
+	}
+
+	if req.Until != nil && !req.Until.AsTime().After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "snooze time must be in the future")
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: req.Name}, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, status.Error(codes.NotFound, "alert not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = createRefreshTrigger(ctx, ctrl, &runtimev1.RefreshTriggerSpec{
+		Alerts: []*runtimev1.RefreshAlertTrigger{{
+			Alert: req.Name,
+			StatusChange: &runtimev1.AlertStatusChange{
+				SnoozeUntil: req.Until,
+				Unsnooze:    req.Until == nil,
+				Actor:       alertActor(ctx, req.Actor),
+			},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimev1.SnoozeAlertResponse{}, nil
+}
+
+// createRefreshTrigger creates an ad-hoc refresh trigger resource, which will apply the spec asynchronously.
+func createRefreshTrigger(ctx context.Context, ctrl *runtime.Controller, spec *runtimev1.RefreshTriggerSpec) error {
 	name := fmt.Sprintf("trigger_adhoc_%s", time.Now().Format(timeLayoutUnseparated))
 	n := &runtimev1.ResourceName{Kind: runtime.ResourceKindRefreshTrigger, Name: name}
 	r := &runtimev1.Resource{Resource: &runtimev1.Resource_RefreshTrigger{RefreshTrigger: &runtimev1.RefreshTrigger{Spec: spec}}}
-	err = ctrl.Create(ctx, n, nil, nil, nil, true, r)
+	err := ctrl.Create(ctx, n, nil, nil, nil, true, r)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, fmt.Errorf("failed to create trigger: %w", err).Error())
+		return status.Error(codes.InvalidArgument, fmt.Errorf("failed to create trigger: %w", err).Error())
 	}
+	return nil
+}
 
-	return &runtimev1.CreateTriggerResponse{}, nil
+// alertActor returns an identifier of who is acknowledging or snoozing an alert.
+// It defaults to the caller's email attribute or token subject if an explicit actor is not provided.
+func alertActor(ctx context.Context, actor string) string {
+	if actor != "" {
+		return actor
+	}
+	claims := auth.GetClaims(ctx)
+	if email, ok := claims.SecurityClaims().UserAttributes["email"].(string); ok && email != "" {
+		return email
+	}
+	return claims.Subject()
 }
 
 // applySecurityPolicy applies relevant security policies to the resource.
@@ -0,0 +1,114 @@
+package testruntime
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	goruntime "runtime"
+	"strconv"
+	"testing"
+
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+
+	// Load the pgx database/sql driver
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// postgresTables are the tables loaded into the Postgres test container.
+// Each table is loaded from a CSV file in the ad_bids project's data directory, keeping the listed CSV columns.
+var postgresTables = []struct {
+	name    string
+	file    string
+	columns []string
+	ddl     string
+}{
+	{
+		name:    "ad_bids",
+		file:    "AdBids.csv.gz",
+		columns: []string{"id", "timestamp", "publisher", "domain", "bid_price"},
+		ddl:     `CREATE TABLE ad_bids (id BIGINT, "timestamp" TIMESTAMP, publisher VARCHAR, domain VARCHAR, bid_price DOUBLE PRECISION)`,
+	},
+	{
+		name:    "ad_bids_mini",
+		file:    "AdBids_mini.csv",
+		columns: []string{"id", "timestamp", "publisher", "domain", "volume", "impressions", "clicks"},
+		ddl:     `CREATE TABLE ad_bids_mini (id BIGINT, "timestamp" TIMESTAMP, publisher VARCHAR, domain VARCHAR, volume BIGINT, impressions BIGINT, clicks BIGINT)`,
+	},
+}
+
+// Postgres starts a Postgres container with the ad_bids test data loaded into the public schema.
+// It returns the database URL of the container.
+func Postgres(t *testing.T) string {
+	pg := pgtestcontainer.New(t)
+	t.Cleanup(func() { pg.Terminate(t) })
+
+	// The simple protocol lets Postgres coerce the CSV values (passed as strings) to the column types
+	db, err := sql.Open("pgx", pg.DatabaseURL+"?default_query_exec_mode=simple_protocol&timezone=UTC")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, currentFile, _, _ := goruntime.Caller(0)
+	dataDir := filepath.Join(currentFile, "..", "testdata", "ad_bids", "data")
+	for _, tbl := range postgresTables {
+		_, err = db.ExecContext(context.Background(), tbl.ddl)
+		require.NoError(t, err)
+		loadCSVTable(t, db, filepath.Join(dataDir, tbl.file), tbl.name, tbl.columns, func(n int) string { return "$" + strconv.Itoa(n) })
+	}
+
+	return pg.DatabaseURL
+}
+
+// NewInstanceWithPostgresProject creates an instance for the ad_bids_postgres project, which contains metrics views on the tables loaded by Postgres.
+func NewInstanceWithPostgresProject(t *testing.T) (*runtime.Runtime, string) {
+	databaseURL := Postgres(t)
+	rt := New(t)
+	_, currentFile, _, _ := goruntime.Caller(0)
+	projectPath := filepath.Join(currentFile, "..", "testdata", "ad_bids_postgres")
+
+	inst := &drivers.Instance{
+		Environment:      "test",
+		OLAPConnector:    "duckdb",
+		RepoConnector:    "repo",
+		CatalogConnector: "catalog",
+		Connectors: []*runtimev1.Connector{
+			{
+				Type:   "file",
+				Name:   "repo",
+				Config: map[string]string{"dsn": projectPath},
+			},
+			{
+				Type:   "postgres",
+				Name:   "postgres",
+				Config: map[string]string{"database_url": databaseURL},
+			},
+			{
+				Type: "sqlite",
+				Name: "catalog",
+				// Setting a test-specific name ensures a unique connection when "cache=shared" is enabled.
+				// "cache=shared" is needed to prevent threading problems.
+				Config: map[string]string{"dsn": fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())},
+			},
+		},
+		Variables: map[string]string{"rill.stage_changes": "false"},
+	}
+
+	err := rt.CreateInstance(context.Background(), inst)
+	require.NoError(t, err)
+	require.NotEmpty(t, inst.ID)
+
+	ctrl, err := rt.Controller(context.Background(), inst.ID)
+	require.NoError(t, err)
+
+	_, err = ctrl.Get(context.Background(), runtime.GlobalProjectParserName, false)
+	require.NoError(t, err)
+
+	err = ctrl.WaitUntilIdle(context.Background(), false)
+	require.NoError(t, err)
+
+	return rt, inst.ID
+}
@@ -34,6 +34,7 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/s3"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
+	_ "github.com/rilldata/rill/runtime/drivers/starrocks"
 	_ "github.com/rilldata/rill/runtime/reconcilers"
 )
 
@@ -88,6 +89,8 @@ type InstanceOptions struct {
 	Variables    map[string]string
 	WatchRepo    bool
 	StageChanges bool
+	// AdminDriver is the driver of the instance's admin connector, such as "mock_admin". If not set, the instance doesn't have an admin connector.
+	AdminDriver string
 }
 
 type InstanceOptionsForResolvers struct {
@@ -142,6 +145,10 @@ func NewInstanceWithOptions(t TestingT, opts InstanceOptions) (*runtime.Runtime,
 		Variables: vars,
 		WatchRepo: opts.WatchRepo,
 	}
+	if opts.AdminDriver != "" {
+		inst.AdminConnector = "admin"
+		inst.Connectors = append(inst.Connectors, &runtimev1.Connector{Type: opts.AdminDriver, Name: "admin"})
+	}
 
 	for path, data := range opts.Files {
 		abs := filepath.Join(tmpDir, path)
@@ -241,6 +248,10 @@ func NewInstanceForResolvers(t *testing.T, opts InstanceOptionsForResolvers) (*r
 		Variables: vars,
 		WatchRepo: opts.WatchRepo,
 	}
+	if opts.AdminDriver != "" {
+		inst.AdminConnector = "admin"
+		inst.Connectors = append(inst.Connectors, &runtimev1.Connector{Type: opts.AdminDriver, Name: "admin"})
+	}
 
 	for path, data := range opts.Files {
 		abs := filepath.Join(tmpDir, path)
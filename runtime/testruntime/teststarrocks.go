@@ -0,0 +1,227 @@
+package testruntime
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// starRocksTables are the tables loaded into the StarRocks test container.
+// Each table is loaded from a CSV file in the ad_bids project's data directory, keeping the listed CSV columns.
+var starRocksTables = []struct {
+	name    string
+	file    string
+	columns []string
+	ddl     string
+}{
+	{
+		name:    "ad_bids",
+		file:    "AdBids.csv.gz",
+		columns: []string{"id", "timestamp", "publisher", "domain", "bid_price"},
+		ddl:     "CREATE TABLE ad_bids (id BIGINT, `timestamp` DATETIME, publisher VARCHAR(256), domain VARCHAR(256), bid_prices DOUBLE) DUPLICATE KEY(id) DISTRIBUTED BY HASH(id) BUCKETS 1 PROPERTIES ('replication_num' = '1')",
+	},
+	{
+		name:    "ad_bids_mini",
+		file:    "AdBids_mini.csv",
+		columns: []string{"id", "timestamp", "publisher", "domain", "volume", "impressions", "clicks"},
+		ddl:     "CREATE TABLE ad_bids_mini (id BIGINT, `timestamp` DATETIME, publisher VARCHAR(256), domain VARCHAR(256), volume BIGINT, impressions BIGINT, clicks BIGINT) DUPLICATE KEY(id) DISTRIBUTED BY HASH(id) BUCKETS 1 PROPERTIES ('replication_num' = '1')",
+	},
+}
+
+// StarRocks starts a single-node StarRocks cluster with the ad_bids test data loaded into the "rill" database.
+// It returns a MySQL DSN for the cluster's frontend.
+func StarRocks(t TestingT) string {
+	ctx := context.Background()
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		Started: true,
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "starrocks/allin1-ubuntu:3.3.5",
+			ExposedPorts: []string{"9030/tcp"},
+			WaitingFor:   wait.ForListeningPort("9030/tcp").WithStartupTimeout(5 * time.Minute),
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(ctx))
+	})
+
+	host, err := container.Host(ctx)
+	require.NoError(t, err)
+	port, err := container.MappedPort(ctx, "9030/tcp")
+	require.NoError(t, err)
+
+	conf := mysql.NewConfig()
+	conf.Net = "tcp"
+	conf.Addr = fmt.Sprintf("%s:%d", host, port.Int())
+	conf.User = "root"
+	conf.ParseTime = true
+	conf.InterpolateParams = true
+	db, err := sql.Open("mysql", conf.FormatDSN())
+	require.NoError(t, err)
+	defer db.Close()
+
+	// The frontend accepts connections before the backend has registered, so we retry until we can create a table
+	deadline := time.Now().Add(5 * time.Minute)
+	for {
+		_, err = db.ExecContext(ctx, "CREATE DATABASE IF NOT EXISTS rill")
+		if err == nil {
+			_, err = db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS rill.ready (id INT) DISTRIBUTED BY HASH(id) BUCKETS 1 PROPERTIES ('replication_num' = '1')")
+		}
+		if err == nil || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(5 * time.Second)
+	}
+	require.NoError(t, err)
+
+	_, currentFile, _, _ := goruntime.Caller(0)
+	dataDir := filepath.Join(currentFile, "..", "testdata", "ad_bids", "data")
+	for _, tbl := range starRocksTables {
+		_, err = db.ExecContext(ctx, "USE rill")
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, tbl.ddl)
+		require.NoError(t, err)
+		loadCSVTable(t, db, filepath.Join(dataDir, tbl.file), "rill."+tbl.name, tbl.columns, func(int) string { return "?" })
+	}
+
+	conf.DBName = "rill"
+	return conf.FormatDSN()
+}
+
+// loadCSVTable inserts the given columns of a CSV file into a table with batched INSERT statements.
+// Empty values are inserted as NULL. The placeholder function returns the bind parameter for the n-th arg (starting at 1).
+func loadCSVTable(t TestingT, db *sql.DB, path, table string, columns []string, placeholder func(n int) string) {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gr, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		defer gr.Close()
+		r = gr
+	}
+
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	require.NoError(t, err)
+	idx := make([]int, len(columns))
+	for i, col := range columns {
+		idx[i] = -1
+		for j, h := range header {
+			if h == col {
+				idx[i] = j
+			}
+		}
+		require.NotEqual(t, -1, idx[i], "column %q not found in %q", col, path)
+	}
+
+	const batchSize = 5000
+	var placeholders []string
+	var args []any
+	flush := func() {
+		if len(placeholders) == 0 {
+			return
+		}
+		_, err := db.ExecContext(context.Background(), fmt.Sprintf("INSERT INTO %s VALUES %s", table, strings.Join(placeholders, ",")), args...)
+		require.NoError(t, err)
+		placeholders = placeholders[:0]
+		args = args[:0]
+	}
+
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		rowPlaceholders := make([]string, len(idx))
+		for i, j := range idx {
+			rowPlaceholders[i] = placeholder(len(args) + 1)
+			v := rec[j]
+			switch {
+			case v == "":
+				args = append(args, nil)
+			case columns[i] == "timestamp":
+				ts, err := time.Parse(time.RFC3339, v)
+				require.NoError(t, err)
+				args = append(args, ts)
+			default:
+				args = append(args, v)
+			}
+		}
+		placeholders = append(placeholders, "("+strings.Join(rowPlaceholders, ",")+")")
+		if len(placeholders) == batchSize {
+			flush()
+		}
+	}
+	flush()
+}
+
+// NewInstanceWithStarRocksProject creates an instance for the ad_bids_starrocks project, which contains metrics views on the tables loaded by StarRocks.
+func NewInstanceWithStarRocksProject(t TestingT) (*runtime.Runtime, string) {
+	dsn := StarRocks(t)
+	rt := New(t)
+	_, currentFile, _, _ := goruntime.Caller(0)
+	projectPath := filepath.Join(currentFile, "..", "testdata", "ad_bids_starrocks")
+
+	inst := &drivers.Instance{
+		Environment:      "test",
+		OLAPConnector:    "duckdb",
+		RepoConnector:    "repo",
+		CatalogConnector: "catalog",
+		Connectors: []*runtimev1.Connector{
+			{
+				Type:   "file",
+				Name:   "repo",
+				Config: map[string]string{"dsn": projectPath},
+			},
+			{
+				Type:   "starrocks",
+				Name:   "starrocks",
+				Config: map[string]string{"dsn": dsn},
+			},
+			{
+				Type: "sqlite",
+				Name: "catalog",
+				// Setting a test-specific name ensures a unique connection when "cache=shared" is enabled.
+				// "cache=shared" is needed to prevent threading problems.
+				Config: map[string]string{"dsn": fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())},
+			},
+		},
+		Variables: map[string]string{"rill.stage_changes": "false"},
+	}
+
+	err := rt.CreateInstance(context.Background(), inst)
+	require.NoError(t, err)
+	require.NotEmpty(t, inst.ID)
+
+	ctrl, err := rt.Controller(context.Background(), inst.ID)
+	require.NoError(t, err)
+
+	_, err = ctrl.Get(context.Background(), runtime.GlobalProjectParserName, false)
+	require.NoError(t, err)
+
+	err = ctrl.WaitUntilIdle(context.Background(), false)
+	require.NoError(t, err)
+
+	return rt, inst.ID
+}